@@ -0,0 +1,139 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultCPUProfileDuration is how long /debug/pprof/profile samples when no seconds are given.
+const defaultCPUProfileDuration = 30 * time.Second
+
+// AdminHandler returns an http.Handler serving the operational endpoints of m under one mux:
+//   - /healthz: responds 200 "ok" while the process is serving
+//   - /debug/loglevel: GET reports the current log level; PUT or POST with a "level" form value
+//     or JSON body {"level": "debug"} changes it through Reload
+//   - /debug/config: the DebugInfo report, as served by DebugHandler
+//   - /debug/metrics: the current instrument values as text, as served by MetricsDebugHandler
+//   - /debug/pprof/: runtime profiles (heap, goroutine, allocs, ...), and
+//     /debug/pprof/profile?seconds=N for a CPU profile, readable by "go tool pprof"
+//
+// Metrics are pushed to the configured exporters, so no Prometheus /metrics endpoint is mounted.
+// The profiles are served without importing net/http/pprof, which would register them on
+// http.DefaultServeMux as a side effect. Mount the handler on an internal port only: it changes
+// the log level and exposes profiling data without authentication.
+//
+// Example:
+//
+//	go func() {
+//	    _ = http.ListenAndServe("localhost:9090", mon.AdminHandler())
+//	}()
+func (m *Monitoring) AdminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc("/debug/loglevel", m.serveLogLevel)
+	mux.Handle("/debug/config", m.DebugHandler())
+	mux.Handle("/debug/metrics", m.MetricsDebugHandler())
+	mux.HandleFunc("/debug/pprof/", servePprofProfile)
+	mux.HandleFunc("/debug/pprof/profile", servePprofCPU)
+	return mux
+}
+
+// serveLogLevel reports the current log level on GET and changes it on PUT or POST.
+func (m *Monitoring) serveLogLevel(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPost:
+		level := r.FormValue("level")
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			var body struct {
+				Level string `json:"level"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			level = body.Level
+		}
+		if err := m.Reload(WithLoggerLevel(level)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, PUT, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"level": m.DebugInfo().Logger.Level})
+}
+
+// servePprofProfile writes the runtime profile named by the path after /debug/pprof/, or an
+// index of the available profiles for the bare path. A non-zero "debug" query value selects
+// the text format instead of the protobuf one.
+func servePprofProfile(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/debug/pprof/")
+	if name == "" {
+		profiles := pprof.Profiles()
+		sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name() < profiles[j].Name() })
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		for _, profile := range profiles {
+			fmt.Fprintf(w, "%s\t%d\n", profile.Name(), profile.Count())
+		}
+		fmt.Fprintln(w, "profile\tCPU profile, ?seconds=N")
+		return
+	}
+
+	profile := pprof.Lookup(name)
+	if profile == nil {
+		http.Error(w, "unknown profile: "+name, http.StatusNotFound)
+		return
+	}
+	debug, _ := strconv.Atoi(r.FormValue("debug"))
+	if debug != 0 {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	}
+	_ = profile.WriteTo(w, debug)
+}
+
+// servePprofCPU writes a CPU profile sampled for the "seconds" query value, 30 by default.
+// The request context cancels the sampling early.
+func servePprofCPU(w http.ResponseWriter, r *http.Request) {
+	duration := defaultCPUProfileDuration
+	if raw := r.FormValue("seconds"); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds <= 0 {
+			http.Error(w, "seconds must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="profile"`)
+	if err := pprof.StartCPUProfile(w); err != nil {
+		// Headers are not written yet, so the error can still be reported.
+		w.Header().Del("Content-Disposition")
+		http.Error(w, "could not start CPU profile: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-r.Context().Done():
+	}
+	pprof.StopCPUProfile()
+}
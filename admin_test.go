@@ -0,0 +1,102 @@
+package monitoring
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMonitoring_Admin_AdminHandler(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	handler := mon.AdminHandler()
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+		wantStatus  int
+		wantBody    string
+		wantLevel   string
+	}{
+		{name: "healthz", method: http.MethodGet, target: "/healthz", wantStatus: http.StatusOK, wantBody: "ok"},
+		{name: "config", method: http.MethodGet, target: "/debug/config", wantStatus: http.StatusOK, wantBody: `"service.name": "test-service"`},
+		{name: "metrics", method: http.MethodGet, target: "/debug/metrics", wantStatus: http.StatusOK},
+		{name: "get log level", method: http.MethodGet, target: "/debug/loglevel", wantStatus: http.StatusOK, wantBody: `{"level":"info"}`},
+		{
+			name:       "set log level from form",
+			method:     http.MethodPut,
+			target:     "/debug/loglevel?level=debug",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"level":"debug"}`,
+			wantLevel:  "debug",
+		},
+		{
+			name:        "set log level from JSON",
+			method:      http.MethodPost,
+			target:      "/debug/loglevel",
+			contentType: "application/json",
+			body:        `{"level": "warn"}`,
+			wantStatus:  http.StatusOK,
+			wantBody:    `{"level":"warn"}`,
+			wantLevel:   "warn",
+		},
+		{
+			name:       "invalid log level",
+			method:     http.MethodPut,
+			target:     "/debug/loglevel?level=verbose",
+			wantStatus: http.StatusBadRequest,
+			wantLevel:  "warn",
+		},
+		{name: "log level method", method: http.MethodDelete, target: "/debug/loglevel", wantStatus: http.StatusMethodNotAllowed},
+		{name: "pprof index", method: http.MethodGet, target: "/debug/pprof/", wantStatus: http.StatusOK, wantBody: "goroutine"},
+		{name: "pprof text profile", method: http.MethodGet, target: "/debug/pprof/goroutine?debug=1", wantStatus: http.StatusOK, wantBody: "goroutine profile"},
+		{name: "unknown profile", method: http.MethodGet, target: "/debug/pprof/unknown", wantStatus: http.StatusNotFound},
+		{name: "invalid CPU profile duration", method: http.MethodGet, target: "/debug/pprof/profile?seconds=0", wantStatus: http.StatusBadRequest},
+		{name: "no metrics endpoint", method: http.MethodGet, target: "/metrics", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("%s %s status = %d, want %d (body %q)", tt.method, tt.target, recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+			if !strings.Contains(recorder.Body.String(), tt.wantBody) {
+				t.Errorf("%s %s body = %q, want it to contain %q", tt.method, tt.target, recorder.Body.String(), tt.wantBody)
+			}
+			if tt.wantLevel != "" && !mon.Logger.Enabled(tt.wantLevel) {
+				t.Errorf("expected the logger to write %s entries", tt.wantLevel)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Admin_AdminHandler_CPUProfile(t *testing.T) {
+	mon := &Monitoring{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // stop sampling immediately
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/pprof/profile?seconds=1", nil).WithContext(ctx)
+	recorder := httptest.NewRecorder()
+	mon.AdminHandler().ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK || recorder.Body.Len() == 0 {
+		t.Errorf("CPU profile status = %d with %d bytes, want 200 with a profile", recorder.Code, recorder.Body.Len())
+	}
+}
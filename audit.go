@@ -0,0 +1,27 @@
+package monitoring
+
+import (
+	"io"
+
+	"github.com/adityakw90/go-monitoring/internal/logger"
+)
+
+// VerifyAuditLog reads the audit records written by Logger.Audit from r and reports whether
+// they are intact: their sequence numbers must be consecutive and, when hmacKey is set, every
+// record must carry the HMAC it was written with under the key given to WithLoggerAudit.
+// The log may start at any record, e.g. after rotation. It returns an error wrapping
+// ErrLoggerAuditTampered for the first record failing a check.
+//
+// Example:
+//
+//	f, err := os.Open("/var/log/app/audit.log")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//	if err := monitoring.VerifyAuditLog(f, key); err != nil {
+//	    log.Printf("audit log check failed: %v", err)
+//	}
+func VerifyAuditLog(r io.Reader, hmacKey []byte) error {
+	return logger.VerifyAudit(r, hmacKey)
+}
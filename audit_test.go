@@ -0,0 +1,53 @@
+package monitoring
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMonitoring_Audit_VerifyAuditLog(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.log")
+	key := []byte("secret")
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithLoggerOutputPath(filepath.Join(dir, "app.log")),
+		WithLoggerAudit(path, key),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	for _, event := range []string{"user.created", "user.deleted"} {
+		if err := mon.Logger.Audit(event, map[string]interface{}{"actor": "admin"}); err != nil {
+			t.Fatalf("Audit(%q) error = %v", event, err)
+		}
+	}
+	if err := mon.Logger.Sync(); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if err := VerifyAuditLog(strings.NewReader(string(data)), key); err != nil {
+		t.Errorf("VerifyAuditLog() = %v, want nil", err)
+	}
+	tampered := strings.Replace(string(data), `"actor":"admin"`, `"actor":"other"`, 1)
+	if err := VerifyAuditLog(strings.NewReader(tampered), key); !errors.Is(err, ErrLoggerAuditTampered) {
+		t.Errorf("VerifyAuditLog() of an edited log = %v, want %v", err, ErrLoggerAuditTampered)
+	}
+}
+
+func TestMonitoring_Audit_NotConfigured(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"), WithLoggerOutputPath(filepath.Join(t.TempDir(), "app.log")))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	if err := mon.Logger.Audit("user.deleted", nil); !errors.Is(err, ErrLoggerAuditNotConfigured) {
+		t.Errorf("Audit() = %v, want %v", err, ErrLoggerAuditNotConfigured)
+	}
+}
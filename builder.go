@@ -0,0 +1,86 @@
+package monitoring
+
+import "time"
+
+// Builder configures a Monitoring with chained method calls, as an alternative to passing
+// functional options to NewMonitoring. Every method records the equivalent Option, so a
+// Builder produces exactly the same Options as the matching NewMonitoring call. Options that
+// have no dedicated method can be added with With.
+//
+// A Builder is not safe for concurrent use.
+//
+// Example:
+//
+//	mon, err := NewBuilder().
+//	    Service("my-service").
+//	    Env("production").
+//	    Tracer("otlp", "localhost", 4317).
+//	    Metric("otlp", "localhost", 4317).
+//	    With(WithTracerInsecure(true), WithMetricInsecure(true)).
+//	    Build()
+type Builder struct {
+	opts []Option
+}
+
+// NewBuilder returns an empty Builder. Unset settings keep the same defaults as NewMonitoring.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Service sets the service name (required). See WithServiceName.
+func (b *Builder) Service(name string) *Builder {
+	return b.With(WithServiceName(name))
+}
+
+// Env sets the deployment environment. See WithEnvironment.
+func (b *Builder) Env(env string) *Builder {
+	return b.With(WithEnvironment(env))
+}
+
+// Instance sets the instance name and host. See WithInstance.
+func (b *Builder) Instance(name, host string) *Builder {
+	return b.With(WithInstance(name, host))
+}
+
+// Logger sets the log level and output path; an empty path writes to stdout.
+// See WithLoggerLevel and WithLoggerOutputPath.
+func (b *Builder) Logger(level, outputPath string) *Builder {
+	return b.With(WithLoggerLevel(level), WithLoggerOutputPath(outputPath))
+}
+
+// Tracer sets the trace exporter and its collector endpoint. See WithTracerProvider.
+func (b *Builder) Tracer(provider, host string, port int) *Builder {
+	return b.With(WithTracerProvider(provider, host, port))
+}
+
+// SampleRatio sets the trace sampling ratio. See WithTracerSampleRatio.
+func (b *Builder) SampleRatio(ratio float64) *Builder {
+	return b.With(WithTracerSampleRatio(ratio))
+}
+
+// Metric sets the metric exporter and its collector endpoint. See WithMetricProvider.
+func (b *Builder) Metric(provider, host string, port int) *Builder {
+	return b.With(WithMetricProvider(provider, host, port))
+}
+
+// MetricInterval sets the time between metric exports. See WithMetricInterval.
+func (b *Builder) MetricInterval(interval time.Duration) *Builder {
+	return b.With(WithMetricInterval(interval))
+}
+
+// With adds functional options to the builder, applied in order after the options already set.
+func (b *Builder) With(opts ...Option) *Builder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Options returns the Options the builder would pass to NewMonitoring, e.g. to call
+// Options.Validate before building.
+func (b *Builder) Options() *Options {
+	return parseOptions(b.opts...)
+}
+
+// Build creates the Monitoring. It is equivalent to calling NewMonitoring with the builder's options.
+func (b *Builder) Build() (*Monitoring, error) {
+	return NewMonitoring(b.opts...)
+}
@@ -0,0 +1,81 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMonitoring_Builder_Options(t *testing.T) {
+	got := NewBuilder().
+		Service("test-service").
+		Env("production").
+		Instance("instance-1", "localhost").
+		Logger("debug", "").
+		Tracer("otlp", "localhost", 4317).
+		SampleRatio(0.5).
+		Metric("otlp", "localhost", 4318).
+		MetricInterval(30 * time.Second).
+		With(WithTracerInsecure(true)).
+		Options()
+
+	want := parseOptions(
+		WithServiceName("test-service"),
+		WithEnvironment("production"),
+		WithInstance("instance-1", "localhost"),
+		WithLoggerLevel("debug"),
+		WithLoggerOutputPath(""),
+		WithTracerProvider("otlp", "localhost", 4317),
+		WithTracerSampleRatio(0.5),
+		WithMetricProvider("otlp", "localhost", 4318),
+		WithMetricInterval(30*time.Second),
+		WithTracerInsecure(true),
+	)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Builder.Options() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMonitoring_Builder_Build(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *Builder
+		wantErr error
+	}{
+		{
+			name:    "success",
+			builder: NewBuilder().Service("test-service"),
+		},
+		{
+			name:    "missing service name",
+			builder: NewBuilder().Env("production"),
+			wantErr: ErrServiceNameRequired,
+		},
+		{
+			name:    "invalid tracer provider",
+			builder: NewBuilder().Service("test-service").Tracer("invalid", "", 0),
+			wantErr: ErrTracerInvalidProvider,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := tt.builder.Build()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Build() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			if err := mon.Shutdown(context.Background()); err != nil {
+				t.Errorf("Shutdown() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ConnectionOptions configures a ConnectionTracker.
+type ConnectionOptions struct {
+	MessageSpans       bool // MessageSpans starts a span for every message received or sent. If false, messages are only counted.
+	LinkedMessageSpans bool // LinkedMessageSpans starts message spans as new traces linked to the connection span instead of as its children, so a connection open for hours does not grow one unbounded trace.
+}
+
+// ConnectionOption is a function that configures ConnectionOptions.
+type ConnectionOption func(*ConnectionOptions)
+
+// WithMessageSpans returns a ConnectionOption that sets whether every message received or sent
+// gets its own span.
+func WithMessageSpans(enabled bool) ConnectionOption {
+	return func(o *ConnectionOptions) {
+		o.MessageSpans = enabled
+	}
+}
+
+// WithLinkedMessageSpans returns a ConnectionOption that sets whether message spans start new
+// traces linked to the connection span instead of being its children. It implies
+// WithMessageSpans(true) when enabled.
+func WithLinkedMessageSpans(enabled bool) ConnectionOption {
+	return func(o *ConnectionOptions) {
+		o.LinkedMessageSpans = enabled
+		if enabled {
+			o.MessageSpans = true
+		}
+	}
+}
+
+// ConnectionTracker instruments long-lived connections such as WebSockets, server-sent event
+// streams, and gRPC streams. Every connection opened with Open gets a span lasting as long as
+// the connection, and its traffic is recorded in shared metrics labelled with the tracker name:
+//   - connections_active: gauge of the connections currently open
+//   - messages_received_total: counter of messages received, also labelled with the message name
+//   - messages_sent_total: counter of messages sent, also labelled with the message name
+//
+// A ConnectionTracker is safe for concurrent use.
+type ConnectionTracker struct {
+	name       string
+	monitoring *Monitoring
+	options    *ConnectionOptions
+	open       atomic.Int64
+	active     otelmetric.Int64Gauge
+	received   otelmetric.Int64Counter
+	sent       otelmetric.Int64Counter
+}
+
+// NewConnectionTracker creates a ConnectionTracker for the connections with the given name.
+// The connection metrics are created once here so that connections only record values.
+//
+// Parameters:
+//   - name: The name of the connections (e.g., "chat-websocket")
+//   - opts: Optional settings (WithMessageSpans, WithLinkedMessageSpans)
+//
+// Returns an error if any of the connection metrics cannot be created.
+//
+// Example:
+//
+//	chat, err := mon.NewConnectionTracker("chat-websocket", monitoring.WithLinkedMessageSpans(true))
+//	if err != nil {
+//	    return err
+//	}
+func (m *Monitoring) NewConnectionTracker(name string, opts ...ConnectionOption) (*ConnectionTracker, error) {
+	options := &ConnectionOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	t := &ConnectionTracker{
+		name:       name,
+		monitoring: m,
+		options:    options,
+	}
+	if m.Metric == nil {
+		return t, nil
+	}
+
+	var err error
+	if t.active, err = m.Metric.CreateGauge("connections_active", "1", "Number of connections currently open"); err != nil {
+		return nil, err
+	}
+	if t.received, err = m.Metric.CreateCounter("messages_received_total", "1", "Total number of messages received on connections"); err != nil {
+		return nil, err
+	}
+	if t.sent, err = m.Metric.CreateCounter("messages_sent_total", "1", "Total number of messages sent on connections"); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Open records a new connection and starts its span, named after the tracker. Call Close on
+// the returned Connection when the connection ends.
+//
+// Parameters:
+//   - ctx: The context the connection was accepted in, e.g. of the upgrade request
+//
+// Returns:
+//   - A new context containing the connection span
+//   - The Connection recording its messages
+//
+// Example:
+//
+//	func serveChat(w http.ResponseWriter, r *http.Request) {
+//	    conn, err := upgrader.Upgrade(w, r, nil)
+//	    if err != nil {
+//	        return
+//	    }
+//	    ctx, tracked := chat.Open(r.Context())
+//	    defer func() { tracked.Close(err) }()
+//	    for {
+//	        var msg Message
+//	        if err = conn.ReadJSON(&msg); err != nil {
+//	            return
+//	        }
+//	        _, done := tracked.Received(msg.Type)
+//	        done(handle(ctx, msg))
+//	    }
+//	}
+func (t *ConnectionTracker) Open(ctx context.Context) (context.Context, *Connection) {
+	tracer := t.monitoring.tracerOrNoop()
+	ctx, span := tracer.StartSpan(ctx, t.name, trace.WithAttributes(attribute.String("connection", t.name)))
+	c := &Connection{tracker: t, ctx: ctx, span: span}
+	t.recordActive(ctx, t.open.Add(1))
+	return ctx, c
+}
+
+// recordActive records open as the number of active connections.
+func (t *ConnectionTracker) recordActive(ctx context.Context, open int64) {
+	if t.monitoring.Metric == nil || t.active == nil {
+		return
+	}
+	t.monitoring.Metric.RecordGauge(ctx, t.active, open, attribute.String("connection", t.name))
+}
+
+// Connection is a connection opened with ConnectionTracker.Open.
+// A Connection is safe for concurrent use.
+type Connection struct {
+	tracker *ConnectionTracker
+	ctx     context.Context
+	span    trace.Span
+	close   sync.Once
+}
+
+// Received records a message received on the connection. With message spans enabled, it
+// starts a consumer span named after the message; otherwise the returned context is the
+// connection context. Call done with the error of handling the message, if any.
+//
+// Parameters:
+//   - name: The name of the message, e.g. its type; keep it low-cardinality
+//
+// Returns:
+//   - A context containing the message span, if any
+//   - A function that records the handling error and ends the message span
+func (c *Connection) Received(name string) (context.Context, func(err error)) {
+	return c.message(name, trace.SpanKindConsumer, c.tracker.received)
+}
+
+// Sent records a message sent on the connection. With message spans enabled, it starts a
+// producer span named after the message; otherwise the returned context is the connection
+// context. Call done with the error of sending the message, if any.
+//
+// Parameters:
+//   - name: The name of the message, e.g. its type; keep it low-cardinality
+//
+// Returns:
+//   - A context containing the message span, if any
+//   - A function that records the send error and ends the message span
+func (c *Connection) Sent(name string) (context.Context, func(err error)) {
+	return c.message(name, trace.SpanKindProducer, c.tracker.sent)
+}
+
+// message counts a message in counter and starts its span when message spans are enabled.
+func (c *Connection) message(name string, kind trace.SpanKind, counter otelmetric.Int64Counter) (context.Context, func(err error)) {
+	t := c.tracker
+	m := t.monitoring
+	if m.Metric != nil && counter != nil {
+		m.Metric.RecordCounter(c.ctx, counter, 1, attribute.String("connection", t.name), attribute.String("message", name))
+	}
+	if !t.options.MessageSpans {
+		return c.ctx, func(error) {}
+	}
+
+	tracer := m.tracerOrNoop()
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(attribute.String("connection", t.name), attribute.String("message", name)),
+	}
+	var ctx context.Context
+	var span trace.Span
+	if t.options.LinkedMessageSpans {
+		opts = append(opts, trace.WithNewRoot())
+		ctx, span = tracer.StartSpanWithLinks(c.ctx, name, []trace.SpanContext{c.span.SpanContext()}, opts...)
+	} else {
+		ctx, span = tracer.StartSpan(c.ctx, name, opts...)
+	}
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		tracer.EndSpan(span)
+	}
+}
+
+// Close records the end of the connection and ends its span, marking it as failed when err is
+// not nil. Closing a connection more than once has no further effect.
+//
+// Parameters:
+//   - err: The error that ended the connection, or nil for a normal close
+func (c *Connection) Close(err error) {
+	c.close.Do(func() {
+		if err != nil {
+			c.span.RecordError(err)
+			c.span.SetStatus(codes.Error, err.Error())
+		}
+		c.tracker.monitoring.tracerOrNoop().EndSpan(c.span)
+		c.tracker.recordActive(c.ctx, c.tracker.open.Add(-1))
+	})
+}
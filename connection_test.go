@@ -0,0 +1,149 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// connectionMetric records the gauge values and the counter increments with their labels.
+type connectionMetric struct {
+	Metric
+	mu       sync.Mutex
+	gauges   []int64
+	counters [][]attribute.KeyValue
+}
+
+func (c *connectionMetric) RecordGauge(ctx context.Context, gauge otelmetric.Int64Gauge, value int64, labels ...attribute.KeyValue) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gauges = append(c.gauges, value)
+}
+
+func (c *connectionMetric) RecordCounter(ctx context.Context, counter otelmetric.Int64Counter, value int64, labels ...attribute.KeyValue) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counters = append(c.counters, labels)
+}
+
+func TestMonitoring_Connection_ConnectionTracker(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	metric := &connectionMetric{Metric: mon.Metric}
+	mon.Metric = metric
+
+	tracker, err := mon.NewConnectionTracker("chat-websocket")
+	if err != nil {
+		t.Fatalf("NewConnectionTracker() error = %v", err)
+	}
+	ctx, first := tracker.Open(context.Background())
+	_, second := tracker.Open(context.Background())
+
+	msgCtx, done := first.Received("chat.message")
+	done(nil)
+	if trace.SpanFromContext(msgCtx) != trace.SpanFromContext(ctx) {
+		t.Error("Received() started a span although message spans are disabled")
+	}
+	_, done = first.Sent("chat.ack")
+	done(nil)
+
+	first.Close(nil)
+	first.Close(nil)
+	second.Close(errors.New("connection reset"))
+
+	if want := []int64{1, 2, 1, 0}; len(metric.gauges) != len(want) || metric.gauges[0] != 1 || metric.gauges[1] != 2 || metric.gauges[2] != 1 || metric.gauges[3] != 0 {
+		t.Errorf("connections_active values = %v, want %v", metric.gauges, want)
+	}
+	if len(metric.counters) != 2 {
+		t.Fatalf("message counters incremented %d times, want 2", len(metric.counters))
+	}
+	wantLabels := []attribute.KeyValue{attribute.String("connection", "chat-websocket"), attribute.String("message", "chat.message")}
+	if labels := metric.counters[0]; len(labels) != 2 || labels[0] != wantLabels[0] || labels[1] != wantLabels[1] {
+		t.Errorf("messages_received_total labels = %v, want %v", labels, wantLabels)
+	}
+
+	ro := trace.SpanFromContext(ctx).(sdktrace.ReadOnlySpan)
+	if ro.Name() != "chat-websocket" || ro.EndTime().IsZero() {
+		t.Errorf("connection span = %q ended %v, want an ended chat-websocket span", ro.Name(), ro.EndTime())
+	}
+	if ro.Status().Code != codes.Unset {
+		t.Errorf("connection span status = %v, want unset for a normal close", ro.Status().Code)
+	}
+	if second.span.(sdktrace.ReadOnlySpan).Status().Code != codes.Error {
+		t.Error("connection span closed with an error is not marked as failed")
+	}
+}
+
+func TestMonitoring_Connection_MessageSpans(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       []ConnectionOption
+		wantLinked bool
+	}{
+		{name: "child spans", opts: []ConnectionOption{WithMessageSpans(true)}},
+		{name: "linked spans", opts: []ConnectionOption{WithLinkedMessageSpans(true)}, wantLinked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(WithServiceName("test-service"))
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+
+			tracker, err := mon.NewConnectionTracker("chat-websocket", tt.opts...)
+			if err != nil {
+				t.Fatalf("NewConnectionTracker() error = %v", err)
+			}
+			ctx, conn := tracker.Open(context.Background())
+			defer conn.Close(nil)
+			connSpan := trace.SpanFromContext(ctx).SpanContext()
+
+			msgCtx, done := conn.Received("chat.message")
+			done(errors.New("invalid payload"))
+
+			ro := trace.SpanFromContext(msgCtx).(sdktrace.ReadOnlySpan)
+			if ro.Name() != "chat.message" || ro.SpanKind() != trace.SpanKindConsumer {
+				t.Errorf("message span = %q (%v), want a chat.message consumer span", ro.Name(), ro.SpanKind())
+			}
+			if ro.Status().Code != codes.Error {
+				t.Errorf("message span status = %v, want error", ro.Status().Code)
+			}
+			linked := len(ro.Links()) == 1 && ro.Links()[0].SpanContext.SpanID() == connSpan.SpanID()
+			if linked != tt.wantLinked {
+				t.Errorf("message span linked to the connection = %v, want %v", linked, tt.wantLinked)
+			}
+			isChild := ro.Parent().SpanID() == connSpan.SpanID()
+			if isChild == tt.wantLinked {
+				t.Errorf("message span child of the connection = %v, want %v", isChild, !tt.wantLinked)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Connection_WithoutComponents(t *testing.T) {
+	mon := &Monitoring{}
+	tracker, err := mon.NewConnectionTracker("chat-websocket", WithMessageSpans(true))
+	if err != nil {
+		t.Fatalf("NewConnectionTracker() error = %v", err)
+	}
+	_, conn := tracker.Open(context.Background())
+	_, done := conn.Sent("chat.message")
+	done(nil)
+	conn.Close(nil)
+}
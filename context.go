@@ -0,0 +1,76 @@
+package monitoring
+
+import "context"
+
+// contextKey is the unexported type of the context key holding a Monitoring, so it cannot
+// collide with keys defined in other packages.
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying m, so frameworks can pass the monitoring bundle
+// through request contexts instead of globals.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - m: The Monitoring to store
+//
+// Example:
+//
+//	func middleware(mon *Monitoring, next http.Handler) http.Handler {
+//	    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//	        next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), mon)))
+//	    })
+//	}
+func NewContext(ctx context.Context, m *Monitoring) context.Context {
+	return context.WithValue(ctx, contextKey{}, m)
+}
+
+// FromContext returns the Monitoring stored in ctx by NewContext.
+// The boolean is false when ctx carries no Monitoring.
+//
+// Example:
+//
+//	if mon, ok := FromContext(ctx); ok {
+//	    mon.Logger.Info("Handling request", nil)
+//	}
+func FromContext(ctx context.Context) (*Monitoring, bool) {
+	m, ok := ctx.Value(contextKey{}).(*Monitoring)
+	return m, ok && m != nil
+}
+
+// LoggerFromContext returns the Logger of the Monitoring stored in ctx.
+// It returns a noop Logger when ctx carries no Monitoring or the Monitoring has no Logger,
+// so callers never need a nil check.
+//
+// Example:
+//
+//	LoggerFromContext(ctx).Info("Order created", map[string]interface{}{"order_id": id})
+func LoggerFromContext(ctx context.Context) Logger {
+	m, _ := FromContext(ctx)
+	return m.loggerOrNoop()
+}
+
+// TracerFromContext returns the Tracer of the Monitoring stored in ctx.
+// It returns a noop Tracer when ctx carries no Monitoring or the Monitoring has no Tracer,
+// so callers never need a nil check.
+//
+// Example:
+//
+//	tr := TracerFromContext(ctx)
+//	ctx, span := tr.StartSpan(ctx, "load-order")
+//	defer tr.EndSpan(span)
+func TracerFromContext(ctx context.Context) Tracer {
+	m, _ := FromContext(ctx)
+	return m.tracerOrNoop()
+}
+
+// MetricFromContext returns the Metric of the Monitoring stored in ctx.
+// It returns a noop Metric when ctx carries no Monitoring or the Monitoring has no Metric,
+// so callers never need a nil check.
+//
+// Example:
+//
+//	counter, _ := MetricFromContext(ctx).CreateCounter("orders_total", "1", "Orders created")
+func MetricFromContext(ctx context.Context) Metric {
+	m, _ := FromContext(ctx)
+	return m.metricOrNoop()
+}
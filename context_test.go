@@ -0,0 +1,95 @@
+package monitoring
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMonitoring_Context_FromContext(t *testing.T) {
+	mon := &Monitoring{}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		want   *Monitoring
+		wantOK bool
+	}{
+		{
+			name:   "stored monitoring",
+			ctx:    NewContext(context.Background(), mon),
+			want:   mon,
+			wantOK: true,
+		},
+		{
+			name:   "empty context",
+			ctx:    context.Background(),
+			want:   nil,
+			wantOK: false,
+		},
+		{
+			name:   "nil monitoring",
+			ctx:    NewContext(context.Background(), nil),
+			want:   nil,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FromContext(tt.ctx)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("FromContext() = (%p, %v), want (%p, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Context_ComponentAccessors(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	ctx := NewContext(context.Background(), mon)
+	if got := LoggerFromContext(ctx); got != mon.Logger {
+		t.Errorf("LoggerFromContext() = %v, want the stored logger", got)
+	}
+	if got := TracerFromContext(ctx); got != mon.Tracer {
+		t.Errorf("TracerFromContext() = %v, want the stored tracer", got)
+	}
+	if got := MetricFromContext(ctx); got != mon.Metric {
+		t.Errorf("MetricFromContext() = %v, want the stored metric", got)
+	}
+}
+
+func TestMonitoring_Context_ComponentAccessors_Noop(t *testing.T) {
+	contexts := map[string]context.Context{
+		"empty context":    context.Background(),
+		"empty monitoring": NewContext(context.Background(), &Monitoring{}),
+	}
+
+	for name, ctx := range contexts {
+		t.Run(name, func(t *testing.T) {
+			if LoggerFromContext(ctx) == nil {
+				t.Error("LoggerFromContext() = nil, want noop logger")
+			}
+			if TracerFromContext(ctx) == nil {
+				t.Error("TracerFromContext() = nil, want noop tracer")
+			}
+			if MetricFromContext(ctx) == nil {
+				t.Error("MetricFromContext() = nil, want noop metric")
+			}
+
+			// The noop components must be usable without further checks.
+			LoggerFromContext(ctx).Info("noop", nil)
+			_, span := TracerFromContext(ctx).StartSpan(ctx, "noop")
+			TracerFromContext(ctx).EndSpan(span)
+			if _, err := MetricFromContext(ctx).CreateCounter("noop_total", "1", "noop"); err != nil {
+				t.Errorf("CreateCounter() error = %v", err)
+			}
+		})
+	}
+}
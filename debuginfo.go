@@ -0,0 +1,189 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+	"github.com/adityakw90/go-monitoring/internal/tracer"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// DebugInfo describes the configuration a Monitoring is currently running with, as returned by
+// Monitoring.DebugInfo. Values changed at runtime (Reload, Logger.SetLogLevel, remote
+// sampling) are reported as they are now, not as they were configured. Durations are formatted
+// with time.Duration.String so the JSON form stays readable.
+type DebugInfo struct {
+	Resource map[string]string `json:"resource"` // Resource holds the resource attributes attached to spans and metrics.
+	Logger   LoggerDebugInfo   `json:"logger"`   // Logger describes the Logger.
+	Tracer   TracerDebugInfo   `json:"tracer"`   // Tracer describes the Tracer and its exporter.
+	Metric   MetricDebugInfo   `json:"metric"`   // Metric describes the Metric and its exporter.
+}
+
+// LoggerDebugInfo describes the Logger in a DebugInfo.
+type LoggerDebugInfo struct {
+	Enabled         bool   `json:"enabled"`                     // Enabled is false when the logger is disabled or replaced by a noop.
+	Level           string `json:"level,omitempty"`             // Level is the lowest level currently written.
+	OutputPath      string `json:"output_path,omitempty"`       // OutputPath is the log file; empty means stdout.
+	ErrorOutputPath string `json:"error_output_path,omitempty"` // ErrorOutputPath is where warn entries and above are written; empty means OutputPath.
+	Sink            string `json:"sink,omitempty"`              // Sink is "syslog", "journald", "loki", or "kafka" when entries go to a logging service instead of OutputPath.
+	AsyncBufferSize int    `json:"async_buffer_size,omitempty"` // AsyncBufferSize is the async buffer size; zero means synchronous writes.
+	AsyncDropPolicy string `json:"async_drop_policy,omitempty"` // AsyncDropPolicy is applied when the async buffer is full.
+}
+
+// TracerDebugInfo describes the Tracer in a DebugInfo.
+type TracerDebugInfo struct {
+	Enabled           bool    `json:"enabled"`                       // Enabled is false when the tracer is disabled or replaced by a noop.
+	Provider          string  `json:"provider,omitempty"`            // Provider is the exporter provider ("stdout" or "otlp"); ignored when Endpoint is a URL.
+	Endpoint          string  `json:"endpoint,omitempty"`            // Endpoint is the collector URL or "host:port"; empty for stdout.
+	Insecure          bool    `json:"insecure"`                      // Insecure is true when the collector connection does not use TLS.
+	SampleRatio       float64 `json:"sample_ratio"`                  // SampleRatio is the sampling ratio currently applied.
+	BatchTimeout      string  `json:"batch_timeout,omitempty"`       // BatchTimeout is the span batch timeout.
+	RemoteSamplingURL string  `json:"remote_sampling_url,omitempty"` // RemoteSamplingURL is the polled sampling strategy endpoint, if any.
+	FallbackProvider  string  `json:"fallback_provider,omitempty"`   // FallbackProvider is where failed spans are spilled, if anywhere.
+	ExporterState     string  `json:"exporter_state,omitempty"`      // ExporterState is the circuit breaker state ("closed", "open", "half-open"); empty when the breaker is disabled.
+}
+
+// MetricDebugInfo describes the Metric in a DebugInfo.
+type MetricDebugInfo struct {
+	Enabled       bool   `json:"enabled"`                  // Enabled is false when the metric is disabled or replaced by a noop.
+	Provider      string `json:"provider,omitempty"`       // Provider is the exporter provider ("stdout", "otlp", "pushgateway", or "influxdb"); ignored when Endpoint is a URL.
+	Endpoint      string `json:"endpoint,omitempty"`       // Endpoint is the collector URL or "host:port"; empty for stdout.
+	Insecure      bool   `json:"insecure"`                 // Insecure is true when the collector connection does not use TLS.
+	Interval      string `json:"interval,omitempty"`       // Interval is the export interval of the periodic reader.
+	ReaderMode    string `json:"reader_mode,omitempty"`    // ReaderMode is "periodic" or "manual".
+	Temporality   string `json:"temporality,omitempty"`    // Temporality is "cumulative" or "delta".
+	Exemplars     bool   `json:"exemplars"`                // Exemplars is true when exemplars are recorded.
+	ExporterState string `json:"exporter_state,omitempty"` // ExporterState is the circuit breaker state ("closed", "open", "half-open"); empty when the breaker is disabled.
+}
+
+// logLevels are the logger levels from most to least verbose.
+var logLevels = []string{"debug", "info", "warn", "error", "fatal"}
+
+// DebugInfo returns the configuration m is currently running with: resource attributes, the
+// log level, exporter providers and endpoints, the sampling ratio, and the state of the
+// exporter circuit breakers. It is meant for admin and diagnostics endpoints; see DebugHandler.
+//
+// Example:
+//
+//	info := mon.DebugInfo()
+//	log.Printf("exporting spans to %s at ratio %v", info.Tracer.Endpoint, info.Tracer.SampleRatio)
+func (m *Monitoring) DebugInfo() DebugInfo {
+	m.mu.Lock()
+	options := defaultOptions()
+	if m.options != nil {
+		*options = *m.options
+	}
+	m.mu.Unlock()
+
+	info := DebugInfo{
+		Resource: map[string]string{},
+		Logger: LoggerDebugInfo{
+			Enabled: m.Logger != nil && !options.LoggerDisabled,
+		},
+		Tracer: TracerDebugInfo{
+			Enabled: m.Tracer != nil && !options.TracerDisabled,
+		},
+		Metric: MetricDebugInfo{
+			Enabled: m.Metric != nil && !options.MetricDisabled,
+		},
+	}
+
+	// later attributes and the configured service identity take precedence, as they do in the
+	// resources
+	for _, attr := range componentResourceAttributes(options) {
+		info.Resource[string(attr.Key)] = attr.Value.Emit()
+	}
+	info.Resource[string(semconv.ServiceNameKey)] = options.ServiceName
+	for key, value := range map[attribute.Key]string{
+		semconv.DeploymentEnvironmentKey: configuredEnvironment(options),
+		semconv.ServiceInstanceIDKey:     options.InstanceName,
+		semconv.HostNameKey:              options.InstanceHost,
+	} {
+		if value != "" {
+			info.Resource[string(key)] = value
+		}
+	}
+
+	if info.Logger.Enabled {
+		for _, level := range logLevels {
+			if m.Logger.Enabled(level) {
+				info.Logger.Level = level
+				break
+			}
+		}
+		info.Logger.OutputPath = options.Logger.OutputPath
+		info.Logger.ErrorOutputPath = options.Logger.ErrorOutputPath
+		info.Logger.Sink = options.Logger.Sink
+		info.Logger.AsyncBufferSize = options.Logger.AsyncBufferSize
+		info.Logger.AsyncDropPolicy = options.Logger.AsyncDropPolicy
+	}
+
+	if info.Tracer.Enabled {
+		info.Tracer.Provider = options.Tracer.Provider
+		if ep, ok := tracerCollector(options); ok {
+			info.Tracer.Endpoint, info.Tracer.Insecure = debugEndpoint(options.Tracer.Endpoint, ep.Address), ep.Insecure
+		}
+		info.Tracer.SampleRatio = options.Tracer.SampleRatio
+		if r, ok := m.Tracer.(tracer.SampleRatioReporter); ok {
+			info.Tracer.SampleRatio = r.SampleRatio()
+		}
+		info.Tracer.BatchTimeout = options.Tracer.BatchTimeout.String()
+		info.Tracer.RemoteSamplingURL = options.Tracer.RemoteSamplingURL
+		info.Tracer.FallbackProvider = options.Tracer.FallbackProvider
+		info.Tracer.ExporterState = m.breakerState("tracer", options)
+	}
+
+	if info.Metric.Enabled {
+		info.Metric.Provider = options.Metric.Provider
+		if ep, ok := metricCollector(options); ok {
+			info.Metric.Endpoint, info.Metric.Insecure = debugEndpoint(options.Metric.Endpoint, ep.Address), ep.Insecure
+		}
+		info.Metric.Interval = options.Metric.Interval.String()
+		info.Metric.ReaderMode = options.Metric.ReaderMode
+		info.Metric.Temporality = options.Metric.Temporality
+		info.Metric.Exemplars = options.Metric.Exemplars
+		info.Metric.ExporterState = m.breakerState("metric", options)
+	}
+
+	return info
+}
+
+// DebugHandler returns an http.Handler that responds with m.DebugInfo as JSON, for mounting on
+// an internal admin endpoint. The report contains collector addresses but no credentials;
+// still, do not expose it publicly.
+//
+// Example:
+//
+//	adminMux.Handle("/debug/monitoring", mon.DebugHandler())
+func (m *Monitoring) DebugHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(m.DebugInfo())
+	})
+}
+
+// debugEndpoint returns the endpoint reported in DebugInfo: the configured URL when there is
+// one, the collector address otherwise.
+func debugEndpoint(rawURL, address string) string {
+	if rawURL != "" {
+		return rawURL
+	}
+	return address
+}
+
+// breakerState returns the last state reported by the named exporter's circuit breaker, or ""
+// when the breakers are disabled or have not reported yet.
+func (m *Monitoring) breakerState(exporter string, options *Options) string {
+	if options.ExporterBreakerThreshold <= 0 {
+		return ""
+	}
+	state, ok := m.breakerStates.Load(exporter)
+	if !ok {
+		return ""
+	}
+	return state.(breaker.State).String()
+}
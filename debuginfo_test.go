@@ -0,0 +1,125 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+)
+
+func TestMonitoring_DebugInfo_DebugInfo(t *testing.T) {
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithEnvironment("staging"),
+		WithInstance("pod-1", "node-a"),
+		WithTracerProvider("otlp", "collector", 4317),
+		WithTracerInsecure(true),
+		WithTracerSampleRatio(0.5),
+		WithMetricEndpoint("https://collector:4318/v1/metrics"),
+		WithMetricTemporality("delta"),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_ = mon.Shutdown(ctx)
+	}()
+
+	if err := mon.Reload(WithTracerSampleRatio(0.25)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	mon.Logger.SetLogLevel("warn")
+	mon.breakerStateRecorder("metric")(breaker.StateOpen)
+
+	info := mon.DebugInfo()
+	if got := info.Resource["service.name"]; got != "test-service" {
+		t.Errorf("Resource[service.name] = %q, want %q", got, "test-service")
+	}
+	if got := info.Resource["deployment.environment"]; got != "staging" {
+		t.Errorf("Resource[deployment.environment] = %q, want %q", got, "staging")
+	}
+	if !info.Logger.Enabled || info.Logger.Level != "warn" {
+		t.Errorf("Logger = %+v, want enabled at warn", info.Logger)
+	}
+	wantTracer := TracerDebugInfo{
+		Enabled:       true,
+		Provider:      "otlp",
+		Endpoint:      "collector:4317",
+		Insecure:      true,
+		SampleRatio:   0.25,
+		BatchTimeout:  "5s",
+		ExporterState: "closed",
+	}
+	if info.Tracer != wantTracer {
+		t.Errorf("Tracer = %+v, want %+v", info.Tracer, wantTracer)
+	}
+	wantMetric := MetricDebugInfo{
+		Enabled:       true,
+		Provider:      "stdout",
+		Endpoint:      "https://collector:4318/v1/metrics",
+		Interval:      "1m0s",
+		ReaderMode:    "periodic",
+		Temporality:   "delta",
+		ExporterState: "open",
+	}
+	if info.Metric != wantMetric {
+		t.Errorf("Metric = %+v, want %+v", info.Metric, wantMetric)
+	}
+}
+
+func TestMonitoring_DebugInfo_DebugInfo_Disabled(t *testing.T) {
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithTracerDisabled(true),
+		WithExporterCircuitBreaker(0, time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	info := mon.DebugInfo()
+	if info.Tracer != (TracerDebugInfo{}) {
+		t.Errorf("Tracer = %+v, want zero value for a disabled tracer", info.Tracer)
+	}
+	if !info.Metric.Enabled || info.Metric.ExporterState != "" {
+		t.Errorf("Metric = %+v, want enabled without exporter state", info.Metric)
+	}
+
+	// A Monitoring assembled by hand has no options and reports its components as disabled.
+	if got := (&Monitoring{}).DebugInfo(); got.Logger.Enabled || got.Tracer.Enabled || got.Metric.Enabled {
+		t.Errorf("DebugInfo() of an empty Monitoring = %+v, want every component disabled", got)
+	}
+}
+
+func TestMonitoring_DebugInfo_DebugHandler(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	recorder := httptest.NewRecorder()
+	mon.DebugHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/monitoring", nil))
+
+	if got := recorder.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+	var info DebugInfo
+	if err := json.Unmarshal(recorder.Body.Bytes(), &info); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v, body = %s", err, recorder.Body.String())
+	}
+	if info.Resource["service.name"] != "test-service" || info.Logger.Level != "info" || info.Tracer.SampleRatio != 1.0 {
+		t.Errorf("decoded DebugInfo = %+v", info)
+	}
+}
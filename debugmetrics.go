@@ -0,0 +1,99 @@
+package monitoring
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MetricsDebugHandler returns an http.Handler that responds with the current value of every
+// instrument as plain text, one line per data point with its name, attributes, and values,
+// so instrumentation can be checked during development without waiting for the periodic
+// export or running a collector. Histograms show their count, sum, mean, and p50, p90, and
+// p99 estimates. A "name" query value keeps the instruments whose name starts with it.
+// Values are read with Metric.Snapshot; counters with delta temporality show the increase
+// since the last export. AdminHandler mounts it at /debug/metrics.
+//
+// Example:
+//
+//	devMux.Handle("/debug/metrics", mon.MetricsDebugHandler())
+//
+//	// $ curl localhost:9090/debug/metrics?name=http_
+//	// # counters
+//	// http_requests_total{method="GET",route="/orders"} 42
+func (m *Monitoring) MetricsDebugHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		snapshot, err := m.Metric.Snapshot(r.Context())
+		if err != nil {
+			http.Error(w, "failed to collect metrics: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		writeMetricsText(w, snapshot, r.FormValue("name"))
+	})
+}
+
+// writeMetricsText writes the data points of snapshot whose instrument name starts with
+// prefix, grouped by instrument kind and sorted by name and attributes.
+func writeMetricsText(w io.Writer, snapshot *Snapshot, prefix string) {
+	var counters, gauges, histograms []string
+	for _, c := range snapshot.Counters {
+		if strings.HasPrefix(c.Name, prefix) {
+			counters = append(counters, c.Name+metricsTextLabels(c.Attributes)+" "+metricsTextValue(c.Value))
+		}
+	}
+	for _, g := range snapshot.Gauges {
+		if strings.HasPrefix(g.Name, prefix) {
+			gauges = append(gauges, g.Name+metricsTextLabels(g.Attributes)+" "+metricsTextValue(g.Value))
+		}
+	}
+	for _, h := range snapshot.Histograms {
+		if strings.HasPrefix(h.Name, prefix) {
+			histograms = append(histograms, fmt.Sprintf("%s%s count=%d sum=%s mean=%s p50=%s p90=%s p99=%s",
+				h.Name, metricsTextLabels(h.Attributes), h.Count, metricsTextValue(h.Sum), metricsTextValue(h.Mean()),
+				metricsTextValue(h.Percentile(0.5)), metricsTextValue(h.Percentile(0.9)), metricsTextValue(h.Percentile(0.99))))
+		}
+	}
+
+	if len(counters)+len(gauges)+len(histograms) == 0 {
+		fmt.Fprintln(w, "no metrics recorded")
+		return
+	}
+	for _, section := range []struct {
+		title string
+		lines []string
+	}{{"counters", counters}, {"gauges", gauges}, {"histograms", histograms}} {
+		if len(section.lines) == 0 {
+			continue
+		}
+		sort.Strings(section.lines)
+		fmt.Fprintf(w, "# %s\n", section.title)
+		for _, line := range section.lines {
+			fmt.Fprintln(w, line)
+		}
+	}
+}
+
+// metricsTextLabels formats attrs as {key="value",...}, or "" when there are none.
+func metricsTextLabels(attrs attribute.Set) string {
+	if attrs.Len() == 0 {
+		return ""
+	}
+	labels := make([]string, 0, attrs.Len())
+	iter := attrs.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		labels = append(labels, string(kv.Key)+"="+strconv.Quote(kv.Value.Emit()))
+	}
+	return "{" + strings.Join(labels, ",") + "}"
+}
+
+// metricsTextValue formats a value without trailing zeros.
+func metricsTextValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
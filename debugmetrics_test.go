@@ -0,0 +1,95 @@
+package monitoring
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestMonitoring_DebugMetrics_MetricsDebugHandler(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	counter, err := mon.Metric.CreateCounter("http_requests_total", "1", "Requests served")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	mon.Metric.RecordCounter(ctx, counter, 40, attribute.String("route", "/orders"), attribute.String("method", "GET"))
+	mon.Metric.RecordCounter(ctx, counter, 2, attribute.String("route", "/orders"), attribute.String("method", "GET"))
+	gauge, err := mon.Metric.CreateGauge("queue_length", "1", "Queued jobs")
+	if err != nil {
+		t.Fatalf("CreateGauge() error = %v", err)
+	}
+	mon.Metric.RecordGauge(ctx, gauge, 7)
+	histogram, err := mon.Metric.CreateHistogram("http_request_duration_ms", "ms", "Request latency")
+	if err != nil {
+		t.Fatalf("CreateHistogram() error = %v", err)
+	}
+	mon.Metric.RecordHistogram(ctx, histogram, 20)
+	mon.Metric.RecordHistogram(ctx, histogram, 40)
+
+	tests := []struct {
+		name        string
+		target      string
+		wantLines   []string
+		unwantLines []string
+	}{
+		{
+			name:   "all instruments",
+			target: "/",
+			wantLines: []string{
+				"# counters",
+				`http_requests_total{method="GET",route="/orders"} 42`,
+				"# gauges",
+				"queue_length 7",
+				"# histograms",
+				"http_request_duration_ms count=2 sum=60 mean=30",
+			},
+		},
+		{
+			name:        "name prefix",
+			target:      "/?name=http_",
+			wantLines:   []string{`http_requests_total{method="GET",route="/orders"} 42`},
+			unwantLines: []string{"queue_length", "# gauges"},
+		},
+		{
+			name:      "no match",
+			target:    "/?name=db_",
+			wantLines: []string{"no metrics recorded"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mon.MetricsDebugHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+			}
+			if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/plain") {
+				t.Errorf("Content-Type = %q, want text/plain", got)
+			}
+			body := rec.Body.String()
+			for _, want := range tt.wantLines {
+				if !strings.Contains(body, want) {
+					t.Errorf("body does not contain %q:\n%s", want, body)
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(body, unwant) {
+					t.Errorf("body contains %q:\n%s", unwant, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package monitoring
+
+import "sync/atomic"
+
+// defaultMonitoring holds the Monitoring registered with SetDefault; nil until then.
+var defaultMonitoring atomic.Pointer[Monitoring]
+
+// SetDefault registers m as the process-wide default Monitoring returned by Default and used
+// by L, T, and M. Passing nil clears the default.
+//
+// The global is an opt-in convenience for small programs such as CLIs. Passing a *Monitoring
+// explicitly (or through NewContext) remains the recommended approach, since it keeps
+// dependencies visible and lets tests use independent instances.
+//
+// Parameters:
+//   - m: The Monitoring to use as default (nil clears it)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(WithServiceName("my-cli"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	SetDefault(mon)
+//	defer mon.Shutdown(context.Background())
+//
+//	L().Info("Starting", nil)
+func SetDefault(m *Monitoring) {
+	defaultMonitoring.Store(m)
+}
+
+// Default returns the Monitoring registered with SetDefault, or nil if none is set.
+func Default() *Monitoring {
+	return defaultMonitoring.Load()
+}
+
+// L returns the Logger of the default Monitoring, or a noop Logger if no default is set.
+//
+// Example:
+//
+//	L().Info("Processing file", map[string]interface{}{"path": path})
+func L() Logger {
+	return Default().loggerOrNoop()
+}
+
+// T returns the Tracer of the default Monitoring, or a noop Tracer if no default is set.
+//
+// Example:
+//
+//	ctx, span := T().StartSpan(ctx, "sync")
+//	defer T().EndSpan(span)
+func T() Tracer {
+	return Default().tracerOrNoop()
+}
+
+// M returns the Metric of the default Monitoring, or a noop Metric if no default is set.
+//
+// Example:
+//
+//	counter, _ := M().CreateCounter("files_processed_total", "1", "Files processed")
+func M() Metric {
+	return Default().metricOrNoop()
+}
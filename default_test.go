@@ -0,0 +1,46 @@
+package monitoring
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMonitoring_Default_SetDefault(t *testing.T) {
+	t.Cleanup(func() {
+		SetDefault(nil)
+	})
+
+	if Default() != nil {
+		t.Fatalf("Default() = %p, want nil before SetDefault", Default())
+	}
+	if L() == nil || T() == nil || M() == nil {
+		t.Fatal("expected noop components when no default is set")
+	}
+
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	SetDefault(mon)
+	if Default() != mon {
+		t.Errorf("Default() = %p, want %p", Default(), mon)
+	}
+	if L() != mon.Logger {
+		t.Error("L() did not return the default logger")
+	}
+	if T() != mon.Tracer {
+		t.Error("T() did not return the default tracer")
+	}
+	if M() != mon.Metric {
+		t.Error("M() did not return the default metric")
+	}
+
+	SetDefault(nil)
+	if Default() != nil {
+		t.Errorf("Default() = %p, want nil after clearing", Default())
+	}
+}
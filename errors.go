@@ -3,9 +3,14 @@ package monitoring
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/adityakw90/go-monitoring/internal/cloud"
+	"github.com/adityakw90/go-monitoring/internal/endpoint"
+	"github.com/adityakw90/go-monitoring/internal/errorreport"
 	"github.com/adityakw90/go-monitoring/internal/logger"
 	"github.com/adityakw90/go-monitoring/internal/metric"
+	"github.com/adityakw90/go-monitoring/internal/redact"
 	"github.com/adityakw90/go-monitoring/internal/tracer"
 )
 
@@ -13,41 +18,265 @@ import (
 var (
 	// ErrServiceNameRequired is returned when service name is not provided.
 	ErrServiceNameRequired = errors.New("service name is required")
+
+	// ErrSLORouteRequired is returned by NewSLOTracker when an Objective has no Route.
+	ErrSLORouteRequired = errors.New("slo route is required")
+	// ErrSLOThresholdInvalid is returned by NewSLOTracker when an Objective's Threshold is not positive.
+	ErrSLOThresholdInvalid = errors.New("slo threshold must be greater than 0")
+	// ErrSLOTargetInvalid is returned by NewSLOTracker when an Objective's Target is not strictly between 0 and 1.
+	ErrSLOTargetInvalid = errors.New("slo target must be between 0 and 1 exclusive")
+
+	// ErrWorkerPoolSizeInvalid is returned by NewWorkerPool when it has no worker or a negative queue size.
+	ErrWorkerPoolSizeInvalid = errors.New("worker pool needs at least one worker and a non-negative queue size")
+	// ErrWorkerPoolClosed is returned by WorkerPool.Submit after the pool is closed.
+	ErrWorkerPoolClosed = errors.New("worker pool is closed")
+)
+
+// ShutdownError is returned by Monitoring.Shutdown when one or more components fail to shut down.
+// Each field holds the error of the corresponding component, or nil if it shut down cleanly.
+type ShutdownError struct {
+	Tracer error // Tracer is the error returned while shutting down the tracer provider.
+	Metric error // Metric is the error returned while shutting down the meter provider.
+	Errors error // Errors is the error returned while sending the errors queued by the error reporter.
+}
+
+// Error returns a message naming every component that failed to shut down.
+func (e *ShutdownError) Error() string {
+	var msgs []string
+	if e.Tracer != nil {
+		msgs = append(msgs, fmt.Sprintf("failed to shutdown tracer: %v", e.Tracer))
+	}
+	if e.Metric != nil {
+		msgs = append(msgs, fmt.Sprintf("failed to shutdown metric: %v", e.Metric))
+	}
+	if e.Errors != nil {
+		msgs = append(msgs, fmt.Sprintf("failed to shutdown error reporter: %v", e.Errors))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the component errors so errors.Is and errors.As can match them.
+func (e *ShutdownError) Unwrap() []error {
+	var errs []error
+	if e.Tracer != nil {
+		errs = append(errs, e.Tracer)
+	}
+	if e.Metric != nil {
+		errs = append(errs, e.Metric)
+	}
+	if e.Errors != nil {
+		errs = append(errs, e.Errors)
+	}
+	return errs
+}
+
+// Components and operations reported by Error.
+const (
+	ComponentLogger = "logger" // ComponentLogger is the Logger.
+	ComponentTracer = "tracer" // ComponentTracer is the Tracer and its exporter.
+	ComponentMetric = "metric" // ComponentMetric is the Metric and its exporter.
+	ComponentErrors = "errors" // ComponentErrors is the error reporter.
+
+	OperationInitialize = "initialize" // OperationInitialize is creating a component in NewMonitoring or New<Component>.
+	OperationValidate   = "validate"   // OperationValidate is checking options in Options.Validate.
+	OperationReload     = "reload"     // OperationReload is applying options in Monitoring.Reload.
+	OperationProbe      = "probe"      // OperationProbe is checking collector reachability requested with WithStartupProbe.
+	OperationFlush      = "flush"      // OperationFlush is exporting buffered telemetry in Monitoring.Flush.
 )
 
+// Error describes a component failure that is not one of the exported sentinel errors, such as
+// an exporter that cannot be created or a log file that cannot be opened. Sentinel errors
+// (ErrTracerProviderHostRequired, ...) are configuration mistakes that fail the same way on
+// every attempt; an *Error usually depends on the environment and may succeed when retried.
+// Retrieve it with errors.As:
+//
+//	var monErr *monitoring.Error
+//	if errors.As(err, &monErr) && monErr.Component == monitoring.ComponentMetric {
+//	    // retry later, or start without metrics
+//	}
+type Error struct {
+	Component string // Component is the failing component: ComponentLogger, ComponentTracer, ComponentMetric, or ComponentErrors.
+	Operation string // Operation is what failed: OperationInitialize, OperationValidate, OperationReload, OperationProbe, or OperationFlush.
+	Provider  string // Provider is the component's exporter provider or endpoint URL; empty for the logger.
+	Err       error  // Err is the underlying error.
+}
+
+// Error returns a message naming the operation and component followed by the underlying error,
+// e.g. "failed to initialize metric: failed to create exporter: ...".
+func (e *Error) Error() string {
+	if e.Operation == OperationValidate {
+		return fmt.Sprintf("invalid %s options: %v", e.Component, e.Err)
+	}
+	return fmt.Sprintf("failed to %s %s: %v", e.Operation, e.Component, e.Err)
+}
+
+// Unwrap returns the underlying error so errors.Is and errors.As can match it.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// InitError is returned by NewMonitoringLenient when one or more components failed to initialize
+// and were replaced with noop implementations. Each field holds the error of the corresponding
+// component, or nil if it initialized.
+type InitError struct {
+	Logger error // Logger is the error returned while creating the logger.
+	Tracer error // Tracer is the error returned while creating the tracer.
+	Metric error // Metric is the error returned while creating the metric.
+}
+
+// Error returns the messages of every component that failed to initialize.
+func (e *InitError) Error() string {
+	var msgs []string
+	for _, err := range e.Unwrap() {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the component errors so errors.Is and errors.As can match them.
+func (e *InitError) Unwrap() []error {
+	var errs []error
+	for _, err := range []error{e.Logger, e.Tracer, e.Metric} {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
 // re-export errors from internal packages
 var (
 	// logger
-	ErrLoggerInvalidLogLevel = logger.ErrInvalidLogLevel
+	ErrLoggerInvalidLogLevel        = logger.ErrInvalidLogLevel
+	ErrLoggerInvalidAsyncBufferSize = logger.ErrInvalidAsyncBufferSize
+	ErrLoggerInvalidDropPolicy      = logger.ErrInvalidDropPolicy
+	ErrLoggerInvalidSink            = logger.ErrInvalidSink
+	ErrLoggerInvalidSyslogFacility  = logger.ErrInvalidSyslogFacility
+	ErrLoggerSinkUnsupported        = logger.ErrSinkUnsupported
+	ErrLoggerLokiURLRequired        = logger.ErrLokiURLRequired
+	ErrLoggerKafkaBrokersRequired   = logger.ErrKafkaBrokersRequired
+	ErrLoggerKafkaTopicRequired     = logger.ErrKafkaTopicRequired
+	ErrLoggerInvalidKafkaBatch      = logger.ErrInvalidKafkaBatch
+	ErrLoggerInvalidSchema          = logger.ErrInvalidSchema
+	ErrLoggerInvalidCallerSkip      = logger.ErrInvalidCallerSkip
+	ErrLoggerInvalidStacktraceLevel = logger.ErrInvalidStacktraceLevel
+	ErrLoggerInvalidDedupWindow     = logger.ErrInvalidDedupWindow
+	ErrLoggerInvalidRateLimit       = logger.ErrInvalidRateLimit
+	ErrLoggerAuditNotConfigured     = logger.ErrAuditNotConfigured
+	ErrLoggerAuditTampered          = logger.ErrAuditTampered
+	ErrLoggerAuditReservedField     = logger.ErrAuditReservedField
 
 	// tracer
-	ErrTracerInvalidProvider      = tracer.ErrInvalidProvider
-	ErrTracerProviderHostRequired = tracer.ErrProviderHostRequired
-	ErrTracerProviderPortRequired = tracer.ErrProviderPortRequired
-	ErrTracerProviderPortInvalid  = tracer.ErrProviderPortInvalid
-	ErrTracerBatchTimeoutInvalid  = tracer.ErrBatchTimeoutInvalid
+	ErrTracerInvalidProvider               = tracer.ErrInvalidProvider
+	ErrTracerProviderHostRequired          = tracer.ErrProviderHostRequired
+	ErrTracerProviderPortRequired          = tracer.ErrProviderPortRequired
+	ErrTracerProviderPortInvalid           = tracer.ErrProviderPortInvalid
+	ErrTracerBatchTimeoutInvalid           = tracer.ErrBatchTimeoutInvalid
+	ErrTracerRemoteSamplingIntervalInvalid = tracer.ErrRemoteSamplingIntervalInvalid
+	ErrTracerInvalidFallbackProvider       = tracer.ErrInvalidFallbackProvider
+	ErrTracerFallbackPathRequired          = tracer.ErrFallbackPathRequired
+	ErrTracerBreakerThresholdInvalid       = tracer.ErrBreakerThresholdInvalid
+	ErrTracerBreakerMaxBackoffInvalid      = tracer.ErrBreakerMaxBackoffInvalid
+	ErrTracerEndpointInvalid               = tracer.ErrEndpointInvalid
+	ErrTracerTraceStateEntryInvalid        = tracer.ErrTraceStateEntryInvalid
+	ErrTracerTraceStateSpanRequired        = tracer.ErrTraceStateSpanRequired
+	ErrTracerBodySnippetLimitInvalid       = tracer.ErrBodySnippetLimitInvalid
+	ErrTracerInvalidStdoutFormat           = tracer.ErrInvalidStdoutFormat
+	ErrTracerLongSpanThresholdInvalid      = tracer.ErrLongSpanThresholdInvalid
+	ErrTracerTraceIDFormatConflict         = tracer.ErrTraceIDFormatConflict
+	ErrTracerMirrorEndpointInvalid         = tracer.ErrMirrorEndpointInvalid
+	ErrTracerMirrorRatioInvalid            = tracer.ErrMirrorRatioInvalid
+	ErrTracerDevViewerPortInvalid          = tracer.ErrDevViewerPortInvalid
 
 	// metric
-	ErrMetricInvalidProvider      = metric.ErrInvalidProvider
-	ErrMetricProviderHostRequired = metric.ErrProviderHostRequired
-	ErrMetricProviderPortRequired = metric.ErrProviderPortRequired
-	ErrMetricProviderPortInvalid  = metric.ErrProviderPortInvalid
-	ErrMetricIntervalInvalid      = metric.ErrIntervalInvalid
+	ErrMetricInvalidProvider          = metric.ErrInvalidProvider
+	ErrMetricInstrumentNameInvalid    = metric.ErrInstrumentNameInvalid
+	ErrMetricInstrumentConflict       = metric.ErrInstrumentConflict
+	ErrMetricDurationUnitUnsupported  = metric.ErrDurationUnitUnsupported
+	ErrMetricProviderHostRequired     = metric.ErrProviderHostRequired
+	ErrMetricProviderPortRequired     = metric.ErrProviderPortRequired
+	ErrMetricProviderPortInvalid      = metric.ErrProviderPortInvalid
+	ErrMetricIntervalInvalid          = metric.ErrIntervalInvalid
+	ErrMetricInvalidReaderMode        = metric.ErrInvalidReaderMode
+	ErrMetricInvalidTemporality       = metric.ErrInvalidTemporality
+	ErrMetricInvalidStdoutFormat      = metric.ErrInvalidStdoutFormat
+	ErrMetricStatsDAddressInvalid     = metric.ErrStatsDAddressInvalid
+	ErrMetricPushgatewayJobRequired   = metric.ErrPushgatewayJobRequired
+	ErrMetricInfluxDBURLInvalid       = metric.ErrInfluxDBURLInvalid
+	ErrMetricInfluxDBOrgRequired      = metric.ErrInfluxDBOrgRequired
+	ErrMetricInfluxDBBucketRequired   = metric.ErrInfluxDBBucketRequired
+	ErrMetricBreakerThresholdInvalid  = metric.ErrBreakerThresholdInvalid
+	ErrMetricBreakerMaxBackoffInvalid = metric.ErrBreakerMaxBackoffInvalid
+	ErrMetricEndpointInvalid          = metric.ErrEndpointInvalid
+
+	// error reporting
+	ErrErrorReportingInvalidDSN       = errorreport.ErrInvalidDSN
+	ErrErrorReportingQueueSizeInvalid = errorreport.ErrQueueSizeInvalid
+
+	// startup probe errors, wrapped in an *Error with the collector address and cause
+	ErrStartupProbeDNS         = endpoint.ErrDNS
+	ErrStartupProbeUnreachable = endpoint.ErrUnreachable
+	ErrStartupProbeTLS         = endpoint.ErrTLS
+
+	// cloud detection
+	ErrInvalidCloudDetection = cloud.ErrInvalidProvider
+
+	// redaction
+	ErrInvalidRedactionAction = redact.ErrInvalidAction
 )
 
 // parseError maps known internal sentinel errors to the package's public API error aliases.
-// If err is nil it returns an error formatted as "<message>: unknown error".
 // If err matches a recognized internal sentinel, it returns the corresponding exported error alias.
-// For any other error it returns the original error wrapped with the provided message.
-func parseError(err error, message string) error {
+// For any other error, including nil (reported as "unknown error"), it returns an *Error carrying
+// the component, operation, and provider.
+func parseError(err error, component, operation, provider string) error {
 	if err == nil {
-		return fmt.Errorf("%s: unknown error", message)
+		return &Error{Component: component, Operation: operation, Provider: provider, Err: errors.New("unknown error")}
 	}
 
 	// logger
 	if errors.Is(err, logger.ErrInvalidLogLevel) {
 		return ErrLoggerInvalidLogLevel
 	}
+	if errors.Is(err, logger.ErrInvalidAsyncBufferSize) {
+		return ErrLoggerInvalidAsyncBufferSize
+	}
+	if errors.Is(err, logger.ErrInvalidDropPolicy) {
+		return ErrLoggerInvalidDropPolicy
+	}
+	if errors.Is(err, logger.ErrInvalidSink) {
+		return ErrLoggerInvalidSink
+	}
+	if errors.Is(err, logger.ErrInvalidSyslogFacility) {
+		return ErrLoggerInvalidSyslogFacility
+	}
+	if errors.Is(err, logger.ErrLokiURLRequired) {
+		return ErrLoggerLokiURLRequired
+	}
+	if errors.Is(err, logger.ErrKafkaBrokersRequired) {
+		return ErrLoggerKafkaBrokersRequired
+	}
+	if errors.Is(err, logger.ErrKafkaTopicRequired) {
+		return ErrLoggerKafkaTopicRequired
+	}
+	if errors.Is(err, logger.ErrInvalidKafkaBatch) {
+		return ErrLoggerInvalidKafkaBatch
+	}
+	if errors.Is(err, logger.ErrInvalidSchema) {
+		return ErrLoggerInvalidSchema
+	}
+	if errors.Is(err, logger.ErrInvalidCallerSkip) {
+		return ErrLoggerInvalidCallerSkip
+	}
+	if errors.Is(err, logger.ErrInvalidStacktraceLevel) {
+		return ErrLoggerInvalidStacktraceLevel
+	}
+	if errors.Is(err, logger.ErrInvalidDedupWindow) {
+		return ErrLoggerInvalidDedupWindow
+	}
+	if errors.Is(err, logger.ErrInvalidRateLimit) {
+		return ErrLoggerInvalidRateLimit
+	}
 
 	// tracer
 	if errors.Is(err, tracer.ErrInvalidProvider) {
@@ -65,6 +294,45 @@ func parseError(err error, message string) error {
 	if errors.Is(err, tracer.ErrBatchTimeoutInvalid) {
 		return ErrTracerBatchTimeoutInvalid
 	}
+	if errors.Is(err, tracer.ErrRemoteSamplingIntervalInvalid) {
+		return ErrTracerRemoteSamplingIntervalInvalid
+	}
+	if errors.Is(err, tracer.ErrInvalidFallbackProvider) {
+		return ErrTracerInvalidFallbackProvider
+	}
+	if errors.Is(err, tracer.ErrFallbackPathRequired) {
+		return ErrTracerFallbackPathRequired
+	}
+	if errors.Is(err, tracer.ErrBreakerThresholdInvalid) {
+		return ErrTracerBreakerThresholdInvalid
+	}
+	if errors.Is(err, tracer.ErrBreakerMaxBackoffInvalid) {
+		return ErrTracerBreakerMaxBackoffInvalid
+	}
+	if errors.Is(err, tracer.ErrEndpointInvalid) {
+		return ErrTracerEndpointInvalid
+	}
+	if errors.Is(err, tracer.ErrBodySnippetLimitInvalid) {
+		return ErrTracerBodySnippetLimitInvalid
+	}
+	if errors.Is(err, tracer.ErrInvalidStdoutFormat) {
+		return ErrTracerInvalidStdoutFormat
+	}
+	if errors.Is(err, tracer.ErrLongSpanThresholdInvalid) {
+		return ErrTracerLongSpanThresholdInvalid
+	}
+	if errors.Is(err, tracer.ErrTraceIDFormatConflict) {
+		return ErrTracerTraceIDFormatConflict
+	}
+	if errors.Is(err, tracer.ErrMirrorEndpointInvalid) {
+		return ErrTracerMirrorEndpointInvalid
+	}
+	if errors.Is(err, tracer.ErrMirrorRatioInvalid) {
+		return ErrTracerMirrorRatioInvalid
+	}
+	if errors.Is(err, tracer.ErrDevViewerPortInvalid) {
+		return ErrTracerDevViewerPortInvalid
+	}
 
 	// metric
 	if errors.Is(err, metric.ErrInvalidProvider) {
@@ -82,6 +350,52 @@ func parseError(err error, message string) error {
 	if errors.Is(err, metric.ErrIntervalInvalid) {
 		return ErrMetricIntervalInvalid
 	}
+	if errors.Is(err, metric.ErrInvalidReaderMode) {
+		return ErrMetricInvalidReaderMode
+	}
+	if errors.Is(err, metric.ErrInvalidTemporality) {
+		return ErrMetricInvalidTemporality
+	}
+	if errors.Is(err, metric.ErrInvalidStdoutFormat) {
+		return ErrMetricInvalidStdoutFormat
+	}
+	if errors.Is(err, metric.ErrStatsDAddressInvalid) {
+		return ErrMetricStatsDAddressInvalid
+	}
+	if errors.Is(err, metric.ErrPushgatewayJobRequired) {
+		return ErrMetricPushgatewayJobRequired
+	}
+	if errors.Is(err, metric.ErrInfluxDBURLInvalid) {
+		return ErrMetricInfluxDBURLInvalid
+	}
+	if errors.Is(err, metric.ErrInfluxDBOrgRequired) {
+		return ErrMetricInfluxDBOrgRequired
+	}
+	if errors.Is(err, metric.ErrInfluxDBBucketRequired) {
+		return ErrMetricInfluxDBBucketRequired
+	}
+	if errors.Is(err, metric.ErrBreakerThresholdInvalid) {
+		return ErrMetricBreakerThresholdInvalid
+	}
+	if errors.Is(err, metric.ErrBreakerMaxBackoffInvalid) {
+		return ErrMetricBreakerMaxBackoffInvalid
+	}
+	if errors.Is(err, metric.ErrEndpointInvalid) {
+		return ErrMetricEndpointInvalid
+	}
+
+	// error reporting
+	if errors.Is(err, errorreport.ErrInvalidDSN) {
+		return ErrErrorReportingInvalidDSN
+	}
+	if errors.Is(err, errorreport.ErrQueueSizeInvalid) {
+		return ErrErrorReportingQueueSizeInvalid
+	}
+
+	// redaction
+	if errors.Is(err, redact.ErrInvalidAction) {
+		return ErrInvalidRedactionAction
+	}
 
-	return fmt.Errorf("%s: %w", message, err)
+	return &Error{Component: component, Operation: operation, Provider: provider, Err: err}
 }
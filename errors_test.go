@@ -1,7 +1,9 @@
 package monitoring
 
 import (
+	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/adityakw90/go-monitoring/internal/logger"
@@ -13,13 +15,11 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 	tests := []struct {
 		name     string
 		err      error
-		message  string
 		validate func(*testing.T, error)
 	}{
 		{
-			name:    "logger invalid log level",
-			err:     logger.ErrInvalidLogLevel,
-			message: "test message",
+			name: "logger invalid log level",
+			err:  logger.ErrInvalidLogLevel,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrLoggerInvalidLogLevel) {
 					t.Errorf("expected ErrLoggerInvalidLogLevel, got %v", got)
@@ -31,9 +31,35 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "tracer invalid provider",
-			err:     tracer.ErrInvalidProvider,
-			message: "test message",
+			name: "logger invalid async buffer size",
+			err:  logger.ErrInvalidAsyncBufferSize,
+			validate: func(t *testing.T, got error) {
+				if got != ErrLoggerInvalidAsyncBufferSize {
+					t.Errorf("expected direct ErrLoggerInvalidAsyncBufferSize, got %v", got)
+				}
+			},
+		},
+		{
+			name: "logger invalid drop policy",
+			err:  logger.ErrInvalidDropPolicy,
+			validate: func(t *testing.T, got error) {
+				if got != ErrLoggerInvalidDropPolicy {
+					t.Errorf("expected direct ErrLoggerInvalidDropPolicy, got %v", got)
+				}
+			},
+		},
+		{
+			name: "logger invalid sink",
+			err:  logger.ErrInvalidSink,
+			validate: func(t *testing.T, got error) {
+				if got != ErrLoggerInvalidSink {
+					t.Errorf("expected direct ErrLoggerInvalidSink, got %v", got)
+				}
+			},
+		},
+		{
+			name: "tracer invalid provider",
+			err:  tracer.ErrInvalidProvider,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrTracerInvalidProvider) {
 					t.Errorf("expected ErrTracerInvalidProvider, got %v", got)
@@ -45,9 +71,8 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "metric invalid provider",
-			err:     metric.ErrInvalidProvider,
-			message: "test message",
+			name: "metric invalid provider",
+			err:  metric.ErrInvalidProvider,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrMetricInvalidProvider) {
 					t.Errorf("expected ErrMetricInvalidProvider, got %v", got)
@@ -59,9 +84,8 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "tracer provider host required",
-			err:     tracer.ErrProviderHostRequired,
-			message: "test message",
+			name: "tracer provider host required",
+			err:  tracer.ErrProviderHostRequired,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrTracerProviderHostRequired) {
 					t.Errorf("expected ErrTracerProviderHostRequired, got %v", got)
@@ -73,9 +97,8 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "tracer provider port required",
-			err:     tracer.ErrProviderPortRequired,
-			message: "test message",
+			name: "tracer provider port required",
+			err:  tracer.ErrProviderPortRequired,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrTracerProviderPortRequired) {
 					t.Errorf("expected ErrTracerProviderPortRequired, got %v", got)
@@ -87,9 +110,8 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "tracer provider port invalid",
-			err:     tracer.ErrProviderPortInvalid,
-			message: "test message",
+			name: "tracer provider port invalid",
+			err:  tracer.ErrProviderPortInvalid,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrTracerProviderPortInvalid) {
 					t.Errorf("expected ErrTracerProviderPortInvalid, got %v", got)
@@ -101,9 +123,8 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "tracer batch timeout invalid",
-			err:     tracer.ErrBatchTimeoutInvalid,
-			message: "test message",
+			name: "tracer batch timeout invalid",
+			err:  tracer.ErrBatchTimeoutInvalid,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrTracerBatchTimeoutInvalid) {
 					t.Errorf("expected ErrTracerBatchTimeoutInvalid, got %v", got)
@@ -115,9 +136,98 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "metric provider host required",
-			err:     metric.ErrProviderHostRequired,
-			message: "test message",
+			name: "tracer remote sampling interval invalid",
+			err:  tracer.ErrRemoteSamplingIntervalInvalid,
+			validate: func(t *testing.T, got error) {
+				if got != ErrTracerRemoteSamplingIntervalInvalid {
+					t.Errorf("expected direct ErrTracerRemoteSamplingIntervalInvalid, got %v", got)
+				}
+			},
+		},
+		{
+			name: "tracer body snippet limit invalid",
+			err:  tracer.ErrBodySnippetLimitInvalid,
+			validate: func(t *testing.T, got error) {
+				if got != ErrTracerBodySnippetLimitInvalid {
+					t.Errorf("expected direct ErrTracerBodySnippetLimitInvalid, got %v", got)
+				}
+			},
+		},
+		{
+			name: "tracer invalid fallback provider",
+			err:  tracer.ErrInvalidFallbackProvider,
+			validate: func(t *testing.T, got error) {
+				if got != ErrTracerInvalidFallbackProvider {
+					t.Errorf("expected direct ErrTracerInvalidFallbackProvider, got %v", got)
+				}
+			},
+		},
+		{
+			name: "tracer fallback path required",
+			err:  tracer.ErrFallbackPathRequired,
+			validate: func(t *testing.T, got error) {
+				if got != ErrTracerFallbackPathRequired {
+					t.Errorf("expected direct ErrTracerFallbackPathRequired, got %v", got)
+				}
+			},
+		},
+		{
+			name: "tracer endpoint invalid",
+			err:  tracer.ErrEndpointInvalid,
+			validate: func(t *testing.T, got error) {
+				if got != ErrTracerEndpointInvalid {
+					t.Errorf("expected direct ErrTracerEndpointInvalid, got %v", got)
+				}
+			},
+		},
+		{
+			name: "metric endpoint invalid",
+			err:  metric.ErrEndpointInvalid,
+			validate: func(t *testing.T, got error) {
+				if got != ErrMetricEndpointInvalid {
+					t.Errorf("expected direct ErrMetricEndpointInvalid, got %v", got)
+				}
+			},
+		},
+		{
+			name: "tracer breaker threshold invalid",
+			err:  tracer.ErrBreakerThresholdInvalid,
+			validate: func(t *testing.T, got error) {
+				if got != ErrTracerBreakerThresholdInvalid {
+					t.Errorf("expected direct ErrTracerBreakerThresholdInvalid, got %v", got)
+				}
+			},
+		},
+		{
+			name: "tracer breaker max backoff invalid",
+			err:  tracer.ErrBreakerMaxBackoffInvalid,
+			validate: func(t *testing.T, got error) {
+				if got != ErrTracerBreakerMaxBackoffInvalid {
+					t.Errorf("expected direct ErrTracerBreakerMaxBackoffInvalid, got %v", got)
+				}
+			},
+		},
+		{
+			name: "metric breaker threshold invalid",
+			err:  metric.ErrBreakerThresholdInvalid,
+			validate: func(t *testing.T, got error) {
+				if got != ErrMetricBreakerThresholdInvalid {
+					t.Errorf("expected direct ErrMetricBreakerThresholdInvalid, got %v", got)
+				}
+			},
+		},
+		{
+			name: "metric breaker max backoff invalid",
+			err:  metric.ErrBreakerMaxBackoffInvalid,
+			validate: func(t *testing.T, got error) {
+				if got != ErrMetricBreakerMaxBackoffInvalid {
+					t.Errorf("expected direct ErrMetricBreakerMaxBackoffInvalid, got %v", got)
+				}
+			},
+		},
+		{
+			name: "metric provider host required",
+			err:  metric.ErrProviderHostRequired,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrMetricProviderHostRequired) {
 					t.Errorf("expected ErrMetricProviderHostRequired, got %v", got)
@@ -129,9 +239,8 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "metric provider port required",
-			err:     metric.ErrProviderPortRequired,
-			message: "test message",
+			name: "metric provider port required",
+			err:  metric.ErrProviderPortRequired,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrMetricProviderPortRequired) {
 					t.Errorf("expected ErrMetricProviderPortRequired, got %v", got)
@@ -143,9 +252,8 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "metric provider port invalid",
-			err:     metric.ErrProviderPortInvalid,
-			message: "test message",
+			name: "metric provider port invalid",
+			err:  metric.ErrProviderPortInvalid,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrMetricProviderPortInvalid) {
 					t.Errorf("expected ErrMetricProviderPortInvalid, got %v", got)
@@ -157,9 +265,8 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "metric interval invalid",
-			err:     metric.ErrIntervalInvalid,
-			message: "test message",
+			name: "metric interval invalid",
+			err:  metric.ErrIntervalInvalid,
 			validate: func(t *testing.T, got error) {
 				if !errors.Is(got, ErrMetricIntervalInvalid) {
 					t.Errorf("expected ErrMetricIntervalInvalid, got %v", got)
@@ -171,19 +278,42 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "generic error wrapped",
-			err:     errors.New("some error"),
-			message: "failed to initialize",
+			name: "metric reader mode invalid",
+			err:  metric.ErrInvalidReaderMode,
+			validate: func(t *testing.T, got error) {
+				if got != ErrMetricInvalidReaderMode {
+					t.Errorf("expected direct ErrMetricInvalidReaderMode, got %v", got)
+				}
+			},
+		},
+		{
+			name: "metric temporality invalid",
+			err:  metric.ErrInvalidTemporality,
+			validate: func(t *testing.T, got error) {
+				if got != ErrMetricInvalidTemporality {
+					t.Errorf("expected direct ErrMetricInvalidTemporality, got %v", got)
+				}
+			},
+		},
+		{
+			name: "generic error wrapped",
+			err:  errors.New("some error"),
 			validate: func(t *testing.T, got error) {
 				if got == nil {
 					t.Error("expected error, got nil")
 					return
 				}
-				// Generic errors are wrapped with fmt.Errorf using %w
-				// Verify the error message format
+				// Generic errors are wrapped in an *Error carrying the component context
+				var monErr *Error
+				if !errors.As(got, &monErr) {
+					t.Fatalf("expected *Error, got %T", got)
+				}
+				if monErr.Component != ComponentTracer || monErr.Operation != OperationInitialize || monErr.Provider != "otlp" {
+					t.Errorf("Error context = (%q, %q, %q), want (tracer, initialize, otlp)", monErr.Component, monErr.Operation, monErr.Provider)
+				}
 				errMsg := got.Error()
-				if errMsg != "failed to initialize: some error" {
-					t.Errorf("expected error message 'failed to initialize: some error', got %q", errMsg)
+				if errMsg != "failed to initialize tracer: some error" {
+					t.Errorf("expected error message 'failed to initialize tracer: some error', got %q", errMsg)
 				}
 				// Verify it's a wrapped error by checking it unwraps correctly
 				unwrapped := errors.Unwrap(got)
@@ -195,18 +325,17 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 			},
 		},
 		{
-			name:    "nil error",
-			err:     nil,
-			message: "test message",
+			name: "nil error",
+			err:  nil,
 			validate: func(t *testing.T, got error) {
 				if got == nil {
 					t.Error("expected error, got nil")
 					return
 				}
-				// parseError returns fmt.Errorf("%s: unknown error", message) for nil errors
+				// parseError reports nil errors as "unknown error"
 				errMsg := got.Error()
-				if errMsg != "test message: unknown error" {
-					t.Errorf("expected error message 'test message: unknown error', got %q", errMsg)
+				if errMsg != "failed to initialize tracer: unknown error" {
+					t.Errorf("expected error message 'failed to initialize tracer: unknown error', got %q", errMsg)
 				}
 			},
 		},
@@ -214,8 +343,126 @@ func TestMonitoring_Errors_ParseError(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := parseError(tt.err, tt.message)
+			got := parseError(tt.err, ComponentTracer, OperationInitialize, "otlp")
 			tt.validate(t, got)
 		})
 	}
 }
+
+func TestMonitoring_Errors_ShutdownError(t *testing.T) {
+	tracerErr := errors.New("tracer down")
+	metricErr := errors.New("metric down")
+
+	tests := []struct {
+		name    string
+		err     *ShutdownError
+		wantMsg string
+		wantIs  []error
+	}{
+		{
+			name:    "tracer only",
+			err:     &ShutdownError{Tracer: tracerErr},
+			wantMsg: "failed to shutdown tracer: tracer down",
+			wantIs:  []error{tracerErr},
+		},
+		{
+			name:    "metric only",
+			err:     &ShutdownError{Metric: metricErr},
+			wantMsg: "failed to shutdown metric: metric down",
+			wantIs:  []error{metricErr},
+		},
+		{
+			name:    "both components",
+			err:     &ShutdownError{Tracer: tracerErr, Metric: metricErr},
+			wantMsg: "failed to shutdown tracer: tracer down; failed to shutdown metric: metric down",
+			wantIs:  []error{tracerErr, metricErr},
+		},
+		{
+			name:    "error reporter",
+			err:     &ShutdownError{Errors: context.DeadlineExceeded},
+			wantMsg: "failed to shutdown error reporter: context deadline exceeded",
+			wantIs:  []error{context.DeadlineExceeded},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.wantMsg {
+				t.Errorf("Error() = %q, want %q", got, tt.wantMsg)
+			}
+			for _, want := range tt.wantIs {
+				if !errors.Is(tt.err, want) {
+					t.Errorf("errors.Is(%v, %v) = false, want true", tt.err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMonitoring_Errors_Error(t *testing.T) {
+	cause := errors.New("connection refused")
+
+	tests := []struct {
+		name    string
+		err     *Error
+		wantMsg string
+	}{
+		{
+			name:    "initialize",
+			err:     &Error{Component: ComponentMetric, Operation: OperationInitialize, Provider: "otlp", Err: cause},
+			wantMsg: "failed to initialize metric: connection refused",
+		},
+		{
+			name:    "reload",
+			err:     &Error{Component: ComponentTracer, Operation: OperationReload, Provider: "grpc://collector:4317", Err: cause},
+			wantMsg: "failed to reload tracer: connection refused",
+		},
+		{
+			name:    "validate",
+			err:     &Error{Component: ComponentLogger, Operation: OperationValidate, Err: cause},
+			wantMsg: "invalid logger options: connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.wantMsg {
+				t.Errorf("Error() = %q, want %q", got, tt.wantMsg)
+			}
+			if !errors.Is(tt.err, cause) {
+				t.Error("expected errors.Is to match the underlying error")
+			}
+		})
+	}
+}
+
+func TestMonitoring_Errors_Error_NewMonitoring(t *testing.T) {
+	_, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithLoggerOutputPath(filepath.Join(t.TempDir(), "missing", "app.log")),
+	)
+
+	var monErr *Error
+	if !errors.As(err, &monErr) {
+		t.Fatalf("NewMonitoring() error = %v, want *Error", err)
+	}
+	if monErr.Component != ComponentLogger || monErr.Operation != OperationInitialize {
+		t.Errorf("Error context = (%q, %q), want (logger, initialize)", monErr.Component, monErr.Operation)
+	}
+}
+
+func TestMonitoring_Errors_InitError(t *testing.T) {
+	loggerErr := errors.New("logger down")
+	metricErr := errors.New("metric down")
+
+	err := &InitError{Logger: loggerErr, Metric: metricErr}
+	if got, want := err.Error(), "logger down; metric down"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(err, loggerErr) || !errors.Is(err, metricErr) {
+		t.Error("expected errors.Is to match every component error")
+	}
+	if len(err.Unwrap()) != 2 {
+		t.Errorf("Unwrap() returned %d errors, want 2", len(err.Unwrap()))
+	}
+}
@@ -0,0 +1,63 @@
+package monitoring
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// eventsMetricName is the counter incremented by Event when WithEventMetrics is enabled.
+const eventsMetricName = "events_total"
+
+// Event emits a structured business or audit event (e.g. "order-placed", "user-deleted")
+// through every configured component at once, so product analytics and audits can consume
+// the same record regardless of the signal they read:
+//   - an info log entry with the event name as message, the fields, and an "event" field
+//     holding the name, correlated with the span found in ctx
+//   - an event with the fields as attributes on the span found in ctx, if it is recording
+//   - an increment of the "events_total" counter labelled with "event", when enabled with
+//     WithEventMetrics; fields are not used as labels, to keep the cardinality bounded
+//
+// Parameters:
+//   - ctx: The context of the operation the event belongs to (may contain a span)
+//   - name: The event name, used as log message, span event name, and metric label
+//   - fields: The event details (may be nil)
+//
+// Example:
+//
+//	mon.Event(ctx, "order-placed", map[string]interface{}{
+//	    "order_id": order.ID,
+//	    "amount":   order.Total,
+//	})
+func (m *Monitoring) Event(ctx context.Context, name string, fields map[string]interface{}) {
+	span := trace.SpanFromContext(ctx)
+
+	if m.Logger != nil {
+		logFields := make(map[string]interface{}, len(fields)+1)
+		for key, value := range fields {
+			logFields[key] = value
+		}
+		logFields["event"] = name
+		logger := m.Logger
+		if spanContext := span.SpanContext(); spanContext.IsValid() {
+			logger = logger.WithSpanContext(spanContext)
+		}
+		logger.Info(name, logFields)
+	}
+
+	if m.Tracer != nil && span.IsRecording() {
+		m.Tracer.AddEvent(span, name, fields)
+	}
+
+	m.mu.Lock()
+	enabled := m.options != nil && m.options.EventMetrics
+	m.mu.Unlock()
+	if enabled && m.Metric != nil {
+		counter, err := m.Metric.CreateCounter(eventsMetricName, "1", "Total number of business and audit events")
+		if err != nil {
+			return
+		}
+		m.Metric.RecordCounter(ctx, counter, 1, attribute.String("event", name))
+	}
+}
@@ -0,0 +1,89 @@
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// infoLogger is a Logger that records info-level messages and fields for assertions.
+type infoLogger struct {
+	recordingLogger
+	messages []string
+	fields   []map[string]interface{}
+}
+
+func (l *infoLogger) Info(message string, fields map[string]interface{}) {
+	l.messages = append(l.messages, message)
+	l.fields = append(l.fields, fields)
+}
+
+func (l *infoLogger) WithSpanContext(span trace.SpanContext) Logger { return l }
+
+// eventMetric is a Metric that records the labels of every counter increment.
+type eventMetric struct {
+	Metric
+	labels [][]attribute.KeyValue
+}
+
+func (m *eventMetric) RecordCounter(ctx context.Context, counter otelmetric.Int64Counter, value int64, labels ...attribute.KeyValue) {
+	m.labels = append(m.labels, labels)
+}
+
+func TestMonitoring_Event_Event(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		mon, err := NewMonitoring(WithServiceName("test-service"), WithEventMetrics(enabled))
+		if err != nil {
+			t.Fatalf("NewMonitoring() error = %v", err)
+		}
+		defer func() {
+			_ = mon.Shutdown(context.Background())
+		}()
+		logger := &infoLogger{}
+		mon.Logger = logger
+		metric := &eventMetric{Metric: mon.Metric}
+		mon.Metric = metric
+
+		ctx, span := mon.Tracer.StartSpan(context.Background(), "checkout")
+		mon.Event(ctx, "order-placed", map[string]interface{}{"order_id": "o-42"})
+		events := span.(sdktrace.ReadOnlySpan).Events()
+		span.End()
+
+		if len(logger.messages) != 1 || logger.messages[0] != "order-placed" {
+			t.Fatalf("logged messages = %v, want [order-placed]", logger.messages)
+		}
+		if fields := logger.fields[0]; fields["event"] != "order-placed" || fields["order_id"] != "o-42" {
+			t.Errorf("logged fields = %v, want event and order_id", fields)
+		}
+		if len(events) != 1 || events[0].Name != "order-placed" {
+			t.Fatalf("span events = %v, want one order-placed event", events)
+		}
+		if attrs := events[0].Attributes; len(attrs) != 1 || attrs[0] != attribute.String("order_id", "o-42") {
+			t.Errorf("span event attributes = %v, want order_id", attrs)
+		}
+
+		wantCounts := 0
+		if enabled {
+			wantCounts = 1
+		}
+		if len(metric.labels) != wantCounts {
+			t.Fatalf("WithEventMetrics(%v): %d counter increments, want %d", enabled, len(metric.labels), wantCounts)
+		}
+		if enabled && (len(metric.labels[0]) != 1 || metric.labels[0][0] != attribute.String("event", "order-placed")) {
+			t.Errorf("counter labels = %v, want event=order-placed", metric.labels[0])
+		}
+	}
+}
+
+func TestMonitoring_Event_WithoutSpan(t *testing.T) {
+	mon := &Monitoring{Logger: &infoLogger{}}
+	// Without a span, the event is only logged.
+	mon.Event(context.Background(), "user-deleted", nil)
+	if logger := mon.Logger.(*infoLogger); len(logger.messages) != 1 || logger.fields[0]["event"] != "user-deleted" {
+		t.Errorf("logged %v %v, want one user-deleted entry", logger.messages, logger.fields)
+	}
+}
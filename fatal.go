@@ -0,0 +1,84 @@
+package monitoring
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"time"
+)
+
+// FlushOnPanic exports the telemetry buffered so far when the calling goroutine panics, then
+// re-panics, so the spans and metrics leading up to a crash are not lost with the process.
+// The panic is logged at error level with its stack trace first. It must be deferred directly,
+// at the top of main or of a goroutine whose panic would crash the process; the flush is
+// bounded by the exit flush timeout (see WithExitFlushTimeout). Without a panic it does nothing.
+//
+// Example:
+//
+//	func main() {
+//	    mon, err := NewMonitoring(WithServiceName("my-service"))
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    defer mon.FlushOnPanic()
+//	    run(mon)
+//	}
+func (m *Monitoring) FlushOnPanic() {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	m.loggerOrNoop().Error("Panic", map[string]interface{}{
+		"panic": fmt.Sprint(r),
+		"stack": string(debug.Stack()),
+	})
+
+	m.mu.Lock()
+	timeout := defaultOptions().Logger.ExitFlushTimeout
+	if m.options != nil {
+		timeout = m.options.Logger.ExitFlushTimeout
+	}
+	m.mu.Unlock()
+	_ = shutdownWithTimeout(context.Background(), timeout, m.Flush)
+
+	panic(r)
+}
+
+// NewWebhookFatalHook returns a FatalHook that posts the fatal entry as JSON to url, e.g. a
+// Slack or PagerDuty webhook relay, so a crash is announced even when logs are not watched.
+// The body is an object with "message", "time", "caller", and "fields". Delivery is attempted
+// once and bounded by timeout; failures are ignored, as the process is exiting.
+//
+// Parameters:
+//   - url: The endpoint the entry is posted to
+//   - timeout: The maximum time to wait for the endpoint
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithFatalHooks(NewWebhookFatalHook(os.Getenv("CRASH_WEBHOOK_URL"), 2*time.Second)),
+//	)
+func NewWebhookFatalHook(url string, timeout time.Duration) FatalHook {
+	client := &http.Client{Timeout: timeout}
+	return func(entry FatalEntry) {
+		body, err := json.Marshal(map[string]interface{}{
+			"message": entry.Message,
+			"time":    entry.Time,
+			"caller":  entry.Caller,
+			"fields":  entry.Fields,
+		})
+		if err != nil {
+			return
+		}
+		resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return
+		}
+		_ = resp.Body.Close()
+	}
+}
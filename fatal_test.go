@@ -0,0 +1,127 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// countingFlushTracer is a Tracer counting ForceFlush calls. Other methods are not implemented.
+type countingFlushTracer struct {
+	Tracer
+	flushes int
+}
+
+func (c *countingFlushTracer) ForceFlush(ctx context.Context) error {
+	c.flushes++
+	return nil
+}
+
+func TestMonitoring_Fatal_FlushOnPanic(t *testing.T) {
+	tracer := &countingFlushTracer{}
+	logs := &recordingLogger{}
+	mon := &Monitoring{Logger: logs, Tracer: tracer, options: defaultOptions()}
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v, want the original panic re-raised", r)
+			}
+		}()
+		defer mon.FlushOnPanic()
+		panic("boom")
+	}()
+
+	if tracer.flushes != 1 {
+		t.Errorf("ForceFlush called %d times, want 1", tracer.flushes)
+	}
+	if logged := logs.waitForErrors(t, 1)[0]; logged["panic"] != "boom" || logged["stack"] == "" {
+		t.Errorf("logged fields = %v", logged)
+	}
+
+	// Without a panic nothing is flushed.
+	func() {
+		defer mon.FlushOnPanic()
+	}()
+	if tracer.flushes != 1 {
+		t.Errorf("ForceFlush called %d times without a panic, want 1", tracer.flushes)
+	}
+}
+
+// TestMonitoring_Fatal_Hooks runs Logger.Fatal in a child process, since it exits, and checks
+// that the hooks ran and the batched span was exported before the exit.
+func TestMonitoring_Fatal_Hooks(t *testing.T) {
+	if marker := os.Getenv("MONITORING_FATAL_MARKER"); marker != "" {
+		mon, err := NewMonitoring(
+			WithServiceName("test-service"),
+			WithLoggerDisabled(true),
+			WithFatalHooks(func(entry FatalEntry) {
+				_ = os.WriteFile(marker, []byte(entry.Message), 0o600)
+			}),
+		)
+		if err != nil {
+			t.Fatalf("NewMonitoring() error = %v", err)
+		}
+		_, span := mon.Tracer.StartSpan(context.Background(), "span-before-fatal")
+		mon.Tracer.EndSpan(span)
+		mon.Logger.Fatal("database unreachable", nil)
+		return
+	}
+
+	marker := filepath.Join(t.TempDir(), "fatal")
+	cmd := exec.Command(os.Args[0], "-test.run=^TestMonitoring_Fatal_Hooks$")
+	cmd.Env = append(os.Environ(), "MONITORING_FATAL_MARKER="+marker)
+	output, err := cmd.Output()
+
+	if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.ExitCode() != 1 {
+		t.Fatalf("child process error = %v, want exit status 1", err)
+	}
+	got, err := os.ReadFile(marker)
+	if err != nil {
+		t.Fatalf("fatal hook did not run: %v", err)
+	}
+	if string(got) != "database unreachable" {
+		t.Errorf("fatal hook saw message %q, want %q", got, "database unreachable")
+	}
+	if !strings.Contains(string(output), "span-before-fatal") {
+		t.Errorf("span was not exported before exit, stdout:\n%s", output)
+	}
+}
+
+func TestMonitoring_Fatal_NewWebhookFatalHook(t *testing.T) {
+	received := make(chan map[string]interface{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		received <- body
+	}))
+	defer server.Close()
+
+	NewWebhookFatalHook(server.URL, time.Second)(FatalEntry{
+		Message: "database unreachable",
+		Caller:  "main.go:42",
+		Fields:  map[string]interface{}{"attempts": 3},
+	})
+
+	select {
+	case body := <-received:
+		if body["message"] != "database unreachable" || body["caller"] != "main.go:42" {
+			t.Errorf("webhook body = %v", body)
+		}
+		if fields, _ := body["fields"].(map[string]interface{}); fields["attempts"] != float64(3) {
+			t.Errorf("webhook fields = %v", body["fields"])
+		}
+	default:
+		t.Fatal("webhook was not called")
+	}
+
+	// An unreachable endpoint must not panic or block past the timeout.
+	NewWebhookFatalHook("http://127.0.0.1:1", 100*time.Millisecond)(FatalEntry{Message: "ignored"})
+}
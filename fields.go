@@ -0,0 +1,68 @@
+package monitoring
+
+import (
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/logger"
+)
+
+// Field is a strongly-typed structured logging field for the Logger *Fields methods
+// (InfoFields, ErrorFields, ...). Logging with typed fields avoids the map and reflection of
+// the map-based API, which matters on hot paths.
+// It is re-exported from the internal logger package for public API use.
+//
+// Example:
+//
+//	mon.Logger.InfoFields("Request completed",
+//	    monitoring.String("method", "GET"),
+//	    monitoring.Int("status_code", 200),
+//	    monitoring.Duration("duration", elapsed),
+//	)
+type Field = logger.Field
+
+// String constructs a Field with a string value.
+func String(key, value string) Field {
+	return logger.String(key, value)
+}
+
+// Int constructs a Field with an int value.
+func Int(key string, value int) Field {
+	return logger.Int(key, value)
+}
+
+// Int64 constructs a Field with an int64 value.
+func Int64(key string, value int64) Field {
+	return logger.Int64(key, value)
+}
+
+// Float64 constructs a Field with a float64 value.
+func Float64(key string, value float64) Field {
+	return logger.Float64(key, value)
+}
+
+// Bool constructs a Field with a bool value.
+func Bool(key string, value bool) Field {
+	return logger.Bool(key, value)
+}
+
+// Duration constructs a Field with a time.Duration value.
+func Duration(key string, value time.Duration) Field {
+	return logger.Duration(key, value)
+}
+
+// Time constructs a Field with a time.Time value.
+func Time(key string, value time.Time) Field {
+	return logger.Time(key, value)
+}
+
+// Err constructs a Field with the key "error" holding err's message. A nil err produces a
+// field that is omitted from the output.
+func Err(err error) Field {
+	return logger.Err(err)
+}
+
+// Any constructs a Field for an arbitrary value. It may allocate; prefer the typed
+// constructors on hot paths.
+func Any(key string, value interface{}) Field {
+	return logger.Any(key, value)
+}
@@ -0,0 +1,37 @@
+package monitoring
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+func TestMonitoring_Fields_Constructors(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name     string
+		field    Field
+		wantKey  string
+		wantType zapcore.FieldType
+	}{
+		{"string", String("method", "GET"), "method", zapcore.StringType},
+		{"int", Int("status", 200), "status", zapcore.Int64Type},
+		{"int64", Int64("bytes", 1024), "bytes", zapcore.Int64Type},
+		{"float64", Float64("ratio", 0.5), "ratio", zapcore.Float64Type},
+		{"bool", Bool("cached", true), "cached", zapcore.BoolType},
+		{"duration", Duration("duration", time.Second), "duration", zapcore.DurationType},
+		{"time", Time("at", now), "at", zapcore.TimeType},
+		{"error", Err(errors.New("boom")), "error", zapcore.ErrorType},
+		{"any", Any("tags", []string{"a"}), "tags", zapcore.ArrayMarshalerType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.field.Key != tt.wantKey || tt.field.Type != tt.wantType {
+				t.Errorf("field = (%q, %v), want (%q, %v)", tt.field.Key, tt.field.Type, tt.wantKey, tt.wantType)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// goroutineFailuresMetricName is the counter incremented by Go when a background goroutine
+// returns an error or panics.
+const goroutineFailuresMetricName = "goroutine_failures_total"
+
+// Go runs fn in a new goroutine that stays correlated with the request that started it.
+// The goroutine gets its own root span named after name, linked to the span found in ctx
+// (if any), so the background work is visible on its own timeline without extending the
+// parent request's trace. The context passed to fn is detached from ctx's cancellation,
+// since background work usually outlives the request that spawned it.
+//
+// If fn returns an error or panics, the failure is recorded on the span, logged at error
+// level, and counted in the "goroutine_failures_total" counter with "goroutine" and "reason"
+// attributes. A panic is recovered and never propagates to the caller.
+//
+// Parameters:
+//   - ctx: The originating context (may contain the parent span to link to)
+//   - name: The name of the background operation, used as span name and metric attribute
+//   - fn: The function to run in the goroutine
+//
+// Example:
+//
+//	mon.Go(ctx, "send-welcome-email", func(ctx context.Context) error {
+//	    return mailer.SendWelcome(ctx, user)
+//	})
+func (m *Monitoring) Go(ctx context.Context, name string, fn func(ctx context.Context) error) {
+	parent := trace.SpanContextFromContext(ctx)
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		var span trace.Span
+		if m.Tracer != nil {
+			spanOpts := []trace.SpanStartOption{trace.WithNewRoot()}
+			if parent.IsValid() {
+				spanOpts = append(spanOpts, trace.WithLinks(trace.Link{SpanContext: parent}))
+			}
+			ctx, span = m.Tracer.StartSpan(ctx, name, spanOpts...)
+			defer m.Tracer.EndSpan(span)
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				m.recordGoroutineFailure(ctx, span, name, "panic", fmt.Errorf("panic: %v", r), debug.Stack())
+			}
+		}()
+
+		if err := fn(ctx); err != nil {
+			m.recordGoroutineFailure(ctx, span, name, "error", err, nil)
+		}
+	}()
+}
+
+// recordGoroutineFailure reports a failed background goroutine on every configured component.
+// The span and stack are optional; nil values are skipped.
+func (m *Monitoring) recordGoroutineFailure(ctx context.Context, span trace.Span, name, reason string, err error, stack []byte) {
+	if span != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	if m.Logger != nil {
+		fields := map[string]interface{}{
+			"goroutine": name,
+			"reason":    reason,
+			"error":     err.Error(),
+		}
+		if stack != nil {
+			fields["stack"] = string(stack)
+		}
+		logger := m.Logger
+		if span != nil {
+			logger = logger.WithSpanContext(span.SpanContext())
+		}
+		logger.Error("Background goroutine failed", fields)
+	}
+
+	if m.Metric != nil {
+		counter, err := m.Metric.CreateCounter(goroutineFailuresMetricName, "1", "Total number of failed background goroutines")
+		if err != nil {
+			return
+		}
+		m.Metric.RecordCounter(ctx, counter, 1,
+			attribute.String("goroutine", name),
+			attribute.String("reason", reason),
+		)
+	}
+}
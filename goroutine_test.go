@@ -0,0 +1,159 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordingLogger is a Logger that records error-level messages for assertions.
+type recordingLogger struct {
+	mu     sync.Mutex
+	errors []map[string]interface{}
+}
+
+func (l *recordingLogger) SetLogLevel(level string)                            {}
+func (l *recordingLogger) Debug(message string, fields map[string]interface{}) {}
+func (l *recordingLogger) Info(message string, fields map[string]interface{})  {}
+func (l *recordingLogger) Warn(message string, fields map[string]interface{})  {}
+func (l *recordingLogger) Fatal(message string, fields map[string]interface{}) {}
+func (l *recordingLogger) Enabled(level string) bool                           { return true }
+func (l *recordingLogger) DebugFields(message string, fields ...Field)         {}
+func (l *recordingLogger) InfoFields(message string, fields ...Field)          {}
+func (l *recordingLogger) WarnFields(message string, fields ...Field)          {}
+func (l *recordingLogger) ErrorFields(message string, fields ...Field)         {}
+func (l *recordingLogger) FatalFields(message string, fields ...Field)         {}
+func (l *recordingLogger) Sync() error                                         { return nil }
+func (l *recordingLogger) WithSpanContext(span trace.SpanContext) Logger       { return l }
+func (l *recordingLogger) SlogHandler() slog.Handler                           { return slog.DiscardHandler }
+func (l *recordingLogger) Logr() logr.Logger                                   { return logr.Discard() }
+func (l *recordingLogger) StdLogger(level string) *log.Logger                  { return log.New(io.Discard, "", 0) }
+func (l *recordingLogger) Error(message string, fields map[string]interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errors = append(l.errors, fields)
+}
+
+func (l *recordingLogger) DebugLazy(message string, fields func() map[string]interface{}) {}
+
+func (l *recordingLogger) Audit(event string, fields map[string]interface{}) error { return nil }
+
+func (l *recordingLogger) waitForErrors(t *testing.T, n int) []map[string]interface{} {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		l.mu.Lock()
+		if len(l.errors) >= n {
+			got := append([]map[string]interface{}(nil), l.errors...)
+			l.mu.Unlock()
+			return got
+		}
+		l.mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d error logs", n)
+	return nil
+}
+
+func TestMonitoring_Goroutine_Go(t *testing.T) {
+	tests := []struct {
+		name       string
+		fn         func(ctx context.Context) error
+		wantReason string
+	}{
+		{
+			name:       "error is recorded",
+			fn:         func(ctx context.Context) error { return errors.New("boom") },
+			wantReason: "error",
+		},
+		{
+			name:       "panic is recovered",
+			fn:         func(ctx context.Context) error { panic("boom") },
+			wantReason: "panic",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(WithServiceName("test-service"))
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+			recorder := &recordingLogger{}
+			mon.Logger = recorder
+
+			mon.Go(context.Background(), "test-job", tt.fn)
+
+			got := recorder.waitForErrors(t, 1)
+			if got[0]["goroutine"] != "test-job" {
+				t.Errorf("expected goroutine = 'test-job', got %v", got[0]["goroutine"])
+			}
+			if got[0]["reason"] != tt.wantReason {
+				t.Errorf("expected reason = %q, got %v", tt.wantReason, got[0]["reason"])
+			}
+		})
+	}
+}
+
+func TestMonitoring_Goroutine_Go_LinksParentSpan(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx, parent := mon.Tracer.StartSpan(ctx, "parent")
+	defer parent.End()
+
+	done := make(chan trace.SpanContext, 1)
+	mon.Go(ctx, "child", func(ctx context.Context) error {
+		// Background work must not be cancelled together with the request.
+		cancel()
+		if ctx.Err() != nil {
+			t.Errorf("expected detached context, got %v", ctx.Err())
+		}
+		done <- trace.SpanContextFromContext(ctx)
+		return nil
+	})
+
+	select {
+	case sc := <-done:
+		if !sc.IsValid() {
+			t.Fatal("expected a valid span context in goroutine")
+		}
+		if sc.TraceID() == parent.SpanContext().TraceID() {
+			t.Error("expected goroutine span to start a new trace")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for goroutine")
+	}
+}
+
+func TestMonitoring_Goroutine_Go_NilComponents(t *testing.T) {
+	mon := &Monitoring{}
+	done := make(chan struct{})
+	mon.Go(context.Background(), "bare", func(ctx context.Context) error {
+		defer close(done)
+		panic("boom")
+	})
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for goroutine")
+	}
+}
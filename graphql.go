@@ -0,0 +1,116 @@
+package monitoring
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GraphQLOperation starts a span for a GraphQL operation, so a gateway serving every operation
+// on one HTTP route gets one span per operation instead of a single "POST /graphql" name. The
+// span is named "<type> <name>" (e.g. "query GetOrder"), or just the type for anonymous
+// operations, and carries graphql.operation.type and graphql.operation.name. Call finish with
+// the errors of the response; each is recorded on the span, which is then marked as failed,
+// and graphql.errors.count is set. It is framework-agnostic; see the example for a gqlgen
+// extension.
+//
+// Parameters:
+//   - ctx: The context of the request (may contain the HTTP server span)
+//   - operationType: The operation type ("query", "mutation", or "subscription")
+//   - operationName: The operation name, or "" for an anonymous operation
+//
+// Returns:
+//   - A new context containing the operation span
+//   - A function that records the errors of the response and ends the span
+//
+// Example:
+//
+//	// graphQLTracing is a gqlgen extension: srv.Use(graphQLTracing{mon})
+//	type graphQLTracing struct{ mon *monitoring.Monitoring }
+//
+//	func (graphQLTracing) ExtensionName() string                   { return "Monitoring" }
+//	func (graphQLTracing) Validate(graphql.ExecutableSchema) error { return nil }
+//
+//	func (e graphQLTracing) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+//	    op := graphql.GetOperationContext(ctx)
+//	    ctx, finish := e.mon.GraphQLOperation(ctx, string(op.Operation.Operation), op.OperationName)
+//	    resp := next(ctx)
+//	    var errs []error
+//	    if resp != nil {
+//	        for _, err := range resp.Errors {
+//	            errs = append(errs, err)
+//	        }
+//	    }
+//	    finish(errs...)
+//	    return resp
+//	}
+func (m *Monitoring) GraphQLOperation(ctx context.Context, operationType, operationName string) (context.Context, func(errs ...error)) {
+	name := operationType
+	attrs := []attribute.KeyValue{semconv.GraphqlOperationTypeKey.String(operationType)}
+	if operationName != "" {
+		name += " " + operationName
+		attrs = append(attrs, semconv.GraphqlOperationName(operationName))
+	}
+
+	tracer := m.tracerOrNoop()
+	ctx, span := tracer.StartSpan(ctx, name, trace.WithAttributes(attrs...))
+	finish := func(errs ...error) {
+		if len(errs) > 0 {
+			for _, err := range errs {
+				span.RecordError(err)
+			}
+			span.SetAttributes(attribute.Int("graphql.errors.count", len(errs)))
+			span.SetStatus(codes.Error, errs[0].Error())
+		}
+		tracer.EndSpan(span)
+	}
+	return ctx, finish
+}
+
+// GraphQLResolver starts a child span for a field resolver, named "<object>.<field>" (e.g.
+// "Order.customer"), with graphql.resolver.object, graphql.resolver.field, and
+// graphql.resolver.path set. Call finish with the error returned by the resolver; an error is
+// recorded on the span, which is then marked as failed. Use it only for fields backed by a
+// resolver function: a span per plain struct field would dwarf the work being traced.
+//
+// Parameters:
+//   - ctx: The context of the resolver (contains the operation span)
+//   - object: The GraphQL type the field belongs to
+//   - field: The field name
+//   - path: The path of the field in the response, e.g. "order.items.0.product"
+//
+// Returns:
+//   - A new context containing the resolver span
+//   - A function that records the resolver error, if any, and ends the span
+//
+// Example:
+//
+//	func (e graphQLTracing) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+//	    fc := graphql.GetFieldContext(ctx)
+//	    if !fc.IsResolver {
+//	        return next(ctx)
+//	    }
+//	    ctx, finish := e.mon.GraphQLResolver(ctx, fc.Object, fc.Field.Name, fc.Path().String())
+//	    res, err := next(ctx)
+//	    finish(err)
+//	    return res, err
+//	}
+func (m *Monitoring) GraphQLResolver(ctx context.Context, object, field, path string) (context.Context, func(err error)) {
+	tracer := m.tracerOrNoop()
+	ctx, span := tracer.StartSpan(ctx, object+"."+field, trace.WithAttributes(
+		attribute.String("graphql.resolver.object", object),
+		attribute.String("graphql.resolver.field", field),
+		attribute.String("graphql.resolver.path", path),
+	))
+	finish := func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		tracer.EndSpan(span)
+	}
+	return ctx, finish
+}
@@ -0,0 +1,124 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMonitoring_GraphQL_GraphQLOperation(t *testing.T) {
+	tests := []struct {
+		name          string
+		operationType string
+		operationName string
+		errs          []error
+		wantName      string
+		wantStatus    codes.Code
+	}{
+		{name: "named query", operationType: "query", operationName: "GetOrder", wantName: "query GetOrder", wantStatus: codes.Unset},
+		{name: "anonymous mutation", operationType: "mutation", wantName: "mutation", wantStatus: codes.Unset},
+		{
+			name:          "operation with errors",
+			operationType: "query",
+			operationName: "GetOrder",
+			errs:          []error{errors.New("order not found"), errors.New("customer not found")},
+			wantName:      "query GetOrder",
+			wantStatus:    codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(WithServiceName("test-service"))
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+
+			ctx, finish := mon.GraphQLOperation(context.Background(), tt.operationType, tt.operationName)
+			finish(tt.errs...)
+
+			ro := trace.SpanFromContext(ctx).(sdktrace.ReadOnlySpan)
+			if ro.Name() != tt.wantName {
+				t.Errorf("span name = %q, want %q", ro.Name(), tt.wantName)
+			}
+			if ro.Status().Code != tt.wantStatus {
+				t.Errorf("span status = %v, want %v", ro.Status().Code, tt.wantStatus)
+			}
+			if len(ro.Events()) != len(tt.errs) {
+				t.Errorf("span events = %d, want one per error (%d)", len(ro.Events()), len(tt.errs))
+			}
+			attrs := map[string]interface{}{}
+			for _, attr := range ro.Attributes() {
+				attrs[string(attr.Key)] = attr.Value.AsInterface()
+			}
+			if attrs["graphql.operation.type"] != tt.operationType {
+				t.Errorf("graphql.operation.type = %v, want %q", attrs["graphql.operation.type"], tt.operationType)
+			}
+			if name, ok := attrs["graphql.operation.name"]; tt.operationName != "" && name != tt.operationName || tt.operationName == "" && ok {
+				t.Errorf("graphql.operation.name = %v, want %q", name, tt.operationName)
+			}
+			if len(tt.errs) > 0 && attrs["graphql.errors.count"] != int64(len(tt.errs)) {
+				t.Errorf("graphql.errors.count = %v, want %d", attrs["graphql.errors.count"], len(tt.errs))
+			}
+		})
+	}
+}
+
+func TestMonitoring_GraphQL_GraphQLResolver(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	ctx, finishOperation := mon.GraphQLOperation(context.Background(), "query", "GetOrder")
+	resolverCtx, finish := mon.GraphQLResolver(ctx, "Order", "customer", "order.customer")
+	finish(errors.New("customer service unavailable"))
+	finishOperation()
+
+	operation := trace.SpanFromContext(ctx).(sdktrace.ReadOnlySpan)
+	resolver := trace.SpanFromContext(resolverCtx).(sdktrace.ReadOnlySpan)
+	if resolver.Name() != "Order.customer" {
+		t.Errorf("resolver span name = %q, want %q", resolver.Name(), "Order.customer")
+	}
+	if resolver.Parent().SpanID() != operation.SpanContext().SpanID() {
+		t.Error("resolver span is not a child of the operation span")
+	}
+	if resolver.Status().Code != codes.Error {
+		t.Errorf("resolver span status = %v, want error", resolver.Status().Code)
+	}
+	attrs := map[string]interface{}{}
+	for _, attr := range resolver.Attributes() {
+		attrs[string(attr.Key)] = attr.Value.AsInterface()
+	}
+	want := map[string]interface{}{
+		"graphql.resolver.object": "Order",
+		"graphql.resolver.field":  "customer",
+		"graphql.resolver.path":   "order.customer",
+	}
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("%s = %v, want %v", key, attrs[key], value)
+		}
+	}
+	if operation.Status().Code != codes.Unset {
+		t.Errorf("operation span status = %v, want unset for a response without errors", operation.Status().Code)
+	}
+}
+
+func TestMonitoring_GraphQL_WithoutTracer(t *testing.T) {
+	mon := &Monitoring{}
+	ctx, finish := mon.GraphQLOperation(context.Background(), "query", "GetOrder")
+	_, finishResolver := mon.GraphQLResolver(ctx, "Order", "customer", "order.customer")
+	finishResolver(nil)
+	finish()
+}
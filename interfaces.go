@@ -1,15 +1,30 @@
 package monitoring
 
 import (
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	"github.com/adityakw90/go-monitoring/internal/errorreport"
 	"github.com/adityakw90/go-monitoring/internal/logger"
 	"github.com/adityakw90/go-monitoring/internal/metric"
 	"github.com/adityakw90/go-monitoring/internal/tracer"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 // Logger is the interface for logging.
 // It is re-exported from the internal logger package for public API use.
 type Logger = logger.Logger
 
+// FatalEntry describes a fatal-level log entry passed to a FatalHook.
+// It is re-exported from the internal logger package for public API use.
+type FatalEntry = logger.FatalEntry
+
+// FatalHook is called with a fatal-level log entry after it is written and before the process
+// exits, for use with WithFatalHooks.
+// It is re-exported from the internal logger package for public API use.
+type FatalHook = logger.FatalHook
+
 // Tracer is the interface for tracing.
 // It is re-exported from the internal tracer package for public API use.
 type Tracer = tracer.Tracer
@@ -17,3 +32,108 @@ type Tracer = tracer.Tracer
 // Metric is the interface for metrics.
 // It is re-exported from the internal metric package for public API use.
 type Metric = metric.Metric
+
+// LoggerOptions holds the logger settings of Options.Logger.
+// It is re-exported from the internal logger package for public API use.
+type LoggerOptions = logger.Options
+
+// TracerOptions holds the tracer settings of Options.Tracer.
+// It is re-exported from the internal tracer package for public API use.
+type TracerOptions = tracer.Options
+
+// MetricOptions holds the metric settings of Options.Metric.
+// It is re-exported from the internal metric package for public API use.
+type MetricOptions = metric.Options
+
+// ErrorReporter captures errors for an error tracking service such as Sentry or GlitchTip.
+// It is re-exported from the internal errorreport package for public API use.
+type ErrorReporter = errorreport.Reporter
+
+// AttributeSet is a reusable, pre-built set of metric labels created by Metric.NewAttributeSet
+// for allocation-free recording on hot paths.
+// It is re-exported from the internal metric package for public API use.
+type AttributeSet = metric.AttributeSet
+
+// WatchEvent describes a threshold crossing reported to a Metric.Watch callback.
+// It is re-exported from the internal metric package for public API use.
+type WatchEvent = metric.WatchEvent
+
+// Snapshot holds the values of every instrument at the time Metric.Snapshot was called, with
+// CounterSnapshot, GaugeSnapshot, and HistogramSnapshot data points.
+// It is re-exported from the internal metric package for public API use.
+type Snapshot = metric.Snapshot
+
+// CounterSnapshot is the value of one data point of a counter in a Snapshot.
+// It is re-exported from the internal metric package for public API use.
+type CounterSnapshot = metric.CounterSnapshot
+
+// GaugeSnapshot is the last value of one data point of a gauge in a Snapshot.
+// It is re-exported from the internal metric package for public API use.
+type GaugeSnapshot = metric.GaugeSnapshot
+
+// HistogramSnapshot summarizes one data point of a histogram in a Snapshot.
+// It is re-exported from the internal metric package for public API use.
+type HistogramSnapshot = metric.HistogramSnapshot
+
+// IDGenerator generates trace and span IDs for new spans.
+// It is re-exported from the internal tracer package for use with WithTracerIDGenerator.
+type IDGenerator = tracer.IDGenerator
+
+// SamplingRule assigns a sampling ratio to the root spans matching its span name and
+// attributes, for use with WithTracerSamplingRules.
+// It is re-exported from the internal tracer package for public API use.
+type SamplingRule = tracer.SamplingRule
+
+// SpanProcessor is notified of spans starting and ending, for use with WithTracerSpanProcessor.
+// It is re-exported from the OpenTelemetry SDK for public API use.
+type SpanProcessor = sdktrace.SpanProcessor
+
+// ReadOnlySpan is an ended span as seen by span processors and the filter set with
+// WithTracerSpanFilter.
+// It is re-exported from the OpenTelemetry SDK for public API use.
+type ReadOnlySpan = sdktrace.ReadOnlySpan
+
+// MetricProducer supplies metrics recorded outside the meter provider, such as those of the
+// OpenCensus bridge, for use with WithMetricProducer.
+// It is re-exported from the OpenTelemetry SDK for public API use.
+type MetricProducer = sdkmetric.Producer
+
+// LongSpan describes a span that stayed open longer than the threshold set with
+// WithTracerLongSpanWatchdog.
+// It is re-exported from the internal tracer package for public API use.
+type LongSpan = tracer.LongSpan
+
+// NewSequentialIDGenerator returns an IDGenerator producing sequential trace and span IDs
+// starting at 1, for reproducible golden-file tests of exported spans. It must not be used in
+// production, since IDs collide across processes.
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerIDGenerator(NewSequentialIDGenerator()),
+//	)
+func NewSequentialIDGenerator() IDGenerator {
+	return tracer.NewSequentialIDGenerator()
+}
+
+// Clock tells the current time and creates tickers.
+// It is re-exported from the internal clock package for use with WithClock.
+type Clock = clock.Clock
+
+// FakeClock is a Clock whose time only moves when Advance is called.
+// Tickers created by a FakeClock fire during Advance.
+type FakeClock = clock.Fake
+
+// NewFakeClock returns a FakeClock set to now, for testing duration-dependent behavior
+// without sleeping.
+//
+// Example:
+//
+//	clk := NewFakeClock(time.Now())
+//	mon, err := NewMonitoring(WithServiceName("my-service"), WithClock(clk))
+//	// ...
+//	clk.Advance(time.Minute)
+func NewFakeClock(now time.Time) *FakeClock {
+	return clock.NewFake(now)
+}
@@ -0,0 +1,152 @@
+// Package breaker provides a circuit breaker for telemetry exporters.
+// While a collector is down, every export would otherwise go through the exporter's full
+// retry cycle. The breaker stops calling the exporter after repeated failures and only
+// sends a single trial export once an exponentially growing backoff has elapsed.
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// initialBackoff is the time the breaker stays open after it first trips.
+const initialBackoff = time.Second
+
+// ErrOpen is returned instead of calling the exporter while the breaker is open.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the state of a Breaker.
+type State int
+
+const (
+	// StateClosed lets every call through.
+	StateClosed State = iota
+	// StateOpen rejects every call until the backoff has elapsed.
+	StateOpen
+	// StateHalfOpen lets a single trial call through to probe the collector.
+	StateHalfOpen
+)
+
+// String returns the lowercase name of the state.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker is a circuit breaker with exponential backoff.
+// It opens after threshold consecutive failures. Once the backoff has elapsed, one trial call
+// is allowed: success closes the breaker, failure reopens it with the backoff doubled, up to
+// maxBackoff. A Breaker is safe for concurrent use.
+type Breaker struct {
+	threshold     int
+	maxBackoff    time.Duration
+	onStateChange func(State)
+	now           func() time.Time
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	backoff   time.Duration
+	openUntil time.Time
+}
+
+// New creates a closed Breaker that opens after threshold consecutive failures and backs off
+// for at most maxBackoff. onStateChange, if not nil, is called after every state transition;
+// it is called outside the breaker's lock and may be called concurrently.
+func New(threshold int, maxBackoff time.Duration, onStateChange func(State)) *Breaker {
+	return &Breaker{
+		threshold:     threshold,
+		maxBackoff:    maxBackoff,
+		onStateChange: onStateChange,
+		now:           time.Now,
+	}
+}
+
+// Do calls fn if the breaker allows it and records the outcome.
+// It returns ErrOpen without calling fn while the breaker is open or a trial call is in flight.
+func (b *Breaker) Do(fn func() error) error {
+	if !b.allow() {
+		return ErrOpen
+	}
+	err := fn()
+	b.record(err)
+	return err
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// allow reports whether a call may proceed, moving an open breaker whose backoff has elapsed
+// to half-open.
+func (b *Breaker) allow() bool {
+	b.mu.Lock()
+	switch b.state {
+	case StateClosed:
+		b.mu.Unlock()
+		return true
+	case StateOpen:
+		if b.now().Before(b.openUntil) {
+			b.mu.Unlock()
+			return false
+		}
+		b.state = StateHalfOpen
+		b.mu.Unlock()
+		b.notify(StateHalfOpen)
+		return true
+	default:
+		b.mu.Unlock()
+		return false
+	}
+}
+
+// record updates the breaker with the outcome of a call that allow let through.
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	previous := b.state
+	if err == nil {
+		b.state = StateClosed
+		b.failures = 0
+		b.backoff = 0
+	} else {
+		b.failures++
+		switch {
+		case previous == StateHalfOpen:
+			b.open(min(b.backoff*2, b.maxBackoff))
+		case previous == StateClosed && b.failures >= b.threshold:
+			b.open(min(initialBackoff, b.maxBackoff))
+		}
+	}
+	current := b.state
+	b.mu.Unlock()
+
+	if current != previous {
+		b.notify(current)
+	}
+}
+
+// open moves the breaker to the open state for backoff. b.mu must be held.
+func (b *Breaker) open(backoff time.Duration) {
+	b.state = StateOpen
+	b.backoff = backoff
+	b.openUntil = b.now().Add(backoff)
+}
+
+// notify reports a state transition to onStateChange.
+func (b *Breaker) notify(state State) {
+	if b.onStateChange != nil {
+		b.onStateChange(state)
+	}
+}
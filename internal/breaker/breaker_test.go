@@ -0,0 +1,167 @@
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/clock"
+)
+
+var errExport = errors.New("export failed")
+
+// newTestBreaker returns a Breaker driven by a fake clock that records its state transitions.
+func newTestBreaker(threshold int, maxBackoff time.Duration) (*Breaker, *clock.Fake, *[]State) {
+	var (
+		mu          sync.Mutex
+		transitions []State
+	)
+	fake := clock.NewFake(time.Unix(0, 0))
+	b := New(threshold, maxBackoff, func(state State) {
+		mu.Lock()
+		defer mu.Unlock()
+		transitions = append(transitions, state)
+	})
+	b.now = fake.Now
+	return b, fake, &transitions
+}
+
+func fail() error {
+	return errExport
+}
+
+func succeed() error {
+	return nil
+}
+
+func TestBreaker_Breaker_Opens(t *testing.T) {
+	b, _, transitions := newTestBreaker(3, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if err := b.Do(fail); !errors.Is(err, errExport) {
+			t.Fatalf("Do() error = %v, want %v", err, errExport)
+		}
+	}
+	if b.State() != StateClosed {
+		t.Fatalf("expected breaker closed below threshold, got %v", b.State())
+	}
+
+	_ = b.Do(fail)
+	if b.State() != StateOpen {
+		t.Fatalf("expected breaker open at threshold, got %v", b.State())
+	}
+
+	called := false
+	err := b.Do(func() error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, ErrOpen) {
+		t.Errorf("Do() error = %v, want %v", err, ErrOpen)
+	}
+	if called {
+		t.Error("expected fn not to be called while the breaker is open")
+	}
+	if len(*transitions) != 1 || (*transitions)[0] != StateOpen {
+		t.Errorf("expected transitions [open], got %v", *transitions)
+	}
+}
+
+func TestBreaker_Breaker_SuccessResetsFailures(t *testing.T) {
+	b, _, _ := newTestBreaker(2, time.Minute)
+
+	_ = b.Do(fail)
+	_ = b.Do(succeed)
+	_ = b.Do(fail)
+	if b.State() != StateClosed {
+		t.Errorf("expected non-consecutive failures to keep the breaker closed, got %v", b.State())
+	}
+}
+
+func TestBreaker_Breaker_HalfOpen(t *testing.T) {
+	tests := []struct {
+		name      string
+		trial     func() error
+		wantState State
+	}{
+		{"successful trial closes", succeed, StateClosed},
+		{"failed trial reopens", fail, StateOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, clock, transitions := newTestBreaker(1, time.Minute)
+			_ = b.Do(fail)
+
+			clock.Advance(initialBackoff)
+			_ = b.Do(tt.trial)
+			if b.State() != tt.wantState {
+				t.Errorf("expected state %v after trial, got %v", tt.wantState, b.State())
+			}
+			want := []State{StateOpen, StateHalfOpen, tt.wantState}
+			if len(*transitions) != len(want) {
+				t.Fatalf("expected transitions %v, got %v", want, *transitions)
+			}
+			for i := range want {
+				if (*transitions)[i] != want[i] {
+					t.Errorf("expected transitions %v, got %v", want, *transitions)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestBreaker_Breaker_HalfOpenAllowsSingleTrial(t *testing.T) {
+	b, clock, _ := newTestBreaker(1, time.Minute)
+	_ = b.Do(fail)
+	clock.Advance(initialBackoff)
+
+	_ = b.Do(func() error {
+		if err := b.Do(succeed); !errors.Is(err, ErrOpen) {
+			t.Errorf("expected concurrent call during trial to be rejected, got %v", err)
+		}
+		return nil
+	})
+	if b.State() != StateClosed {
+		t.Errorf("expected breaker closed after successful trial, got %v", b.State())
+	}
+}
+
+func TestBreaker_Breaker_Backoff(t *testing.T) {
+	b, clock, _ := newTestBreaker(1, 3*time.Second)
+	_ = b.Do(fail)
+
+	// backoffs: 1s, 2s, then capped at 3s
+	for _, backoff := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second} {
+		clock.Advance(backoff - time.Millisecond)
+		if err := b.Do(fail); !errors.Is(err, ErrOpen) {
+			t.Fatalf("expected breaker still open before %v backoff elapsed, got %v", backoff, err)
+		}
+		clock.Advance(time.Millisecond)
+		if err := b.Do(fail); !errors.Is(err, errExport) {
+			t.Fatalf("expected trial export after %v backoff, got %v", backoff, err)
+		}
+	}
+}
+
+func TestBreaker_State_String(t *testing.T) {
+	tests := []struct {
+		state State
+		want  string
+	}{
+		{StateClosed, "closed"},
+		{StateOpen, "open"},
+		{StateHalfOpen, "half-open"},
+		{State(42), "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.state.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
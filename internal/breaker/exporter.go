@@ -0,0 +1,47 @@
+package breaker
+
+import (
+	"context"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// spanExporter guards a span exporter with a Breaker.
+type spanExporter struct {
+	sdktrace.SpanExporter
+	breaker *Breaker
+}
+
+// WrapSpanExporter returns a span exporter that sends exports to exporter through b.
+// While b is open, ExportSpans returns ErrOpen and the spans are not sent.
+func WrapSpanExporter(exporter sdktrace.SpanExporter, b *Breaker) sdktrace.SpanExporter {
+	return &spanExporter{SpanExporter: exporter, breaker: b}
+}
+
+// ExportSpans exports spans through the breaker.
+func (e *spanExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	return e.breaker.Do(func() error {
+		return e.SpanExporter.ExportSpans(ctx, spans)
+	})
+}
+
+// metricExporter guards a metric exporter with a Breaker.
+type metricExporter struct {
+	sdkmetric.Exporter
+	breaker *Breaker
+}
+
+// WrapMetricExporter returns a metric exporter that sends exports to exporter through b.
+// While b is open, Export returns ErrOpen and the data is not sent.
+func WrapMetricExporter(exporter sdkmetric.Exporter, b *Breaker) sdkmetric.Exporter {
+	return &metricExporter{Exporter: exporter, breaker: b}
+}
+
+// Export exports metrics through the breaker.
+func (e *metricExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return e.breaker.Do(func() error {
+		return e.Exporter.Export(ctx, rm)
+	})
+}
@@ -0,0 +1,44 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// countingExporter is a span exporter that fails every export and counts the attempts.
+type countingExporter struct {
+	calls int
+}
+
+func (e *countingExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	e.calls++
+	return errors.New("collector unavailable")
+}
+
+func (e *countingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestBreaker_Exporter_WrapSpanExporter(t *testing.T) {
+	primary := &countingExporter{}
+	exporter := WrapSpanExporter(primary, New(2, time.Minute, nil))
+	spans := tracetest.SpanStubs{{Name: "span"}}.Snapshots()
+
+	for i := 0; i < 5; i++ {
+		_ = exporter.ExportSpans(context.Background(), spans)
+	}
+	if primary.calls != 2 {
+		t.Errorf("expected the breaker to stop exports after 2 failures, got %d calls", primary.calls)
+	}
+	if err := exporter.ExportSpans(context.Background(), spans); !errors.Is(err, ErrOpen) {
+		t.Errorf("ExportSpans() error = %v, want %v", err, ErrOpen)
+	}
+	if err := exporter.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock_Fake_Now(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+	c.Advance(90 * time.Second)
+	if got := c.Now(); !got.Equal(start.Add(90 * time.Second)) {
+		t.Errorf("Now() = %v, want %v", got, start.Add(90*time.Second))
+	}
+}
+
+func TestClock_Fake_Ticker(t *testing.T) {
+	c := NewFake(time.Unix(0, 0))
+	ticker := c.NewTicker(time.Minute)
+
+	c.Advance(59 * time.Second)
+	select {
+	case <-ticker.C():
+		t.Fatal("ticker fired before its period elapsed")
+	default:
+	}
+
+	c.Advance(time.Second)
+	select {
+	case <-ticker.C():
+	default:
+		t.Fatal("expected ticker to fire after its period")
+	}
+
+	ticker.Reset(time.Hour)
+	c.Advance(time.Minute)
+	select {
+	case <-ticker.C():
+		t.Fatal("ticker fired before its reset period elapsed")
+	default:
+	}
+
+	ticker.Stop()
+	c.Advance(time.Hour)
+	select {
+	case <-ticker.C():
+		t.Fatal("stopped ticker fired")
+	default:
+	}
+}
+
+func TestClock_Real(t *testing.T) {
+	c := Real()
+	before := time.Now()
+	if got := c.Now(); got.Before(before) {
+		t.Errorf("Now() = %v, want a time after %v", got, before)
+	}
+
+	ticker := c.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	select {
+	case <-ticker.C():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for real ticker")
+	}
+}
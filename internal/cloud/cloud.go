@@ -0,0 +1,262 @@
+// Package cloud detects the cloud environment a process runs in and describes it with the
+// OpenTelemetry cloud.* semantic convention attributes. Serverless and container platforms
+// (AWS Lambda, Amazon ECS, Cloud Run) are recognized from the environment variables their
+// runtimes set; virtual machines are recognized by querying the provider's instance metadata
+// endpoint, which only answers from inside that cloud.
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Providers accepted by Detect.
+const (
+	ProviderAWS   = "aws"
+	ProviderGCP   = "gcp"
+	ProviderAzure = "azure"
+	ProviderAuto  = "auto"
+)
+
+// ErrInvalidProvider is returned by Validate for a provider Detect does not support.
+var ErrInvalidProvider = errors.New("cloud detection must be aws, gcp, azure, or auto")
+
+// Instance metadata endpoints. They are variables so tests can point them at a local server.
+var (
+	awsMetadataURL   = "http://169.254.169.254"
+	gcpMetadataURL   = "http://metadata.google.internal"
+	azureMetadataURL = "http://169.254.169.254"
+)
+
+// client queries the metadata endpoints. It never uses a proxy: the endpoints are link-local
+// and a proxy would answer for its own host, if at all.
+var client = &http.Client{Transport: &http.Transport{Proxy: nil}}
+
+// Validate returns ErrInvalidProvider unless provider is one of the Provider constants.
+func Validate(provider string) error {
+	switch provider {
+	case ProviderAWS, ProviderGCP, ProviderAzure, ProviderAuto:
+		return nil
+	default:
+		return ErrInvalidProvider
+	}
+}
+
+// Detect returns the cloud.* attributes (provider, platform, region, availability zone,
+// account) and the instance identifiers (host.id, faas.*, aws.ecs.*) of the environment the
+// process runs in, according to provider. ProviderAuto queries every provider concurrently
+// and keeps the first match in the order AWS, GCP, Azure. Detect returns nil when the
+// environment is not recognized or its metadata endpoint does not answer before ctx is done;
+// callers bound the detection with ctx, since outside the cloud the requests hang until then.
+func Detect(ctx context.Context, provider string) []attribute.KeyValue {
+	switch provider {
+	case ProviderAWS:
+		return detectAWS(ctx)
+	case ProviderGCP:
+		return detectGCP(ctx)
+	case ProviderAzure:
+		return detectAzure(ctx)
+	case ProviderAuto:
+		detectors := []func(context.Context) []attribute.KeyValue{detectAWS, detectGCP, detectAzure}
+		results := make([][]attribute.KeyValue, len(detectors))
+		var wg sync.WaitGroup
+		for i, detect := range detectors {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = detect(ctx)
+			}()
+		}
+		wg.Wait()
+		for _, attrs := range results {
+			if attrs != nil {
+				return attrs
+			}
+		}
+	}
+	return nil
+}
+
+// detectAWS recognizes AWS Lambda and Amazon ECS from their runtime environment variables,
+// and EC2 from the instance identity document served by IMDSv2.
+func detectAWS(ctx context.Context) []attribute.KeyValue {
+	region := os.Getenv("AWS_REGION")
+
+	if name := os.Getenv("AWS_LAMBDA_FUNCTION_NAME"); name != "" {
+		return appendNonEmpty([]attribute.KeyValue{semconv.CloudProviderAWS, semconv.CloudPlatformAWSLambda},
+			value{semconv.CloudRegionKey, region},
+			value{semconv.FaaSNameKey, name},
+			value{semconv.FaaSVersionKey, os.Getenv("AWS_LAMBDA_FUNCTION_VERSION")},
+			value{semconv.FaaSInstanceKey, os.Getenv("AWS_LAMBDA_LOG_STREAM_NAME")},
+		)
+	}
+
+	if uri := os.Getenv("ECS_CONTAINER_METADATA_URI_V4"); uri != "" {
+		var task struct {
+			Cluster          string
+			TaskARN          string
+			AvailabilityZone string
+			LaunchType       string
+		}
+		// The platform is known from the variable alone; the task metadata only adds detail.
+		_ = fetchJSON(ctx, http.MethodGet, uri+"/task", nil, &task)
+		clusterARN := ""
+		if strings.HasPrefix(task.Cluster, "arn:") {
+			clusterARN = task.Cluster
+		}
+		return appendNonEmpty([]attribute.KeyValue{semconv.CloudProviderAWS, semconv.CloudPlatformAWSECS},
+			value{semconv.CloudRegionKey, region},
+			value{semconv.CloudAvailabilityZoneKey, task.AvailabilityZone},
+			value{semconv.AWSECSTaskARNKey, task.TaskARN},
+			value{semconv.AWSECSClusterARNKey, clusterARN},
+			value{semconv.AWSECSLaunchtypeKey, strings.ToLower(task.LaunchType)},
+		)
+	}
+
+	token, err := fetch(ctx, http.MethodPut, awsMetadataURL+"/latest/api/token",
+		map[string]string{"X-aws-ec2-metadata-token-ttl-seconds": "60"})
+	if err != nil {
+		return nil
+	}
+	var document struct {
+		Region           string `json:"region"`
+		AvailabilityZone string `json:"availabilityZone"`
+		InstanceID       string `json:"instanceId"`
+		AccountID        string `json:"accountId"`
+	}
+	if err := fetchJSON(ctx, http.MethodGet, awsMetadataURL+"/latest/dynamic/instance-identity/document",
+		map[string]string{"X-aws-ec2-metadata-token": string(token)}, &document); err != nil {
+		return nil
+	}
+	return appendNonEmpty([]attribute.KeyValue{semconv.CloudProviderAWS, semconv.CloudPlatformAWSEC2},
+		value{semconv.CloudRegionKey, document.Region},
+		value{semconv.CloudAvailabilityZoneKey, document.AvailabilityZone},
+		value{semconv.CloudAccountIDKey, document.AccountID},
+		value{semconv.HostIDKey, document.InstanceID},
+	)
+}
+
+// detectGCP recognizes Cloud Run from its runtime environment variables and Compute Engine
+// otherwise, both through the metadata server, which every GCP runtime serves.
+func detectGCP(ctx context.Context) []attribute.KeyValue {
+	get := func(path string) string {
+		body, err := fetch(ctx, http.MethodGet, gcpMetadataURL+"/computeMetadata/v1/"+path,
+			map[string]string{"Metadata-Flavor": "Google"})
+		if err != nil {
+			return ""
+		}
+		return string(body)
+	}
+
+	project := get("project/project-id")
+	if project == "" {
+		return nil
+	}
+	instanceID := get("instance/id")
+
+	if service := os.Getenv("K_SERVICE"); service != "" {
+		// "projects/123456789/regions/us-central1"
+		return appendNonEmpty([]attribute.KeyValue{semconv.CloudProviderGCP, semconv.CloudPlatformGCPCloudRun},
+			value{semconv.CloudAccountIDKey, project},
+			value{semconv.CloudRegionKey, lastSegment(get("instance/region"))},
+			value{semconv.FaaSNameKey, service},
+			value{semconv.FaaSVersionKey, os.Getenv("K_REVISION")},
+			value{semconv.FaaSInstanceKey, instanceID},
+		)
+	}
+
+	// "projects/123456789/zones/us-central1-a"
+	zone := lastSegment(get("instance/zone"))
+	region := ""
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		region = zone[:i]
+	}
+	return appendNonEmpty([]attribute.KeyValue{semconv.CloudProviderGCP, semconv.CloudPlatformGCPComputeEngine},
+		value{semconv.CloudAccountIDKey, project},
+		value{semconv.CloudRegionKey, region},
+		value{semconv.CloudAvailabilityZoneKey, zone},
+		value{semconv.HostIDKey, instanceID},
+	)
+}
+
+// detectAzure recognizes Azure virtual machines from the Instance Metadata Service.
+func detectAzure(ctx context.Context) []attribute.KeyValue {
+	var compute struct {
+		Location       string `json:"location"`
+		Zone           string `json:"zone"`
+		VMID           string `json:"vmId"`
+		SubscriptionID string `json:"subscriptionId"`
+		ResourceID     string `json:"resourceId"`
+	}
+	if err := fetchJSON(ctx, http.MethodGet, azureMetadataURL+"/metadata/instance/compute?api-version=2021-02-01&format=json",
+		map[string]string{"Metadata": "true"}, &compute); err != nil {
+		return nil
+	}
+	return appendNonEmpty([]attribute.KeyValue{semconv.CloudProviderAzure, semconv.CloudPlatformAzureVM},
+		value{semconv.CloudRegionKey, compute.Location},
+		value{semconv.CloudAvailabilityZoneKey, compute.Zone},
+		value{semconv.CloudAccountIDKey, compute.SubscriptionID},
+		value{semconv.CloudResourceIDKey, compute.ResourceID},
+		value{semconv.HostIDKey, compute.VMID},
+	)
+}
+
+// fetch sends a request to a metadata endpoint and returns the body of a 200 response.
+func fetch(ctx context.Context, method, url string, header map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metadata endpoint returned %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// fetchJSON is fetch decoding the response body into v.
+func fetchJSON(ctx context.Context, method, url string, header map[string]string, v interface{}) error {
+	body, err := fetch(ctx, method, url, header)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// value is a string attribute that is only set when the value is known.
+type value struct {
+	key   attribute.Key
+	value string
+}
+
+// appendNonEmpty appends the values that are not empty to attrs.
+func appendNonEmpty(attrs []attribute.KeyValue, values ...value) []attribute.KeyValue {
+	for _, v := range values {
+		if v.value != "" {
+			attrs = append(attrs, v.key.String(v.value))
+		}
+	}
+	return attrs
+}
+
+// lastSegment returns the part of a metadata resource path after its last slash.
+func lastSegment(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
@@ -0,0 +1,211 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// newMetadataServer serves the AWS, GCP, and Azure metadata endpoints the way they answer
+// inside the respective cloud, checking the headers each one requires.
+func newMetadataServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /latest/api/token", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("imds-token"))
+	})
+	mux.HandleFunc("GET /latest/dynamic/instance-identity/document", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-aws-ec2-metadata-token") != "imds-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"region":"eu-west-1","availabilityZone":"eu-west-1a","instanceId":"i-0abc","accountId":"123456789012"}`))
+	})
+	mux.HandleFunc("GET /task", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Cluster":"arn:aws:ecs:eu-west-1:123456789012:cluster/prod","TaskARN":"arn:aws:ecs:eu-west-1:123456789012:task/prod/abc","AvailabilityZone":"eu-west-1b","LaunchType":"FARGATE"}`))
+	})
+	gcp := map[string]string{
+		"project/project-id": "acme-prod",
+		"instance/id":        "4520031799277581759",
+		"instance/zone":      "projects/123/zones/us-central1-a",
+		"instance/region":    "projects/123/regions/us-central1",
+	}
+	mux.HandleFunc("GET /computeMetadata/v1/", func(w http.ResponseWriter, r *http.Request) {
+		value, ok := gcp[r.URL.Path[len("/computeMetadata/v1/"):]]
+		if !ok || r.Header.Get("Metadata-Flavor") != "Google" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(value))
+	})
+	mux.HandleFunc("GET /metadata/instance/compute", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"location":"westeurope","zone":"2","vmId":"02aab8a4","subscriptionId":"8d10da13","resourceId":"/subscriptions/8d10da13/vm"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// pointMetadataURLs points the metadata endpoints of every provider at url for the test.
+func pointMetadataURLs(t *testing.T, aws, gcp, azure string) {
+	t.Helper()
+	previousAWS, previousGCP, previousAzure := awsMetadataURL, gcpMetadataURL, azureMetadataURL
+	awsMetadataURL, gcpMetadataURL, azureMetadataURL = aws, gcp, azure
+	t.Cleanup(func() {
+		awsMetadataURL, gcpMetadataURL, azureMetadataURL = previousAWS, previousGCP, previousAzure
+	})
+}
+
+func TestCloud_Cloud_Detect(t *testing.T) {
+	server := newMetadataServer(t)
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := []struct {
+		name     string
+		provider string
+		env      map[string]string
+		aws      string
+		gcp      string
+		azure    string
+		want     map[string]string
+	}{
+		{
+			name:     "aws lambda",
+			provider: ProviderAWS,
+			env:      map[string]string{"AWS_LAMBDA_FUNCTION_NAME": "checkout", "AWS_LAMBDA_FUNCTION_VERSION": "$LATEST", "AWS_REGION": "eu-west-1"},
+			want: map[string]string{
+				"cloud.provider": "aws", "cloud.platform": "aws_lambda", "cloud.region": "eu-west-1",
+				"faas.name": "checkout", "faas.version": "$LATEST",
+			},
+		},
+		{
+			name:     "aws ecs",
+			provider: ProviderAWS,
+			env:      map[string]string{"ECS_CONTAINER_METADATA_URI_V4": server.URL, "AWS_REGION": "eu-west-1"},
+			want: map[string]string{
+				"cloud.provider": "aws", "cloud.platform": "aws_ecs", "cloud.region": "eu-west-1",
+				"cloud.availability_zone": "eu-west-1b",
+				"aws.ecs.task.arn":        "arn:aws:ecs:eu-west-1:123456789012:task/prod/abc",
+				"aws.ecs.cluster.arn":     "arn:aws:ecs:eu-west-1:123456789012:cluster/prod",
+				"aws.ecs.launchtype":      "fargate",
+			},
+		},
+		{
+			name:     "aws ec2",
+			provider: ProviderAWS,
+			aws:      server.URL,
+			want: map[string]string{
+				"cloud.provider": "aws", "cloud.platform": "aws_ec2", "cloud.region": "eu-west-1",
+				"cloud.availability_zone": "eu-west-1a", "cloud.account.id": "123456789012", "host.id": "i-0abc",
+			},
+		},
+		{
+			name:     "gcp compute engine",
+			provider: ProviderGCP,
+			gcp:      server.URL,
+			want: map[string]string{
+				"cloud.provider": "gcp", "cloud.platform": "gcp_compute_engine", "cloud.account.id": "acme-prod",
+				"cloud.region": "us-central1", "cloud.availability_zone": "us-central1-a", "host.id": "4520031799277581759",
+			},
+		},
+		{
+			name:     "gcp cloud run",
+			provider: ProviderGCP,
+			env:      map[string]string{"K_SERVICE": "checkout", "K_REVISION": "checkout-00042"},
+			gcp:      server.URL,
+			want: map[string]string{
+				"cloud.provider": "gcp", "cloud.platform": "gcp_cloud_run", "cloud.account.id": "acme-prod",
+				"cloud.region": "us-central1", "faas.name": "checkout", "faas.version": "checkout-00042",
+				"faas.instance": "4520031799277581759",
+			},
+		},
+		{
+			name:     "azure vm",
+			provider: ProviderAzure,
+			azure:    server.URL,
+			want: map[string]string{
+				"cloud.provider": "azure", "cloud.platform": "azure_vm", "cloud.region": "westeurope",
+				"cloud.availability_zone": "2", "cloud.account.id": "8d10da13",
+				"cloud.resource_id": "/subscriptions/8d10da13/vm", "host.id": "02aab8a4",
+			},
+		},
+		{
+			name:     "auto prefers the first provider that answers",
+			provider: ProviderAuto,
+			gcp:      server.URL,
+			azure:    server.URL,
+			want: map[string]string{
+				"cloud.provider": "gcp", "cloud.platform": "gcp_compute_engine", "cloud.account.id": "acme-prod",
+				"cloud.region": "us-central1", "cloud.availability_zone": "us-central1-a", "host.id": "4520031799277581759",
+			},
+		},
+		{
+			name:     "auto outside the cloud",
+			provider: ProviderAuto,
+		},
+		{
+			name:     "unknown provider",
+			provider: "digitalocean",
+			aws:      server.URL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{
+				"AWS_LAMBDA_FUNCTION_NAME", "AWS_LAMBDA_FUNCTION_VERSION", "AWS_LAMBDA_LOG_STREAM_NAME",
+				"AWS_REGION", "ECS_CONTAINER_METADATA_URI_V4", "K_SERVICE", "K_REVISION",
+			} {
+				t.Setenv(name, tt.env[name])
+			}
+			urls := []string{tt.aws, tt.gcp, tt.azure}
+			for i := range urls {
+				if urls[i] == "" {
+					urls[i] = unreachable.URL
+				}
+			}
+			pointMetadataURLs(t, urls[0], urls[1], urls[2])
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			got := toMap(Detect(ctx, tt.provider))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Detect(%q) = %v, want %v", tt.provider, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloud_Cloud_Validate(t *testing.T) {
+	for _, provider := range []string{ProviderAWS, ProviderGCP, ProviderAzure, ProviderAuto} {
+		if err := Validate(provider); err != nil {
+			t.Errorf("Validate(%q) error = %v", provider, err)
+		}
+	}
+	if err := Validate("digitalocean"); !errors.Is(err, ErrInvalidProvider) {
+		t.Errorf("Validate(%q) error = %v, want %v", "digitalocean", err, ErrInvalidProvider)
+	}
+}
+
+// toMap returns attrs keyed by attribute name, or nil when there are none.
+func toMap(attrs []attribute.KeyValue) map[string]string {
+	if len(attrs) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		m[string(attr.Key)] = attr.Value.AsString()
+	}
+	return m
+}
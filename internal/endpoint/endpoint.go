@@ -0,0 +1,78 @@
+// Package endpoint parses OTLP collector endpoint URLs shared by the tracer and metric exporters.
+// The URL scheme selects both the transport and TLS:
+//   - grpc://host:port: OTLP over gRPC without TLS
+//   - grpcs://host:port: OTLP over gRPC with TLS
+//   - http://host:port/path: OTLP over HTTP without TLS
+//   - https://host:port/path: OTLP over HTTP with TLS
+//
+// A missing port defaults to 4317 for gRPC and 4318 for HTTP, the standard OTLP ports.
+package endpoint
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// Protocols supported by an Endpoint.
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+)
+
+// Default OTLP ports used when the endpoint URL has none.
+const (
+	defaultGRPCPort = "4317"
+	defaultHTTPPort = "4318"
+)
+
+// ErrInvalid is returned when an endpoint URL cannot be parsed or uses an unsupported scheme.
+var ErrInvalid = errors.New("endpoint must be a URL with scheme grpc, grpcs, http, or https")
+
+// Endpoint is a parsed collector endpoint.
+type Endpoint struct {
+	Protocol string // Protocol is ProtocolGRPC or ProtocolHTTP.
+	Host     string // Host is the collector hostname, without port.
+	Address  string // Address is the collector "host:port".
+	Path     string // Path is the URL path for HTTP endpoints; empty means the exporter default (e.g., "/v1/traces").
+	Insecure bool   // Insecure is true when the connection must not use TLS.
+}
+
+// Parse parses an endpoint URL such as "https://collector.example.com:4318/v1/traces".
+// It returns ErrInvalid for a malformed URL, an unsupported scheme, a missing host, or a path
+// on a gRPC endpoint.
+func Parse(raw string) (Endpoint, error) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || u.Hostname() == "" {
+		return Endpoint{}, ErrInvalid
+	}
+
+	var e Endpoint
+	port := defaultHTTPPort
+	switch strings.ToLower(u.Scheme) {
+	case "grpc":
+		e.Protocol, e.Insecure, port = ProtocolGRPC, true, defaultGRPCPort
+	case "grpcs":
+		e.Protocol, port = ProtocolGRPC, defaultGRPCPort
+	case "http":
+		e.Protocol, e.Insecure = ProtocolHTTP, true
+	case "https":
+		e.Protocol = ProtocolHTTP
+	default:
+		return Endpoint{}, ErrInvalid
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	if e.Protocol == ProtocolGRPC && path != "" {
+		return Endpoint{}, ErrInvalid
+	}
+	e.Path = path
+
+	if u.Port() != "" {
+		port = u.Port()
+	}
+	e.Host = u.Hostname()
+	e.Address = net.JoinHostPort(e.Host, port)
+	return e, nil
+}
@@ -0,0 +1,64 @@
+package endpoint
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEndpoint_Endpoint_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Endpoint
+		wantErr bool
+	}{
+		{
+			name: "https with path",
+			raw:  "https://collector.example.com:4318/v1/traces",
+			want: Endpoint{Protocol: ProtocolHTTP, Host: "collector.example.com", Address: "collector.example.com:4318", Path: "/v1/traces"},
+		},
+		{
+			name: "http default port",
+			raw:  "http://localhost",
+			want: Endpoint{Protocol: ProtocolHTTP, Host: "localhost", Address: "localhost:4318", Insecure: true},
+		},
+		{
+			name: "grpcs",
+			raw:  "grpcs://collector.example.com:4317",
+			want: Endpoint{Protocol: ProtocolGRPC, Host: "collector.example.com", Address: "collector.example.com:4317"},
+		},
+		{
+			name: "grpc default port",
+			raw:  "grpc://localhost/",
+			want: Endpoint{Protocol: ProtocolGRPC, Host: "localhost", Address: "localhost:4317", Insecure: true},
+		},
+		{
+			name: "ipv6 host",
+			raw:  "grpc://[::1]:4317",
+			want: Endpoint{Protocol: ProtocolGRPC, Host: "::1", Address: "[::1]:4317", Insecure: true},
+		},
+		{name: "grpc with path", raw: "grpc://localhost:4317/v1/traces", wantErr: true},
+		{name: "unsupported scheme", raw: "ftp://localhost:4317", wantErr: true},
+		{name: "missing scheme", raw: "localhost:4317", wantErr: true},
+		{name: "missing host", raw: "https:///v1/traces", wantErr: true},
+		{name: "empty", raw: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalid) {
+					t.Errorf("Parse(%q) error = %v, want %v", tt.raw, err, ErrInvalid)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package endpoint
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// Probe failures, in the order Probe checks for them.
+var (
+	ErrDNS         = errors.New("collector hostname could not be resolved")
+	ErrUnreachable = errors.New("collector is not reachable")
+	ErrTLS         = errors.New("collector TLS handshake failed")
+)
+
+// Probe checks that the collector at e accepts connections: it resolves the hostname, opens a
+// TCP connection, and performs a TLS handshake unless e is insecure. It sends no OTLP request.
+// The returned error wraps ErrDNS, ErrUnreachable, or ErrTLS together with the cause
+// (e.g., "connection refused" or a certificate error). ctx bounds the whole probe.
+func Probe(ctx context.Context, e Endpoint) error {
+	if net.ParseIP(e.Host) == nil {
+		if _, err := net.DefaultResolver.LookupHost(ctx, e.Host); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrDNS, e.Host, err)
+		}
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", e.Address)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrUnreachable, e.Address, err)
+	}
+	defer conn.Close()
+
+	if e.Insecure {
+		return nil
+	}
+	// gRPC servers reject TLS clients that do not offer h2 through ALPN.
+	nextProtos := []string{"h2", "http/1.1"}
+	if e.Protocol == ProtocolGRPC {
+		nextProtos = []string{"h2"}
+	}
+	tlsConn := tls.Client(conn, &tls.Config{ServerName: e.Host, NextProtos: nextProtos})
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrTLS, e.Address, err)
+	}
+	return nil
+}
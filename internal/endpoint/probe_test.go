@@ -0,0 +1,89 @@
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEndpoint_Probe_Probe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	tlsServer := httptest.NewTLSServer(http.NotFoundHandler())
+	defer tlsServer.Close()
+	tlsAddress := tlsServer.Listener.Addr().String()
+
+	// A listener closed before dialing leaves a port with nothing accepting on it.
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	closedAddress := closed.Addr().String()
+	closed.Close()
+
+	tests := []struct {
+		name    string
+		e       Endpoint
+		wantErr error
+	}{
+		{
+			name: "insecure listener",
+			e:    Endpoint{Protocol: ProtocolGRPC, Host: "127.0.0.1", Address: listener.Addr().String(), Insecure: true},
+		},
+		{
+			name:    "refused",
+			e:       Endpoint{Protocol: ProtocolGRPC, Host: "127.0.0.1", Address: closedAddress, Insecure: true},
+			wantErr: ErrUnreachable,
+		},
+		{
+			name:    "unresolvable host",
+			e:       Endpoint{Protocol: ProtocolHTTP, Host: "collector.invalid", Address: "collector.invalid:4318"},
+			wantErr: ErrDNS,
+		},
+		{
+			name:    "untrusted certificate",
+			e:       Endpoint{Protocol: ProtocolHTTP, Host: "127.0.0.1", Address: tlsAddress},
+			wantErr: ErrTLS,
+		},
+		{
+			name:    "plain listener with TLS",
+			e:       Endpoint{Protocol: ProtocolGRPC, Host: "127.0.0.1", Address: listener.Addr().String()},
+			wantErr: ErrTLS,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			err := Probe(ctx, tt.e)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Probe() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Probe() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
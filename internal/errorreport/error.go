@@ -0,0 +1,10 @@
+package errorreport
+
+import "errors"
+
+var (
+	// ErrInvalidDSN is returned when the DSN is not a Sentry DSN URL.
+	ErrInvalidDSN = errors.New("error reporting DSN must be a URL of the form https://<public key>@<host>/<project id>")
+	// ErrQueueSizeInvalid is returned when the queue size is not positive.
+	ErrQueueSizeInvalid = errors.New("error reporting queue size must be greater than 0")
+)
@@ -0,0 +1,27 @@
+package errorreport
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorReport_Error_Sentinels(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "ErrInvalidDSN is defined", err: ErrInvalidDSN},
+		{name: "ErrQueueSizeInvalid is defined", err: ErrQueueSizeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil || tt.err.Error() == "" {
+				t.Fatal("sentinel error must have a message")
+			}
+			if !errors.Is(tt.err, tt.err) {
+				t.Error("sentinel error should match itself")
+			}
+		})
+	}
+}
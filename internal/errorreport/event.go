@@ -0,0 +1,159 @@
+package errorreport
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"runtime"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// dsn is a parsed Sentry DSN.
+type dsn struct {
+	storeURL  string // storeURL is the endpoint events are posted to.
+	publicKey string // publicKey authenticates the events.
+}
+
+// parseDSN parses a DSN of the form "<scheme>://<public key>@<host>[/<path>]/<project id>".
+// It returns ErrInvalidDSN when raw is not of that form.
+func parseDSN(raw string) (*dsn, error) {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User == nil || u.User.Username() == "" {
+		return nil, ErrInvalidDSN
+	}
+	path := strings.TrimSuffix(u.Path, "/")
+	i := strings.LastIndexByte(path, '/')
+	if i < 0 || path[i+1:] == "" {
+		return nil, ErrInvalidDSN
+	}
+	return &dsn{
+		storeURL:  fmt.Sprintf("%s://%s%s/api/%s/store/", u.Scheme, u.Host, path[:i], path[i+1:]),
+		publicKey: u.User.Username(),
+	}, nil
+}
+
+// event is an error event in the Sentry event payload format.
+type event struct {
+	EventID     string                 `json:"event_id"`
+	Timestamp   string                 `json:"timestamp"`
+	Level       string                 `json:"level"`
+	Platform    string                 `json:"platform"`
+	ServerName  string                 `json:"server_name,omitempty"`
+	Environment string                 `json:"environment,omitempty"`
+	Exception   exceptions             `json:"exception"`
+	Tags        map[string]string      `json:"tags,omitempty"`
+	Contexts    map[string]interface{} `json:"contexts,omitempty"`
+	Extra       map[string]interface{} `json:"extra,omitempty"`
+}
+
+// exceptions is the exception interface of an event.
+type exceptions struct {
+	Values []exception `json:"values"`
+}
+
+// exception describes one error of an event.
+type exception struct {
+	Type       string      `json:"type"`
+	Value      string      `json:"value"`
+	Stacktrace *stacktrace `json:"stacktrace,omitempty"`
+}
+
+// stacktrace lists the frames of an exception, oldest call first.
+type stacktrace struct {
+	Frames []frame `json:"frames"`
+}
+
+// frame is one stack frame of an exception.
+type frame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	AbsPath  string `json:"abs_path"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+// newEvent builds the event reporting err, with the stack of the caller skip frames above
+// newEvent's caller and the trace context of span.
+func (r *reporter) newEvent(err error, fields map[string]interface{}, span trace.SpanContext, skip int) *event {
+	e := &event{
+		EventID:     newEventID(),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339Nano),
+		Level:       "error",
+		Platform:    "go",
+		ServerName:  r.options.InstanceHost,
+		Environment: r.options.Environment,
+		Exception: exceptions{Values: []exception{{
+			Type:       fmt.Sprintf("%T", err),
+			Value:      err.Error(),
+			Stacktrace: callerStack(skip + 1),
+		}}},
+		Tags:  map[string]string{},
+		Extra: fields,
+	}
+	if r.options.ServiceName != "" {
+		e.Tags["service"] = r.options.ServiceName
+	}
+	if r.options.InstanceName != "" {
+		e.Tags["instance"] = r.options.InstanceName
+	}
+	if span.IsValid() {
+		e.Tags["trace_id"] = span.TraceID().String()
+		e.Contexts = map[string]interface{}{
+			"trace": map[string]string{
+				"type":     "trace",
+				"trace_id": span.TraceID().String(),
+				"span_id":  span.SpanID().String(),
+			},
+		}
+	}
+	return e
+}
+
+// newEventID returns a random event ID: 32 lowercase hex characters.
+func newEventID() string {
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
+
+// callerStack returns the stack of the caller skip frames above callerStack's caller, oldest
+// call first as Sentry expects. Frames of the Go runtime and testing packages are not in app.
+func callerStack(skip int) *stacktrace {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var st []frame
+	for {
+		f, more := frames.Next()
+		module, function := splitFunction(f.Function)
+		st = append(st, frame{
+			Function: function,
+			Module:   module,
+			AbsPath:  f.File,
+			Lineno:   f.Line,
+			InApp:    module != "runtime" && module != "testing",
+		})
+		if !more {
+			break
+		}
+	}
+	for i, j := 0, len(st)-1; i < j; i, j = i+1, j-1 {
+		st[i], st[j] = st[j], st[i]
+	}
+	return &stacktrace{Frames: st}
+}
+
+// splitFunction splits a fully qualified function name such as
+// "github.com/acme/app/orders.(*Service).Place" into its package path and function name.
+func splitFunction(name string) (module, function string) {
+	slash := strings.LastIndexByte(name, '/')
+	if dot := strings.IndexByte(name[slash+1:], '.'); dot >= 0 {
+		return name[:slash+1+dot], name[slash+1+dot+1:]
+	}
+	return "", name
+}
@@ -0,0 +1,93 @@
+package errorreport
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestErrorReport_Event_parseDSN(t *testing.T) {
+	tests := []struct {
+		name      string
+		dsn       string
+		wantStore string
+		wantKey   string
+		wantErr   bool
+	}{
+		{
+			name:      "sentry",
+			dsn:       "https://abc123@o1.ingest.sentry.io/42",
+			wantStore: "https://o1.ingest.sentry.io/api/42/store/",
+			wantKey:   "abc123",
+		},
+		{
+			name:      "glitchtip behind a path",
+			dsn:       "http://abc123@glitchtip.internal:8000/errors/7/",
+			wantStore: "http://glitchtip.internal:8000/errors/api/7/store/",
+			wantKey:   "abc123",
+		},
+		{name: "missing key", dsn: "https://o1.ingest.sentry.io/42", wantErr: true},
+		{name: "missing project", dsn: "https://abc123@o1.ingest.sentry.io", wantErr: true},
+		{name: "unsupported scheme", dsn: "ftp://abc123@o1.ingest.sentry.io/42", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDSN(tt.dsn)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidDSN) {
+					t.Errorf("parseDSN() error = %v, want ErrInvalidDSN", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDSN() error = %v", err)
+			}
+			if got.storeURL != tt.wantStore || got.publicKey != tt.wantKey {
+				t.Errorf("parseDSN() = %+v, want store %q and key %q", got, tt.wantStore, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestErrorReport_Event_newEvent(t *testing.T) {
+	r := &reporter{options: &Options{ServiceName: "orders", Environment: "production", InstanceName: "orders-1", InstanceHost: "10.0.0.1"}}
+	span := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01},
+		SpanID:  trace.SpanID{0x02},
+	})
+
+	e := r.newEvent(errors.New("payment declined"), map[string]interface{}{"order_id": 7}, span, 0)
+
+	if len(e.EventID) != 32 {
+		t.Errorf("EventID = %q, want 32 hex characters", e.EventID)
+	}
+	if e.ServerName != "10.0.0.1" || e.Environment != "production" || e.Level != "error" {
+		t.Errorf("event = %+v", e)
+	}
+	if e.Tags["service"] != "orders" || e.Tags["instance"] != "orders-1" || e.Tags["trace_id"] != span.TraceID().String() {
+		t.Errorf("Tags = %v", e.Tags)
+	}
+	if e.Extra["order_id"] != 7 {
+		t.Errorf("Extra = %v", e.Extra)
+	}
+
+	ex := e.Exception.Values[0]
+	if ex.Type != "*errors.errorString" || ex.Value != "payment declined" {
+		t.Errorf("exception = %s: %s", ex.Type, ex.Value)
+	}
+	frames := ex.Stacktrace.Frames
+	if last := frames[len(frames)-1]; !strings.HasSuffix(last.Function, "TestErrorReport_Event_newEvent") || !last.InApp {
+		t.Errorf("innermost frame = %+v, want the test function", last)
+	}
+}
+
+func TestErrorReport_Event_newEventWithoutSpan(t *testing.T) {
+	r := &reporter{options: &Options{}}
+	e := r.newEvent(errors.New("boom"), nil, trace.SpanContext{}, 0)
+	if _, ok := e.Tags["trace_id"]; ok || e.Contexts != nil {
+		t.Errorf("event without span has trace context: tags %v, contexts %v", e.Tags, e.Contexts)
+	}
+}
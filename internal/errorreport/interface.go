@@ -0,0 +1,9 @@
+package errorreport
+
+import "context"
+
+// Reporter captures errors for an error tracking service such as Sentry or GlitchTip.
+type Reporter interface {
+	Capture(ctx context.Context, err error, fields map[string]interface{}) string
+	Shutdown(ctx context.Context) error
+}
@@ -0,0 +1,79 @@
+package errorreport
+
+import "context"
+
+// Options contains configuration options for creating a Reporter.
+// All fields are optional; without a DSN, errors are passed to CaptureHook but not sent anywhere.
+type Options struct {
+	DSN          string // DSN is the Sentry DSN events are sent to, e.g. "https://<public key>@o1.ingest.sentry.io/<project id>". If empty, nothing is sent.
+	ServiceName  string // ServiceName is reported as the "service" tag.
+	Environment  string // Environment is the deployment environment events are filed under.
+	InstanceName string // InstanceName is reported as the "instance" tag.
+	InstanceHost string // InstanceHost is reported as the server name.
+	QueueSize    int    // QueueSize is the number of events buffered for sending. Events captured while the queue is full are dropped.
+	CaptureHook  Hook   // CaptureHook is called synchronously with every captured error and the ID of its event, e.g. to log it.
+}
+
+// Hook is called with a captured error, its fields, and the ID of the event reported for it.
+type Hook func(ctx context.Context, err error, fields map[string]interface{}, eventID string)
+
+// Validate reports whether the options describe a valid reporter without creating it.
+// It returns ErrInvalidDSN or ErrQueueSizeInvalid for the first invalid setting found.
+func (o *Options) Validate() error {
+	if o.DSN != "" {
+		if _, err := parseDSN(o.DSN); err != nil {
+			return err
+		}
+	}
+	if o.QueueSize <= 0 {
+		return ErrQueueSizeInvalid
+	}
+	return nil
+}
+
+// Option is a function that configures Options.
+// It follows the functional options pattern for flexible reporter configuration.
+type Option func(*Options)
+
+// WithDSN returns an Option that sets the Sentry DSN events are sent to.
+func WithDSN(dsn string) Option {
+	return func(o *Options) {
+		o.DSN = dsn
+	}
+}
+
+// WithServiceName returns an Option that sets the service reported with every event.
+func WithServiceName(name string) Option {
+	return func(o *Options) {
+		o.ServiceName = name
+	}
+}
+
+// WithEnvironment returns an Option that sets the environment events are filed under.
+func WithEnvironment(env string) Option {
+	return func(o *Options) {
+		o.Environment = env
+	}
+}
+
+// WithInstance returns an Option that sets the instance name and host reported with every event.
+func WithInstance(name, host string) Option {
+	return func(o *Options) {
+		o.InstanceName = name
+		o.InstanceHost = host
+	}
+}
+
+// WithQueueSize returns an Option that sets the number of events buffered for sending.
+func WithQueueSize(size int) Option {
+	return func(o *Options) {
+		o.QueueSize = size
+	}
+}
+
+// WithCaptureHook returns an Option that sets the function called with every captured error.
+func WithCaptureHook(hook Hook) Option {
+	return func(o *Options) {
+		o.CaptureHook = hook
+	}
+}
@@ -0,0 +1,59 @@
+package errorreport
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestErrorReport_Option_With(t *testing.T) {
+	opts := &Options{}
+	hook := func(context.Context, error, map[string]interface{}, string) {}
+	for _, opt := range []Option{
+		WithDSN("https://key@sentry.example.com/42"),
+		WithServiceName("orders"),
+		WithEnvironment("production"),
+		WithInstance("orders-1", "10.0.0.1"),
+		WithQueueSize(10),
+		WithCaptureHook(hook),
+	} {
+		opt(opts)
+	}
+
+	if opts.DSN != "https://key@sentry.example.com/42" {
+		t.Errorf("DSN = %q", opts.DSN)
+	}
+	if opts.ServiceName != "orders" || opts.Environment != "production" {
+		t.Errorf("ServiceName, Environment = %q, %q", opts.ServiceName, opts.Environment)
+	}
+	if opts.InstanceName != "orders-1" || opts.InstanceHost != "10.0.0.1" {
+		t.Errorf("InstanceName, InstanceHost = %q, %q", opts.InstanceName, opts.InstanceHost)
+	}
+	if opts.QueueSize != 10 {
+		t.Errorf("QueueSize = %d, want 10", opts.QueueSize)
+	}
+	if opts.CaptureHook == nil {
+		t.Error("CaptureHook not set")
+	}
+}
+
+func TestErrorReport_Option_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		wantErr error
+	}{
+		{name: "no DSN", options: Options{QueueSize: 1}},
+		{name: "valid DSN", options: Options{DSN: "https://key@sentry.example.com/42", QueueSize: 1}},
+		{name: "invalid DSN", options: Options{DSN: "sentry.example.com/42", QueueSize: 1}, wantErr: ErrInvalidDSN},
+		{name: "zero queue size", options: Options{}, wantErr: ErrQueueSizeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.options.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
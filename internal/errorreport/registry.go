@@ -0,0 +1,59 @@
+package errorreport
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// clientName identifies the library in the X-Sentry-Auth header.
+const clientName = "go-monitoring/1.0"
+
+// requestTimeout bounds each request to the error tracking service.
+const requestTimeout = 10 * time.Second
+
+// NewReporter creates a Reporter according to the provided Options. With a DSN, events are
+// sent to its Sentry-compatible store endpoint (Sentry, GlitchTip) by a background goroutine
+// through a queue of 100 events by default; without one, captured errors only reach the
+// capture hook. It returns ErrInvalidDSN or ErrQueueSizeInvalid if validation fails.
+func NewReporter(opts ...Option) (Reporter, error) {
+	options := &Options{
+		QueueSize: 100,
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	r := &reporter{options: options}
+	if options.DSN == "" {
+		return r, nil
+	}
+	r.dsn, _ = parseDSN(options.DSN)
+	r.client = &http.Client{Timeout: requestTimeout}
+	r.queue = make(chan *event, options.QueueSize)
+	r.done = make(chan struct{})
+	go r.run()
+	return r, nil
+}
+
+// NewNoopReporter returns a Reporter that discards every error.
+// It is used when error reporting is disabled but callers still expect a non-nil Reporter.
+func NewNoopReporter() Reporter {
+	return noopReporter{}
+}
+
+// noopReporter is a Reporter that discards every error.
+type noopReporter struct{}
+
+// Capture discards err and returns "".
+func (noopReporter) Capture(ctx context.Context, err error, fields map[string]interface{}) string {
+	return ""
+}
+
+// Shutdown does nothing.
+func (noopReporter) Shutdown(ctx context.Context) error { return nil }
@@ -0,0 +1,123 @@
+package errorreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// reporter sends captured errors to a Sentry-compatible store endpoint from a background
+// goroutine, so Capture never waits on the network.
+type reporter struct {
+	options *Options
+	dsn     *dsn         // dsn is nil when events are not sent.
+	client  *http.Client // client posts the events.
+
+	mu     sync.RWMutex // mu guards closed against concurrent Capture and Shutdown.
+	closed bool
+	queue  chan *event
+	done   chan struct{}
+}
+
+// Capture reports err with fields as extra data and the trace context of the span in ctx,
+// and returns the ID of the event. The stack trace is taken where Capture is called. The
+// capture hook is called before the event is queued; the event is dropped if the queue is
+// full or the reporter is shut down. A nil err is ignored and returns "".
+//
+// Parameters:
+//   - ctx: The context the error occurred in (may contain a span)
+//   - err: The error to report
+//   - fields: Additional details reported as extra data (may be nil)
+//
+// Example:
+//
+//	if err := charge(ctx, order); err != nil {
+//	    reporter.Capture(ctx, err, map[string]interface{}{"order_id": order.ID})
+//	}
+func (r *reporter) Capture(ctx context.Context, err error, fields map[string]interface{}) string {
+	if err == nil {
+		return ""
+	}
+	e := r.newEvent(err, fields, trace.SpanContextFromContext(ctx), 1)
+	if r.options.CaptureHook != nil {
+		r.options.CaptureHook(ctx, err, fields, e.EventID)
+	}
+	if r.dsn == nil {
+		return e.EventID
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return e.EventID
+	}
+	select {
+	case r.queue <- e:
+	default:
+		otel.Handle(fmt.Errorf("error reporting queue is full, dropped event %s", e.EventID))
+	}
+	return e.EventID
+}
+
+// Shutdown sends the queued events and stops the reporter, waiting until the queue is drained
+// or ctx is done. Errors captured afterwards are passed to the capture hook only.
+func (r *reporter) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		if r.queue != nil {
+			close(r.queue)
+		}
+	}
+	r.mu.Unlock()
+
+	if r.done == nil {
+		return nil
+	}
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run sends queued events until the queue is closed. Send failures are reported to the global
+// OpenTelemetry error handler, like failed span and metric exports.
+func (r *reporter) run() {
+	defer close(r.done)
+	for e := range r.queue {
+		if err := r.send(e); err != nil {
+			otel.Handle(err)
+		}
+	}
+}
+
+// send posts e to the store endpoint.
+func (r *reporter) send(e *event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode error event: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, r.dsn.storeURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to send error event: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_client=%s, sentry_key=%s", clientName, r.dsn.publicKey))
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send error event: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("failed to send error event: %s", resp.Status)
+	}
+	return nil
+}
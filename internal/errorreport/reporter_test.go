@@ -0,0 +1,143 @@
+package errorreport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// storeServer is a Sentry store endpoint recording the events it receives.
+type storeServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	events  []map[string]interface{}
+	headers []http.Header
+}
+
+func newStoreServer(t *testing.T) *storeServer {
+	t.Helper()
+	s := &storeServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/42/store/" {
+			http.NotFound(w, r)
+			return
+		}
+		var e map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		s.events = append(s.events, e)
+		s.headers = append(s.headers, r.Header.Clone())
+		s.mu.Unlock()
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// dsn returns a DSN pointing at the server.
+func (s *storeServer) dsn() string {
+	return strings.Replace(s.URL, "http://", "http://public@", 1) + "/42"
+}
+
+func TestErrorReport_Reporter_Capture(t *testing.T) {
+	server := newStoreServer(t)
+	var hooked []string
+	r, err := NewReporter(
+		WithDSN(server.dsn()),
+		WithServiceName("orders"),
+		WithCaptureHook(func(ctx context.Context, err error, fields map[string]interface{}, eventID string) {
+			hooked = append(hooked, eventID)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewReporter() error = %v", err)
+	}
+
+	id := r.Capture(context.Background(), errors.New("payment declined"), map[string]interface{}{"order_id": "o-1"})
+	if err := r.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	if len(hooked) != 1 || hooked[0] != id {
+		t.Errorf("hook saw %v, want [%s]", hooked, id)
+	}
+	if len(server.events) != 1 {
+		t.Fatalf("server received %d events, want 1", len(server.events))
+	}
+	e := server.events[0]
+	if e["event_id"] != id || e["extra"].(map[string]interface{})["order_id"] != "o-1" {
+		t.Errorf("event = %v", e)
+	}
+	auth := server.headers[0].Get("X-Sentry-Auth")
+	if !strings.Contains(auth, "sentry_key=public") || !strings.Contains(auth, "sentry_version=7") {
+		t.Errorf("X-Sentry-Auth = %q", auth)
+	}
+}
+
+func TestErrorReport_Reporter_CaptureNil(t *testing.T) {
+	r, err := NewReporter(WithCaptureHook(func(context.Context, error, map[string]interface{}, string) {
+		t.Error("hook must not be called for a nil error")
+	}))
+	if err != nil {
+		t.Fatalf("NewReporter() error = %v", err)
+	}
+	if id := r.Capture(context.Background(), nil, nil); id != "" {
+		t.Errorf("Capture(nil) = %q, want empty", id)
+	}
+}
+
+func TestErrorReport_Reporter_WithoutDSN(t *testing.T) {
+	called := false
+	r, err := NewReporter(WithCaptureHook(func(context.Context, error, map[string]interface{}, string) {
+		called = true
+	}))
+	if err != nil {
+		t.Fatalf("NewReporter() error = %v", err)
+	}
+	if id := r.Capture(context.Background(), errors.New("boom"), nil); id == "" {
+		t.Error("Capture() returned an empty event ID")
+	}
+	if !called {
+		t.Error("hook was not called")
+	}
+	if err := r.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+}
+
+func TestErrorReport_Reporter_AfterShutdown(t *testing.T) {
+	server := newStoreServer(t)
+	r, err := NewReporter(WithDSN(server.dsn()))
+	if err != nil {
+		t.Fatalf("NewReporter() error = %v", err)
+	}
+	if err := r.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if err := r.Shutdown(context.Background()); err != nil {
+		t.Errorf("second Shutdown() error = %v", err)
+	}
+	r.Capture(context.Background(), errors.New("late"), nil)
+	if len(server.events) != 0 {
+		t.Errorf("server received %d events after shutdown, want 0", len(server.events))
+	}
+}
+
+func TestErrorReport_Registry_NewReporter(t *testing.T) {
+	if _, err := NewReporter(WithDSN("not a dsn")); !errors.Is(err, ErrInvalidDSN) {
+		t.Errorf("NewReporter() error = %v, want ErrInvalidDSN", err)
+	}
+	if _, err := NewReporter(WithQueueSize(0)); !errors.Is(err, ErrQueueSizeInvalid) {
+		t.Errorf("NewReporter() error = %v, want ErrQueueSizeInvalid", err)
+	}
+	if id := NewNoopReporter().Capture(context.Background(), errors.New("boom"), nil); id != "" {
+		t.Errorf("noop Capture() = %q, want empty", id)
+	}
+}
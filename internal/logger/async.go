@@ -0,0 +1,207 @@
+package logger
+
+import (
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Drop policies for an async logger whose buffer is full.
+const (
+	DropPolicyBlock      = "block"       // DropPolicyBlock waits for room in the buffer; no entry is lost.
+	DropPolicyDropNewest = "drop_newest" // DropPolicyDropNewest discards the entry being written.
+	DropPolicyDropOldest = "drop_oldest" // DropPolicyDropOldest discards the oldest buffered entry to make room.
+)
+
+// asyncEntry is a log entry waiting in an asyncQueue, or a flush marker when flushed is set.
+type asyncEntry struct {
+	core    zapcore.Core
+	entry   zapcore.Entry
+	fields  []zapcore.Field
+	flushed chan struct{}
+}
+
+// asyncQueue is the bounded buffer shared by an async core and the cores derived from it
+// with With. A single goroutine writes its entries until the queue is closed.
+type asyncQueue struct {
+	entries chan asyncEntry
+	policy  string
+	dropped func(entries int)
+	stopped chan struct{} // stopped is closed when the writer goroutine exits.
+
+	mu     sync.RWMutex // mu is held for reading while entries is used, and for writing to close it.
+	closed bool
+}
+
+// newAsyncQueue creates a queue holding up to size entries and starts its writer goroutine.
+func newAsyncQueue(size int, policy string, dropped func(entries int)) *asyncQueue {
+	q := &asyncQueue{
+		entries: make(chan asyncEntry, size),
+		policy:  policy,
+		dropped: dropped,
+		stopped: make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+// run writes queued entries to their core and releases flush markers in order, until the queue
+// is closed. Write errors are ignored, as there is nowhere left to report them.
+func (q *asyncQueue) run() {
+	defer close(q.stopped)
+	for e := range q.entries {
+		if e.flushed != nil {
+			close(e.flushed)
+			continue
+		}
+		_ = e.core.Write(e.entry, e.fields)
+	}
+}
+
+// enqueue adds e to the queue, applying the drop policy when the queue is full. Once the queue
+// is closed, e is written before enqueue returns.
+func (q *asyncQueue) enqueue(e asyncEntry) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		_ = e.core.Write(e.entry, e.fields)
+		return
+	}
+	switch q.policy {
+	case DropPolicyDropNewest:
+		select {
+		case q.entries <- e:
+		default:
+			q.drop()
+		}
+	case DropPolicyDropOldest:
+		for {
+			select {
+			case q.entries <- e:
+				return
+			default:
+			}
+			// Never lose a flush marker: the markers met while looking for the oldest entry are
+			// queued again behind the others, so the Sync waiting for one still returns only
+			// after the entries queued before it. When only markers are queued, e is dropped.
+			var markers []asyncEntry
+			evicted := false
+			for !evicted {
+				select {
+				case oldest := <-q.entries:
+					if oldest.flushed != nil {
+						markers = append(markers, oldest)
+						continue
+					}
+					q.drop()
+					evicted = true
+					continue
+				default:
+				}
+				break
+			}
+			for _, marker := range markers {
+				q.entries <- marker
+			}
+			if !evicted {
+				q.drop()
+				return
+			}
+		}
+	default:
+		q.entries <- e
+	}
+}
+
+// flush blocks until every entry queued before the call has been written. It returns at once
+// when the queue is closed, as close writes every queued entry.
+func (q *asyncQueue) flush() {
+	q.mu.RLock()
+	if q.closed {
+		q.mu.RUnlock()
+		return
+	}
+	flushed := make(chan struct{})
+	q.entries <- asyncEntry{flushed: flushed}
+	q.mu.RUnlock()
+	<-flushed
+}
+
+// close writes the queued entries and stops the writer goroutine. Entries written afterwards
+// are written synchronously. It is safe to call more than once.
+func (q *asyncQueue) close() {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.entries)
+	}
+	q.mu.Unlock()
+	<-q.stopped
+}
+
+// drop reports one discarded entry to the dropped handler.
+func (q *asyncQueue) drop() {
+	if q.dropped != nil {
+		q.dropped(1)
+	}
+}
+
+// asyncCore is a zapcore.Core that hands entries to a background goroutine, so a stalled
+// disk or stdout does not block the caller. Entries above error level (DPanic, Panic, Fatal)
+// are written synchronously after the buffer is flushed, since the process may stop right
+// after them.
+type asyncCore struct {
+	core  zapcore.Core
+	queue *asyncQueue
+}
+
+// newAsyncCore wraps core so entries are written by a background goroutine through a buffer
+// of size entries, applying policy when it is full and reporting discarded entries to dropped.
+func newAsyncCore(core zapcore.Core, size int, policy string, dropped func(entries int)) *asyncCore {
+	return &asyncCore{
+		core:  core,
+		queue: newAsyncQueue(size, policy, dropped),
+	}
+}
+
+func (c *asyncCore) Enabled(level zapcore.Level) bool {
+	return c.core.Enabled(level)
+}
+
+func (c *asyncCore) With(fields []zapcore.Field) zapcore.Core {
+	return &asyncCore{
+		core:  c.core.With(fields),
+		queue: c.queue,
+	}
+}
+
+func (c *asyncCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checkWrapped(c, c.core, entry, checked)
+}
+
+// Write queues the entry. The fields are copied, but values they reference (objects, byte
+// slices) are encoded later and must not be modified by the caller after logging.
+func (c *asyncCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if entry.Level > zapcore.ErrorLevel {
+		c.queue.flush()
+		return c.core.Write(entry, fields)
+	}
+	c.queue.enqueue(asyncEntry{
+		core:   c.core,
+		entry:  entry,
+		fields: append([]zapcore.Field(nil), fields...),
+	})
+	return nil
+}
+
+// Sync waits for the buffered entries to be written and syncs the underlying core.
+func (c *asyncCore) Sync() error {
+	c.queue.flush()
+	return c.core.Sync()
+}
+
+// close writes the buffered entries and stops the background goroutine; see asyncQueue.close.
+func (c *asyncCore) close() error {
+	c.queue.close()
+	return nil
+}
@@ -0,0 +1,202 @@
+package logger
+
+import (
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+)
+
+// blockingCore is a zapcore.Core whose writes wait until release is closed.
+type blockingCore struct {
+	zapcore.LevelEnabler
+	release chan struct{}
+
+	mu       sync.Mutex
+	messages []string
+}
+
+func (c *blockingCore) With(fields []zapcore.Field) zapcore.Core { return c }
+
+func (c *blockingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checked.AddCore(entry, c)
+}
+
+func (c *blockingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	<-c.release
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, entry.Message)
+	return nil
+}
+
+func (c *blockingCore) Sync() error { return nil }
+
+func (c *blockingCore) written() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.messages...)
+}
+
+func TestLogger_Async_NewLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithAsync(16, DropPolicyBlock))
+	require.NoError(t, err)
+
+	loggerInstance.Info("first", map[string]interface{}{"n": 1})
+	loggerInstance.WithSpanContext(trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})).InfoFields("second", Int("n", 2))
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	require.Equal(t, "first", entries[0]["msg"])
+	require.Equal(t, "second", entries[1]["msg"])
+	require.Contains(t, entries[1], "traceID")
+}
+
+func TestLogger_Async_LoggerClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithAsync(16, DropPolicyBlock))
+	require.NoError(t, err)
+	l := loggerInstance.(*logger)
+	require.Len(t, l.closers, 1)
+
+	loggerInstance.Info("before close", nil)
+	require.NoError(t, loggerInstance.(Closer).Close())
+	loggerInstance.Info("after close", nil)
+	require.NoError(t, loggerInstance.(Closer).Close())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	require.Equal(t, "before close", entries[0]["msg"])
+	require.Equal(t, "after close", entries[1]["msg"])
+}
+
+func TestLogger_Async_DropPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		wantWritten []string
+	}{
+		// "a" is taken by the writer goroutine and blocks it, "b" fills the buffer, and "c" and
+		// "d" arrive while the buffer is full.
+		{"drop newest", DropPolicyDropNewest, []string{"a", "b"}},
+		{"drop oldest", DropPolicyDropOldest, []string{"a", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &blockingCore{LevelEnabler: zapcore.DebugLevel, release: make(chan struct{})}
+			var dropped atomic.Int64
+			core := newAsyncCore(inner, 1, tt.policy, func(entries int) { dropped.Add(int64(entries)) })
+
+			require.NoError(t, core.Write(zapcore.Entry{Message: "a"}, nil))
+			// Wait for the writer goroutine to take "a" so the buffer is empty again.
+			require.Eventually(t, func() bool { return len(core.queue.entries) == 0 }, time.Second, time.Millisecond)
+			for _, message := range []string{"b", "c", "d"} {
+				require.NoError(t, core.Write(zapcore.Entry{Message: message}, nil))
+			}
+			require.Equal(t, int64(2), dropped.Load())
+
+			close(inner.release)
+			require.NoError(t, core.Sync())
+			require.Equal(t, tt.wantWritten, inner.written())
+		})
+	}
+}
+
+func TestLogger_Async_DropOldestKeepsFlushMarker(t *testing.T) {
+	inner := &blockingCore{LevelEnabler: zapcore.DebugLevel, release: make(chan struct{})}
+	var dropped atomic.Int64
+	core := newAsyncCore(inner, 1, DropPolicyDropOldest, func(entries int) { dropped.Add(int64(entries)) })
+
+	require.NoError(t, core.Write(zapcore.Entry{Message: "a"}, nil))
+	require.Eventually(t, func() bool { return len(core.queue.entries) == 0 }, time.Second, time.Millisecond)
+	synced := make(chan struct{})
+	go func() {
+		_ = core.Sync()
+		close(synced)
+	}()
+	require.Eventually(t, func() bool { return len(core.queue.entries) == 1 }, time.Second, time.Millisecond)
+
+	// The buffer only holds the flush marker, so the new entry is the one dropped.
+	require.NoError(t, core.Write(zapcore.Entry{Message: "b"}, nil))
+	require.Equal(t, int64(1), dropped.Load())
+	require.Never(t, func() bool {
+		select {
+		case <-synced:
+			return true
+		default:
+			return false
+		}
+	}, 50*time.Millisecond, time.Millisecond, "Sync returned before the entry queued ahead of it was written")
+
+	close(inner.release)
+	<-synced
+	require.Equal(t, []string{"a"}, inner.written())
+}
+
+func TestLogger_Async_Close(t *testing.T) {
+	inner := &blockingCore{LevelEnabler: zapcore.DebugLevel, release: make(chan struct{})}
+	close(inner.release)
+	core := newAsyncCore(inner, 4, DropPolicyBlock, nil)
+
+	require.NoError(t, core.Write(zapcore.Entry{Message: "queued"}, nil))
+	require.NoError(t, core.close())
+	select {
+	case <-core.queue.stopped:
+	default:
+		t.Fatal("close() did not stop the writer goroutine")
+	}
+	require.Equal(t, []string{"queued"}, inner.written())
+
+	// Entries logged after close are written synchronously, and closing again is a no-op.
+	require.NoError(t, core.Write(zapcore.Entry{Message: "late"}, nil))
+	require.NoError(t, core.Sync())
+	require.NoError(t, core.close())
+	require.Equal(t, []string{"queued", "late"}, inner.written())
+}
+
+func TestLogger_Async_FatalIsSynchronous(t *testing.T) {
+	inner := &blockingCore{LevelEnabler: zapcore.DebugLevel, release: make(chan struct{})}
+	close(inner.release)
+	core := newAsyncCore(inner, 4, DropPolicyBlock, nil)
+
+	require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Message: "queued"}, nil))
+	require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.FatalLevel, Message: "fatal"}, nil))
+
+	// The fatal entry is written only after the entries queued before it.
+	require.Equal(t, []string{"queued", "fatal"}, inner.written())
+}
+
+func TestLogger_Async_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{"synchronous", nil, nil},
+		{"block", []Option{WithAsync(8, DropPolicyBlock)}, nil},
+		{"negative buffer", []Option{WithAsync(-1, DropPolicyBlock)}, ErrInvalidAsyncBufferSize},
+		{"unknown policy", []Option{WithAsync(8, "drop_random")}, ErrInvalidDropPolicy},
+		{"policy ignored when synchronous", []Option{WithAsync(0, "")}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := &Options{Level: "info"}
+			for _, opt := range tt.opts {
+				opt(options)
+			}
+			require.ErrorIs(t, options.Validate(), tt.wantErr)
+		})
+	}
+}
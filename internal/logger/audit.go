@@ -0,0 +1,232 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// auditHMACField is the field of an audit record holding its HMAC. It is always the last one.
+const auditHMACField = `,"hmac":"`
+
+// auditReservedFields are the fields the audit log sets in every record. A duplicate key would
+// be decoded in place of the real one, so Audit rejects fields using them.
+var auditReservedFields = map[string]bool{"time": true, "event": true, "seq": true, "hmac": true}
+
+// auditEncoderConfig encodes audit records with their time, in UTC, and event name.
+var auditEncoderConfig = zapcore.EncoderConfig{
+	TimeKey:    "time",
+	MessageKey: "event",
+	EncodeTime: func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+		enc.AppendString(t.UTC().Format(time.RFC3339Nano))
+	},
+	EncodeDuration: zapcore.StringDurationEncoder,
+}
+
+// auditLog writes audit records, one JSON object per line, to an output of its own. Every
+// record carries a sequence number one above the previous one and, when a key is set, an
+// HMAC-SHA256 of the record chained with the HMAC of the previous record, so removed,
+// reordered, or edited records are detected by VerifyAudit.
+type auditLog struct {
+	key []byte
+
+	mu      sync.Mutex
+	out     zapcore.WriteSyncer
+	encoder zapcore.Encoder
+	seq     uint64
+	prevMAC []byte
+
+	recovered error // recovered describes the partial last record cut from the file when it was opened, if any.
+}
+
+// newAuditLog opens path, which may be "stderr", "stdout", or a file, for audit records signed
+// with key. When path is an existing file, the sequence and HMAC chain continue from its last
+// record; see resume for a partial last record.
+func newAuditLog(path string, key []byte) (*auditLog, error) {
+	a := &auditLog{key: key, encoder: zapcore.NewJSONEncoder(auditEncoderConfig)}
+	if path != "stdout" && path != "stderr" {
+		if err := a.resume(path); err != nil {
+			return nil, fmt.Errorf("failed to read audit output: %w", err)
+		}
+	}
+	out, _, err := zap.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit output: %w", err)
+	}
+	a.out = out
+	return a, nil
+}
+
+// resume continues the sequence and HMAC chain from the last record of the file at path, if
+// it exists. A last line without a newline that is not a record is what a write interrupted
+// by a crash or a full disk leaves: it is cut from the file, described in a.recovered, and the
+// chain continues from the record before it. Any other line that is not a record fails.
+func (a *auditLog) resume(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var end, offset int64 // end follows the last record read, offset the last line read.
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		offset += int64(len(line))
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+			record, mac, parseErr := parseAuditRecord(trimmed)
+			if parseErr != nil && err == io.EOF {
+				if err := f.Truncate(end); err != nil {
+					return err
+				}
+				a.recovered = fmt.Errorf("cut %d bytes of a partial record following sequence %d: %w", len(line), a.seq, parseErr)
+				return nil
+			}
+			if parseErr != nil {
+				return parseErr
+			}
+			if err == io.EOF {
+				// the record is complete but its newline was not written
+				if _, err := f.WriteAt([]byte("\n"), offset); err != nil {
+					return err
+				}
+			}
+			a.seq, a.prevMAC, end = record.Seq, mac, offset
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// write appends the record of event with fields.
+func (a *auditLog) write(event string, fields []zapcore.Field) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	seq := a.seq + 1
+	buf, err := a.encoder.EncodeEntry(zapcore.Entry{Time: time.Now(), Message: event}, append([]zapcore.Field{zap.Uint64("seq", seq)}, fields...))
+	if err != nil {
+		return fmt.Errorf("failed to encode audit record: %w", err)
+	}
+	defer buf.Free()
+
+	line := buf.Bytes()
+	var mac []byte
+	if a.key != nil {
+		// The HMAC covers the record without its hmac field: the line up to its closing brace.
+		record := bytes.TrimRight(line, "\n")
+		mac = auditMAC(a.key, a.prevMAC, record)
+		line = append(append(append(record[:len(record)-1:len(record)-1], auditHMACField...), hex.EncodeToString(mac)...), "\"}\n"...)
+	}
+	if _, err := a.out.Write(line); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	a.seq = seq
+	a.prevMAC = mac
+	return nil
+}
+
+// sync flushes the audit output.
+func (a *auditLog) sync() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.out.Sync()
+}
+
+// auditMAC returns the HMAC-SHA256 under key of the HMAC of the previous record followed by
+// record.
+func auditMAC(key, prevMAC, record []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write(prevMAC)
+	_, _ = h.Write(record)
+	return h.Sum(nil)
+}
+
+// auditRecord is the part of an audit record VerifyAudit checks.
+type auditRecord struct {
+	Seq uint64 `json:"seq"`
+}
+
+// parseAuditRecord decodes line and returns its HMAC, nil when it has none.
+func parseAuditRecord(line []byte) (auditRecord, []byte, error) {
+	var record auditRecord
+	if err := json.Unmarshal(line, &record); err != nil {
+		return record, nil, err
+	}
+	i := bytes.LastIndex(line, []byte(auditHMACField))
+	if i < 0 {
+		return record, nil, nil
+	}
+	mac, err := hex.DecodeString(string(bytes.TrimSuffix(line[i+len(auditHMACField):], []byte(`"}`))))
+	return record, mac, err
+}
+
+// VerifyAudit reads the audit records written by Logger.Audit from r and reports whether their
+// sequence numbers are consecutive and, when key is set, whether every record carries the HMAC
+// it was written with under key. The first record may have any sequence number, so a log that
+// was rotated can be verified from any point; unless it is the first record written, its own
+// HMAC cannot be checked, but it still anchors the HMACs of the records that follow. It
+// returns an error wrapping ErrAuditTampered for the first record failing a check.
+//
+// Example:
+//
+//	f, _ := os.Open("/var/log/app/audit.log")
+//	if err := VerifyAudit(f, key); err != nil {
+//	    log.Printf("audit log check failed: %v", err)
+//	}
+func VerifyAudit(r io.Reader, key []byte) error {
+	br := bufio.NewReader(r)
+	var records int
+	var prevSeq uint64
+	var prevMAC []byte
+	for n := 1; ; n++ {
+		line, err := br.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			record, mac, parseErr := parseAuditRecord(line)
+			if parseErr != nil {
+				return fmt.Errorf("%w: line %d: %v", ErrAuditTampered, n, parseErr)
+			}
+			if records > 0 && record.Seq != prevSeq+1 {
+				return fmt.Errorf("%w: line %d: sequence %d follows %d", ErrAuditTampered, n, record.Seq, prevSeq)
+			}
+			if key != nil {
+				if mac == nil {
+					return fmt.Errorf("%w: line %d: missing hmac", ErrAuditTampered, n)
+				}
+				// The HMAC of the first record read chains the HMAC of a record before it,
+				// unless the log starts there.
+				i := bytes.LastIndex(line, []byte(auditHMACField))
+				if (records > 0 || record.Seq == 1) && !hmac.Equal(mac, auditMAC(key, prevMAC, append(line[:i:i], '}'))) {
+					return fmt.Errorf("%w: line %d: hmac mismatch", ErrAuditTampered, n)
+				}
+			}
+			records++
+			prevSeq, prevMAC = record.Seq, mac
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
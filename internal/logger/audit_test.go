@@ -0,0 +1,179 @@
+package logger
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// writeAudit writes the audit events to path through a new logger with key.
+func writeAudit(t *testing.T, path string, key []byte, events ...string) {
+	t.Helper()
+	loggerInstance, err := NewLogger(WithOutputPath(filepath.Join(filepath.Dir(path), "app.log")), WithLevel("error"), WithAudit(path, key))
+	require.NoError(t, err)
+	for _, event := range events {
+		require.NoError(t, loggerInstance.Audit(event, map[string]interface{}{"actor": "admin"}))
+	}
+	require.NoError(t, loggerInstance.Sync())
+}
+
+func TestLogger_Audit_Audit(t *testing.T) {
+	key := []byte("secret")
+	path := filepath.Join(t.TempDir(), "audit.log")
+	writeAudit(t, path, key, "user.created", "user.deleted")
+	// A new logger continues the sequence and HMAC chain of the file.
+	writeAudit(t, path, key, "role.granted")
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 3)
+	for i, event := range []string{"user.created", "user.deleted", "role.granted"} {
+		assert.Equal(t, event, entries[i]["event"])
+		assert.Equal(t, float64(i+1), entries[i]["seq"])
+		assert.Equal(t, "admin", entries[i]["actor"])
+		assert.NotEmpty(t, entries[i]["time"])
+		assert.Len(t, entries[i]["hmac"], 64)
+	}
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	assert.NoError(t, VerifyAudit(f, key))
+}
+
+func TestLogger_Audit_PartialRecord(t *testing.T) {
+	key := []byte("secret")
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.log")
+	writeAudit(t, path, key, "user.created", "user.deleted")
+	intact, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// A write interrupted part way leaves a last line without its end.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"time":"2026-01-01T00:00:00Z","event":"role.gran`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	appPath := filepath.Join(dir, "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(appPath), WithAudit(path, key))
+	require.NoError(t, err)
+	require.NoError(t, loggerInstance.Audit("role.granted", nil))
+	require.NoError(t, loggerInstance.Sync())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, intact), "the records before the partial one should be kept")
+	entries := readEntries(t, path)
+	require.Len(t, entries, 3)
+	assert.Equal(t, float64(3), entries[2]["seq"])
+	assert.NoError(t, VerifyAudit(bytes.NewReader(data), key))
+
+	warnings := readEntries(t, appPath)
+	require.Len(t, warnings, 1, "the partial record should be reported")
+	assert.Equal(t, "warn", warnings[0]["level"])
+
+	// A record whose newline was not written is kept.
+	require.NoError(t, os.WriteFile(path, bytes.TrimSuffix(data, []byte("\n")), 0o600))
+	writeAudit(t, path, key, "role.revoked")
+	f, err = os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	assert.NoError(t, VerifyAudit(f, key))
+	assert.Len(t, readEntries(t, path), 4)
+}
+
+func TestLogger_Audit_ReservedField(t *testing.T) {
+	key := []byte("secret")
+	path := filepath.Join(t.TempDir(), "audit.log")
+	loggerInstance, err := NewLogger(WithOutputPath(filepath.Join(filepath.Dir(path), "app.log")), WithAudit(path, key))
+	require.NoError(t, err)
+
+	for _, name := range []string{"time", "event", "seq", "hmac"} {
+		err := loggerInstance.Audit("user.deleted", map[string]interface{}{"actor": "admin", name: "abc"})
+		assert.ErrorIs(t, err, ErrAuditReservedField, "field %q", name)
+	}
+	require.NoError(t, loggerInstance.Audit("user.deleted", map[string]interface{}{"actor": "admin"}))
+	require.NoError(t, loggerInstance.Sync())
+
+	// The rejected records were not written, so the log verifies and a new logger resumes it.
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	assert.NoError(t, VerifyAudit(f, key))
+	writeAudit(t, path, key, "role.granted")
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	assert.Equal(t, float64(2), entries[1]["seq"])
+}
+
+func TestLogger_Audit_NotConfigured(t *testing.T) {
+	loggerInstance, err := NewLogger(WithOutputPath(filepath.Join(t.TempDir(), "app.log")))
+	require.NoError(t, err)
+	assert.ErrorIs(t, loggerInstance.Audit("user.deleted", nil), ErrAuditNotConfigured)
+	assert.ErrorIs(t, NewNoopLogger().Audit("user.deleted", nil), ErrAuditNotConfigured)
+}
+
+func TestLogger_Audit_Isolated(t *testing.T) {
+	dir := t.TempDir()
+	appPath := filepath.Join(dir, "app.log")
+	auditPath := filepath.Join(dir, "audit.log")
+	loggerInstance, err := NewLogger(WithOutputPath(appPath), WithLevel("fatal"), WithAudit(auditPath, nil))
+	require.NoError(t, err)
+
+	loggerInstance.Error("not written", nil)
+	require.NoError(t, loggerInstance.WithSpanContext(trace.SpanContext{}).Audit("user.deleted", nil))
+	require.NoError(t, loggerInstance.Sync())
+
+	app, err := os.ReadFile(appPath)
+	require.NoError(t, err)
+	assert.Empty(t, app)
+	entries := readEntries(t, auditPath)
+	require.Len(t, entries, 1, "audit records should be written regardless of the log level")
+	assert.NotContains(t, entries[0], "hmac", "records should not be signed without a key")
+}
+
+func TestLogger_Audit_VerifyAudit(t *testing.T) {
+	key := []byte("secret")
+	path := filepath.Join(t.TempDir(), "audit.log")
+	writeAudit(t, path, key, "a", "b", "c", "d")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 4)
+
+	edited := strings.Replace(lines[1], `"actor":"admin"`, `"actor":"intruder"`, 1)
+
+	tests := []struct {
+		name    string
+		log     string
+		key     []byte
+		wantErr bool
+	}{
+		{name: "intact", log: string(data), key: key},
+		{name: "rotated", log: lines[2] + lines[3], key: key},
+		{name: "intact without key", log: string(data)},
+		{name: "wrong key", log: string(data), key: []byte("other"), wantErr: true},
+		{name: "edited record", log: lines[0] + edited + lines[2] + lines[3], key: key, wantErr: true},
+		{name: "removed record", log: lines[0] + lines[2] + lines[3], key: key, wantErr: true},
+		{name: "removed record without key", log: lines[0] + lines[2] + lines[3], wantErr: true},
+		{name: "reordered records", log: lines[0] + lines[2] + lines[1] + lines[3], key: key, wantErr: true},
+		{name: "malformed record", log: lines[0] + "{\n", key: key, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyAudit(bytes.NewBufferString(tt.log), tt.key)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrAuditTampered)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package logger
+
+import (
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// dedupKeyEncoder encodes the fields hashed into a dedup key.
+var dedupKeyEncoder = zapcore.NewJSONEncoder(zapcore.EncoderConfig{})
+
+// dedupEntry is the first entry of a dedup window and the number of identical entries
+// suppressed since.
+type dedupEntry struct {
+	core       zapcore.Core
+	entry      zapcore.Entry
+	fields     []zapcore.Field
+	suppressed int
+}
+
+// dedupState holds the open dedup windows of a dedup core and the cores derived from it with
+// With. While windows are open, a goroutine closes the expired ones every window; it stops once
+// none is left, after Sync or when entries stop, so a logger that is dropped does not leak it.
+type dedupState struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	entries map[uint64]*dedupEntry
+	running bool // running is set while the goroutine closing windows runs.
+}
+
+// newDedupState creates the state of windows lasting window.
+func newDedupState(window time.Duration) *dedupState {
+	return &dedupState{window: window, entries: make(map[uint64]*dedupEntry)}
+}
+
+// run closes expired windows every window until none is open. It is started by the first
+// window opened while it is not running.
+func (s *dedupState) run() {
+	ticker := time.NewTicker(s.window)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		_ = s.sweep(now)
+		s.mu.Lock()
+		idle := len(s.entries) == 0
+		if idle {
+			s.running = false
+		}
+		s.mu.Unlock()
+		if idle {
+			return
+		}
+	}
+}
+
+// sweep closes the windows opened a window or more before now, writing a summary of each
+// window with suppressed entries.
+func (s *dedupState) sweep(now time.Time) error {
+	s.mu.Lock()
+	var closed []*dedupEntry
+	for key, e := range s.entries {
+		if now.Sub(e.entry.Time) >= s.window {
+			closed = append(closed, e)
+			delete(s.entries, key)
+		}
+	}
+	s.mu.Unlock()
+	return writeSummaries(closed, now)
+}
+
+// flush closes every window, writing a summary of each window with suppressed entries.
+func (s *dedupState) flush() error {
+	s.mu.Lock()
+	closed := make([]*dedupEntry, 0, len(s.entries))
+	for key, e := range s.entries {
+		closed = append(closed, e)
+		delete(s.entries, key)
+	}
+	s.mu.Unlock()
+	return writeSummaries(closed, time.Now())
+}
+
+// writeSummaries writes, for every closed window with suppressed entries, its first entry
+// again at now with a "count" field holding the number of entries suppressed.
+func writeSummaries(closed []*dedupEntry, now time.Time) error {
+	var err error
+	for _, e := range closed {
+		if e.suppressed == 0 {
+			continue
+		}
+		entry := e.entry
+		entry.Time = now
+		fields := append(e.fields[:len(e.fields):len(e.fields)], zap.Int("count", e.suppressed))
+		if writeErr := e.core.Write(entry, fields); writeErr != nil && err == nil {
+			err = writeErr
+		}
+	}
+	return err
+}
+
+// dedupCore collapses identical entries, with the same level, message, and fields, written
+// within a window: the first one is written, the next ones are suppressed, and when the window
+// closes the first one is written again with a "count" field holding the number suppressed.
+// Entries above error level (DPanic, Panic, Fatal) are always written.
+type dedupCore struct {
+	zapcore.Core
+	state   *dedupState
+	context uint64 // context is the hash of the fields added with With.
+}
+
+// newDedupCore wraps core so identical entries within window are collapsed.
+func newDedupCore(core zapcore.Core, window time.Duration) zapcore.Core {
+	return &dedupCore{Core: core, state: newDedupState(window)}
+}
+
+func (c *dedupCore) With(fields []zapcore.Field) zapcore.Core {
+	return &dedupCore{
+		Core:    c.Core.With(fields),
+		state:   c.state,
+		context: c.hash(zapcore.Entry{}, fields),
+	}
+}
+
+func (c *dedupCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checkWrapped(c, c.Core, entry, checked)
+}
+
+func (c *dedupCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if entry.Level > zapcore.ErrorLevel {
+		return c.Core.Write(entry, fields)
+	}
+
+	key := c.hash(entry, fields)
+	s := c.state
+	s.mu.Lock()
+	if e, ok := s.entries[key]; ok && entry.Time.Sub(e.entry.Time) < s.window {
+		e.suppressed++
+		s.mu.Unlock()
+		return nil
+	}
+	previous := s.entries[key]
+	s.entries[key] = &dedupEntry{core: c.Core, entry: entry, fields: append([]zapcore.Field(nil), fields...)}
+	if !s.running {
+		s.running = true
+		go s.run()
+	}
+	s.mu.Unlock()
+
+	// The window of an identical entry that expired before the sweep closes it here.
+	if previous != nil {
+		if err := writeSummaries([]*dedupEntry{previous}, entry.Time); err != nil {
+			return err
+		}
+	}
+	return c.Core.Write(entry, fields)
+}
+
+// Sync writes the summaries of the open windows, which stops the goroutine closing them until
+// the next entry, and syncs the wrapped core.
+func (c *dedupCore) Sync() error {
+	if err := c.state.flush(); err != nil {
+		return err
+	}
+	return c.Core.Sync()
+}
+
+// hash returns the dedup key of entry with fields, written to this core.
+func (c *dedupCore) hash(entry zapcore.Entry, fields []zapcore.Field) uint64 {
+	h := fnv.New64a()
+	var context [8]byte
+	for i := range context {
+		context[i] = byte(c.context >> (8 * i))
+	}
+	_, _ = h.Write(context[:])
+	_, _ = h.Write([]byte{byte(entry.Level)})
+	_, _ = h.Write([]byte(entry.LoggerName + "\x00" + entry.Message + "\x00"))
+	if buf, err := dedupKeyEncoder.EncodeEntry(zapcore.Entry{}, fields); err == nil {
+		_, _ = h.Write(buf.Bytes())
+		buf.Free()
+	}
+	return h.Sum64()
+}
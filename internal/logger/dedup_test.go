@@ -0,0 +1,106 @@
+package logger
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogger_Dedup_Window(t *testing.T) {
+	observed, logs := observer.New(zapcore.DebugLevel)
+	core := &dedupCore{Core: observed, state: &dedupState{window: time.Second, entries: make(map[uint64]*dedupEntry)}}
+	start := time.Unix(1000, 0)
+	write := func(offset time.Duration, message string, fields ...zapcore.Field) {
+		require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.ErrorLevel, Time: start.Add(offset), Message: message}, fields))
+	}
+
+	write(0, "db down", zap.String("db", "orders"))
+	write(100*time.Millisecond, "db down", zap.String("db", "orders"))
+	write(200*time.Millisecond, "db down", zap.String("db", "orders"))
+	write(300*time.Millisecond, "db down", zap.String("db", "users"))
+	assert.Equal(t, 2, logs.Len(), "identical entries should be suppressed within the window")
+
+	require.NoError(t, core.state.sweep(start.Add(1100*time.Millisecond)))
+	entries := logs.TakeAll()
+	require.Len(t, entries, 3)
+	summary := entries[2]
+	assert.Equal(t, "db down", summary.Message)
+	assert.Equal(t, map[string]interface{}{"db": "orders", "count": int64(2)}, summary.ContextMap())
+
+	require.NoError(t, core.state.sweep(start.Add(2*time.Second)))
+	assert.Equal(t, 0, logs.Len(), "windows without suppressed entries should not write a summary")
+
+	write(3*time.Second, "db down", zap.String("db", "orders"))
+	assert.Equal(t, 1, logs.Len(), "an entry after the window should be written")
+}
+
+func TestLogger_Dedup_ExpiredWindow(t *testing.T) {
+	observed, logs := observer.New(zapcore.DebugLevel)
+	core := &dedupCore{Core: observed, state: &dedupState{window: time.Second, entries: make(map[uint64]*dedupEntry)}}
+	start := time.Unix(1000, 0)
+
+	for _, offset := range []time.Duration{0, 500 * time.Millisecond, 1500 * time.Millisecond} {
+		require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.WarnLevel, Time: start.Add(offset), Message: "retry"}, nil))
+	}
+	entries := logs.TakeAll()
+	require.Len(t, entries, 3, "the expired window should be summarized before the new entry")
+	assert.Equal(t, map[string]interface{}{"count": int64(1)}, entries[1].ContextMap())
+	assert.Empty(t, entries[2].ContextMap())
+}
+
+func TestLogger_Dedup_Context(t *testing.T) {
+	observed, logs := observer.New(zapcore.DebugLevel)
+	core := &dedupCore{Core: observed, state: &dedupState{window: time.Second, entries: make(map[uint64]*dedupEntry)}}
+	entry := zapcore.Entry{Level: zapcore.InfoLevel, Time: time.Unix(1000, 0), Message: "request failed"}
+
+	require.NoError(t, core.With([]zapcore.Field{zap.String("traceID", "a")}).Write(entry, nil))
+	require.NoError(t, core.With([]zapcore.Field{zap.String("traceID", "b")}).Write(entry, nil))
+	require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.FatalLevel, Time: entry.Time, Message: "fatal"}, nil))
+	require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.FatalLevel, Time: entry.Time, Message: "fatal"}, nil))
+	assert.Equal(t, 4, logs.Len(), "entries with different context fields and fatal entries should not be suppressed")
+}
+
+func TestLogger_Dedup_Goroutine(t *testing.T) {
+	observed, logs := observer.New(zapcore.DebugLevel)
+	core := newDedupCore(observed, 10*time.Millisecond).(*dedupCore)
+	running := func() bool {
+		core.state.mu.Lock()
+		defer core.state.mu.Unlock()
+		return core.state.running
+	}
+	assert.False(t, running(), "no goroutine should run before an entry is written")
+
+	entry := zapcore.Entry{Level: zapcore.InfoLevel, Time: time.Now(), Message: "retry"}
+	require.NoError(t, core.Write(entry, nil))
+	require.NoError(t, core.Write(entry, nil))
+	assert.True(t, running())
+	assert.Eventually(t, func() bool { return !running() }, time.Second, time.Millisecond, "the goroutine should stop once every window is closed")
+	assert.Equal(t, 2, logs.Len(), "the expired window should be summarized")
+
+	require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: time.Now(), Message: "retry"}, nil))
+	assert.True(t, running(), "a new window should restart the goroutine")
+	require.NoError(t, core.Sync())
+	assert.Eventually(t, func() bool { return !running() }, time.Second, time.Millisecond, "the goroutine should stop after Sync")
+}
+
+func TestLogger_Dedup_NewLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithDedup(time.Hour))
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		loggerInstance.Error("connection refused", map[string]interface{}{"host": "db"})
+	}
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	assert.NotContains(t, entries[0], "count")
+	assert.Equal(t, float64(4), entries[1]["count"], "Sync should write the summary of the open window")
+}
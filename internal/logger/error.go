@@ -3,5 +3,22 @@ package logger
 import "errors"
 
 var (
-	ErrInvalidLogLevel = errors.New("invalid log level")
+	ErrInvalidLogLevel        = errors.New("invalid log level")
+	ErrInvalidAsyncBufferSize = errors.New("async buffer size must not be negative")
+	ErrInvalidDropPolicy      = errors.New("drop policy must be block, drop_newest, or drop_oldest")
+	ErrInvalidSink            = errors.New("sink must be syslog, journald, loki, or kafka")
+	ErrInvalidSyslogFacility  = errors.New("invalid syslog facility")
+	ErrSinkUnsupported        = errors.New("sink is not supported on this platform")
+	ErrLokiURLRequired        = errors.New("loki url is required for the loki sink")
+	ErrKafkaBrokersRequired   = errors.New("kafka brokers are required for the kafka sink")
+	ErrKafkaTopicRequired     = errors.New("kafka topic is required for the kafka sink")
+	ErrInvalidKafkaBatch      = errors.New("kafka batch size, batch timeout, and buffer size must not be negative")
+	ErrInvalidSchema          = errors.New("log schema must be ecs, gcp, or datadog")
+	ErrInvalidCallerSkip      = errors.New("caller skip must not be negative")
+	ErrInvalidStacktraceLevel = errors.New("stacktrace level must be a log level or off")
+	ErrInvalidDedupWindow     = errors.New("dedup window must not be negative")
+	ErrInvalidRateLimit       = errors.New("rate limit key must not be empty and its limit must be positive")
+	ErrAuditNotConfigured     = errors.New("audit output path is not configured")
+	ErrAuditTampered          = errors.New("audit log has been tampered with")
+	ErrAuditReservedField     = errors.New("audit field name is reserved: time, event, seq, and hmac are set by the audit log")
 )
@@ -0,0 +1,94 @@
+package logger
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// FatalEntry describes a fatal-level entry passed to a FatalHook.
+type FatalEntry struct {
+	Message string                 // Message is the message of the entry.
+	Time    time.Time              // Time is when the entry was logged.
+	Caller  string                 // Caller is the file and line the entry was logged from, if known.
+	Stack   string                 // Stack is the stack trace of the entry, if captured.
+	Fields  map[string]interface{} // Fields are the fields passed with the entry.
+}
+
+// FatalHook is called with a fatal-level entry after it is written and before the process exits.
+type FatalHook func(entry FatalEntry)
+
+// exit terminates the process after the fatal hooks ran. Tests replace it to observe the exit.
+var exit = os.Exit
+
+// fatalHooks is the zap hook run after a fatal entry is written: it calls each hook in order,
+// then the exit flush bounded by flushTimeout, and exits with status 1 like zap's default. A
+// panicking hook or flush does not prevent the rest from running or the process from exiting.
+type fatalHooks struct {
+	hooks        []FatalHook
+	flush        func(ctx context.Context) error
+	flushTimeout time.Duration
+}
+
+// newFatalHooks returns the zap hook for the fatal hooks and exit flush of options, or nil
+// when neither is set and zap's default applies.
+func newFatalHooks(options *Options) zapcore.CheckWriteHook {
+	if len(options.FatalHooks) == 0 && options.ExitFlush == nil {
+		return nil
+	}
+	return &fatalHooks{hooks: options.FatalHooks, flush: options.ExitFlush, flushTimeout: options.ExitFlushTimeout}
+}
+
+// OnWrite runs the hooks and the exit flush for the written entry and exits.
+func (h *fatalHooks) OnWrite(ce *zapcore.CheckedEntry, fields []zapcore.Field) {
+	entry := FatalEntry{
+		Message: ce.Message,
+		Time:    ce.Time,
+		Stack:   ce.Stack,
+		Fields:  fieldsMap(fields),
+	}
+	if ce.Caller.Defined {
+		entry.Caller = ce.Caller.TrimmedPath()
+	}
+	for _, hook := range h.hooks {
+		runFatalHook(hook, entry)
+	}
+	if h.flush != nil {
+		runExitFlush(h.flush, h.flushTimeout)
+	}
+	exit(1)
+}
+
+// runExitFlush calls flush bounded by timeout, when positive, recovering from a panic so the
+// process still exits. Its error is ignored, as there is nowhere left to report it.
+func runExitFlush(flush func(ctx context.Context) error, timeout time.Duration) {
+	defer func() {
+		_ = recover()
+	}()
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	_ = flush(ctx)
+}
+
+// runFatalHook calls hook, recovering from a panic so the process still exits.
+func runFatalHook(hook FatalHook, entry FatalEntry) {
+	defer func() {
+		_ = recover()
+	}()
+	hook(entry)
+}
+
+// fieldsMap converts zap fields back to the field map they were logged with.
+func fieldsMap(fields []zapcore.Field) map[string]interface{} {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	return enc.Fields
+}
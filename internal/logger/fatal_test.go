@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stubExit replaces exit for the duration of the test and returns the recorded exit codes.
+func stubExit(t *testing.T) *[]int {
+	t.Helper()
+	var codes []int
+	previous := exit
+	exit = func(code int) { codes = append(codes, code) }
+	t.Cleanup(func() { exit = previous })
+	return &codes
+}
+
+func TestLogger_Fatal_Hooks(t *testing.T) {
+	codes := stubExit(t)
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	var calls []string
+	var got FatalEntry
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithFatalHooks(
+		func(entry FatalEntry) {
+			calls = append(calls, "first")
+			got = entry
+		},
+		func(entry FatalEntry) {
+			calls = append(calls, "panicking")
+			panic("hook failed")
+		},
+		func(entry FatalEntry) { calls = append(calls, "last") },
+	))
+	require.NoError(t, err)
+
+	loggerInstance.Fatal("database unreachable", map[string]interface{}{"attempts": 3})
+
+	require.Equal(t, []string{"first", "panicking", "last"}, calls)
+	require.Equal(t, []int{1}, *codes)
+	require.Equal(t, "database unreachable", got.Message)
+	require.EqualValues(t, 3, got.Fields["attempts"])
+	require.Contains(t, got.Caller, "fatal_test.go")
+
+	// The entry is written before the hooks run.
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	require.Equal(t, "fatal", entries[0]["level"])
+}
+
+func TestLogger_Fatal_FatalFields(t *testing.T) {
+	codes := stubExit(t)
+	var got FatalEntry
+	loggerInstance, err := NewLogger(WithOutputPath(filepath.Join(t.TempDir(), "app.log")), WithFatalHooks(func(entry FatalEntry) {
+		got = entry
+	}))
+	require.NoError(t, err)
+
+	loggerInstance.FatalFields("config invalid", String("key", "port"))
+
+	require.Equal(t, []int{1}, *codes)
+	require.Equal(t, "port", got.Fields["key"])
+}
+
+func TestLogger_Fatal_NoopLogger(t *testing.T) {
+	codes := stubExit(t)
+	called := false
+	NewNoopLogger(WithFatalHooks(func(FatalEntry) { called = true })).Fatal("shutting down", nil)
+
+	require.True(t, called)
+	require.Equal(t, []int{1}, *codes)
+}
+
+func TestLogger_Fatal_ExitFlush(t *testing.T) {
+	codes := stubExit(t)
+	var calls []string
+	var deadline time.Time
+	loggerInstance, err := NewLogger(
+		WithOutputPath(filepath.Join(t.TempDir(), "app.log")),
+		WithFatalHooks(func(FatalEntry) { calls = append(calls, "hook") }),
+		WithExitFlush(func(ctx context.Context) error {
+			calls = append(calls, "flush")
+			deadline, _ = ctx.Deadline()
+			return nil
+		}, time.Second),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Fatal("database unreachable", nil)
+
+	require.Equal(t, []string{"hook", "flush"}, calls)
+	require.WithinDuration(t, time.Now().Add(time.Second), deadline, time.Second)
+	require.Equal(t, []int{1}, *codes)
+}
+
+func TestLogger_Fatal_ExitFlushPanics(t *testing.T) {
+	codes := stubExit(t)
+	NewNoopLogger(WithExitFlush(func(context.Context) error {
+		panic("flush failed")
+	}, 0)).Fatal("shutting down", nil)
+
+	require.Equal(t, []int{1}, *codes)
+}
@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Field is a strongly-typed structured logging field, built with the constructors in this file
+// and passed to the *Fields logging methods. Unlike the map-based API, typed fields are encoded
+// without an intermediate map or reflection, which keeps hot-path logging cheap.
+type Field = zap.Field
+
+// String constructs a Field with a string value.
+func String(key, value string) Field {
+	return zap.String(key, value)
+}
+
+// Int constructs a Field with an int value.
+func Int(key string, value int) Field {
+	return zap.Int(key, value)
+}
+
+// Int64 constructs a Field with an int64 value.
+func Int64(key string, value int64) Field {
+	return zap.Int64(key, value)
+}
+
+// Float64 constructs a Field with a float64 value.
+func Float64(key string, value float64) Field {
+	return zap.Float64(key, value)
+}
+
+// Bool constructs a Field with a bool value.
+func Bool(key string, value bool) Field {
+	return zap.Bool(key, value)
+}
+
+// Duration constructs a Field with a time.Duration value.
+func Duration(key string, value time.Duration) Field {
+	return zap.Duration(key, value)
+}
+
+// Time constructs a Field with a time.Time value.
+func Time(key string, value time.Time) Field {
+	return zap.Time(key, value)
+}
+
+// Err constructs a Field with the key "error" holding err's message. A nil err produces a
+// field that is omitted from the output.
+func Err(err error) Field {
+	return zap.Error(err)
+}
+
+// Any constructs a Field for an arbitrary value, choosing the typed encoding when one exists.
+// It falls back to reflection and may allocate; prefer the typed constructors on hot paths.
+func Any(key string, value interface{}) Field {
+	return zap.Any(key, value)
+}
@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogger_Field_Methods(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithLevel("debug"), WithOutputPath(path))
+	require.NoError(t, err)
+
+	loggerInstance.DebugFields("debug", String("level", "debug"))
+	loggerInstance.InfoFields("request completed",
+		String("method", "GET"),
+		Int("status", 200),
+		Int64("bytes", 1024),
+		Float64("ratio", 0.5),
+		Bool("cached", true),
+		Duration("duration", 150*time.Millisecond),
+		Any("tags", []string{"a", "b"}),
+	)
+	loggerInstance.WarnFields("warn", Err(nil))
+	loggerInstance.ErrorFields("payment failed", Err(errors.New("card declined")))
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 4)
+
+	info := entries[1]
+	require.Equal(t, "request completed", info["msg"])
+	require.Equal(t, "GET", info["method"])
+	require.Equal(t, float64(200), info["status"])
+	require.Equal(t, float64(1024), info["bytes"])
+	require.Equal(t, 0.5, info["ratio"])
+	require.Equal(t, true, info["cached"])
+	require.Contains(t, info, "duration")
+	require.Equal(t, []interface{}{"a", "b"}, info["tags"])
+
+	require.NotContains(t, entries[2], "error", "a nil error must be omitted")
+	require.Equal(t, "card declined", entries[3]["error"])
+}
+
+func BenchmarkLogger_Field_InfoFields(b *testing.B) {
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(io.Discard), zapcore.InfoLevel)
+	atomicLevel := zap.NewAtomicLevel()
+	loggerInstance := &logger{logger: zap.New(core), level: &atomicLevel}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		loggerInstance.InfoFields("request completed", String("method", "GET"), Int("status", 200))
+	}
+}
+
+func BenchmarkLogger_Field_InfoMap(b *testing.B) {
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(io.Discard), zapcore.InfoLevel)
+	atomicLevel := zap.NewAtomicLevel()
+	loggerInstance := &logger{logger: zap.New(core), level: &atomicLevel}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		loggerInstance.Info("request completed", map[string]interface{}{"method": "GET", "status": 200})
+	}
+}
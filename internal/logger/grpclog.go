@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/grpclog"
+)
+
+// grpcLogger adapts a zap logger to grpclog.LoggerV2 so gRPC's internal logs are written as
+// structured entries. gRPC logs connection lifecycle details at info severity, so info is
+// mapped to debug to keep them out of production logs; warning, error, and fatal map to the
+// zap level of the same name, and fatal still exits the process as gRPC expects.
+type grpcLogger struct {
+	sugar *zap.SugaredLogger
+	core  zapcore.Core
+}
+
+// newGRPCLogger returns a grpclog.LoggerV2 writing through l.
+func newGRPCLogger(l *zap.Logger) grpclog.LoggerV2 {
+	l = l.With(zap.String("component", "grpc"))
+	return &grpcLogger{
+		sugar: l.Sugar(),
+		core:  l.Core(),
+	}
+}
+
+func (g *grpcLogger) Info(args ...interface{})                    { g.sugar.Debug(args...) }
+func (g *grpcLogger) Infoln(args ...interface{})                  { g.sugar.Debugln(args...) }
+func (g *grpcLogger) Infof(format string, args ...interface{})    { g.sugar.Debugf(format, args...) }
+func (g *grpcLogger) Warning(args ...interface{})                 { g.sugar.Warn(args...) }
+func (g *grpcLogger) Warningln(args ...interface{})               { g.sugar.Warnln(args...) }
+func (g *grpcLogger) Warningf(format string, args ...interface{}) { g.sugar.Warnf(format, args...) }
+func (g *grpcLogger) Error(args ...interface{})                   { g.sugar.Error(args...) }
+func (g *grpcLogger) Errorln(args ...interface{})                 { g.sugar.Errorln(args...) }
+func (g *grpcLogger) Errorf(format string, args ...interface{})   { g.sugar.Errorf(format, args...) }
+func (g *grpcLogger) Fatal(args ...interface{})                   { g.sugar.Fatal(args...) }
+func (g *grpcLogger) Fatalln(args ...interface{})                 { g.sugar.Fatalln(args...) }
+func (g *grpcLogger) Fatalf(format string, args ...interface{})   { g.sugar.Fatalf(format, args...) }
+
+// V reports whether gRPC's verbose logs at level l are enabled. Only verbosity 0 is logged,
+// and only when the logger is at debug level, since gRPC info maps to debug.
+func (g *grpcLogger) V(l int) bool {
+	return l <= 0 && g.core.Enabled(zapcore.DebugLevel)
+}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/grpclog"
+)
+
+func TestLogger_GRPCLog_SeverityMapping(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	config := zap.NewProductionConfig()
+	config.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
+	config.OutputPaths = []string{path}
+	zapLogger, err := config.Build()
+	require.NoError(t, err)
+
+	g := newGRPCLogger(zapLogger)
+	g.Infof("channel %d created", 1)
+	g.Warningln("connection", "reset")
+	g.Error("tls handshake failed")
+	require.NoError(t, zapLogger.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 3)
+	wants := []struct{ level, msg string }{
+		{"debug", "channel 1 created"},
+		{"warn", "connection reset"},
+		{"error", "tls handshake failed"},
+	}
+	for i, want := range wants {
+		require.Equal(t, want.level, entries[i]["level"])
+		require.Equal(t, want.msg, entries[i]["msg"])
+		require.Equal(t, "grpc", entries[i]["component"])
+	}
+}
+
+func TestLogger_GRPCLog_V(t *testing.T) {
+	tests := []struct {
+		name  string
+		level zap.AtomicLevel
+		v     int
+		want  bool
+	}{
+		{name: "debug level verbosity 0", level: zap.NewAtomicLevelAt(zap.DebugLevel), v: 0, want: true},
+		{name: "debug level verbosity 2", level: zap.NewAtomicLevelAt(zap.DebugLevel), v: 2, want: false},
+		{name: "info level verbosity 0", level: zap.NewAtomicLevelAt(zap.InfoLevel), v: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := zap.NewProductionConfig()
+			config.Level = tt.level
+			zapLogger, err := config.Build()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, newGRPCLogger(zapLogger).V(tt.v))
+		})
+	}
+}
+
+func TestLogger_GRPCLog_CaptureGRPCLog(t *testing.T) {
+	t.Cleanup(func() {
+		grpclog.SetLoggerV2(grpclog.NewLoggerV2(io.Discard, io.Discard, io.Discard))
+	})
+
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithCaptureGRPCLog(true))
+	require.NoError(t, err)
+
+	grpclog.Warning("transport: connection reset")
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	require.Equal(t, "warn", entries[0]["level"])
+	require.Equal(t, "transport: connection reset", entries[0]["msg"])
+}
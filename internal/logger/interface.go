@@ -1,6 +1,12 @@
 package logger
 
-import "go.opentelemetry.io/otel/trace"
+import (
+	"log"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
+)
 
 // Logger defines the contract for logging operations.
 type Logger interface {
@@ -10,6 +16,28 @@ type Logger interface {
 	Warn(message string, fields map[string]interface{})
 	Error(message string, fields map[string]interface{})
 	Fatal(message string, fields map[string]interface{})
+	Enabled(level string) bool
+	DebugLazy(message string, fields func() map[string]interface{})
+	DebugFields(message string, fields ...Field)
+	InfoFields(message string, fields ...Field)
+	WarnFields(message string, fields ...Field)
+	ErrorFields(message string, fields ...Field)
+	FatalFields(message string, fields ...Field)
 	WithSpanContext(span trace.SpanContext) Logger
+	SlogHandler() slog.Handler
+	Logr() logr.Logger
+	StdLogger(level string) *log.Logger
+	Audit(event string, fields map[string]interface{}) error
 	Sync() error
 }
+
+// Closer is implemented by loggers that run background goroutines or hold connections, which
+// Close releases after writing the buffered entries.
+type Closer interface {
+	Close() error
+}
+
+// Reloader is implemented by loggers that can change their configuration at runtime.
+type Reloader interface {
+	Reload(opts ...Option) error
+}
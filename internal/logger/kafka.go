@@ -0,0 +1,252 @@
+package logger
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SinkKafka produces entries to a Kafka topic.
+const SinkKafka = "kafka"
+
+// Defaults of the "kafka" sink batch settings.
+const (
+	DefaultKafkaBatchSize    = 100         // DefaultKafkaBatchSize is the number of entries produced in one request.
+	DefaultKafkaBatchTimeout = time.Second // DefaultKafkaBatchTimeout is how long a batch waits to fill before it is produced.
+	DefaultKafkaBufferSize   = 10000       // DefaultKafkaBufferSize is the number of entries buffered for the producer.
+)
+
+// kafkaMessage is a record waiting in a kafkaQueue, or a flush marker when flushed is set.
+type kafkaMessage struct {
+	record  kafkaRecord
+	flushed chan struct{}
+}
+
+// kafkaQueue is the bounded buffer shared by a Kafka core and the cores derived from it with
+// With. A single goroutine produces its records in batches until the queue is closed.
+type kafkaQueue struct {
+	messages chan kafkaMessage
+	produce  func(records []kafkaRecord) error
+	release  func() // release closes the connections of produce once the goroutine exits.
+	size     int
+	timeout  time.Duration
+	dropped  func(entries int)
+	stopped  chan struct{} // stopped is closed when the producer goroutine exits.
+
+	mu      sync.Mutex
+	lastErr error // lastErr is the error of the last failed produce, returned by the next Sync.
+
+	closeMu sync.RWMutex // closeMu is held for reading while messages is used, and for writing to close it.
+	closed  bool
+}
+
+// newKafkaQueue creates a queue holding up to bufferSize records, produced by produce in
+// batches of up to batchSize records at least every batchTimeout, and starts its goroutine.
+// release, when not nil, is called when the goroutine exits.
+func newKafkaQueue(produce func(records []kafkaRecord) error, release func(), batchSize int, batchTimeout time.Duration, bufferSize int, dropped func(entries int)) *kafkaQueue {
+	q := &kafkaQueue{
+		messages: make(chan kafkaMessage, bufferSize),
+		produce:  produce,
+		release:  release,
+		size:     batchSize,
+		timeout:  batchTimeout,
+		dropped:  dropped,
+		stopped:  make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+// run collects records into batches and produces them when full, when the batch timeout
+// expires, or when a flush marker arrives, which is released after the batch before it.
+// When the queue is closed, it produces the last batch and releases the connections.
+func (q *kafkaQueue) run() {
+	defer close(q.stopped)
+	var batch []kafkaRecord
+	timer := time.NewTimer(q.timeout)
+	timer.Stop()
+	for {
+		select {
+		case m, ok := <-q.messages:
+			if !ok {
+				timer.Stop()
+				q.send(batch)
+				if q.release != nil {
+					q.release()
+				}
+				return
+			}
+			if m.flushed != nil {
+				batch = q.send(batch)
+				close(m.flushed)
+				continue
+			}
+			if len(batch) == 0 {
+				timer.Reset(q.timeout)
+			}
+			batch = append(batch, m.record)
+			if len(batch) >= q.size {
+				timer.Stop()
+				batch = q.send(batch)
+			}
+		case <-timer.C:
+			batch = q.send(batch)
+		}
+	}
+}
+
+// send produces batch and returns it emptied for reuse. The records of a failed produce are
+// counted as dropped, so an unreachable cluster does not block or grow the logger.
+func (q *kafkaQueue) send(batch []kafkaRecord) []kafkaRecord {
+	if len(batch) == 0 {
+		return batch
+	}
+	if err := q.produce(batch); err != nil {
+		q.mu.Lock()
+		q.lastErr = err
+		q.mu.Unlock()
+		q.drop(len(batch))
+	}
+	return batch[:0]
+}
+
+// enqueue adds record to the queue, dropping it when the queue is full or closed. When wait is
+// set, it waits for room instead of dropping the record while the queue is open.
+func (q *kafkaQueue) enqueue(record kafkaRecord, wait bool) {
+	q.closeMu.RLock()
+	defer q.closeMu.RUnlock()
+	if q.closed {
+		q.drop(1)
+		return
+	}
+	if wait {
+		q.messages <- kafkaMessage{record: record}
+		return
+	}
+	select {
+	case q.messages <- kafkaMessage{record: record}:
+	default:
+		q.drop(1)
+	}
+}
+
+// flush blocks until every record queued before the call has been produced, and returns the
+// error of the last failed produce, if any.
+func (q *kafkaQueue) flush() error {
+	q.closeMu.RLock()
+	if !q.closed {
+		flushed := make(chan struct{})
+		q.messages <- kafkaMessage{flushed: flushed}
+		q.closeMu.RUnlock()
+		<-flushed
+	} else {
+		q.closeMu.RUnlock()
+	}
+	return q.takeErr()
+}
+
+// close produces the queued records, stops the producer goroutine, and closes its broker
+// connections, returning the error of the last failed produce, if any. Records written
+// afterwards are dropped. It is safe to call more than once.
+func (q *kafkaQueue) close() error {
+	q.closeMu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.messages)
+	}
+	q.closeMu.Unlock()
+	<-q.stopped
+	return q.takeErr()
+}
+
+// takeErr returns and clears the error of the last failed produce.
+func (q *kafkaQueue) takeErr() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	err := q.lastErr
+	q.lastErr = nil
+	return err
+}
+
+// drop reports discarded entries to the dropped handler.
+func (q *kafkaQueue) drop(entries int) {
+	if q.dropped != nil {
+		q.dropped(entries)
+	}
+}
+
+// kafkaCore writes JSON-encoded entries to a kafkaQueue. Entries written while the queue is
+// full are dropped and reported to the dropped handler, so a slow cluster never blocks the
+// caller.
+type kafkaCore struct {
+	zapcore.LevelEnabler
+	enc   zapcore.Encoder
+	queue *kafkaQueue
+}
+
+// newKafkaCore creates the core producing to options.KafkaTopic on options.KafkaBrokers.
+func newKafkaCore(options *Options, enc zapcore.Encoder, level zapcore.LevelEnabler) *kafkaCore {
+	size, timeout, buffer := options.KafkaBatchSize, options.KafkaBatchTimeout, options.KafkaBufferSize
+	if size == 0 {
+		size = DefaultKafkaBatchSize
+	}
+	if timeout == 0 {
+		timeout = DefaultKafkaBatchTimeout
+	}
+	if buffer == 0 {
+		buffer = DefaultKafkaBufferSize
+	}
+	producer := newKafkaProducer(options.KafkaBrokers, options.KafkaTopic)
+	return &kafkaCore{
+		LevelEnabler: level,
+		enc:          enc,
+		queue:        newKafkaQueue(producer.produce, producer.close, size, timeout, buffer, options.DroppedHandler),
+	}
+}
+
+func (c *kafkaCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &kafkaCore{LevelEnabler: c.LevelEnabler, enc: c.enc.Clone(), queue: c.queue}
+	for _, f := range fields {
+		f.AddTo(clone.enc)
+	}
+	return clone
+}
+
+func (c *kafkaCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+// Write queues the entry. Entries above error level (DPanic, Panic, Fatal) wait for room in the
+// queue instead of being dropped, and are produced before Write returns, since the process may
+// stop right after them.
+func (c *kafkaCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.enc.EncodeEntry(entry, fields)
+	if err != nil {
+		return err
+	}
+	value := []byte(strings.TrimSuffix(buf.String(), "\n"))
+	buf.Free()
+	record := kafkaRecord{time: entry.Time, value: value}
+	if entry.Level > zapcore.ErrorLevel {
+		c.queue.enqueue(record, true)
+		return c.queue.flush()
+	}
+	c.queue.enqueue(record, false)
+	return nil
+}
+
+// Sync waits for the queued entries to be produced and returns the error of the last failed
+// produce, if any.
+func (c *kafkaCore) Sync() error {
+	return c.queue.flush()
+}
+
+// close produces the queued entries and stops the producer; see kafkaQueue.close.
+func (c *kafkaCore) close() error {
+	return c.queue.close()
+}
@@ -0,0 +1,368 @@
+package logger
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// The subset of the Kafka protocol the "kafka" sink speaks: Metadata v1 to find the partition
+// leaders of the topic, and Produce v3 with uncompressed v2 record batches, acknowledged by the
+// leader (acks=1). It needs Kafka 0.11 or newer and a plaintext listener; TLS, SASL, and
+// compression are not supported.
+const (
+	kafkaAPIProduce      = 0
+	kafkaAPIMetadata     = 3
+	kafkaProduceVersion  = 3
+	kafkaMetadataVersion = 1
+	kafkaClientID        = "go-monitoring"
+	kafkaTimeout         = 10 * time.Second
+	kafkaMaxResponseSize = 1 << 20 // kafkaMaxResponseSize bounds the responses read, which only describe one topic.
+)
+
+// kafkaCastagnoli is the CRC-32C table of record batch checksums.
+var kafkaCastagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// errMalformedKafkaResponse is returned for a response that is truncated or holds lengths or
+// partition indexes out of range.
+var errMalformedKafkaResponse = errors.New("malformed kafka response")
+
+// kafkaRecord is a message produced to the topic.
+type kafkaRecord struct {
+	time  time.Time
+	value []byte
+}
+
+// kafkaProducer produces records to the partitions of a topic in turn, one batch per request.
+// It is used by a single goroutine.
+type kafkaProducer struct {
+	brokers []string
+	topic   string
+
+	leaders     []string // leaders are the addresses of the partition leaders, indexed by partition.
+	conns       map[string]*kafkaConn
+	next        int
+	correlation int32
+}
+
+// newKafkaProducer returns a producer to topic; brokers are the bootstrap addresses.
+func newKafkaProducer(brokers []string, topic string) *kafkaProducer {
+	return &kafkaProducer{brokers: brokers, topic: topic, conns: make(map[string]*kafkaConn)}
+}
+
+// produce sends records to the next partition. On failure, the metadata and the connection are
+// dropped so the next call starts from the bootstrap brokers.
+func (p *kafkaProducer) produce(records []kafkaRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if p.leaders == nil {
+		if err := p.refreshMetadata(); err != nil {
+			return err
+		}
+	}
+	partition := p.next % len(p.leaders)
+	p.next++
+	addr := p.leaders[partition]
+	if err := p.sendProduce(addr, int32(partition), records); err != nil {
+		p.leaders = nil
+		p.closeConn(addr)
+		return fmt.Errorf("failed to produce to kafka topic %s partition %d: %w", p.topic, partition, err)
+	}
+	return nil
+}
+
+// refreshMetadata asks the bootstrap brokers in turn for the partition leaders of the topic.
+func (p *kafkaProducer) refreshMetadata() error {
+	var errs []error
+	for _, broker := range p.brokers {
+		leaders, err := p.fetchMetadata(broker)
+		if err == nil {
+			p.leaders = leaders
+			return nil
+		}
+		p.closeConn(broker)
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("failed to fetch kafka metadata: %w", errors.Join(errs...))
+}
+
+// fetchMetadata sends a Metadata request for the topic to broker.
+func (p *kafkaProducer) fetchMetadata(broker string) ([]string, error) {
+	var req kafkaWriter
+	req.int32(1)
+	req.string(p.topic)
+	resp, err := p.roundTrip(broker, kafkaAPIMetadata, kafkaMetadataVersion, req.buf)
+	if err != nil {
+		return nil, err
+	}
+
+	r := kafkaReader{buf: resp}
+	addrs := make(map[int32]string)
+	for n := r.count(12); n > 0; n-- {
+		id, host, port := r.int32(), r.string(), r.int32()
+		r.string() // rack
+		addrs[id] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	r.int32() // controller_id
+	var leaders []string
+	for n := r.count(9); n > 0 && r.err == nil; n-- {
+		code, name := r.int16(), r.string()
+		r.int8() // is_internal
+		if name == p.topic && code != 0 && r.err == nil {
+			return nil, fmt.Errorf("topic %s: kafka error code %d", name, code)
+		}
+		partitions := r.count(18)
+		if name == p.topic {
+			leaders = make([]string, partitions)
+		}
+		for m := partitions; m > 0 && r.err == nil; m-- {
+			r.int16() // partition error_code
+			index, leader := r.int32(), r.int32()
+			r.skipInt32Array() // replicas
+			r.skipInt32Array() // isr
+			if name != p.topic || r.err != nil {
+				continue
+			}
+			if index < 0 || int(index) >= len(leaders) {
+				return nil, errMalformedKafkaResponse
+			}
+			leaders[index] = addrs[leader]
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if len(leaders) == 0 {
+		return nil, fmt.Errorf("topic %s not found", p.topic)
+	}
+	for partition, addr := range leaders {
+		if addr == "" {
+			return nil, fmt.Errorf("topic %s partition %d has no leader", p.topic, partition)
+		}
+	}
+	return leaders, nil
+}
+
+// sendProduce sends records as one batch to partition on its leader addr.
+func (p *kafkaProducer) sendProduce(addr string, partition int32, records []kafkaRecord) error {
+	var req kafkaWriter
+	req.int16(-1) // transactional_id
+	req.int16(1)  // acks
+	req.int32(int32(kafkaTimeout / time.Millisecond))
+	req.int32(1)
+	req.string(p.topic)
+	req.int32(1)
+	req.int32(partition)
+	batch := encodeKafkaBatch(records)
+	req.int32(int32(len(batch)))
+	req.buf = append(req.buf, batch...)
+
+	resp, err := p.roundTrip(addr, kafkaAPIProduce, kafkaProduceVersion, req.buf)
+	if err != nil {
+		return err
+	}
+	r := kafkaReader{buf: resp}
+	for n := r.count(6); n > 0 && r.err == nil; n-- {
+		r.string() // topic
+		for m := r.count(22); m > 0 && r.err == nil; m-- {
+			r.int32() // partition
+			if code := r.int16(); code != 0 && r.err == nil {
+				return fmt.Errorf("kafka error code %d", code)
+			}
+			r.int64() // base_offset
+			r.int64() // log_append_time
+		}
+	}
+	return r.err
+}
+
+// roundTrip sends a request to addr and returns the body of its response, after the
+// correlation id.
+func (p *kafkaProducer) roundTrip(addr string, apiKey, version int16, body []byte) ([]byte, error) {
+	conn, err := p.conn(addr)
+	if err != nil {
+		return nil, err
+	}
+	p.correlation++
+	var req kafkaWriter
+	req.int32(0) // size, set below
+	req.int16(apiKey)
+	req.int16(version)
+	req.int32(p.correlation)
+	req.string(kafkaClientID)
+	req.buf = append(req.buf, body...)
+	binary.BigEndian.PutUint32(req.buf, uint32(len(req.buf)-4))
+
+	_ = conn.SetDeadline(time.Now().Add(kafkaTimeout))
+	if _, err := conn.Write(req.buf); err != nil {
+		return nil, err
+	}
+	var size [4]byte
+	if _, err := io.ReadFull(conn.r, size[:]); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if n > kafkaMaxResponseSize {
+		return nil, fmt.Errorf("kafka response of %d bytes exceeds %d bytes", n, kafkaMaxResponseSize)
+	}
+	resp := make([]byte, n)
+	if _, err := io.ReadFull(conn.r, resp); err != nil {
+		return nil, err
+	}
+	if len(resp) < 4 || int32(binary.BigEndian.Uint32(resp)) != p.correlation {
+		return nil, errors.New("unexpected kafka correlation id")
+	}
+	return resp[4:], nil
+}
+
+// kafkaConn is a connection to a broker.
+type kafkaConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// conn returns the connection to addr, dialing it when needed.
+func (p *kafkaProducer) conn(addr string) (*kafkaConn, error) {
+	if c, ok := p.conns[addr]; ok {
+		return c, nil
+	}
+	conn, err := net.DialTimeout("tcp", addr, kafkaTimeout)
+	if err != nil {
+		return nil, err
+	}
+	c := &kafkaConn{Conn: conn, r: bufio.NewReader(conn)}
+	p.conns[addr] = c
+	return c, nil
+}
+
+// close closes the connections to every broker. It must not be called while a produce runs.
+func (p *kafkaProducer) close() {
+	for addr := range p.conns {
+		p.closeConn(addr)
+	}
+}
+
+// closeConn closes the connection to addr, if any.
+func (p *kafkaProducer) closeConn(addr string) {
+	if c, ok := p.conns[addr]; ok {
+		_ = c.Close()
+		delete(p.conns, addr)
+	}
+}
+
+// encodeKafkaBatch encodes records as an uncompressed v2 record batch without keys or headers.
+func encodeKafkaBatch(records []kafkaRecord) []byte {
+	first, last := records[0].time.UnixMilli(), records[0].time.UnixMilli()
+	for _, record := range records {
+		last = max(last, record.time.UnixMilli())
+	}
+
+	// body is the part of the batch covered by the checksum, from attributes on.
+	var body kafkaWriter
+	body.int16(0) // attributes
+	body.int32(int32(len(records) - 1))
+	body.int64(first)
+	body.int64(last)
+	body.int64(-1) // producer_id
+	body.int16(-1) // producer_epoch
+	body.int32(-1) // base_sequence
+	body.int32(int32(len(records)))
+	for i, record := range records {
+		var rec kafkaWriter
+		rec.int8(0) // attributes
+		rec.varint(record.time.UnixMilli() - first)
+		rec.varint(int64(i))
+		rec.varint(-1) // key
+		rec.varint(int64(len(record.value)))
+		rec.buf = append(rec.buf, record.value...)
+		rec.varint(0) // headers
+		body.varint(int64(len(rec.buf)))
+		body.buf = append(body.buf, rec.buf...)
+	}
+
+	var batch kafkaWriter
+	batch.int64(0) // base_offset
+	batch.int32(int32(4 + 1 + 4 + len(body.buf)))
+	batch.int32(-1) // partition_leader_epoch
+	batch.int8(2)   // magic
+	batch.int32(int32(crc32.Checksum(body.buf, kafkaCastagnoli)))
+	batch.buf = append(batch.buf, body.buf...)
+	return batch.buf
+}
+
+// kafkaWriter appends Kafka protocol primitives to buf.
+type kafkaWriter struct {
+	buf []byte
+}
+
+func (w *kafkaWriter) int8(v int8)   { w.buf = append(w.buf, byte(v)) }
+func (w *kafkaWriter) int16(v int16) { w.buf = binary.BigEndian.AppendUint16(w.buf, uint16(v)) }
+func (w *kafkaWriter) int32(v int32) { w.buf = binary.BigEndian.AppendUint32(w.buf, uint32(v)) }
+func (w *kafkaWriter) int64(v int64) { w.buf = binary.BigEndian.AppendUint64(w.buf, uint64(v)) }
+func (w *kafkaWriter) varint(v int64) {
+	w.buf = binary.AppendVarint(w.buf, v)
+}
+
+func (w *kafkaWriter) string(s string) {
+	w.int16(int16(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+// kafkaReader reads Kafka protocol primitives from buf. After the first short read, err is
+// set and every read returns zero.
+type kafkaReader struct {
+	buf []byte
+	err error
+}
+
+func (r *kafkaReader) next(n int) []byte {
+	if r.err != nil || n < 0 || len(r.buf) < n {
+		r.err = errMalformedKafkaResponse
+		return make([]byte, 8) // enough for any primitive, so reads after an error return zero
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *kafkaReader) int8() int8   { return int8(r.next(1)[0]) }
+func (r *kafkaReader) int16() int16 { return int16(binary.BigEndian.Uint16(r.next(2))) }
+func (r *kafkaReader) int32() int32 { return int32(binary.BigEndian.Uint32(r.next(4))) }
+func (r *kafkaReader) int64() int64 { return int64(binary.BigEndian.Uint64(r.next(8))) }
+
+// string reads a string, returning "" for a null one.
+func (r *kafkaReader) string() string {
+	n := r.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(r.next(int(n)))
+}
+
+// count reads the length of an array whose elements take at least minSize bytes each. A null
+// array is empty; a negative length, or one the rest of buf cannot hold, sets err and
+// returns 0, so a malformed length cannot make the caller loop over elements that are not there.
+func (r *kafkaReader) count(minSize int) int {
+	n := r.int32()
+	switch {
+	case r.err != nil || n == -1:
+		return 0
+	case n < -1 || int64(n)*int64(minSize) > int64(len(r.buf)):
+		r.err = errMalformedKafkaResponse
+		return 0
+	}
+	return int(n)
+}
+
+func (r *kafkaReader) skipInt32Array() {
+	if n := r.count(4); n > 0 {
+		r.next(n * 4)
+	}
+}
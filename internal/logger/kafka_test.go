@@ -0,0 +1,375 @@
+package logger
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// kafkaBroker is a single-broker Kafka cluster answering the Metadata and Produce requests of
+// the "kafka" sink, leading every partition of its topic.
+type kafkaBroker struct {
+	listener   net.Listener
+	topic      string
+	partitions int
+	open       atomic.Int64 // open is the number of client connections not yet closed.
+
+	mu       sync.Mutex
+	produced map[int32][]string // produced are the record values received, by partition.
+}
+
+func newKafkaBroker(t *testing.T, topic string, partitions int) *kafkaBroker {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	b := &kafkaBroker{listener: listener, topic: topic, partitions: partitions, produced: make(map[int32][]string)}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go b.serve(t, conn)
+		}
+	}()
+	return b
+}
+
+func (b *kafkaBroker) serve(t *testing.T, conn net.Conn) {
+	b.open.Add(1)
+	defer b.open.Add(-1)
+	defer conn.Close()
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return
+		}
+		req := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(conn, req); err != nil {
+			return
+		}
+		r := kafkaReader{buf: req}
+		apiKey, _, correlation := r.int16(), r.int16(), r.int32()
+		r.string() // client_id
+
+		var resp kafkaWriter
+		resp.int32(0)
+		resp.int32(correlation)
+		switch apiKey {
+		case kafkaAPIMetadata:
+			b.metadata(&resp)
+		case kafkaAPIProduce:
+			if err := b.produce(&r, &resp); err != nil {
+				t.Errorf("invalid produce request: %v", err)
+				return
+			}
+		default:
+			t.Errorf("unexpected api key %d", apiKey)
+			return
+		}
+		binary.BigEndian.PutUint32(resp.buf, uint32(len(resp.buf)-4))
+		if _, err := conn.Write(resp.buf); err != nil {
+			return
+		}
+	}
+}
+
+func (b *kafkaBroker) metadata(resp *kafkaWriter) {
+	host, port, _ := net.SplitHostPort(b.listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+	resp.int32(1)
+	resp.int32(1)
+	resp.string(host)
+	resp.int32(int32(portNumber))
+	resp.int16(-1) // rack
+	resp.int32(1)  // controller_id
+	resp.int32(1)
+	resp.int16(0)
+	resp.string(b.topic)
+	resp.int8(0)
+	resp.int32(int32(b.partitions))
+	for i := 0; i < b.partitions; i++ {
+		resp.int16(0)
+		resp.int32(int32(i))
+		resp.int32(1)
+		resp.int32(1)
+		resp.int32(1)
+		resp.int32(1)
+		resp.int32(1)
+	}
+}
+
+func (b *kafkaBroker) produce(r *kafkaReader, resp *kafkaWriter) error {
+	r.string() // transactional_id
+	r.int16()  // acks
+	r.int32()  // timeout
+	r.int32()  // topics
+	topic := r.string()
+	r.int32() // partitions
+	partition := r.int32()
+	batch := r.next(int(r.int32()))
+	if r.err != nil {
+		return r.err
+	}
+	values, err := decodeKafkaBatch(batch)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.produced[partition] = append(b.produced[partition], values...)
+	b.mu.Unlock()
+
+	resp.int32(1)
+	resp.string(topic)
+	resp.int32(1)
+	resp.int32(partition)
+	resp.int16(0)
+	resp.int64(0)
+	resp.int64(-1)
+	resp.int32(0) // throttle_time_ms
+	return nil
+}
+
+func (b *kafkaBroker) values() map[int32][]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	values := make(map[int32][]string, len(b.produced))
+	for partition, v := range b.produced {
+		values[partition] = append([]string(nil), v...)
+	}
+	return values
+}
+
+// decodeKafkaBatch returns the record values of a v2 record batch, checking its checksum.
+func decodeKafkaBatch(batch []byte) ([]string, error) {
+	r := kafkaReader{buf: batch}
+	r.int64() // base_offset
+	r.int32() // batch_length
+	r.int32() // partition_leader_epoch
+	if magic := r.int8(); magic != 2 {
+		return nil, errors.New("unexpected magic")
+	}
+	crc := uint32(r.int32())
+	if crc32.Checksum(r.buf, kafkaCastagnoli) != crc {
+		return nil, errors.New("checksum mismatch")
+	}
+	r.next(2 + 4 + 8 + 8 + 8 + 2 + 4)
+	count := r.int32()
+	var values []string
+	for i := int32(0); i < count; i++ {
+		length := kafkaVarint(&r)
+		record := kafkaReader{buf: r.next(int(length))}
+		record.int8()
+		kafkaVarint(&record) // timestamp_delta
+		if delta := kafkaVarint(&record); delta != int64(i) {
+			return nil, errors.New("unexpected offset delta")
+		}
+		kafkaVarint(&record) // key
+		values = append(values, string(record.next(int(kafkaVarint(&record)))))
+	}
+	return values, r.err
+}
+
+func kafkaVarint(r *kafkaReader) int64 {
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.err = errors.New("invalid varint")
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func TestLogger_Kafka_Produce(t *testing.T) {
+	broker := newKafkaBroker(t, "logs", 2)
+	loggerInstance, err := NewLogger(
+		WithSink(SinkKafka),
+		WithKafka([]string{broker.listener.Addr().String()}, "logs"),
+		WithKafkaBatch(2, time.Hour, 16),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		loggerInstance.Info("entry "+strconv.Itoa(i), map[string]interface{}{"n": i})
+	}
+	require.NoError(t, loggerInstance.Sync())
+
+	values := broker.values()
+	require.Len(t, values[0], 2, "the first full batch should go to partition 0")
+	require.Len(t, values[1], 1, "the flushed batch should go to partition 1")
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(values[1][0]), &entry))
+	assert.Equal(t, "entry 2", entry["msg"])
+	assert.Equal(t, float64(2), entry["n"])
+}
+
+func TestLogger_Kafka_BatchTimeout(t *testing.T) {
+	broker := newKafkaBroker(t, "logs", 1)
+	loggerInstance, err := NewLogger(
+		WithSink(SinkKafka),
+		WithKafka([]string{broker.listener.Addr().String()}, "logs"),
+		WithKafkaBatch(100, 10*time.Millisecond, 16),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Info("entry", nil)
+	assert.Eventually(t, func() bool { return len(broker.values()[0]) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestLogger_Kafka_Close(t *testing.T) {
+	broker := newKafkaBroker(t, "logs", 1)
+	var dropped atomic.Int64
+	loggerInstance, err := NewLogger(
+		WithSink(SinkKafka),
+		WithKafka([]string{broker.listener.Addr().String()}, "logs"),
+		WithKafkaBatch(100, time.Hour, 16),
+		WithDroppedHandler(func(entries int) { dropped.Add(int64(entries)) }),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Info("before close", nil)
+	require.NoError(t, loggerInstance.(Closer).Close())
+	require.Len(t, broker.values()[0], 1, "Close should produce the queued entries")
+	assert.Eventually(t, func() bool { return broker.open.Load() == 0 }, 5*time.Second, 10*time.Millisecond, "Close should close the broker connections")
+
+	// Entries logged after Close are dropped instead of reopening a connection.
+	loggerInstance.Info("after close", nil)
+	require.NoError(t, loggerInstance.Sync())
+	assert.Equal(t, int64(1), dropped.Load())
+	assert.Len(t, broker.values()[0], 1)
+}
+
+func TestLogger_Kafka_Unreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	var dropped atomic.Int64
+	loggerInstance, err := NewLogger(
+		WithSink(SinkKafka),
+		WithKafka([]string{addr}, "logs"),
+		WithDroppedHandler(func(entries int) { dropped.Add(int64(entries)) }),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Info("first", nil)
+	loggerInstance.Info("second", nil)
+	assert.Error(t, loggerInstance.Sync())
+	assert.Equal(t, int64(2), dropped.Load(), "the entries of a failed produce should be counted as dropped")
+	assert.NoError(t, loggerInstance.Sync(), "the error should be reported once")
+}
+
+func TestLogger_Kafka_MalformedMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     uint32 // size overrides the length prefix of the response when set.
+		response func(resp *kafkaWriter)
+	}{
+		{
+			name: "oversized response",
+			size: kafkaMaxResponseSize + 1,
+		},
+		{
+			name: "broker count beyond the response",
+			response: func(resp *kafkaWriter) {
+				resp.int32(1 << 30)
+			},
+		},
+		{
+			name: "negative topic count",
+			response: func(resp *kafkaWriter) {
+				resp.int32(0) // brokers
+				resp.int32(1) // controller_id
+				resp.int32(-5)
+			},
+		},
+		{
+			name: "negative partition index",
+			response: func(resp *kafkaWriter) {
+				resp.int32(0) // brokers
+				resp.int32(1) // controller_id
+				resp.int32(1)
+				resp.int16(0)
+				resp.string("logs")
+				resp.int8(0)
+				resp.int32(1)
+				resp.int16(0)
+				resp.int32(-1) // partition_index
+				resp.int32(1)  // leader_id
+				resp.int32(0)  // replicas
+				resp.int32(0)  // isr
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = listener.Close() })
+			go func() {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+				var size [4]byte
+				if _, err := io.ReadFull(conn, size[:]); err != nil {
+					return
+				}
+				req := make([]byte, binary.BigEndian.Uint32(size[:]))
+				if _, err := io.ReadFull(conn, req); err != nil {
+					return
+				}
+				var resp kafkaWriter
+				resp.int32(0)
+				resp.int32(1) // correlation_id
+				if tt.response != nil {
+					tt.response(&resp)
+				}
+				binary.BigEndian.PutUint32(resp.buf, uint32(len(resp.buf)-4))
+				if tt.size != 0 {
+					binary.BigEndian.PutUint32(resp.buf, tt.size)
+				}
+				_, _ = conn.Write(resp.buf)
+			}()
+
+			p := newKafkaProducer([]string{listener.Addr().String()}, "logs")
+			assert.Error(t, p.refreshMetadata())
+			assert.Nil(t, p.leaders)
+		})
+	}
+}
+
+func TestLogger_Kafka_DropOnFull(t *testing.T) {
+	release := make(chan struct{})
+	var produced atomic.Int64
+	var dropped atomic.Int64
+	q := newKafkaQueue(func(records []kafkaRecord) error {
+		<-release
+		produced.Add(int64(len(records)))
+		return nil
+	}, nil, 1, time.Hour, 1, func(entries int) { dropped.Add(int64(entries)) })
+
+	q.enqueue(kafkaRecord{value: []byte("in flight")}, false)
+	require.Eventually(t, func() bool { return len(q.messages) == 0 }, 5*time.Second, time.Millisecond)
+	q.enqueue(kafkaRecord{value: []byte("buffered")}, false)
+	q.enqueue(kafkaRecord{value: []byte("dropped")}, false)
+	assert.Equal(t, int64(1), dropped.Load())
+
+	close(release)
+	require.NoError(t, q.flush())
+	assert.Equal(t, int64(2), produced.Load())
+}
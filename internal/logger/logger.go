@@ -1,7 +1,9 @@
 package logger
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -9,8 +11,13 @@ import (
 )
 
 type logger struct {
-	logger *zap.Logger
-	level  *zap.AtomicLevel
+	logger     *zap.Logger
+	level      *zap.AtomicLevel
+	callerSkip int       // callerSkip is the caller skip added for helpers wrapping the Logger; see WithCaller.
+	audit      *auditLog // audit writes the records of Audit; nil when no audit output is set.
+
+	closeOnce sync.Once
+	closers   []func() error // closers stop the background goroutines of the cores, outermost first.
 }
 
 // SetLogLevel dynamically changes the log level at runtime.
@@ -122,6 +129,133 @@ func (l *logger) Fatal(message string, fields map[string]interface{}) {
 	l.logger.Fatal(message, zapFields...)
 }
 
+// Enabled reports whether entries at level would be written, so callers can skip building
+// expensive fields that would be discarded. An unrecognized level reports false.
+//
+// Parameters:
+//   - level: The log level to check ("debug", "info", "warn", "error", "fatal")
+//
+// Example:
+//
+//	if logger.Enabled("debug") {
+//	    logger.Debug("Cache state", map[string]interface{}{
+//	        "entries": cache.Dump(),
+//	    })
+//	}
+func (l *logger) Enabled(level string) bool {
+	logLevel, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return false
+	}
+	return l.logger.Core().Enabled(logLevel)
+}
+
+// DebugLazy logs a debug-level message whose fields are built by fields only when debug
+// logging is enabled, so expensive diagnostic data is not computed when it would be discarded.
+//
+// Parameters:
+//   - message: The log message
+//   - fields: A function returning the structured fields (may be nil, or return nil)
+//
+// Example:
+//
+//	logger.DebugLazy("Cache state", func() map[string]interface{} {
+//	    return map[string]interface{}{"entries": cache.Dump()}
+//	})
+func (l *logger) DebugLazy(message string, fields func() map[string]interface{}) {
+	if !l.logger.Core().Enabled(zapcore.DebugLevel) {
+		return
+	}
+	var built map[string]interface{}
+	if fields != nil {
+		built = fields()
+	}
+	l.Debug(message, built)
+}
+
+// DebugFields logs a debug-level message with typed fields.
+// It is the typed counterpart of Debug for hot paths, encoding fields without a map or reflection.
+//
+// Parameters:
+//   - message: The log message
+//   - fields: Typed fields built with String, Int, Err, and the other Field constructors
+//
+// Example:
+//
+//	logger.DebugFields("Processing request",
+//	    String("request_id", "123"),
+//	    Int("user_id", 456),
+//	)
+func (l *logger) DebugFields(message string, fields ...Field) {
+	l.logger.Debug(message, fields...)
+}
+
+// InfoFields logs an informational message with typed fields.
+// It is the typed counterpart of Info for hot paths, encoding fields without a map or reflection.
+//
+// Parameters:
+//   - message: The log message
+//   - fields: Typed fields built with String, Int, Err, and the other Field constructors
+//
+// Example:
+//
+//	logger.InfoFields("Request completed",
+//	    Int("status_code", 200),
+//	    Duration("duration", elapsed),
+//	)
+func (l *logger) InfoFields(message string, fields ...Field) {
+	l.logger.Info(message, fields...)
+}
+
+// WarnFields logs a warning message with typed fields.
+// It is the typed counterpart of Warn for hot paths, encoding fields without a map or reflection.
+//
+// Parameters:
+//   - message: The log message
+//   - fields: Typed fields built with String, Int, Err, and the other Field constructors
+//
+// Example:
+//
+//	logger.WarnFields("Rate limit approaching",
+//	    Int("current_rate", 90),
+//	    Int("limit", 100),
+//	)
+func (l *logger) WarnFields(message string, fields ...Field) {
+	l.logger.Warn(message, fields...)
+}
+
+// ErrorFields logs an error message with typed fields.
+// It is the typed counterpart of Error for hot paths, encoding fields without a map or reflection.
+//
+// Parameters:
+//   - message: The log message
+//   - fields: Typed fields built with String, Int, Err, and the other Field constructors
+//
+// Example:
+//
+//	logger.ErrorFields("Failed to process payment",
+//	    String("payment_id", "pay_123"),
+//	    Err(err),
+//	)
+func (l *logger) ErrorFields(message string, fields ...Field) {
+	l.logger.Error(message, fields...)
+}
+
+// FatalFields logs a fatal message with typed fields and exits the application.
+// This function calls os.Exit(1) after logging.
+//
+// Parameters:
+//   - message: The log message
+//   - fields: Typed fields built with String, Int, Err, and the other Field constructors
+//
+// Example:
+//
+//	logger.FatalFields("Failed to initialize database", Err(err))
+//	// Application exits here
+func (l *logger) FatalFields(message string, fields ...Field) {
+	l.logger.Fatal(message, fields...)
+}
+
 // WithSpanContext creates a new logger instance with trace and span IDs added to all log entries.
 // This enables correlation between logs and traces in distributed systems.
 //
@@ -145,11 +279,45 @@ func (l *logger) WithSpanContext(span trace.SpanContext) Logger {
 			zap.String("traceID", span.TraceID().String()),
 			zap.String("spanID", span.SpanID().String()),
 		),
-		level: l.level,
+		level:      l.level,
+		callerSkip: l.callerSkip,
+		audit:      l.audit,
 	}
 }
 
-// Sync flushes any buffered log entries.
+// Audit writes an audit record of event with fields to the audit output, for compliance trails
+// that ordinary entries do not satisfy. Records are written regardless of the log level and
+// carry a sequence number one above the previous record and, when an HMAC key is set, an HMAC
+// chained to the previous record, so a gap or an edit is detected by VerifyAudit.
+// Unlike the other methods, Audit reports failures: ErrAuditNotConfigured when no audit output
+// is set, an error wrapping ErrAuditReservedField when a field is named "time", "event", "seq",
+// or "hmac", or the error encoding or writing the record.
+//
+// Parameters:
+//   - event: The audited event, e.g. "user.deleted"
+//   - fields: Optional key-value pairs describing the event (can be nil)
+//
+// Example:
+//
+//	if err := logger.Audit("user.deleted", map[string]interface{}{
+//	    "actor":   "admin@example.com",
+//	    "user_id": 456,
+//	}); err != nil {
+//	    return err
+//	}
+func (l *logger) Audit(event string, fields map[string]interface{}) error {
+	if l.audit == nil {
+		return ErrAuditNotConfigured
+	}
+	for key := range fields {
+		if auditReservedFields[key] {
+			return fmt.Errorf("%w: %q", ErrAuditReservedField, key)
+		}
+	}
+	return l.audit.write(event, convertFields(fields))
+}
+
+// Sync flushes any buffered log entries and audit records.
 // This should be called before application shutdown to ensure all logs are written.
 // It is safe to call on a nil logger.
 //
@@ -166,5 +334,53 @@ func (l *logger) Sync() error {
 	if l == nil || l.logger == nil {
 		return nil
 	}
+	if l.audit != nil {
+		return errors.Join(l.logger.Sync(), l.audit.sync())
+	}
 	return l.logger.Sync()
 }
+
+// Close writes the buffered entries and audit records, then stops the background goroutines
+// and connections of the logger (the async buffer and the Loki and Kafka sinks). Entries
+// logged afterwards are still written, synchronously, where the sink allows it. It is safe to
+// call more than once; only the first call closes anything.
+//
+// Returns an error if flushing or closing fails.
+func (l *logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	err := l.Sync()
+	l.closeOnce.Do(func() {
+		for _, c := range l.closers {
+			err = errors.Join(err, c())
+		}
+	})
+	return err
+}
+
+// Reload applies opts to the running logger.
+// Only the log level can be changed at runtime; unlike SetLogLevel, an invalid level is
+// rejected with ErrInvalidLogLevel and the current level is kept. The output paths are fixed
+// when the logger is built and are ignored.
+//
+// Example:
+//
+//	if err := logger.Reload(WithLevel("debug")); err != nil {
+//	    log.Printf("Failed to reload logger: %v", err)
+//	}
+func (l *logger) Reload(opts ...Option) error {
+	options := &Options{
+		Level: l.level.Level().String(),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	logLevel, err := zapcore.ParseLevel(options.Level)
+	if err != nil {
+		return ErrInvalidLogLevel
+	}
+	l.level.SetLevel(logLevel)
+	return nil
+}
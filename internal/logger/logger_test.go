@@ -2,6 +2,7 @@ package logger
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -539,3 +540,101 @@ func TestLogger_Logger_AllLogLevels(t *testing.T) {
 		})
 	}
 }
+
+func TestLogger_Logger_Reload(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []Option
+		wantErr       error
+		expectedLevel zapcore.Level
+	}{
+		{
+			name:          "change to debug level",
+			opts:          []Option{WithLevel("debug")},
+			expectedLevel: zapcore.DebugLevel,
+		},
+		{
+			name:          "no options keeps current level",
+			opts:          nil,
+			expectedLevel: zapcore.WarnLevel,
+		},
+		{
+			name:          "invalid level keeps current level",
+			opts:          []Option{WithLevel("invalid")},
+			wantErr:       ErrInvalidLogLevel,
+			expectedLevel: zapcore.WarnLevel,
+		},
+		{
+			name:          "output path is ignored",
+			opts:          []Option{WithOutputPath("/tmp/ignored.log")},
+			expectedLevel: zapcore.WarnLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loggerInstance, err := NewLogger(WithLevel("warn"))
+			require.NoError(t, err)
+
+			err = loggerInstance.(Reloader).Reload(tt.opts...)
+			if err != tt.wantErr {
+				t.Errorf("Reload() error = %v, want %v", err, tt.wantErr)
+			}
+			if loggerInstance.(*logger).level.Level() != tt.expectedLevel {
+				t.Errorf("Reload() level = %v, want %v", loggerInstance.(*logger).level.Level(), tt.expectedLevel)
+			}
+		})
+	}
+}
+
+func TestLogger_Logger_Enabled(t *testing.T) {
+	loggerInstance, err := NewLogger(WithLevel("warn"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		level string
+		want  bool
+	}{
+		{"debug", false},
+		{"info", false},
+		{"warn", true},
+		{"error", true},
+		{"fatal", true},
+		{"verbose", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.level, func(t *testing.T) {
+			require.Equal(t, tt.want, loggerInstance.Enabled(tt.level))
+		})
+	}
+
+	loggerInstance.SetLogLevel("debug")
+	require.True(t, loggerInstance.Enabled("debug"), "Enabled must follow runtime level changes")
+	require.False(t, NewNoopLogger().Enabled("error"), "a noop logger writes nothing")
+}
+
+func TestLogger_Logger_DebugLazy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithLevel("info"), WithOutputPath(path))
+	require.NoError(t, err)
+
+	calls := 0
+	fields := func() map[string]interface{} {
+		calls++
+		return map[string]interface{}{"expensive": "value"}
+	}
+
+	loggerInstance.DebugLazy("skipped", fields)
+	require.Equal(t, 0, calls, "fields must not be built when debug is disabled")
+
+	loggerInstance.SetLogLevel("debug")
+	loggerInstance.DebugLazy("written", fields)
+	loggerInstance.DebugLazy("no fields", nil)
+	require.Equal(t, 1, calls)
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	require.Equal(t, "written", entries[0]["msg"])
+	require.Equal(t, "value", entries[0]["expensive"])
+}
@@ -0,0 +1,19 @@
+package logger
+
+import "github.com/go-logr/logr"
+
+// Logr returns a logr.Logger that writes through this logger, so libraries built on logr
+// (e.g. controller-runtime and the Kubernetes client) share the same output and fields.
+// logr verbosity maps onto levels as V(0) at info and V(1) and above at debug; Error is
+// logged at error level with the error in the "err" field. Names added with WithName are
+// logged in the "logger" field.
+//
+// Returns:
+//   - A logr.Logger backed by this logger
+//
+// Example:
+//
+//	ctrl.SetLogger(logger.Logr())
+func (l *logger) Logr() logr.Logger {
+	return logr.FromSlogHandler(l.SlogHandler())
+}
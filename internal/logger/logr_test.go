@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_Logr_Logr(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithLevel("debug"), WithOutputPath(path))
+	require.NoError(t, err)
+
+	log := loggerInstance.Logr().WithName("controller").WithValues("namespace", "default")
+	log.Info("reconciling", "name", "web")
+	log.V(1).Info("cache hit")
+	log.Error(errors.New("conflict"), "update failed")
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 3)
+
+	require.Equal(t, "info", entries[0]["level"])
+	require.Equal(t, "reconciling", entries[0]["msg"])
+	require.Equal(t, "default", entries[0]["namespace"])
+	require.Equal(t, "web", entries[0]["name"])
+	require.Equal(t, "controller", entries[0]["logger"])
+	require.Contains(t, entries[0]["caller"], "logger/logr_test.go:")
+
+	require.Equal(t, "debug", entries[1]["level"])
+	require.Equal(t, "cache hit", entries[1]["msg"])
+
+	require.Equal(t, "error", entries[2]["level"])
+	require.Equal(t, "conflict", entries[2]["err"])
+}
+
+func TestLogger_Logr_Enabled(t *testing.T) {
+	loggerInstance, err := NewLogger(WithLevel("info"))
+	require.NoError(t, err)
+	log := loggerInstance.Logr()
+
+	require.True(t, log.Enabled())
+	require.False(t, log.V(1).Enabled())
+}
@@ -0,0 +1,244 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SinkLoki pushes entries to Grafana Loki over its HTTP push API.
+const SinkLoki = "loki"
+
+// lokiPushPath is the path of the Loki push API, added to a LokiURL without a path.
+const lokiPushPath = "/loki/api/v1/push"
+
+// lokiBatchSize is the number of entries that triggers a push before the flush interval ends,
+// and the largest number of entries pushed in one request.
+const lokiBatchSize = 1000
+
+// lokiBufferSize is the number of entries buffered while a push is running or failing; entries
+// written while it is full are dropped.
+const lokiBufferSize = 10 * lokiBatchSize
+
+// lokiFlushInterval is how often buffered entries are pushed. Tests shorten it.
+var lokiFlushInterval = time.Second
+
+// lokiClient buffers the entries of a Loki core and the cores derived from it with With, and
+// pushes them as one stream labelled with the labels of the logger. A single goroutine pushes
+// them every lokiFlushInterval until the client is closed.
+type lokiClient struct {
+	url     string
+	labels  map[string]string
+	client  *http.Client
+	dropped func(entries int)
+
+	mu      sync.Mutex
+	values  [][2]string // values are the buffered [timestamp in nanoseconds, line] pairs.
+	lastErr error       // lastErr is the error of the last failed background push, returned by the next Sync.
+	pushMu  sync.Mutex  // pushMu keeps pushes in order.
+	wake    chan struct{}
+
+	stopOnce sync.Once
+	stop     chan struct{} // stop is closed to stop the flush goroutine.
+	stopped  chan struct{} // stopped is closed when the flush goroutine exits.
+}
+
+// newLokiClient creates the client pushing to rawURL and starts its flush goroutine. Discarded
+// entries are reported to dropped.
+func newLokiClient(rawURL string, labels map[string]string, dropped func(entries int)) (*lokiClient, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid loki url %q", rawURL)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = lokiPushPath
+	}
+	c := &lokiClient{
+		url:     u.String(),
+		labels:  labels,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		dropped: dropped,
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go c.run()
+	return c, nil
+}
+
+// run pushes the buffered entries every lokiFlushInterval, or as soon as a batch is full, until
+// the client is closed.
+func (c *lokiClient) run() {
+	defer close(c.stopped)
+	ticker := time.NewTicker(lokiFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-c.wake:
+		case <-c.stop:
+			return
+		}
+		if err := c.flush(); err != nil {
+			c.mu.Lock()
+			c.lastErr = err
+			c.mu.Unlock()
+		}
+	}
+}
+
+// add buffers line, waking the flush goroutine when a batch is full. The line is dropped when
+// lokiBufferSize entries are already waiting.
+func (c *lokiClient) add(ts time.Time, line string) {
+	c.mu.Lock()
+	if len(c.values) >= lokiBufferSize {
+		c.mu.Unlock()
+		c.drop(1)
+		return
+	}
+	c.values = append(c.values, [2]string{strconv.FormatInt(ts.UnixNano(), 10), line})
+	full := len(c.values) >= lokiBatchSize
+	c.mu.Unlock()
+	if full {
+		select {
+		case c.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// flush pushes the buffered entries in requests of up to lokiBatchSize entries. Once a push
+// fails, its entries and the remaining ones are discarded and reported to the dropped handler,
+// so an unreachable Loki does not grow the buffer or hold it for more than one timeout.
+func (c *lokiClient) flush() error {
+	c.pushMu.Lock()
+	defer c.pushMu.Unlock()
+
+	c.mu.Lock()
+	values := c.values
+	c.values = nil
+	c.mu.Unlock()
+
+	for len(values) > 0 {
+		batch := values[:min(len(values), lokiBatchSize)]
+		if err := c.push(batch); err != nil {
+			c.drop(len(values))
+			return err
+		}
+		values = values[len(batch):]
+	}
+	return nil
+}
+
+// push sends values to Loki in one request.
+func (c *lokiClient) push(values [][2]string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"streams": []map[string]interface{}{{"stream": c.labels, "values": values}},
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Post(c.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to push to loki: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("failed to push to loki: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// sync pushes the buffered entries and returns the error of the last background push, if any.
+func (c *lokiClient) sync() error {
+	err := c.flush()
+	c.mu.Lock()
+	lastErr := c.lastErr
+	c.lastErr = nil
+	c.mu.Unlock()
+	return errors.Join(lastErr, err)
+}
+
+// close stops the flush goroutine and pushes the buffered entries, returning the push error or
+// that of the last background push. Entries written afterwards are pushed by Sync, or before
+// Write returns above error level. It is safe to call more than once.
+func (c *lokiClient) close() error {
+	c.stopOnce.Do(func() { close(c.stop) })
+	<-c.stopped
+	return c.sync()
+}
+
+// drop reports discarded entries to the dropped handler.
+func (c *lokiClient) drop(entries int) {
+	if c.dropped != nil {
+		c.dropped(entries)
+	}
+}
+
+// lokiCore writes JSON-encoded entries to a lokiClient.
+type lokiCore struct {
+	zapcore.LevelEnabler
+	enc    zapcore.Encoder
+	client *lokiClient
+}
+
+// newLokiCore creates the core pushing to options.LokiURL with options.LokiLabels.
+func newLokiCore(options *Options, enc zapcore.Encoder, level zapcore.LevelEnabler) (*lokiCore, error) {
+	client, err := newLokiClient(options.LokiURL, options.LokiLabels, options.DroppedHandler)
+	if err != nil {
+		return nil, err
+	}
+	return &lokiCore{LevelEnabler: level, enc: enc, client: client}, nil
+}
+
+func (c *lokiCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &lokiCore{LevelEnabler: c.LevelEnabler, enc: c.enc.Clone(), client: c.client}
+	for _, f := range fields {
+		f.AddTo(clone.enc)
+	}
+	return clone
+}
+
+func (c *lokiCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+// Write buffers the entry; it is pushed by the flush goroutine or the next Sync. Entries above
+// error level (DPanic, Panic, Fatal) are pushed before Write returns, since the process may stop
+// right after them.
+func (c *lokiCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.enc.EncodeEntry(entry, fields)
+	if err != nil {
+		return err
+	}
+	defer buf.Free()
+	c.client.add(entry.Time, strings.TrimSuffix(buf.String(), "\n"))
+	if entry.Level > zapcore.ErrorLevel {
+		return c.client.flush()
+	}
+	return nil
+}
+
+// Sync pushes the buffered entries, returning the push error or that of the last background push.
+func (c *lokiCore) Sync() error {
+	return c.client.sync()
+}
+
+// close pushes the buffered entries and stops the flush goroutine; see lokiClient.close.
+func (c *lokiCore) close() error {
+	return c.client.close()
+}
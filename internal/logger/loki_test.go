@@ -0,0 +1,230 @@
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// lokiPush is the body of a Loki push request.
+type lokiPush struct {
+	Streams []struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	} `json:"streams"`
+}
+
+// lokiServer records the push requests it receives.
+type lokiServer struct {
+	mu     sync.Mutex
+	paths  []string
+	pushes []lokiPush
+	status int
+}
+
+func (s *lokiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var push lokiPush
+	_ = json.NewDecoder(r.Body).Decode(&push)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paths = append(s.paths, r.URL.Path)
+	s.pushes = append(s.pushes, push)
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestLogger_Loki_Push(t *testing.T) {
+	recorder := &lokiServer{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	labels := map[string]string{"service": "api", "env": "production", "instance": "api-0"}
+	loggerInstance, err := NewLogger(
+		WithSink(SinkLoki),
+		WithLoki(server.URL, labels),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Info("order created", map[string]interface{}{"order_id": "o-1"})
+	loggerInstance.Error("payment failed", nil)
+	require.NoError(t, loggerInstance.Sync())
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	require.Len(t, recorder.pushes, 1)
+	assert.Equal(t, lokiPushPath, recorder.paths[0])
+	require.Len(t, recorder.pushes[0].Streams, 1)
+	stream := recorder.pushes[0].Streams[0]
+	assert.Equal(t, labels, stream.Stream)
+	require.Len(t, stream.Values, 2)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stream.Values[0][1]), &entry))
+	assert.Equal(t, "order created", entry["msg"])
+	assert.Equal(t, "o-1", entry["order_id"])
+	assert.NotEmpty(t, stream.Values[0][0])
+}
+
+func TestLogger_Loki_PushPath(t *testing.T) {
+	recorder := &lokiServer{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	loggerInstance, err := NewLogger(
+		WithSink(SinkLoki),
+		WithLoki(server.URL+"/custom/push", nil),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Info("entry", nil)
+	require.NoError(t, loggerInstance.Sync())
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	assert.Equal(t, []string{"/custom/push"}, recorder.paths)
+}
+
+func TestLogger_Loki_PushError(t *testing.T) {
+	recorder := &lokiServer{status: http.StatusBadRequest}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	loggerInstance, err := NewLogger(
+		WithSink(SinkLoki),
+		WithLoki(server.URL, map[string]string{"service": "api"}),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Info("entry", nil)
+	assert.Error(t, loggerInstance.Sync())
+	assert.NoError(t, loggerInstance.Sync(), "the entries of a failed push should be discarded")
+}
+
+func TestLogger_Loki_Close(t *testing.T) {
+	recorder := &lokiServer{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	loggerInstance, err := NewLogger(
+		WithSink(SinkLoki),
+		WithLoki(server.URL, nil),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Info("before close", nil)
+	require.NoError(t, loggerInstance.(Closer).Close())
+	recorder.mu.Lock()
+	assert.Len(t, recorder.pushes, 1, "Close should push the buffered entries")
+	recorder.mu.Unlock()
+
+	l := loggerInstance.(*logger)
+	require.Len(t, l.closers, 1)
+	require.NoError(t, l.closers[0]())
+}
+
+func TestLogger_Loki_Chunks(t *testing.T) {
+	recorder := &lokiServer{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	enc := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	core, err := newLokiCore(&Options{LokiURL: server.URL}, enc, zapcore.DebugLevel)
+	require.NoError(t, err)
+	defer core.close()
+
+	entries := 2*lokiBatchSize + 500
+	for i := 0; i < entries; i++ {
+		require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: time.Now(), Message: strconv.Itoa(i)}, nil))
+	}
+	require.NoError(t, core.Sync())
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	pushed := 0
+	for _, push := range recorder.pushes {
+		require.Len(t, push.Streams, 1)
+		assert.LessOrEqual(t, len(push.Streams[0].Values), lokiBatchSize)
+		pushed += len(push.Streams[0].Values)
+	}
+	assert.Equal(t, entries, pushed)
+}
+
+func TestLogger_Loki_BufferLimit(t *testing.T) {
+	release := make(chan struct{})
+	received := make(chan struct{}, 1)
+	var pushed atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var push lokiPush
+		_ = json.NewDecoder(r.Body).Decode(&push)
+		select {
+		case received <- struct{}{}:
+		default:
+		}
+		<-release
+		pushed.Add(int64(len(push.Streams[0].Values)))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var dropped atomic.Int64
+	enc := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	core, err := newLokiCore(&Options{LokiURL: server.URL, DroppedHandler: func(entries int) { dropped.Add(int64(entries)) }}, enc, zapcore.DebugLevel)
+	require.NoError(t, err)
+	defer core.close()
+
+	// A push stalls on the first entry while the buffer fills up behind it.
+	require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: time.Now(), Message: "stalled"}, nil))
+	synced := make(chan error, 1)
+	go func() { synced <- core.Sync() }()
+	<-received
+	for i := 0; i < lokiBufferSize+5; i++ {
+		require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: time.Now(), Message: strconv.Itoa(i)}, nil))
+	}
+	assert.Equal(t, int64(5), dropped.Load())
+
+	close(release)
+	require.NoError(t, <-synced)
+	require.NoError(t, core.Sync())
+	assert.Equal(t, int64(1+lokiBufferSize), pushed.Load())
+}
+
+func TestLogger_Loki_InvalidURL(t *testing.T) {
+	_, err := NewLogger(WithSink(SinkLoki), WithLoki("loki:3100", nil))
+	assert.Error(t, err)
+}
+
+func TestLogger_Loki_WriteFatal(t *testing.T) {
+	recorder := &lokiServer{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	enc := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	core, err := newLokiCore(&Options{LokiURL: server.URL}, enc, zapcore.DebugLevel)
+	require.NoError(t, err)
+
+	require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: time.Now(), Message: "buffered"}, nil))
+	recorder.mu.Lock()
+	assert.Empty(t, recorder.pushes, "entries up to error level should wait for the next push")
+	recorder.mu.Unlock()
+
+	// The process exits right after a fatal entry, so it is pushed before Write returns.
+	require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.FatalLevel, Time: time.Now(), Message: "exiting"}, nil))
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	require.Len(t, recorder.pushes, 1)
+	require.Len(t, recorder.pushes[0].Streams, 1)
+	assert.Len(t, recorder.pushes[0].Streams[0].Values, 2)
+}
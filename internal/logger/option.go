@@ -1,12 +1,143 @@
 package logger
 
+import (
+	"context"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/redact"
+	"go.uber.org/zap/zapcore"
+)
+
+// StacktraceOff is the Options.StacktraceLevel disabling stack traces.
+const StacktraceOff = "off"
+
 type Options struct {
-	Level      string // Level is the minimum log level to output. Valid values: "debug", "info", "warn", "error", "fatal".
-	OutputPath string // OutputPath is the file path where logs will be written. If empty, logs will be written to stdout.
+	Level             string                          // Level is the minimum log level to output. Valid values: "debug", "info", "warn", "error", "fatal".
+	OutputPath        string                          // OutputPath is the file path where logs will be written. If empty, logs will be written to stdout.
+	ErrorOutputPath   string                          // ErrorOutputPath is where warn, error, and fatal entries are written instead of OutputPath: "stderr", "stdout", or a file path. If empty, every entry goes to OutputPath.
+	Sink              string                          // Sink replaces OutputPath with a logging service: "syslog", "journald", "loki", or "kafka". If empty, entries are written to OutputPath.
+	SyslogNetwork     string                          // SyslogNetwork is the network of SyslogAddress: "udp", "tcp", or "unix".
+	SyslogAddress     string                          // SyslogAddress is the address of the syslog daemon. If empty, the local daemon is used.
+	SyslogFacility    string                          // SyslogFacility is the syslog facility of the entries, e.g. "daemon" or "local0". If empty, "user" is used.
+	SyslogTag         string                          // SyslogTag is the program name syslog and journald entries are tagged with. If empty, the executable name is used.
+	LokiURL           string                          // LokiURL is the Loki server the "loki" sink pushes to, e.g. "http://loki:3100". Without a path, "/loki/api/v1/push" is used.
+	LokiLabels        map[string]string               // LokiLabels are the labels of the stream the "loki" sink pushes to.
+	KafkaBrokers      []string                        // KafkaBrokers are the bootstrap brokers of the "kafka" sink, as "host:port".
+	KafkaTopic        string                          // KafkaTopic is the topic the "kafka" sink produces to.
+	KafkaBatchSize    int                             // KafkaBatchSize is the number of entries produced in one request. Zero means DefaultKafkaBatchSize.
+	KafkaBatchTimeout time.Duration                   // KafkaBatchTimeout is how long a batch waits to fill before it is produced. Zero means DefaultKafkaBatchTimeout.
+	KafkaBufferSize   int                             // KafkaBufferSize is the number of entries buffered for the producer; entries written while it is full are dropped. Zero means DefaultKafkaBufferSize.
+	CaptureStdLog     bool                            // CaptureStdLog redirects the output of the standard library's global logger into this logger at info level.
+	CaptureGRPCLog    bool                            // CaptureGRPCLog installs this logger as gRPC's internal logger (grpclog.LoggerV2).
+	AsyncBufferSize   int                             // AsyncBufferSize is the number of entries buffered for a background writer. Zero writes synchronously.
+	AsyncDropPolicy   string                          // AsyncDropPolicy selects what happens when the async buffer is full: "block", "drop_newest", or "drop_oldest".
+	DroppedHandler    func(entries int)               // DroppedHandler is notified of entries discarded by the async drop policy or the Kafka and Loki sinks.
+	FatalHooks        []FatalHook                     // FatalHooks are called in order after a fatal entry is written, before the process exits.
+	ExitFlush         func(ctx context.Context) error // ExitFlush is called after the FatalHooks to export buffered telemetry before the process exits.
+	ExitFlushTimeout  time.Duration                   // ExitFlushTimeout bounds ExitFlush. Zero means no limit.
+	Fields            map[string]interface{}          // Fields are added to every entry, e.g. the Kubernetes pod the process runs in.
+	Schema            string                          // Schema renames the standard fields of every entry after a log schema: "ecs" for the Elastic Common Schema, "gcp" for Google Cloud Logging, or "datadog". If empty, the zap field names are used.
+	GCPProjectID      string                          // GCPProjectID is the Google Cloud project of the trace IDs written with the "gcp" schema.
+	TimeLayout        string                          // TimeLayout is the time.Format layout of entry timestamps, or "epoch", "epoch_millis", or "epoch_nanos". If empty, the layout of Schema or DefaultTimeLayout is used.
+	TimeLocation      *time.Location                  // TimeLocation is the time zone timestamps are written in. If nil, the local time zone is used.
+	DisableCaller     bool                            // DisableCaller omits the caller file and line from entries.
+	CallerSkip        int                             // CallerSkip is the number of additional stack frames skipped to find the caller, for helpers wrapping the Logger.
+	StacktraceLevel   string                          // StacktraceLevel is the lowest level entries carry a stack trace at, or "off". If empty, "error" is used.
+	DedupWindow       time.Duration                   // DedupWindow collapses identical entries written within it into one entry with a "count" field. Zero disables deduplication.
+	RateLimits        map[string]int                  // RateLimits are the entries written per second for each rate limit key; see WithRateLimit.
+	RedactedFields    []string                        // RedactedFields are the field keys redacted from every entry, e.g. "user.email".
+	RedactionAction   string                          // RedactionAction selects how RedactedFields are redacted: "delete" (default) or "hash".
+	AuditOutputPath   string                          // AuditOutputPath is where Logger.Audit writes its records: "stderr", "stdout", or a file path. If empty, Audit returns ErrAuditNotConfigured.
+	AuditHMACKey      []byte                          // AuditHMACKey signs every audit record with an HMAC-SHA256 chained to the previous record. If nil, records are not signed.
+}
+
+// Validate reports whether the options describe a valid logger without creating it.
+// It returns ErrInvalidLogLevel if Level is not a recognized log level, ErrInvalidAsyncBufferSize
+// if AsyncBufferSize is negative, ErrInvalidDropPolicy if async writing is enabled with an
+// unknown AsyncDropPolicy, ErrInvalidSink if Sink is unknown, ErrInvalidSyslogFacility if
+// SyslogFacility is unknown, ErrLokiURLRequired if Sink is "loki" without a LokiURL, or, when
+// Sink is "kafka", ErrKafkaBrokersRequired, ErrKafkaTopicRequired, or ErrInvalidKafkaBatch if
+// the brokers or topic are missing or a batch setting is negative. It returns ErrInvalidSchema
+// if Schema is unknown, ErrInvalidCallerSkip if CallerSkip is negative, or
+// ErrInvalidStacktraceLevel if StacktraceLevel is neither a log level nor "off", or
+// ErrInvalidDedupWindow if DedupWindow is negative, or ErrInvalidRateLimit if a RateLimits key
+// is empty or its limit is not positive, or redact.ErrInvalidAction if RedactedFields are set
+// with an unknown RedactionAction.
+func (o *Options) Validate() error {
+	if _, err := zapcore.ParseLevel(o.Level); err != nil {
+		return ErrInvalidLogLevel
+	}
+	if o.AsyncBufferSize < 0 {
+		return ErrInvalidAsyncBufferSize
+	}
+	if o.AsyncBufferSize > 0 {
+		switch o.AsyncDropPolicy {
+		case DropPolicyBlock, DropPolicyDropNewest, DropPolicyDropOldest:
+		default:
+			return ErrInvalidDropPolicy
+		}
+	}
+	switch o.Sink {
+	case "", SinkSyslog, SinkJournald:
+	case SinkLoki:
+		if o.LokiURL == "" {
+			return ErrLokiURLRequired
+		}
+	case SinkKafka:
+		if len(o.KafkaBrokers) == 0 {
+			return ErrKafkaBrokersRequired
+		}
+		if o.KafkaTopic == "" {
+			return ErrKafkaTopicRequired
+		}
+		if o.KafkaBatchSize < 0 || o.KafkaBatchTimeout < 0 || o.KafkaBufferSize < 0 {
+			return ErrInvalidKafkaBatch
+		}
+	default:
+		return ErrInvalidSink
+	}
+	switch o.Schema {
+	case "", SchemaECS, SchemaGCP, SchemaDatadog:
+	default:
+		return ErrInvalidSchema
+	}
+	if o.CallerSkip < 0 {
+		return ErrInvalidCallerSkip
+	}
+	if o.StacktraceLevel != "" && o.StacktraceLevel != StacktraceOff {
+		if _, err := zapcore.ParseLevel(o.StacktraceLevel); err != nil {
+			return ErrInvalidStacktraceLevel
+		}
+	}
+	if o.DedupWindow < 0 {
+		return ErrInvalidDedupWindow
+	}
+	for key, perSecond := range o.RateLimits {
+		if key == "" || perSecond <= 0 {
+			return ErrInvalidRateLimit
+		}
+	}
+	if len(o.RedactedFields) > 0 {
+		if err := redact.Validate(o.RedactionAction); err != nil {
+			return err
+		}
+	}
+	if _, ok := syslogFacilities[o.SyslogFacility]; o.SyslogFacility != "" && !ok {
+		return ErrInvalidSyslogFacility
+	}
+	return nil
 }
 
 type Option func(*Options)
 
+// WithOptions returns an Option that replaces every setting with options. The root package
+// keeps the logger settings of its Options in a logger Options and passes them with it.
+func WithOptions(options Options) Option {
+	return func(o *Options) {
+		*o = options
+	}
+}
+
 // WithLevel returns an Option that sets the Level field of Options to the provided log level.
 // Valid values are "debug", "info", "warn", "error", and "fatal".
 func WithLevel(level string) Option {
@@ -21,4 +152,253 @@ func WithOutputPath(path string) Option {
 	return func(o *Options) {
 		o.OutputPath = path
 	}
-}
\ No newline at end of file
+}
+
+// WithErrorOutputPath returns an Option that sets the Options.ErrorOutputPath. When set, warn,
+// error, and fatal entries are written to path ("stderr", "stdout", or a file path) and debug
+// and info entries to the output path, so container runtimes can route log severity by stream.
+// An empty path writes every entry to the output path.
+func WithErrorOutputPath(path string) Option {
+	return func(o *Options) {
+		o.ErrorOutputPath = path
+	}
+}
+
+// WithSink returns an Option that sets the Options.Sink. "syslog" sends every entry, JSON
+// encoded, to a syslog daemon, and "journald" sends it to the systemd journal with the message,
+// caller, and fields as separate journal fields. Both map zap levels to syslog severities:
+// debug to debug, info to info, warn to warning, error to err, and dpanic, panic, and fatal to
+// crit. "loki" pushes JSON entries to Grafana Loki; see WithLoki. "kafka" produces JSON
+// entries to a Kafka topic; see WithKafka. An empty sink writes to the output path.
+func WithSink(sink string) Option {
+	return func(o *Options) {
+		o.Sink = sink
+	}
+}
+
+// WithSyslog returns an Option that sets the syslog daemon the "syslog" sink connects to: the
+// local daemon when address is empty, otherwise address over network ("udp", "tcp", or
+// "unix"). facility (e.g. "daemon" or "local0", default "user") is the facility of the entries,
+// and tag (default the executable name) the program name they are tagged with, also used as the
+// SYSLOG_IDENTIFIER of the "journald" sink.
+func WithSyslog(network, address, facility, tag string) Option {
+	return func(o *Options) {
+		o.SyslogNetwork = network
+		o.SyslogAddress = address
+		o.SyslogFacility = facility
+		o.SyslogTag = tag
+	}
+}
+
+// WithLoki returns an Option that sets the Loki server the "loki" sink pushes to and the labels
+// of its stream. Entries are buffered and pushed every second, or as soon as 1000 are waiting,
+// in requests of up to 1000 entries, by a goroutine that runs until the logger is closed; Sync
+// pushes them immediately and reports the error of a failed background push. Up to 10000
+// entries are buffered: entries written while the buffer is full and the entries of a failed
+// push are discarded and reported to the DroppedHandler.
+func WithLoki(url string, labels map[string]string) Option {
+	return func(o *Options) {
+		o.LokiURL = url
+		o.LokiLabels = labels
+	}
+}
+
+// WithKafka returns an Option that sets the bootstrap brokers and the topic the "kafka" sink
+// produces to. Entries are produced uncompressed, without keys, to the partitions in turn, and
+// acknowledged by the partition leader; the brokers need Kafka 0.11 or newer and a plaintext
+// listener, as TLS and SASL are not supported. A goroutine produces them in batches (see
+// WithKafkaBatch) until the logger is closed. Entries written while the buffer is full or after
+// Close, and the entries of a failed produce, are dropped and reported to the DroppedHandler;
+// Sync waits for the buffered entries and reports the error of a failed produce, and Close
+// also stops the goroutine and closes the broker connections.
+func WithKafka(brokers []string, topic string) Option {
+	return func(o *Options) {
+		o.KafkaBrokers = brokers
+		o.KafkaTopic = topic
+	}
+}
+
+// WithKafkaBatch returns an Option that sets the batch settings of the "kafka" sink: up to
+// size entries are produced in one request, at least every timeout, from a buffer of
+// bufferSize entries. Zero values use DefaultKafkaBatchSize, DefaultKafkaBatchTimeout, and
+// DefaultKafkaBufferSize.
+func WithKafkaBatch(size int, timeout time.Duration, bufferSize int) Option {
+	return func(o *Options) {
+		o.KafkaBatchSize = size
+		o.KafkaBatchTimeout = timeout
+		o.KafkaBufferSize = bufferSize
+	}
+}
+
+// WithSchema returns an Option that sets the Options.Schema. With "ecs", entries follow the
+// Elastic Common Schema: ts becomes @timestamp (RFC 3339), msg message, level log.level,
+// logger log.logger, stacktrace error.stack_trace, and the traceID and spanID fields trace.id
+// and span.id, and every entry carries ecs.version. With "gcp", entries follow the Google Cloud
+// Logging structured format: ts becomes time, msg message, level severity (DEBUG, INFO,
+// WARNING, ERROR, and CRITICAL, ALERT, and EMERGENCY for dpanic, panic, and fatal),
+// stacktrace stack_trace, and the traceID and spanID fields logging.googleapis.com/trace and
+// logging.googleapis.com/spanId; see WithGCPProject. With "datadog", the field names are kept
+// and dd.trace_id and dd.span_id are added next to traceID and spanID, in the 64-bit decimal
+// form Datadog correlates logs with traces by.
+func WithSchema(schema string) Option {
+	return func(o *Options) {
+		o.Schema = schema
+	}
+}
+
+// WithTimeFormat returns an Option that sets how entry timestamps are written: with layout, a
+// time.Format layout, in location, or for "epoch", "epoch_millis", and "epoch_nanos", as the
+// seconds (with a fraction), milliseconds, or nanoseconds since the Unix epoch. An empty
+// layout keeps the layout of the schema, or DefaultTimeLayout; a nil location keeps the local
+// time zone.
+func WithTimeFormat(layout string, location *time.Location) Option {
+	return func(o *Options) {
+		o.TimeLayout = layout
+		o.TimeLocation = location
+	}
+}
+
+// WithCaller returns an Option that sets whether entries carry the caller file and line, and how
+// many additional stack frames are skipped to find it. A helper wrapping the Logger passes the
+// number of its own frames between the caller and the Logger method, usually 1, so entries
+// point at the code calling the helper. The skip applies to the Logger methods only; StdLogger
+// and the gRPC bridge keep pointing at their callers.
+func WithCaller(enabled bool, skip int) Option {
+	return func(o *Options) {
+		o.DisableCaller = !enabled
+		o.CallerSkip = skip
+	}
+}
+
+// WithStacktraceLevel returns an Option that sets the lowest level entries carry a stack trace
+// at, e.g. "error" (the default) or "fatal", or "off" to never attach one.
+func WithStacktraceLevel(level string) Option {
+	return func(o *Options) {
+		o.StacktraceLevel = level
+	}
+}
+
+// WithDedup returns an Option that collapses identical entries, with the same level, message,
+// and fields, written within window. The first entry is written as usual and the next ones are
+// suppressed; when the window closes, the first entry is written again with a "count" field
+// holding the number of entries suppressed. Windows are closed by a goroutine that lives as
+// long as the process, and by Sync. Entries above error level are never suppressed.
+func WithDedup(window time.Duration) Option {
+	return func(o *Options) {
+		o.DedupWindow = window
+	}
+}
+
+// WithRateLimit returns an Option that throttles the entries identified by key to perSecond
+// entries per second, independently of sampling. An entry is identified by key when its message
+// starts with key or it carries a field named key; an entry identified by several keys is
+// written only while each of them is under its limit. Entries above error level are never
+// throttled. Each call adds a key; calling it again with the same key replaces its limit.
+func WithRateLimit(key string, perSecond int) Option {
+	return func(o *Options) {
+		if o.RateLimits == nil {
+			o.RateLimits = make(map[string]int)
+		}
+		o.RateLimits[key] = perSecond
+	}
+}
+
+// WithRedaction returns an Option that redacts the fields named keys from every entry, whether
+// added with the entry or to a child logger: action "delete" drops them and "hash" replaces
+// their values with the SHA-256 hash the tracer records for the same value, so entries and
+// spans still correlate. The initial Fields are not redacted.
+func WithRedaction(action string, keys ...string) Option {
+	return func(o *Options) {
+		o.RedactionAction = action
+		o.RedactedFields = keys
+	}
+}
+
+// WithAudit returns an Option that sets the output of the audit records written by
+// Logger.Audit: "stderr", "stdout", or a file path, isolated from the level, sink, sampling,
+// and other settings of the ordinary entries. Records carry consecutive sequence numbers,
+// continued from the last record when path is an existing file, and, when hmacKey is set, an
+// HMAC-SHA256 chained to the previous record; see VerifyAudit.
+func WithAudit(path string, hmacKey []byte) Option {
+	return func(o *Options) {
+		o.AuditOutputPath = path
+		o.AuditHMACKey = hmacKey
+	}
+}
+
+// WithGCPProject returns an Option that sets the Google Cloud project the trace IDs written with
+// the "gcp" schema belong to, so they are written as "projects/<id>/traces/<trace ID>" and
+// Cloud Logging correlates the entries with Cloud Trace.
+func WithGCPProject(id string) Option {
+	return func(o *Options) {
+		o.GCPProjectID = id
+	}
+}
+
+// WithCaptureStdLog returns an Option that sets the Options.CaptureStdLog field.
+// When true, output written through the standard library's global logger (log.Printf and
+// friends) is logged at info level instead of being printed to stderr.
+func WithCaptureStdLog(capture bool) Option {
+	return func(o *Options) {
+		o.CaptureStdLog = capture
+	}
+}
+
+// WithCaptureGRPCLog returns an Option that sets the Options.CaptureGRPCLog field.
+// When true, gRPC's internal logs (connection resets, TLS failures) are written through this
+// logger: gRPC info maps to debug, and warning, error, and fatal map to the same zap level.
+func WithCaptureGRPCLog(capture bool) Option {
+	return func(o *Options) {
+		o.CaptureGRPCLog = capture
+	}
+}
+
+// WithAsync returns an Option that moves encoding and writing onto a background goroutine
+// through a buffer of bufferSize entries, so a stalled disk or stdout does not add latency to
+// the caller. dropPolicy selects what happens when the buffer is full: "block" waits for room,
+// "drop_newest" discards the entry being written, and "drop_oldest" discards the oldest buffered
+// entry. A bufferSize of 0 writes synchronously. Sync waits for the buffer to drain, and Close
+// drains it and stops the goroutine.
+func WithAsync(bufferSize int, dropPolicy string) Option {
+	return func(o *Options) {
+		o.AsyncBufferSize = bufferSize
+		o.AsyncDropPolicy = dropPolicy
+	}
+}
+
+// WithFields returns an Option that sets the Options.Fields added to every entry written by
+// the logger, including entries of loggers derived with WithSpanContext.
+func WithFields(fields map[string]interface{}) Option {
+	return func(o *Options) {
+		o.Fields = fields
+	}
+}
+
+// WithDroppedHandler returns an Option that sets the function notified of entries discarded by
+// the async drop policy or the Kafka and Loki sinks, e.g. to count them in a metric. The handler runs on the logging
+// goroutine and must not log through the same logger.
+func WithDroppedHandler(handler func(entries int)) Option {
+	return func(o *Options) {
+		o.DroppedHandler = handler
+	}
+}
+
+// WithFatalHooks returns an Option that sets the functions called after a fatal entry is
+// written and before the process exits, e.g. to flush telemetry. Hooks run on the goroutine
+// that logged the entry; the process exits even if a hook panics.
+func WithFatalHooks(hooks ...FatalHook) Option {
+	return func(o *Options) {
+		o.FatalHooks = hooks
+	}
+}
+
+// WithExitFlush returns an Option that sets the function called after the fatal hooks and
+// before the process exits, to export the telemetry buffered by other components (e.g. the
+// tracer and meter providers). flush receives a context bounded by timeout; a timeout of zero
+// means no limit.
+func WithExitFlush(flush func(ctx context.Context) error, timeout time.Duration) Option {
+	return func(o *Options) {
+		o.ExitFlush = flush
+		o.ExitFlushTimeout = timeout
+	}
+}
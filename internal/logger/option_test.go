@@ -1,7 +1,11 @@
 package logger
 
 import (
+	"errors"
 	"testing"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/redact"
 )
 
 func TestLogger_Option_WithLevel(t *testing.T) {
@@ -161,3 +165,47 @@ func TestLogger_Option_WithOutputPath(t *testing.T) {
 		})
 	}
 }
+
+func TestLogger_Option_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr error
+	}{
+		{"valid level", Options{Level: "warn"}, nil},
+		{"empty level defaults to info", Options{}, nil},
+		{"invalid level", Options{Level: "verbose"}, ErrInvalidLogLevel},
+		{"syslog sink", Options{Sink: SinkSyslog, SyslogFacility: "local0"}, nil},
+		{"journald sink", Options{Sink: SinkJournald}, nil},
+		{"invalid sink", Options{Sink: "eventlog"}, ErrInvalidSink},
+		{"invalid syslog facility", Options{Sink: SinkSyslog, SyslogFacility: "local9"}, ErrInvalidSyslogFacility},
+		{"loki sink", Options{Sink: SinkLoki, LokiURL: "http://loki:3100"}, nil},
+		{"loki sink without url", Options{Sink: SinkLoki}, ErrLokiURLRequired},
+		{"kafka sink", Options{Sink: SinkKafka, KafkaBrokers: []string{"kafka:9092"}, KafkaTopic: "logs"}, nil},
+		{"kafka sink without brokers", Options{Sink: SinkKafka, KafkaTopic: "logs"}, ErrKafkaBrokersRequired},
+		{"kafka sink without topic", Options{Sink: SinkKafka, KafkaBrokers: []string{"kafka:9092"}}, ErrKafkaTopicRequired},
+		{"ecs schema", Options{Schema: SchemaECS}, nil},
+		{"gcp schema", Options{Schema: SchemaGCP}, nil},
+		{"datadog schema", Options{Schema: SchemaDatadog}, nil},
+		{"negative caller skip", Options{CallerSkip: -1}, ErrInvalidCallerSkip},
+		{"stacktrace level", Options{StacktraceLevel: "fatal"}, nil},
+		{"stacktrace off", Options{StacktraceLevel: StacktraceOff}, nil},
+		{"invalid stacktrace level", Options{StacktraceLevel: "never"}, ErrInvalidStacktraceLevel},
+		{"negative dedup window", Options{DedupWindow: -time.Second}, ErrInvalidDedupWindow},
+		{"empty rate limit key", Options{RateLimits: map[string]int{"": 1}}, ErrInvalidRateLimit},
+		{"zero rate limit", Options{RateLimits: map[string]int{"poll": 0}}, ErrInvalidRateLimit},
+		{"invalid schema", Options{Schema: "gelf"}, ErrInvalidSchema},
+		{"redaction", Options{RedactedFields: []string{"user.email"}, RedactionAction: "hash"}, nil},
+		{"invalid redaction action", Options{RedactedFields: []string{"user.email"}, RedactionAction: "mask"}, redact.ErrInvalidAction},
+		{"kafka negative batch size", Options{Sink: SinkKafka, KafkaBrokers: []string{"kafka:9092"}, KafkaTopic: "logs", KafkaBatchSize: -1}, ErrInvalidKafkaBatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			if err := opts.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package logger
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// rateLimiter counts the entries of a rate limit key written in the current second.
+type rateLimiter struct {
+	perSecond int
+
+	mu     sync.Mutex
+	second int64
+	count  int
+}
+
+// allowAll reports whether another entry matching every one of limiters may be written in the
+// second of unix time second, and counts the entry against each of them only when it may. The
+// limiters are locked together, in the order given, so an entry dropped by one does not use up
+// the limit of the others; callers pass them sorted by key to keep the lock order consistent.
+func allowAll(limiters []*rateLimiter, second int64) bool {
+	for _, r := range limiters {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+	}
+	for _, r := range limiters {
+		if second != r.second {
+			r.second = second
+			r.count = 0
+		}
+		if r.count >= r.perSecond {
+			return false
+		}
+	}
+	for _, r := range limiters {
+		r.count++
+	}
+	return true
+}
+
+// rateLimitCore throttles the entries matching a rate limit key to its limit per second. An
+// entry matches a key when its message starts with the key or it carries a field, added with
+// With or to the entry, named the key. Entries over the limit of any key they match are
+// dropped without counting against the other keys; entries above error level (DPanic, Panic,
+// Fatal) are always written.
+type rateLimitCore struct {
+	zapcore.Core
+	keys     []string // keys are the rate limit keys, sorted.
+	limiters map[string]*rateLimiter
+	context  []string // context are the keys matched by the fields added with With.
+}
+
+// newRateLimitCore wraps core so the entries matching each key of limits are throttled to its
+// number per second.
+func newRateLimitCore(core zapcore.Core, limits map[string]int) zapcore.Core {
+	c := &rateLimitCore{Core: core, limiters: make(map[string]*rateLimiter, len(limits))}
+	for key, perSecond := range limits {
+		c.keys = append(c.keys, key)
+		c.limiters[key] = &rateLimiter{perSecond: perSecond}
+	}
+	sort.Strings(c.keys)
+	return c
+}
+
+func (c *rateLimitCore) With(fields []zapcore.Field) zapcore.Core {
+	return &rateLimitCore{
+		Core:     c.Core.With(fields),
+		keys:     c.keys,
+		limiters: c.limiters,
+		context:  c.fieldKeys(c.context, fields),
+	}
+}
+
+func (c *rateLimitCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checkWrapped(c, c.Core, entry, checked)
+}
+
+func (c *rateLimitCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if entry.Level > zapcore.ErrorLevel {
+		return c.Core.Write(entry, fields)
+	}
+
+	keys := slices.Clone(c.fieldKeys(c.context, fields))
+	for _, key := range c.keys {
+		if strings.HasPrefix(entry.Message, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	keys = slices.Compact(keys)
+	limiters := make([]*rateLimiter, len(keys))
+	for i, key := range keys {
+		limiters[i] = c.limiters[key]
+	}
+	if !allowAll(limiters, entry.Time.Unix()) {
+		return nil
+	}
+	return c.Core.Write(entry, fields)
+}
+
+// fieldKeys returns matched with the rate limit keys named by fields appended.
+func (c *rateLimitCore) fieldKeys(matched []string, fields []zapcore.Field) []string {
+	for _, f := range fields {
+		if _, ok := c.limiters[f.Key]; ok {
+			matched = append(matched[:len(matched):len(matched)], f.Key)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,162 @@
+package logger
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogger_RateLimit_Write(t *testing.T) {
+	start := time.Unix(1000, 0)
+	tests := []struct {
+		name   string
+		limits map[string]int
+		with   []zapcore.Field
+		write  func(core zapcore.Core) error
+		want   int
+	}{
+		{
+			name:   "message prefix",
+			limits: map[string]int{"cache miss": 2},
+			write: func(core zapcore.Core) error {
+				return core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: start, Message: "cache miss for user"}, nil)
+			},
+			want: 2,
+		},
+		{
+			name:   "entry field",
+			limits: map[string]int{"poll": 3},
+			write: func(core zapcore.Core) error {
+				return core.Write(zapcore.Entry{Level: zapcore.WarnLevel, Time: start, Message: "polling"}, []zapcore.Field{zap.Bool("poll", true)})
+			},
+			want: 3,
+		},
+		{
+			name:   "context field",
+			limits: map[string]int{"poll": 1},
+			with:   []zapcore.Field{zap.String("poll", "queue")},
+			write: func(core zapcore.Core) error {
+				return core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: start, Message: "polling"}, nil)
+			},
+			want: 1,
+		},
+		{
+			name:   "unmatched entry",
+			limits: map[string]int{"cache miss": 1},
+			write: func(core zapcore.Core) error {
+				return core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: start, Message: "request served"}, nil)
+			},
+			want: 10,
+		},
+		{
+			name:   "fatal entry",
+			limits: map[string]int{"shutdown": 1},
+			write: func(core zapcore.Core) error {
+				return core.Write(zapcore.Entry{Level: zapcore.FatalLevel, Time: start, Message: "shutdown"}, nil)
+			},
+			want: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			observed, logs := observer.New(zapcore.DebugLevel)
+			core := newRateLimitCore(observed, tt.limits)
+			if tt.with != nil {
+				core = core.With(tt.with)
+			}
+			for i := 0; i < 10; i++ {
+				require.NoError(t, tt.write(core))
+			}
+			assert.Equal(t, tt.want, logs.Len())
+		})
+	}
+}
+
+func TestLogger_RateLimit_DroppedEntryKeepsOtherLimits(t *testing.T) {
+	start := time.Unix(1000, 0)
+	observed, logs := observer.New(zapcore.DebugLevel)
+	core := newRateLimitCore(observed, map[string]int{"cache miss": 1, "poll": 3})
+
+	// only the first entry is written; the dropped ones do not count against "poll"
+	for i := 0; i < 5; i++ {
+		require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: start, Message: "cache miss"}, []zapcore.Field{zap.Bool("poll", true)}))
+	}
+	for i := 0; i < 5; i++ {
+		require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: start, Message: "polling"}, []zapcore.Field{zap.Bool("poll", true)}))
+	}
+	assert.Equal(t, 3, logs.Len())
+	assert.Equal(t, 1, logs.FilterMessage("cache miss").Len())
+	assert.Equal(t, 2, logs.FilterMessage("polling").Len())
+}
+
+func TestLogger_RateLimit_NextSecond(t *testing.T) {
+	observed, logs := observer.New(zapcore.DebugLevel)
+	core := newRateLimitCore(observed, map[string]int{"retry": 1, "db": 2})
+	start := time.Unix(1000, 0)
+	write := func(offset time.Duration, message string, fields ...zapcore.Field) {
+		require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: start.Add(offset), Message: message}, fields))
+	}
+
+	write(0, "retry")
+	write(100*time.Millisecond, "retry")
+	assert.Equal(t, 1, logs.Len(), "entries over the limit should be dropped")
+
+	write(time.Second, "retry")
+	assert.Equal(t, 2, logs.Len(), "the limit should reset every second")
+
+	write(1100*time.Millisecond, "query", zap.String("db", "orders"))
+	write(1200*time.Millisecond, "retry query", zap.String("db", "orders"))
+	assert.Equal(t, 3, logs.Len(), "entries over the limit of any key they match should be dropped")
+}
+
+func TestLogger_RateLimit_Sampling(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    int
+	}{
+		{"rate limit below sampling", "cache miss", 3},
+		{"sampling below rate limit", "poll", 5},
+		{"unmatched entry", "request served", 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			observed, logs := observer.New(zapcore.DebugLevel)
+			sampled := zapcore.NewSamplerWithOptions(observed, time.Hour, 5, 0)
+			core := newRateLimitCore(sampled, map[string]int{"cache miss": 3, "poll": 8})
+			entry := zapcore.Entry{Level: zapcore.InfoLevel, Time: time.Unix(1000, 0), Message: tt.message}
+			for i := 0; i < 10; i++ {
+				if checked := core.Check(entry, nil); checked != nil {
+					checked.Write()
+				}
+			}
+			assert.Equal(t, tt.want, logs.Len(), "both the sampler and the rate limit should apply")
+		})
+	}
+}
+
+func TestLogger_RateLimit_NewLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithRateLimit("heartbeat", 2))
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		loggerInstance.Info("heartbeat", nil)
+		loggerInstance.Info("tick", map[string]interface{}{"heartbeat": i})
+	}
+	loggerInstance.Info("request served", nil)
+	require.NoError(t, loggerInstance.Sync())
+
+	// The message and field matches share the key's limit; a second boundary while logging
+	// allows two more.
+	entries := readEntries(t, path)
+	assert.GreaterOrEqual(t, len(entries), 3)
+	assert.LessOrEqual(t, len(entries), 5)
+	assert.Equal(t, "request served", entries[len(entries)-1]["msg"])
+}
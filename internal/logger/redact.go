@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"fmt"
+
+	"github.com/adityakw90/go-monitoring/internal/redact"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redactCore redacts the fields named by the keys of policy, added with With or to an entry,
+// before they reach the wrapped core: they are dropped, or their values replaced with a hash
+// equal to the one the tracer records for the same value.
+type redactCore struct {
+	zapcore.Core
+	policy *redact.Policy
+}
+
+// newRedactCore wraps core so the fields policy redacts are redacted.
+func newRedactCore(core zapcore.Core, policy *redact.Policy) zapcore.Core {
+	return &redactCore{Core: core, policy: policy}
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(c.redact(fields)), policy: c.policy}
+}
+
+func (c *redactCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checkWrapped(c, c.Core, entry, checked)
+}
+
+func (c *redactCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, c.redact(fields))
+}
+
+// redact returns fields with the redacted fields dropped or hashed. fields is returned
+// unchanged when nothing is redacted.
+func (c *redactCore) redact(fields []zapcore.Field) []zapcore.Field {
+	var redacted []zapcore.Field
+	for i, f := range fields {
+		if !c.policy.Redacts(f.Key) {
+			if redacted != nil {
+				redacted = append(redacted, f)
+			}
+			continue
+		}
+		if redacted == nil {
+			redacted = append(make([]zapcore.Field, 0, len(fields)), fields[:i]...)
+		}
+		if c.policy.Hashes() {
+			redacted = append(redacted, zap.String(f.Key, redact.Hash(fieldValue(f))))
+		}
+	}
+	if redacted == nil {
+		return fields
+	}
+	return redacted
+}
+
+// fieldValue returns the value of f as a string, as the tracer emits attribute values.
+func fieldValue(f zapcore.Field) string {
+	if f.Type == zapcore.StringType {
+		return f.String
+	}
+	enc := zapcore.NewMapObjectEncoder()
+	f.AddTo(enc)
+	return fmt.Sprint(enc.Fields[f.Key])
+}
@@ -0,0 +1,81 @@
+package logger
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/adityakw90/go-monitoring/internal/redact"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogger_Redact_Write(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+		with   []zapcore.Field
+		fields []zapcore.Field
+		want   map[string]interface{}
+	}{
+		{
+			name:   "delete entry field",
+			action: redact.ActionDelete,
+			fields: []zapcore.Field{zap.String("user.email", "alice@example.com"), zap.Int("user.id", 7)},
+			want:   map[string]interface{}{"user.id": int64(7)},
+		},
+		{
+			name:   "delete context field",
+			action: redact.ActionDelete,
+			with:   []zapcore.Field{zap.String("db.statement", "SELECT 1")},
+			fields: []zapcore.Field{zap.Int("user.id", 7)},
+			want:   map[string]interface{}{"user.id": int64(7)},
+		},
+		{
+			name:   "hash string field",
+			action: redact.ActionHash,
+			fields: []zapcore.Field{zap.String("user.email", "alice@example.com")},
+			want:   map[string]interface{}{"user.email": redact.Hash("alice@example.com")},
+		},
+		{
+			name:   "hash non-string field",
+			action: redact.ActionHash,
+			with:   []zapcore.Field{zap.Int("user.email", 42)},
+			want:   map[string]interface{}{"user.email": redact.Hash("42")},
+		},
+		{
+			name:   "unredacted fields are kept",
+			action: redact.ActionHash,
+			fields: []zapcore.Field{zap.String("user.name", "alice")},
+			want:   map[string]interface{}{"user.name": "alice"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			observed, logs := observer.New(zapcore.DebugLevel)
+			core := newRedactCore(observed, redact.New(tt.action, []string{"user.email", "db.statement"}))
+			if tt.with != nil {
+				core = core.With(tt.with)
+			}
+			require.NoError(t, core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Message: "login"}, tt.fields))
+			require.Equal(t, 1, logs.Len())
+			assert.Equal(t, tt.want, logs.All()[0].ContextMap())
+		})
+	}
+}
+
+func TestLogger_Redact_NewLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithRedaction(redact.ActionHash, "user.email"))
+	require.NoError(t, err)
+
+	loggerInstance.Info("login", map[string]interface{}{"user.email": "alice@example.com", "user.id": 7})
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, redact.Hash("alice@example.com"), entries[0]["user.email"])
+	assert.EqualValues(t, 7, entries[0]["user.id"])
+}
@@ -3,14 +3,29 @@ package logger
 import (
 	"fmt"
 
+	"github.com/adityakw90/go-monitoring/internal/redact"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/grpclog"
 )
 
 // NewLogger creates and configures a zap-backed Logger according to the provided options.
 // It defaults the log level to "info", parses and applies the configured level (returning ErrInvalidLogLevel on parse failure),
-// enforces JSON encoding and a fixed timestamp layout ("2006-01-02T15:04:05.000-0700"), and optionally directs output to a custom path.
-// The built logger includes caller information and a caller-skip of 1; on build failure it returns a wrapped error.
+// enforces JSON encoding with the timestamp layout of TimeLayout (default DefaultTimeLayout, "2006-01-02T15:04:05.000-0700"), and optionally directs output to a custom path.
+// When Sink is set, entries are sent to syslog, journald, Loki, or Kafka instead of the output path, and when
+// ErrorOutputPath is set, warn, error, and fatal entries are written there instead. When Schema
+// is set, the standard fields are renamed after it.
+// The built logger includes caller information, unless DisableCaller is set, and a caller-skip of 1 plus CallerSkip; on build failure it returns a wrapped error.
+// Entries at StacktraceLevel and above (default error) carry a stack trace, and when DedupWindow
+// is set, identical entries within it are collapsed; see WithDedup. Entries matching the
+// RateLimits keys are throttled; see WithRateLimit. The RedactedFields are redacted; see
+// WithRedaction. When AuditOutputPath is set, it is opened for
+// the records written by Audit; see WithAudit.
+// When CaptureStdLog is set, the standard library's global logger is redirected into the new logger,
+// and when CaptureGRPCLog is set, the new logger is installed as gRPC's internal logger.
+// When AsyncBufferSize is set, entries are written by a background goroutine that runs until the
+// logger is closed with Close; see WithAsync. Invalid async settings return ErrInvalidAsyncBufferSize or
+// ErrInvalidDropPolicy.
 func NewLogger(opts ...Option) (Logger, error) {
 	options := &Options{
 		Level: "info",
@@ -20,6 +35,10 @@ func NewLogger(opts ...Option) (Logger, error) {
 		opt(options)
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	atomicLevel := zap.NewAtomicLevel()
 
 	// Parse log level
@@ -32,21 +51,129 @@ func NewLogger(opts ...Option) (Logger, error) {
 	config := zap.NewProductionConfig()
 	config.Level = atomicLevel
 	config.Encoding = "json"
-	// Use TimeEncoderOfLayout to ensure consistent format with +0000 for UTC instead of Z
-	// This ensures timestamps are always in offset format (e.g., +0000, +0700) regardless of timezone
-	config.EncoderConfig.EncodeTime = zapcore.TimeEncoderOfLayout("2006-01-02T15:04:05.000-0700")
 
 	if options.OutputPath != "" {
 		config.OutputPaths = []string{options.OutputPath}
 	}
+	if len(options.Fields) > 0 {
+		config.InitialFields = options.Fields
+	}
+	rename := applySchema(options, &config)
+	config.EncoderConfig.EncodeTime = newTimeEncoder(timeLayout(options), options.TimeLocation)
 
-	loggerInstance, err := config.Build(zap.AddCaller(), zap.AddCallerSkip(1))
+	buildOpts := []zap.Option{zap.WithCaller(!options.DisableCaller), zap.AddCallerSkip(1)}
+	switch options.StacktraceLevel {
+	case "":
+	case StacktraceOff:
+		config.DisableStacktrace = true
+	default:
+		stacktraceLevel, _ := zapcore.ParseLevel(options.StacktraceLevel)
+		buildOpts = append(buildOpts, zap.AddStacktrace(stacktraceLevel))
+	}
+	if hook := newFatalHooks(options); hook != nil {
+		buildOpts = append(buildOpts, zap.WithFatalHook(hook))
+	}
+	// closers are run outermost core first, so each core writes into cores still running.
+	var closers []func() error
+	closeAll := func() {
+		for _, c := range closers {
+			_ = c()
+		}
+	}
+	if options.Sink != "" {
+		sink, closeSink, err := newSinkCore(options, config)
+		if err != nil {
+			return nil, err
+		}
+		if closeSink != nil {
+			closers = append(closers, closeSink)
+		}
+		buildOpts = append(buildOpts, zap.WrapCore(sink))
+	}
+	if options.ErrorOutputPath != "" {
+		split, err := newSplitCore(options.ErrorOutputPath, config)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		buildOpts = append(buildOpts, zap.WrapCore(split))
+	}
+	if rename != nil {
+		buildOpts = append(buildOpts, rename)
+	}
+	if options.DedupWindow > 0 {
+		buildOpts = append(buildOpts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return newDedupCore(core, options.DedupWindow)
+		}))
+	}
+	if len(options.RateLimits) > 0 {
+		buildOpts = append(buildOpts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return newRateLimitCore(core, options.RateLimits)
+		}))
+	}
+	if policy := redact.New(options.RedactionAction, options.RedactedFields); policy != nil {
+		// wrapped outside the dedup and rate limit cores so they only see redacted fields
+		buildOpts = append(buildOpts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return newRedactCore(core, policy)
+		}))
+	}
+	if options.AsyncBufferSize > 0 {
+		buildOpts = append(buildOpts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			async := newAsyncCore(core, options.AsyncBufferSize, options.AsyncDropPolicy, options.DroppedHandler)
+			closers = append([]func() error{async.close}, closers...)
+			return async
+		}))
+	}
+	loggerInstance, err := config.Build(buildOpts...)
 	if err != nil {
+		closeAll()
 		return nil, fmt.Errorf("failed to build logger: %w", err)
 	}
 
+	l := &logger{
+		logger:     loggerInstance.WithOptions(zap.AddCallerSkip(options.CallerSkip)),
+		level:      &atomicLevel,
+		callerSkip: options.CallerSkip,
+		closers:    closers,
+	}
+	if options.AuditOutputPath != "" {
+		if l.audit, err = newAuditLog(options.AuditOutputPath, options.AuditHMACKey); err != nil {
+			_ = l.Close()
+			return nil, err
+		}
+		if l.audit.recovered != nil {
+			loggerInstance.Warn("audit output recovered from a partial record", zap.String("path", options.AuditOutputPath), zap.Error(l.audit.recovered))
+		}
+	}
+	if options.CaptureStdLog {
+		// The redirection is process-wide and lasts until another logger captures the output.
+		zap.RedirectStdLog(l.stdLogBase())
+	}
+	if options.CaptureGRPCLog {
+		// grpclog.SetLoggerV2 is not synchronized; the logger must be installed before any
+		// gRPC connection is created, which NewMonitoring guarantees by building it first.
+		grpclog.SetLoggerV2(newGRPCLogger(loggerInstance))
+	}
+	return l, nil
+}
+
+// NewNoopLogger returns a Logger that discards every entry.
+// It is used when logging is disabled but callers still expect a non-nil Logger.
+// Fatal still terminates the process after discarding the entry, running the FatalHooks and
+// ExitFlush of opts first; other options are ignored.
+func NewNoopLogger(opts ...Option) Logger {
+	options := &Options{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	atomicLevel := zap.NewAtomicLevel()
+	nop := zap.NewNop()
+	if hook := newFatalHooks(options); hook != nil {
+		nop = nop.WithOptions(zap.WithFatalHook(hook))
+	}
 	return &logger{
-		logger: loggerInstance,
+		logger: nop,
 		level:  &atomicLevel,
-	}, nil
-}
\ No newline at end of file
+	}
+}
@@ -1,13 +1,18 @@
 package logger
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestLogger_Registry_NewLogger(t *testing.T) {
@@ -316,3 +321,162 @@ func TestLogger_Registry_NewLogger(t *testing.T) {
 		})
 	}
 }
+
+func TestLogger_Registry_NewLogger_Fields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithFields(map[string]interface{}{"k8s.pod.name": "api-0"}))
+	assert.NoError(t, err)
+
+	loggerInstance.Info("with fields", map[string]interface{}{"request_id": "123"})
+	loggerInstance.WithSpanContext(trace.SpanContext{}).Info("derived", nil)
+	assert.NoError(t, loggerInstance.Sync())
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	for decoder.More() {
+		var logEntry map[string]interface{}
+		assert.NoError(t, decoder.Decode(&logEntry))
+		assert.Equal(t, "api-0", logEntry["k8s.pod.name"], "every entry should carry the fields")
+	}
+}
+
+// logThroughHelper logs message through a helper frame, as code wrapping the Logger would, and
+// returns the caller the entry should report when the helper frame is skipped.
+func logThroughHelper(l Logger, message string) string {
+	_, file, line, _ := runtime.Caller(1)
+	l.Info(message, nil)
+	return filepath.Base(file) + ":" + strconv.Itoa(line)
+}
+
+func TestLogger_Registry_NewLogger_Caller(t *testing.T) {
+	tests := []struct {
+		name       string
+		enabled    bool
+		skip       int
+		wantHelper bool
+	}{
+		{"default skip reports the helper", true, 0, true},
+		{"skip reports the helper caller", true, 1, false},
+		{"disabled", false, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.log")
+			loggerInstance, err := NewLogger(WithOutputPath(path), WithCaller(tt.enabled, tt.skip))
+			assert.NoError(t, err)
+
+			wantCaller := logThroughHelper(loggerInstance, "entry")
+			_, _, stdLine, _ := runtime.Caller(0)
+			loggerInstance.StdLogger("info").Print("std entry")
+			assert.NoError(t, loggerInstance.Sync())
+
+			content, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			decoder := json.NewDecoder(bytes.NewReader(content))
+			var entries []map[string]interface{}
+			for decoder.More() {
+				var logEntry map[string]interface{}
+				assert.NoError(t, decoder.Decode(&logEntry))
+				entries = append(entries, logEntry)
+			}
+			if !assert.Len(t, entries, 2) {
+				return
+			}
+			caller, hasCaller := entries[0]["caller"].(string)
+			switch {
+			case !tt.enabled:
+				assert.False(t, hasCaller, "caller should be omitted")
+				return
+			case tt.wantHelper:
+				assert.NotContains(t, caller, wantCaller)
+				assert.Contains(t, caller, "registry_test.go")
+			default:
+				assert.Contains(t, caller, wantCaller)
+			}
+			assert.Contains(t, entries[1]["caller"], "registry_test.go:"+strconv.Itoa(stdLine+1), "StdLogger should keep pointing at its caller")
+		})
+	}
+}
+
+func TestLogger_Registry_NewLogger_StacktraceLevel(t *testing.T) {
+	tests := []struct {
+		level     string
+		wantWarn  bool
+		wantError bool
+	}{
+		{"", false, true},
+		{"warn", true, true},
+		{"fatal", false, false},
+		{StacktraceOff, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.log")
+			loggerInstance, err := NewLogger(WithOutputPath(path), WithStacktraceLevel(tt.level))
+			assert.NoError(t, err)
+
+			loggerInstance.Warn("warn entry", nil)
+			loggerInstance.Error("error entry", nil)
+			assert.NoError(t, loggerInstance.Sync())
+
+			content, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			decoder := json.NewDecoder(bytes.NewReader(content))
+			var got []bool
+			for decoder.More() {
+				var logEntry map[string]interface{}
+				assert.NoError(t, decoder.Decode(&logEntry))
+				_, hasStacktrace := logEntry["stacktrace"]
+				got = append(got, hasStacktrace)
+			}
+			assert.Equal(t, []bool{tt.wantWarn, tt.wantError}, got)
+		})
+	}
+}
+
+func TestLogger_Registry_NewLogger_Sampling(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{"default", nil},
+		{"async", []Option{WithAsync(2000, DropPolicyBlock)}},
+		{"schema", []Option{WithSchema(SchemaECS)}},
+		{"dedup", []Option{WithDedup(time.Hour)}},
+		{"redaction", []Option{WithRedaction("delete", "password")}},
+		{"rate limit", []Option{WithRateLimit("heartbeat", 1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.log")
+			loggerInstance, err := NewLogger(append([]Option{WithOutputPath(path)}, tt.opts...)...)
+			assert.NoError(t, err)
+
+			// The fields differ so dedup keeps every entry, while the sampler, keyed by level
+			// and message, writes the first 100 and every 100th after them.
+			for i := 0; i < 1000; i++ {
+				loggerInstance.Info("cache miss", map[string]interface{}{"i": i})
+			}
+			assert.NoError(t, loggerInstance.Sync())
+			assert.Len(t, readEntries(t, path), 109)
+		})
+	}
+}
+
+func TestLogger_Registry_NewNoopLogger(t *testing.T) {
+	loggerInstance := NewNoopLogger()
+	assert.NotNil(t, loggerInstance)
+
+	// Every method must be safe to call and produce no output.
+	loggerInstance.Debug("debug", map[string]interface{}{"key": "value"})
+	loggerInstance.Info("info", nil)
+	loggerInstance.Warn("warn", nil)
+	loggerInstance.Error("error", nil)
+	loggerInstance.SetLogLevel("debug")
+	assert.NotNil(t, loggerInstance.WithSpanContext(trace.SpanContext{}))
+	assert.NoError(t, loggerInstance.Sync())
+}
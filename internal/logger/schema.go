@@ -0,0 +1,182 @@
+package logger
+
+import (
+	"strconv"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Log schemas the standard fields of every entry can be named after.
+const (
+	SchemaECS     = "ecs"     // SchemaECS follows the Elastic Common Schema.
+	SchemaGCP     = "gcp"     // SchemaGCP follows the structured logging format of Google Cloud Logging.
+	SchemaDatadog = "datadog" // SchemaDatadog adds the trace correlation fields of Datadog.
+)
+
+// ecsVersion is the ECS version added to every entry as ecs.version.
+const ecsVersion = "8.11.0"
+
+// Field names Google Cloud Logging reads trace correlation from.
+const (
+	gcpTraceKey  = "logging.googleapis.com/trace"
+	gcpSpanIDKey = "logging.googleapis.com/spanId"
+)
+
+// applySchema renames the standard fields of config after options.Schema and returns the
+// option mapping the trace fields added by WithSpanContext and slog, or nil when no schema is
+// set.
+//
+// ECS entries carry @timestamp in RFC 3339, message, log.level, log.logger,
+// error.stack_trace, trace.id, span.id, and ecs.version.
+//
+// GCP entries carry time in RFC 3339, message, severity (DEBUG, INFO, WARNING, ERROR, and
+// CRITICAL, ALERT, and EMERGENCY for dpanic, panic, and fatal), stack_trace, and the trace as
+// logging.googleapis.com/trace, "projects/<GCPProjectID>/traces/<trace ID>", and
+// logging.googleapis.com/spanId. Without a GCPProjectID the bare trace ID is written, which Cloud
+// Logging does not correlate.
+//
+// Datadog entries keep the default names and add dd.trace_id and dd.span_id next to the trace
+// fields, in the unsigned 64-bit decimal form Datadog uses: the span ID, and the lower 64 bits
+// of the trace ID.
+func applySchema(options *Options, config *zap.Config) zap.Option {
+	var mapField fieldMapper
+	switch options.Schema {
+	case SchemaECS:
+		config.EncoderConfig.TimeKey = "@timestamp"
+		config.EncoderConfig.MessageKey = "message"
+		config.EncoderConfig.LevelKey = "log.level"
+		config.EncoderConfig.NameKey = "log.logger"
+		config.EncoderConfig.StacktraceKey = "error.stack_trace"
+		config.InitialFields = withField(config.InitialFields, "ecs.version", ecsVersion)
+		mapField = renameField(map[string]string{"traceID": "trace.id", "spanID": "span.id"})
+	case SchemaGCP:
+		config.EncoderConfig.TimeKey = "time"
+		config.EncoderConfig.MessageKey = "message"
+		config.EncoderConfig.LevelKey = "severity"
+		config.EncoderConfig.EncodeLevel = gcpSeverity
+		config.EncoderConfig.StacktraceKey = "stack_trace"
+		rename := renameField(map[string]string{"spanID": gcpSpanIDKey})
+		mapField = func(f zapcore.Field) []zapcore.Field {
+			if f.Key != "traceID" || f.Type != zapcore.StringType {
+				return rename(f)
+			}
+			trace := f.String
+			if options.GCPProjectID != "" {
+				trace = "projects/" + options.GCPProjectID + "/traces/" + trace
+			}
+			return []zapcore.Field{zap.String(gcpTraceKey, trace)}
+		}
+	case SchemaDatadog:
+		names := map[string]string{"traceID": "dd.trace_id", "spanID": "dd.span_id"}
+		mapField = func(f zapcore.Field) []zapcore.Field {
+			name, ok := names[f.Key]
+			if !ok || f.Type != zapcore.StringType {
+				return nil
+			}
+			return []zapcore.Field{f, zap.String(name, datadogID(f.String))}
+		}
+	default:
+		return nil
+	}
+	return zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &fieldMapCore{Core: core, mapField: mapField}
+	})
+}
+
+// gcpSeverity encodes a level as a Cloud Logging severity.
+func gcpSeverity(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+	switch level {
+	case zapcore.DebugLevel:
+		enc.AppendString("DEBUG")
+	case zapcore.InfoLevel:
+		enc.AppendString("INFO")
+	case zapcore.WarnLevel:
+		enc.AppendString("WARNING")
+	case zapcore.ErrorLevel:
+		enc.AppendString("ERROR")
+	case zapcore.DPanicLevel:
+		enc.AppendString("CRITICAL")
+	case zapcore.PanicLevel:
+		enc.AppendString("ALERT")
+	case zapcore.FatalLevel:
+		enc.AppendString("EMERGENCY")
+	default:
+		enc.AppendString("DEFAULT")
+	}
+}
+
+// datadogID converts a hexadecimal trace or span ID to the decimal form of its lower 64 bits.
+func datadogID(hex string) string {
+	if len(hex) > 16 {
+		hex = hex[len(hex)-16:]
+	}
+	id, err := strconv.ParseUint(hex, 16, 64)
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatUint(id, 10)
+}
+
+// withField returns a copy of fields with key set to value.
+func withField(fields map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	copied := map[string]interface{}{key: value}
+	for k, v := range fields {
+		copied[k] = v
+	}
+	return copied
+}
+
+// fieldMapper returns the fields replacing f, or nil to keep f as it is.
+type fieldMapper func(f zapcore.Field) []zapcore.Field
+
+// renameField returns a fieldMapper replacing the keys in names.
+func renameField(names map[string]string) fieldMapper {
+	return func(f zapcore.Field) []zapcore.Field {
+		name, ok := names[f.Key]
+		if !ok {
+			return nil
+		}
+		f.Key = name
+		return []zapcore.Field{f}
+	}
+}
+
+// fieldMapCore maps the fields written to the core it wraps with mapField.
+type fieldMapCore struct {
+	zapcore.Core
+	mapField fieldMapper
+}
+
+func (c *fieldMapCore) With(fields []zapcore.Field) zapcore.Core {
+	return &fieldMapCore{Core: c.Core.With(c.mapFields(fields)), mapField: c.mapField}
+}
+
+func (c *fieldMapCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checkWrapped(c, c.Core, entry, checked)
+}
+
+func (c *fieldMapCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, c.mapFields(fields))
+}
+
+// mapFields returns fields mapped with mapField, copying them only when one is replaced.
+func (c *fieldMapCore) mapFields(fields []zapcore.Field) []zapcore.Field {
+	var mapped []zapcore.Field
+	for i, f := range fields {
+		replacement := c.mapField(f)
+		switch {
+		case replacement != nil:
+			if mapped == nil {
+				mapped = append(make([]zapcore.Field, 0, len(fields)+len(replacement)), fields[:i]...)
+			}
+			mapped = append(mapped, replacement...)
+		case mapped != nil:
+			mapped = append(mapped, f)
+		}
+	}
+	if mapped == nil {
+		return fields
+	}
+	return mapped
+}
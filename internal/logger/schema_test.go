@@ -0,0 +1,151 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLogger_Schema_ECS(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(
+		WithOutputPath(path),
+		WithSchema(SchemaECS),
+		WithFields(map[string]interface{}{"k8s.pod.name": "api-0"}),
+	)
+	require.NoError(t, err)
+
+	span := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01},
+		SpanID:  trace.SpanID{0x02},
+	})
+	loggerInstance.WithSpanContext(span).Info("order created", map[string]interface{}{"order_id": "o-1"})
+	slog.New(loggerInstance.SlogHandler()).InfoContext(trace.ContextWithSpanContext(context.Background(), span), "from slog")
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.Equal(t, "info", entry["log.level"])
+		assert.Equal(t, span.TraceID().String(), entry["trace.id"])
+		assert.Equal(t, span.SpanID().String(), entry["span.id"])
+		assert.Equal(t, ecsVersion, entry["ecs.version"])
+		assert.Equal(t, "api-0", entry["k8s.pod.name"])
+		for _, key := range []string{"ts", "msg", "level", "traceID", "spanID"} {
+			assert.NotContains(t, entry, key)
+		}
+		_, err := time.Parse(time.RFC3339, entry["@timestamp"].(string))
+		assert.NoError(t, err, "@timestamp should be RFC 3339")
+	}
+	assert.Equal(t, "order created", entries[0]["message"])
+	assert.Equal(t, "o-1", entries[0]["order_id"])
+	assert.Equal(t, "from slog", entries[1]["message"])
+}
+
+func TestLogger_Schema_Default(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path))
+	require.NoError(t, err)
+
+	loggerInstance.Info("entry", nil)
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "entry", entries[0]["msg"])
+	assert.NotContains(t, entries[0], "ecs.version")
+}
+
+func TestLogger_Schema_GCP(t *testing.T) {
+	span := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01},
+		SpanID:  trace.SpanID{0x02},
+	})
+
+	tests := []struct {
+		name      string
+		project   string
+		wantTrace string
+	}{
+		{"with project", "my-project", "projects/my-project/traces/" + span.TraceID().String()},
+		{"without project", "", span.TraceID().String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.log")
+			loggerInstance, err := NewLogger(
+				WithLevel("debug"),
+				WithOutputPath(path),
+				WithSchema(SchemaGCP),
+				WithGCPProject(tt.project),
+			)
+			require.NoError(t, err)
+
+			spanLogger := loggerInstance.WithSpanContext(span)
+			spanLogger.Debug("debug entry", nil)
+			spanLogger.Warn("warn entry", nil)
+			spanLogger.Error("error entry", nil)
+			require.NoError(t, loggerInstance.Sync())
+
+			entries := readEntries(t, path)
+			require.Len(t, entries, 3)
+			var severities []interface{}
+			for _, entry := range entries {
+				severities = append(severities, entry["severity"])
+				assert.Equal(t, tt.wantTrace, entry["logging.googleapis.com/trace"])
+				assert.Equal(t, span.SpanID().String(), entry["logging.googleapis.com/spanId"])
+				assert.NotContains(t, entry, "traceID")
+				_, err := time.Parse(time.RFC3339Nano, entry["time"].(string))
+				assert.NoError(t, err, "time should be RFC 3339")
+			}
+			assert.Equal(t, []interface{}{"DEBUG", "WARNING", "ERROR"}, severities)
+			assert.Equal(t, "error entry", entries[2]["message"])
+			assert.Contains(t, entries[2], "stack_trace")
+		})
+	}
+}
+
+func TestLogger_Schema_Datadog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithSchema(SchemaDatadog))
+	require.NoError(t, err)
+
+	span := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x0f, 15: 0x01, 14: 0x01},
+		SpanID:  trace.SpanID{7: 0xff},
+	})
+	loggerInstance.WithSpanContext(span).Info("entry", nil)
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "257", entries[0]["dd.trace_id"], "only the lower 64 bits of the trace ID should be kept")
+	assert.Equal(t, "255", entries[0]["dd.span_id"])
+	assert.Equal(t, span.TraceID().String(), entries[0]["traceID"])
+	assert.Equal(t, "entry", entries[0]["msg"])
+}
+
+func TestLogger_Schema_DatadogID(t *testing.T) {
+	tests := []struct {
+		hex  string
+		want string
+	}{
+		{"00000000000000ff", "255"},
+		{"0af7651916cd43dd8448eb211c80319c", "9532127138774266268"},
+		{"ffffffffffffffff", "18446744073709551615"},
+		{"invalid", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.hex, func(t *testing.T) {
+			assert.Equal(t, tt.want, datadogID(tt.hex))
+		})
+	}
+}
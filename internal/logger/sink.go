@@ -0,0 +1,214 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Sinks a logger can write to instead of its output path.
+const (
+	SinkSyslog   = "syslog"   // SinkSyslog sends entries to a syslog daemon.
+	SinkJournald = "journald" // SinkJournald sends entries to the systemd journal.
+)
+
+// journaldSocket is the socket of the systemd journal native protocol. Tests replace it.
+var journaldSocket = "/run/systemd/journal/socket"
+
+// syslogFacilities are the syslog facility names and codes accepted in Options.SyslogFacility.
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19,
+	"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// syslogSeverity returns the syslog severity of a zap level: debug 7, info 6, warn 4, error 3,
+// and 2 (critical) for dpanic, panic, and fatal.
+func syslogSeverity(level zapcore.Level) int {
+	switch {
+	case level <= zapcore.DebugLevel:
+		return 7
+	case level == zapcore.InfoLevel:
+		return 6
+	case level == zapcore.WarnLevel:
+		return 4
+	case level == zapcore.ErrorLevel:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// sinkIdentifier returns the program name entries are tagged with: tag, or the executable name
+// when tag is empty.
+func sinkIdentifier(tag string) string {
+	if tag != "" {
+		return tag
+	}
+	return filepath.Base(os.Args[0])
+}
+
+// sinkCloser is implemented by the sink cores that run a goroutine, which close stops after
+// writing the buffered entries.
+type sinkCloser interface {
+	close() error
+}
+
+// newSinkCore returns a function replacing the core zap.Config builds with one writing to the
+// sink of options, using the encoder, level, sampling, and initial fields of config, and the
+// function stopping the goroutine of the sink, or nil when it runs none.
+func newSinkCore(options *Options, config zap.Config) (func(core zapcore.Core) zapcore.Core, func() error, error) {
+	var (
+		core zapcore.Core
+		err  error
+	)
+	switch options.Sink {
+	case SinkSyslog:
+		core, err = newSyslogCore(options, zapcore.NewJSONEncoder(config.EncoderConfig), config.Level)
+	case SinkJournald:
+		core, err = newJournaldCore(options, config.Level)
+	case SinkLoki:
+		core, err = newLokiCore(options, zapcore.NewJSONEncoder(config.EncoderConfig), config.Level)
+	case SinkKafka:
+		core = newKafkaCore(options, zapcore.NewJSONEncoder(config.EncoderConfig), config.Level)
+	default:
+		return nil, nil, ErrInvalidSink
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var closeSink func() error
+	if c, ok := core.(sinkCloser); ok {
+		closeSink = c.close
+	}
+	core = withConfig(core, config)
+	return func(zapcore.Core) zapcore.Core { return core }, closeSink, nil
+}
+
+// journaldCore writes entries to the systemd journal with the native protocol, so the message,
+// priority, caller, and every field become separate, queryable journal fields.
+type journaldCore struct {
+	zapcore.LevelEnabler
+	conn       *net.UnixConn
+	identifier string
+	fields     []zapcore.Field
+}
+
+// newJournaldCore connects to the journal socket. It fails when the journal is not running.
+func newJournaldCore(options *Options, level zapcore.LevelEnabler) (*journaldCore, error) {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: journaldSocket, Net: "unixgram"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to journald: %w", err)
+	}
+	return &journaldCore{
+		LevelEnabler: level,
+		conn:         conn,
+		identifier:   sinkIdentifier(options.SyslogTag),
+	}, nil
+}
+
+func (c *journaldCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.fields = append(append([]zapcore.Field(nil), c.fields...), fields...)
+	return &clone
+}
+
+func (c *journaldCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+// Write sends entry as one datagram. Field names are upper-cased with every character other
+// than letters, digits, and "_" replaced by "_", as journald requires; values that are not
+// strings are JSON encoded.
+func (c *journaldCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range c.fields {
+		f.AddTo(enc)
+	}
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+
+	var msg bytes.Buffer
+	writeJournalField(&msg, "MESSAGE", entry.Message)
+	writeJournalField(&msg, "PRIORITY", strconv.Itoa(syslogSeverity(entry.Level)))
+	writeJournalField(&msg, "SYSLOG_IDENTIFIER", c.identifier)
+	if entry.Caller.Defined {
+		writeJournalField(&msg, "CODE_FILE", entry.Caller.File)
+		writeJournalField(&msg, "CODE_LINE", strconv.Itoa(entry.Caller.Line))
+		writeJournalField(&msg, "CODE_FUNC", entry.Caller.Function)
+	}
+	if entry.Stack != "" {
+		writeJournalField(&msg, "STACKTRACE", entry.Stack)
+	}
+	for key, value := range enc.Fields {
+		name := journalFieldName(key)
+		if name == "" {
+			continue
+		}
+		if s, ok := value.(string); ok {
+			writeJournalField(&msg, name, s)
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			encoded = []byte(fmt.Sprint(value))
+		}
+		writeJournalField(&msg, name, string(encoded))
+	}
+
+	if _, err := c.conn.Write(msg.Bytes()); err != nil {
+		return fmt.Errorf("failed to write to journald: %w", err)
+	}
+	return nil
+}
+
+func (c *journaldCore) Sync() error {
+	return nil
+}
+
+// journalFieldName converts key to a journal field name: upper case letters, digits, and "_",
+// not starting with "_" or a digit. It returns "" when nothing is left.
+func journalFieldName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+	return strings.TrimLeft(name, "_0123456789")
+}
+
+// writeJournalField appends a field in the journal native protocol: "NAME=value\n", or for
+// values containing a newline, the name, a newline, the little-endian 64-bit length of the
+// value, the value, and a newline.
+func writeJournalField(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(name)
+	if !strings.Contains(value, "\n") {
+		buf.WriteByte('=')
+		buf.WriteString(value)
+		buf.WriteByte('\n')
+		return
+	}
+	buf.WriteByte('\n')
+	_ = binary.Write(buf, binary.LittleEndian, uint64(len(value)))
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+}
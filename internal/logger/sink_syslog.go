@@ -0,0 +1,74 @@
+//go:build !windows && !plan9
+
+package logger
+
+import (
+	"fmt"
+	"log/syslog"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// syslogCore writes JSON-encoded entries to a syslog daemon with the priority of their level.
+type syslogCore struct {
+	zapcore.LevelEnabler
+	enc    zapcore.Encoder
+	writer *syslog.Writer
+}
+
+// newSyslogCore connects to the syslog daemon of options: the local daemon when
+// SyslogAddress is empty, otherwise SyslogAddress over SyslogNetwork ("udp", "tcp", or "unix").
+func newSyslogCore(options *Options, enc zapcore.Encoder, level zapcore.LevelEnabler) (zapcore.Core, error) {
+	facility := syslogFacilities[options.SyslogFacility]
+	if options.SyslogFacility == "" {
+		facility = syslogFacilities["user"]
+	}
+	writer, err := syslog.Dial(options.SyslogNetwork, options.SyslogAddress, syslog.Priority(facility<<3), sinkIdentifier(options.SyslogTag))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	return &syslogCore{LevelEnabler: level, enc: enc, writer: writer}, nil
+}
+
+func (c *syslogCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &syslogCore{LevelEnabler: c.LevelEnabler, enc: c.enc.Clone(), writer: c.writer}
+	for _, f := range fields {
+		f.AddTo(clone.enc)
+	}
+	return clone
+}
+
+func (c *syslogCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+// Write sends the JSON entry with the syslog severity of its level; see syslogSeverity.
+func (c *syslogCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.enc.EncodeEntry(entry, fields)
+	if err != nil {
+		return err
+	}
+	defer buf.Free()
+	msg := strings.TrimSuffix(buf.String(), "\n")
+
+	switch syslogSeverity(entry.Level) {
+	case 7:
+		return c.writer.Debug(msg)
+	case 6:
+		return c.writer.Info(msg)
+	case 4:
+		return c.writer.Warning(msg)
+	case 3:
+		return c.writer.Err(msg)
+	default:
+		return c.writer.Crit(msg)
+	}
+}
+
+func (c *syslogCore) Sync() error {
+	return nil
+}
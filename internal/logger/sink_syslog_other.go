@@ -0,0 +1,10 @@
+//go:build windows || plan9
+
+package logger
+
+import "go.uber.org/zap/zapcore"
+
+// newSyslogCore returns ErrSinkUnsupported: log/syslog is not available on this platform.
+func newSyslogCore(options *Options, enc zapcore.Encoder, level zapcore.LevelEnabler) (zapcore.Core, error) {
+	return nil, ErrSinkUnsupported
+}
@@ -0,0 +1,149 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogger_Sink_SyslogSeverity(t *testing.T) {
+	tests := []struct {
+		level zapcore.Level
+		want  int
+	}{
+		{zapcore.DebugLevel, 7},
+		{zapcore.InfoLevel, 6},
+		{zapcore.WarnLevel, 4},
+		{zapcore.ErrorLevel, 3},
+		{zapcore.DPanicLevel, 2},
+		{zapcore.PanicLevel, 2},
+		{zapcore.FatalLevel, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, syslogSeverity(tt.level))
+		})
+	}
+}
+
+func TestLogger_Sink_JournalFieldName(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"user_id", "USER_ID"},
+		{"k8s.pod.name", "K8S_POD_NAME"},
+		{"_private", "PRIVATE"},
+		{"1st", "ST"},
+		{"...", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.want, journalFieldName(tt.key))
+		})
+	}
+}
+
+func TestLogger_Sink_Syslog(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	loggerInstance, err := NewLogger(
+		WithLevel("debug"),
+		WithSink(SinkSyslog),
+		WithSyslog("udp", conn.LocalAddr().String(), "local0", "api"),
+		WithFields(map[string]interface{}{"service": "api"}),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Warn("disk almost full", map[string]interface{}{"free": 5})
+
+	buf := make([]byte, 2048)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	packet := string(buf[:n])
+
+	// local0 (16) * 8 + warning (4)
+	assert.True(t, strings.HasPrefix(packet, "<132>"), "unexpected priority in %q", packet)
+	assert.Contains(t, packet, "api[")
+	assert.Contains(t, packet, `"msg":"disk almost full"`)
+	assert.Contains(t, packet, `"free":5`)
+	assert.Contains(t, packet, `"service":"api"`)
+}
+
+func TestLogger_Sink_Journald(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "journal.socket")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	previous := journaldSocket
+	journaldSocket = socket
+	defer func() { journaldSocket = previous }()
+
+	loggerInstance, err := NewLogger(
+		WithSink(SinkJournald),
+		WithSyslog("", "", "", "api"),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Error("payment failed", map[string]interface{}{
+		"order.id": "o-1",
+		"amount":   12.5,
+		"detail":   "line one\nline two",
+	})
+
+	buf := make([]byte, 8192)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	fields := parseJournalFields(t, buf[:n])
+
+	assert.Equal(t, "payment failed", fields["MESSAGE"])
+	assert.Equal(t, "3", fields["PRIORITY"])
+	assert.Equal(t, "api", fields["SYSLOG_IDENTIFIER"])
+	assert.Equal(t, "o-1", fields["ORDER_ID"])
+	assert.Equal(t, "12.5", fields["AMOUNT"])
+	assert.Equal(t, "line one\nline two", fields["DETAIL"])
+	assert.Contains(t, fields["CODE_FILE"], "sink_test.go")
+}
+
+func TestLogger_Sink_JournaldUnavailable(t *testing.T) {
+	previous := journaldSocket
+	journaldSocket = filepath.Join(t.TempDir(), "missing.socket")
+	defer func() { journaldSocket = previous }()
+
+	_, err := NewLogger(WithSink(SinkJournald))
+	assert.Error(t, err)
+}
+
+// parseJournalFields decodes a journal native protocol datagram.
+func parseJournalFields(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	fields := make(map[string]string)
+	for len(data) > 0 {
+		line, rest, _ := bytes.Cut(data, []byte("\n"))
+		if name, value, ok := bytes.Cut(line, []byte("=")); ok {
+			fields[string(name)] = string(value)
+			data = rest
+			continue
+		}
+		require.GreaterOrEqual(t, len(rest), 8, "truncated binary field %s", line)
+		size := binary.LittleEndian.Uint64(rest[:8])
+		fields[string(line)] = string(rest[8 : 8+size])
+		data = rest[8+size+1:]
+	}
+	return fields
+}
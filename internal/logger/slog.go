@@ -0,0 +1,189 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// slogHandler is a slog.Handler writing through a zap logger, so records from log/slog share
+// the encoding, output, and level of the Logger they were created from.
+// Attributes added outside any group are attached to logger; groups opened with WithGroup are
+// kept separately so trace correlation fields stay at the top level of every entry.
+type slogHandler struct {
+	logger *zap.Logger
+	groups []slogGroup // groups are the open groups, outermost first.
+}
+
+// slogGroup is a group opened with WithGroup and the attributes added to it with WithAttrs.
+type slogGroup struct {
+	name   string
+	fields []zap.Field
+}
+
+// zapFields marshals a list of fields as a nested object.
+type zapFields []zap.Field
+
+// MarshalLogObject adds every field to enc.
+func (f zapFields) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, field := range f {
+		field.AddTo(enc)
+	}
+	return nil
+}
+
+// SlogHandler returns a slog.Handler that writes through this logger.
+// Records use the logger's output, JSON encoding, and level, which still follows SetLogLevel
+// and Reload. When the context passed to slog carries a valid span, the traceID and spanID
+// fields are added as WithSpanContext does. slog levels map to the nearest zap level at or
+// below them; records above error are logged at error level and never exit the process.
+//
+// Returns:
+//   - A slog.Handler backed by this logger
+//
+// Example:
+//
+//	slog.SetDefault(slog.New(logger.SlogHandler()))
+//	slog.InfoContext(ctx, "Request completed", "status_code", 200)
+func (l *logger) SlogHandler() slog.Handler {
+	// The caller is taken from the slog record, since zap's own caller lookup would point
+	// into the handler.
+	return &slogHandler{logger: l.logger.WithOptions(zap.WithCaller(false))}
+}
+
+// Enabled reports whether the logger emits records at level.
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.logger.Core().Enabled(zapLevel(level))
+}
+
+// Handle writes r, adding trace correlation fields from ctx.
+func (h *slogHandler) Handle(ctx context.Context, r slog.Record) error {
+	ce := h.logger.Check(zapLevel(r.Level), r.Message)
+	if ce == nil {
+		return nil
+	}
+	if !r.Time.IsZero() {
+		ce.Time = r.Time
+	}
+	if r.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{r.PC}).Next()
+		ce.Caller = zapcore.NewEntryCaller(frame.PC, frame.File, frame.Line, true)
+		ce.Caller.Function = frame.Function
+	}
+
+	fields := make([]zap.Field, 0, r.NumAttrs())
+	r.Attrs(func(attr slog.Attr) bool {
+		fields = appendAttr(fields, attr)
+		return true
+	})
+	// Nest the record attributes in the open groups, innermost first; empty groups are omitted.
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		group := h.groups[i]
+		inner := append(group.fields[:len(group.fields):len(group.fields)], fields...)
+		if len(inner) == 0 {
+			fields = nil
+			continue
+		}
+		fields = []zap.Field{zap.Object(group.name, zapFields(inner))}
+	}
+
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		fields = append([]zap.Field{
+			zap.String("traceID", span.TraceID().String()),
+			zap.String("spanID", span.SpanID().String()),
+		}, fields...)
+	}
+	ce.Write(fields...)
+	return nil
+}
+
+// WithAttrs returns a handler that adds attrs to every record, inside the innermost open group.
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make([]zap.Field, 0, len(attrs))
+	for _, attr := range attrs {
+		fields = appendAttr(fields, attr)
+	}
+	if len(h.groups) == 0 {
+		return &slogHandler{logger: h.logger.With(fields...)}
+	}
+
+	groups := append([]slogGroup(nil), h.groups...)
+	last := &groups[len(groups)-1]
+	last.fields = append(last.fields[:len(last.fields):len(last.fields)], fields...)
+	return &slogHandler{logger: h.logger, groups: groups}
+}
+
+// WithGroup returns a handler that nests the attributes added afterwards under name.
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	groups := append(h.groups[:len(h.groups):len(h.groups)], slogGroup{name: name})
+	return &slogHandler{logger: h.logger, groups: groups}
+}
+
+// zapLevel maps a slog level to the nearest zap level at or below it.
+// Levels above error map to error, so slog can never trigger zap's fatal exit.
+func zapLevel(level slog.Level) zapcore.Level {
+	switch {
+	case level < slog.LevelInfo:
+		return zapcore.DebugLevel
+	case level < slog.LevelWarn:
+		return zapcore.InfoLevel
+	case level < slog.LevelError:
+		return zapcore.WarnLevel
+	default:
+		return zapcore.ErrorLevel
+	}
+}
+
+// appendAttr converts attr into zap fields and appends them to fields, following the
+// slog.Handler rules: empty attributes are dropped and groups with an empty key are inlined.
+func appendAttr(fields []zap.Field, attr slog.Attr) []zap.Field {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return fields
+	}
+
+	switch attr.Value.Kind() {
+	case slog.KindGroup:
+		group := attr.Value.Group()
+		if len(group) == 0 {
+			return fields
+		}
+		if attr.Key == "" {
+			for _, a := range group {
+				fields = appendAttr(fields, a)
+			}
+			return fields
+		}
+		var nested []zap.Field
+		for _, a := range group {
+			nested = appendAttr(nested, a)
+		}
+		return append(fields, zap.Object(attr.Key, zapFields(nested)))
+	case slog.KindString:
+		return append(fields, zap.String(attr.Key, attr.Value.String()))
+	case slog.KindInt64:
+		return append(fields, zap.Int64(attr.Key, attr.Value.Int64()))
+	case slog.KindUint64:
+		return append(fields, zap.Uint64(attr.Key, attr.Value.Uint64()))
+	case slog.KindFloat64:
+		return append(fields, zap.Float64(attr.Key, attr.Value.Float64()))
+	case slog.KindBool:
+		return append(fields, zap.Bool(attr.Key, attr.Value.Bool()))
+	case slog.KindDuration:
+		return append(fields, zap.Duration(attr.Key, attr.Value.Duration()))
+	case slog.KindTime:
+		return append(fields, zap.Time(attr.Key, attr.Value.Time()))
+	default:
+		if err, ok := attr.Value.Any().(error); ok {
+			return append(fields, zap.NamedError(attr.Key, err))
+		}
+		return append(fields, zap.Any(attr.Key, attr.Value.Any()))
+	}
+}
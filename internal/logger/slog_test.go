@@ -0,0 +1,114 @@
+package logger
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+)
+
+// readEntries decodes the JSON log entries written to path.
+func readEntries(t *testing.T, path string) []map[string]interface{} {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var entries []map[string]interface{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestLogger_Slog_SlogHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithLevel("info"), WithOutputPath(path))
+	require.NoError(t, err)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	log := slog.New(loggerInstance.SlogHandler())
+	log.Debug("dropped by level")
+	log.With("component", "billing").WithGroup("request").InfoContext(ctx, "request completed",
+		"status", 200,
+		"duration", 150*time.Millisecond,
+		slog.Group("user", "id", "u-1"),
+	)
+	log.Error("payment failed", "error", errors.New("card declined"))
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+
+	info := entries[0]
+	require.Equal(t, "info", info["level"])
+	require.Equal(t, "request completed", info["msg"])
+	require.Equal(t, "billing", info["component"])
+	require.Equal(t, spanContext.TraceID().String(), info["traceID"])
+	require.Equal(t, spanContext.SpanID().String(), info["spanID"])
+	require.Contains(t, info["caller"], "logger/slog_test.go:")
+	request, ok := info["request"].(map[string]interface{})
+	require.True(t, ok, "expected request group, got %v", info["request"])
+	require.Equal(t, float64(200), request["status"])
+	require.Equal(t, map[string]interface{}{"id": "u-1"}, request["user"])
+
+	failure := entries[1]
+	require.Equal(t, "error", failure["level"])
+	require.Equal(t, "card declined", failure["error"])
+	require.NotContains(t, failure, "traceID")
+}
+
+func TestLogger_Slog_Enabled(t *testing.T) {
+	loggerInstance, err := NewLogger(WithLevel("warn"))
+	require.NoError(t, err)
+	handler := loggerInstance.SlogHandler()
+
+	require.False(t, handler.Enabled(context.Background(), slog.LevelInfo))
+	require.True(t, handler.Enabled(context.Background(), slog.LevelWarn))
+
+	// The handler follows runtime level changes.
+	loggerInstance.SetLogLevel("debug")
+	require.True(t, handler.Enabled(context.Background(), slog.LevelDebug))
+}
+
+func TestLogger_Slog_ZapLevel(t *testing.T) {
+	tests := []struct {
+		level slog.Level
+		want  zapcore.Level
+	}{
+		{slog.LevelDebug - 4, zapcore.DebugLevel},
+		{slog.LevelDebug, zapcore.DebugLevel},
+		{slog.LevelInfo, zapcore.InfoLevel},
+		{slog.LevelInfo + 2, zapcore.InfoLevel},
+		{slog.LevelWarn, zapcore.WarnLevel},
+		{slog.LevelError, zapcore.ErrorLevel},
+		{slog.LevelError + 4, zapcore.ErrorLevel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			require.Equal(t, tt.want, zapLevel(tt.level))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// splitCore writes entries at warn level and above to their own core, so they can be routed to
+// stderr or a separate file while lower levels keep the main output.
+type splitCore struct {
+	low  zapcore.Core // low writes debug and info entries.
+	high zapcore.Core // high writes warn, error, and fatal entries.
+}
+
+// newSplitCore opens path, which may be "stderr", "stdout", or a file, and returns a function
+// wrapping the main core of config with a splitCore writing warn entries and above to it. The
+// error output uses the encoder, level, sampling, and initial fields of config.
+func newSplitCore(path string, config zap.Config) (func(core zapcore.Core) zapcore.Core, error) {
+	sink, _, err := zap.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open error output: %w", err)
+	}
+	high := withConfig(zapcore.NewCore(zapcore.NewJSONEncoder(config.EncoderConfig), sink, config.Level), config)
+	return func(core zapcore.Core) zapcore.Core {
+		return &splitCore{low: core, high: high}
+	}, nil
+}
+
+// withConfig applies the sampling and initial fields of config to a core built next to the one
+// zap.Config builds, so entries are sampled and annotated the same way on every output.
+func withConfig(core zapcore.Core, config zap.Config) zapcore.Core {
+	if s := config.Sampling; s != nil {
+		core = zapcore.NewSamplerWithOptions(core, time.Second, s.Initial, s.Thereafter)
+	}
+	return core.With(initialFields(config.InitialFields))
+}
+
+// initialFields returns fields as zap fields sorted by key, as zap.Config adds them to its core.
+func initialFields(fields map[string]interface{}) []zapcore.Field {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	zapFields := make([]zapcore.Field, 0, len(keys))
+	for _, k := range keys {
+		zapFields = append(zapFields, zap.Any(k, fields[k]))
+	}
+	return zapFields
+}
+
+// route returns the core writing entries at level.
+func (c *splitCore) route(level zapcore.Level) zapcore.Core {
+	if level >= zapcore.WarnLevel {
+		return c.high
+	}
+	return c.low
+}
+
+func (c *splitCore) Enabled(level zapcore.Level) bool {
+	return c.route(level).Enabled(level)
+}
+
+func (c *splitCore) With(fields []zapcore.Field) zapcore.Core {
+	return &splitCore{low: c.low.With(fields), high: c.high.With(fields)}
+}
+
+func (c *splitCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return c.route(entry.Level).Check(entry, checked)
+}
+
+func (c *splitCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.route(entry.Level).Write(entry, fields)
+}
+
+func (c *splitCore) Sync() error {
+	return errors.Join(c.low.Sync(), c.high.Sync())
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLogger_Split_ErrorOutputPath(t *testing.T) {
+	dir := t.TempDir()
+	outPath := filepath.Join(dir, "app.log")
+	errPath := filepath.Join(dir, "error.log")
+	loggerInstance, err := NewLogger(
+		WithLevel("debug"),
+		WithOutputPath(outPath),
+		WithErrorOutputPath(errPath),
+		WithFields(map[string]interface{}{"k8s.pod.name": "api-0"}),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Debug("debug entry", nil)
+	loggerInstance.Info("info entry", nil)
+	loggerInstance.Warn("warn entry", nil)
+	loggerInstance.WithSpanContext(trace.SpanContext{}).Error("error entry", nil)
+	require.NoError(t, loggerInstance.Sync())
+
+	var out []string
+	for _, entry := range readEntries(t, outPath) {
+		out = append(out, entry["msg"].(string))
+	}
+	assert.Equal(t, []string{"debug entry", "info entry"}, out)
+
+	errEntries := readEntries(t, errPath)
+	var errs []string
+	for _, entry := range errEntries {
+		errs = append(errs, entry["msg"].(string))
+		assert.Equal(t, "api-0", entry["k8s.pod.name"], "error entries should carry the initial fields")
+	}
+	assert.Equal(t, []string{"warn entry", "error entry"}, errs)
+	assert.Contains(t, errEntries[1], "traceID", "derived loggers should keep their fields on the error output")
+}
+
+func TestLogger_Split_ErrorOutputPath_Level(t *testing.T) {
+	dir := t.TempDir()
+	errPath := filepath.Join(dir, "error.log")
+	loggerInstance, err := NewLogger(
+		WithLevel("error"),
+		WithOutputPath(filepath.Join(dir, "app.log")),
+		WithErrorOutputPath(errPath),
+	)
+	require.NoError(t, err)
+
+	loggerInstance.Warn("below level", nil)
+	loggerInstance.Error("at level", nil)
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, errPath)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "at level", entries[0]["msg"])
+}
+
+func TestLogger_Split_ErrorOutputPath_Invalid(t *testing.T) {
+	_, err := NewLogger(WithErrorOutputPath(filepath.Join(t.TempDir(), "missing", "error.log")))
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"fmt"
+	"log"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// StdLogger returns a standard library *log.Logger whose output is written through this
+// logger at the given level, for third-party code that only accepts a *log.Logger
+// (e.g. http.Server.ErrorLog). An invalid level defaults to info, as in SetLogLevel.
+//
+// Parameters:
+//   - level: The level entries are logged at ("debug", "info", "warn", "error", "fatal")
+//
+// Returns:
+//   - A *log.Logger backed by this logger
+//
+// Example:
+//
+//	server := &http.Server{
+//	    Addr:     ":8080",
+//	    ErrorLog: logger.StdLogger("error"),
+//	}
+func (l *logger) StdLogger(level string) *log.Logger {
+	logLevel, err := zapcore.ParseLevel(level)
+	if err != nil {
+		l.Info(fmt.Sprintf("Invalid log level: %s, defaulting to INFO", level), nil)
+		logLevel = zapcore.InfoLevel
+	}
+	stdLogger, err := zap.NewStdLogAt(l.stdLogBase(), logLevel)
+	if err != nil {
+		// NewStdLogAt only rejects levels that ParseLevel does not produce.
+		return zap.NewStdLog(l.stdLogBase())
+	}
+	return stdLogger
+}
+
+// stdLogBase returns the zap logger to hand to zap's standard library bridges. They add their
+// own caller skip, so the skip added for the Logger methods, including the one of WithCaller, is
+// removed to keep the caller pointing at the code that called the standard library logger.
+func (l *logger) stdLogBase() *zap.Logger {
+	return l.logger.WithOptions(zap.AddCallerSkip(-1 - l.callerSkip))
+}
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"log"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_StdLog_StdLogger(t *testing.T) {
+	tests := []struct {
+		name      string
+		level     string
+		wantLevel string
+	}{
+		{name: "warn level", level: "warn", wantLevel: "warn"},
+		{name: "error level", level: "error", wantLevel: "error"},
+		{name: "invalid level defaults to info", level: "invalid", wantLevel: "info"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.log")
+			loggerInstance, err := NewLogger(WithLevel("info"), WithOutputPath(path))
+			require.NoError(t, err)
+
+			loggerInstance.StdLogger(tt.level).Printf("connection reset by %s", "peer")
+			require.NoError(t, loggerInstance.Sync())
+
+			entries := readEntries(t, path)
+			last := entries[len(entries)-1]
+			require.Equal(t, tt.wantLevel, last["level"])
+			require.Equal(t, "connection reset by peer", last["msg"])
+			require.Contains(t, last["caller"], "logger/stdlog_test.go:")
+		})
+	}
+}
+
+func TestLogger_StdLog_CaptureStdLog(t *testing.T) {
+	flags, prefix, writer := log.Flags(), log.Prefix(), log.Writer()
+	t.Cleanup(func() {
+		log.SetFlags(flags)
+		log.SetPrefix(prefix)
+		log.SetOutput(writer)
+	})
+
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithCaptureStdLog(true))
+	require.NoError(t, err)
+
+	log.Print("legacy dependency message")
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	require.Equal(t, "info", entries[0]["level"])
+	require.Equal(t, "legacy dependency message", entries[0]["msg"])
+	require.Contains(t, entries[0]["caller"], "logger/stdlog_test.go:")
+}
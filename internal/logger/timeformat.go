@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// DefaultTimeLayout is the timestamp layout of entries without a TimeLayout or schema: RFC 3339
+// with milliseconds and the offset always written as digits (+0000 rather than Z).
+const DefaultTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// Time formats writing entry timestamps as numbers instead of with a layout.
+const (
+	TimeFormatEpoch       = "epoch"        // TimeFormatEpoch writes seconds since the Unix epoch, with a fraction.
+	TimeFormatEpochMillis = "epoch_millis" // TimeFormatEpochMillis writes whole milliseconds since the Unix epoch.
+	TimeFormatEpochNanos  = "epoch_nanos"  // TimeFormatEpochNanos writes whole nanoseconds since the Unix epoch.
+)
+
+// timeLayout returns the timestamp layout of options: TimeLayout, or else the layout of the
+// schema, or else DefaultTimeLayout.
+func timeLayout(options *Options) string {
+	if options.TimeLayout != "" {
+		return options.TimeLayout
+	}
+	switch options.Schema {
+	case SchemaECS:
+		return "2006-01-02T15:04:05.000Z07:00"
+	case SchemaGCP:
+		return time.RFC3339Nano
+	}
+	return DefaultTimeLayout
+}
+
+// newTimeEncoder returns the encoder writing timestamps with layout, or as a number for the
+// epoch formats, in location; a nil location keeps the local time zone.
+func newTimeEncoder(layout string, location *time.Location) zapcore.TimeEncoder {
+	return func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+		switch layout {
+		case TimeFormatEpoch:
+			enc.AppendFloat64(float64(t.UnixNano()) / float64(time.Second))
+		case TimeFormatEpochMillis:
+			enc.AppendInt64(t.UnixMilli())
+		case TimeFormatEpochNanos:
+			enc.AppendInt64(t.UnixNano())
+		default:
+			if location != nil {
+				t = t.In(location)
+			}
+			enc.AppendString(t.Format(layout))
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogger_TimeFormat_NewTimeEncoder(t *testing.T) {
+	ts := time.Date(2024, 3, 1, 12, 30, 45, 123456789, time.FixedZone("WIB", 7*60*60))
+
+	tests := []struct {
+		name     string
+		layout   string
+		location *time.Location
+		want     interface{}
+	}{
+		{"default layout", DefaultTimeLayout, nil, "2024-03-01T12:30:45.123+0700"},
+		{"utc with Z", "2006-01-02T15:04:05.000Z07:00", time.UTC, "2024-03-01T05:30:45.123Z"},
+		{"epoch", TimeFormatEpoch, time.UTC, 1709271045.123456789},
+		{"epoch millis", TimeFormatEpochMillis, nil, float64(1709271045123)},
+		{"epoch nanos", TimeFormatEpochNanos, nil, float64(1709271045123456789)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc := zapcore.NewJSONEncoder(zapcore.EncoderConfig{TimeKey: "ts", EncodeTime: newTimeEncoder(tt.layout, tt.location)})
+			buf, err := enc.EncodeEntry(zapcore.Entry{Time: ts}, nil)
+			require.NoError(t, err)
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			assert.Equal(t, tt.want, entry["ts"])
+		})
+	}
+}
+
+func TestLogger_TimeFormat_TimeLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		want    string
+	}{
+		{"default", Options{}, DefaultTimeLayout},
+		{"ecs schema", Options{Schema: SchemaECS}, "2006-01-02T15:04:05.000Z07:00"},
+		{"gcp schema", Options{Schema: SchemaGCP}, time.RFC3339Nano},
+		{"layout overrides schema", Options{Schema: SchemaECS, TimeLayout: TimeFormatEpochMillis}, TimeFormatEpochMillis},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, timeLayout(&tt.options))
+		})
+	}
+}
+
+func TestLogger_TimeFormat_NewLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	loggerInstance, err := NewLogger(WithOutputPath(path), WithTimeFormat(TimeFormatEpochMillis, nil))
+	require.NoError(t, err)
+
+	before := time.Now().UnixMilli()
+	loggerInstance.Info("entry", nil)
+	require.NoError(t, loggerInstance.Sync())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	ts, ok := entries[0]["ts"].(float64)
+	require.True(t, ok, "ts should be a number, got %v", entries[0]["ts"])
+	assert.GreaterOrEqual(t, int64(ts), before)
+}
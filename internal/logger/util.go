@@ -1,6 +1,9 @@
 package logger
 
-import "go.uber.org/zap"
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
 
 // convertFields converts a map[string]interface{} into a slice of zap.Field,
 // producing one zap.Field for each map entry. If the input is nil, convertFields returns nil.
@@ -13,4 +16,14 @@ func convertFields(fields map[string]interface{}) []zap.Field {
 		zapFields = append(zapFields, zap.Any(k, v))
 	}
 	return zapFields
-}
\ No newline at end of file
+}
+
+// checkWrapped adds wrapper to checked when the core it wraps accepts entry. The Check of the
+// wrapped core is run rather than only its Enabled, so the sampler zap.Config installs below
+// the wrapper still drops the entries it samples out.
+func checkWrapped(wrapper, core zapcore.Core, entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if core.Check(entry, nil) == nil {
+		return checked
+	}
+	return checked.AddCore(entry, wrapper)
+}
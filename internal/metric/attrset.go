@@ -0,0 +1,32 @@
+package metric
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+)
+
+// AttributeSet is a reusable set of metric labels created by Metric.NewAttributeSet.
+// The labels are sorted and de-duplicated once and the measurement options built from them
+// are kept, so recording with an AttributeSet does not allocate. The zero value records
+// without labels. An AttributeSet is safe for concurrent use.
+type AttributeSet struct {
+	set    attribute.Set
+	add    []otelmetric.AddOption
+	record []otelmetric.RecordOption
+}
+
+// newAttributeSet builds an AttributeSet from kvs; when a key repeats, the last value wins.
+func newAttributeSet(kvs ...attribute.KeyValue) AttributeSet {
+	set := attribute.NewSet(kvs...)
+	opt := otelmetric.WithAttributeSet(set)
+	return AttributeSet{
+		set:    set,
+		add:    []otelmetric.AddOption{opt},
+		record: []otelmetric.RecordOption{opt},
+	}
+}
+
+// Set returns the labels of the AttributeSet.
+func (s AttributeSet) Set() attribute.Set {
+	return s.set
+}
@@ -0,0 +1,31 @@
+package metric
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestMetric_AttrSet_NewAttributeSet(t *testing.T) {
+	set := newAttributeSet(
+		attribute.String("status", "500"),
+		attribute.String("method", "GET"),
+		attribute.String("status", "200"),
+	)
+
+	want := attribute.NewSet(attribute.String("method", "GET"), attribute.String("status", "200"))
+	got := set.Set()
+	if !got.Equals(&want) {
+		t.Errorf("Set() = %v, want %v", got.Encoded(attribute.DefaultEncoder()), want.Encoded(attribute.DefaultEncoder()))
+	}
+	if len(set.add) != 1 || len(set.record) != 1 {
+		t.Errorf("expected one pre-built add and record option, got %d and %d", len(set.add), len(set.record))
+	}
+}
+
+func TestMetric_AttrSet_ZeroValue(t *testing.T) {
+	var set AttributeSet
+	if got := set.Set(); got.Len() != 0 {
+		t.Errorf("zero AttributeSet has %d labels, want 0", got.Len())
+	}
+}
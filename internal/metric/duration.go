@@ -0,0 +1,98 @@
+package metric
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+)
+
+// durationUnits maps the time units CreateDurationHistogram accepts, in UCUM notation, to their length.
+var durationUnits = map[string]time.Duration{
+	"ns":  time.Nanosecond,
+	"us":  time.Microsecond,
+	"ms":  time.Millisecond,
+	"s":   time.Second,
+	"min": time.Minute,
+	"h":   time.Hour,
+}
+
+// durationHistogram is a histogram created with a time unit, which RecordDuration converts to.
+type durationHistogram struct {
+	otelmetric.Float64Histogram
+	unit time.Duration
+}
+
+// CreateDurationHistogram creates a new histogram of durations in unit, which must be a time
+// unit ("ns", "us", "ms", "s", "min", or "h"). Durations recorded with RecordDuration are
+// converted to unit without rounding, so sub-unit durations are kept.
+// Like CreateHistogram, it rejects a name already created with other metadata with an error
+// wrapping ErrInstrumentConflict, including a name created by CreateHistogram, whose int64
+// histogram the OpenTelemetry SDK treats as a conflicting instrument.
+//
+// Parameters:
+//   - name: The metric name (should follow OpenTelemetry naming conventions)
+//   - unit: The time unit durations are recorded in
+//   - description: A human-readable description of what the histogram measures
+//
+// Returns:
+//   - The created histogram metric
+//   - An error wrapping ErrDurationUnitUnsupported if unit is not a time unit, or if histogram creation fails
+//
+// Example:
+//
+//	histogram, err := metric.CreateDurationHistogram(
+//	    "checkout_duration_seconds",
+//	    "s",
+//	    "Checkout duration",
+//	)
+func (m *metric) CreateDurationHistogram(name, unit, description string) (otelmetric.Float64Histogram, error) {
+	d, ok := durationUnits[unit]
+	if !ok {
+		return nil, fmt.Errorf("%w: got %q", ErrDurationUnitUnsupported, unit)
+	}
+	if err := m.checkInstrument(kindFloatHistogram, name, unit, description); err != nil {
+		return nil, err
+	}
+	histogram, err := m.meter.Float64Histogram(
+		m.prefix+name,
+		otelmetric.WithDescription(description),
+		otelmetric.WithUnit(unit),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create histogram: %w", err)
+	}
+	return durationHistogram{Float64Histogram: histogram, unit: d}, nil
+}
+
+// RecordDuration records duration in a histogram, converted to the time unit the histogram was
+// created with as a fractional value, so a duration can never be recorded in the wrong unit and
+// a 1.4s duration in a "s" histogram is recorded as 1.4. A histogram not created by
+// CreateDurationHistogram records nothing, and an error wrapping ErrDurationUnitUnsupported is
+// reported to the OpenTelemetry error handler.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - histogram: The histogram metric to record to, created by CreateDurationHistogram
+//   - duration: The duration to record
+//   - labels: Optional key-value pairs for metric dimensions
+//
+// Example:
+//
+//	histogram, _ := metric.CreateDurationHistogram("checkout_duration_seconds", "s", "Checkout duration")
+//	start := time.Now()
+//	// ... perform operation ...
+//	metric.RecordDuration(ctx, histogram, time.Since(start),
+//	    metric.CreateAttributeString("endpoint", "/api/checkout"),
+//	)
+func (m *metric) RecordDuration(ctx context.Context, histogram otelmetric.Float64Histogram, duration time.Duration, labels ...attribute.KeyValue) {
+	h, ok := histogram.(durationHistogram)
+	if !ok {
+		otel.Handle(fmt.Errorf("%w: the histogram was not created by CreateDurationHistogram", ErrDurationUnitUnsupported))
+		return
+	}
+	h.Record(ctx, float64(duration)/float64(h.unit), otelmetric.WithAttributes(labels...))
+}
@@ -0,0 +1,103 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMetric_Duration_RecordDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		unit     string
+		duration time.Duration
+		want     float64
+	}{
+		{name: "seconds", unit: "s", duration: 2500 * time.Millisecond, want: 2.5},
+		{name: "milliseconds", unit: "ms", duration: 1500 * time.Microsecond, want: 1.5},
+		{name: "microseconds", unit: "us", duration: 1200 * time.Nanosecond, want: 1.2},
+		{name: "nanoseconds", unit: "ns", duration: 42, want: 42},
+		{name: "minutes", unit: "min", duration: 90 * time.Second, want: 1.5},
+		{name: "hours", unit: "h", duration: 2 * time.Hour, want: 2},
+		{name: "sub-unit duration is kept", unit: "s", duration: 400 * time.Millisecond, want: 0.4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := newManualReader(&recordingExporter{})
+			provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+			metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+			defer func() {
+				_ = metricInstance.Shutdown(context.Background())
+			}()
+
+			ctx := context.Background()
+			histogram, err := metricInstance.CreateDurationHistogram("operation_duration", tt.unit, "Operation duration")
+			if err != nil {
+				t.Fatalf("CreateDurationHistogram() error = %v", err)
+			}
+			metricInstance.RecordDuration(ctx, histogram, tt.duration)
+
+			var rm metricdata.ResourceMetrics
+			if err := reader.reader.Collect(ctx, &rm); err != nil {
+				t.Fatalf("Collect() error = %v", err)
+			}
+			data := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+			if got := data.DataPoints[0].Sum; got != tt.want {
+				t.Errorf("RecordDuration() recorded %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_Duration_CreateDurationHistogram_Unsupported(t *testing.T) {
+	reader := newManualReader(&recordingExporter{})
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	if _, err := metricInstance.CreateDurationHistogram("payload_size_bytes", "By", "Payload size"); !errors.Is(err, ErrDurationUnitUnsupported) {
+		t.Errorf("CreateDurationHistogram() error = %v, want %v", err, ErrDurationUnitUnsupported)
+	}
+}
+
+func TestMetric_Duration_RecordDuration_Unsupported(t *testing.T) {
+	var handled []error
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		handled = append(handled, err)
+	}))
+	defer otel.SetErrorHandler(otel.ErrorHandlerFunc(func(error) {}))
+
+	reader := newManualReader(&recordingExporter{})
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	histogram, err := provider.Meter("test-service").Float64Histogram("operation_duration", otelmetric.WithUnit("s"))
+	if err != nil {
+		t.Fatalf("Float64Histogram() error = %v", err)
+	}
+	metricInstance.RecordDuration(ctx, histogram, time.Second)
+
+	if len(handled) != 1 || !errors.Is(handled[0], ErrDurationUnitUnsupported) {
+		t.Fatalf("handled errors = %v, want one wrapping %v", handled, ErrDurationUnitUnsupported)
+	}
+	var rm metricdata.ResourceMetrics
+	if err := reader.reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(rm.ScopeMetrics) != 0 {
+		t.Errorf("RecordDuration() recorded %+v, want nothing", rm.ScopeMetrics)
+	}
+}
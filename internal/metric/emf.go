@@ -0,0 +1,184 @@
+package metric
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// emfMaxDimensions is the number of dimensions CloudWatch accepts in a dimension set.
+const emfMaxDimensions = 30
+
+// emfUnits maps the UCUM units of instruments to CloudWatch units. Other units, such as "ns"
+// which CloudWatch has no unit for, are omitted.
+var emfUnits = map[string]string{
+	"us":   "Microseconds",
+	"ms":   "Milliseconds",
+	"s":    "Seconds",
+	"By":   "Bytes",
+	"KiBy": "Kilobytes",
+	"MiBy": "Megabytes",
+	"GiBy": "Gigabytes",
+	"%":    "Percent",
+	"1":    "Count",
+}
+
+// emfExporter is a metric exporter that writes every data point as one CloudWatch Embedded
+// Metric Format (EMF) JSON object per line, which the CloudWatch Logs agent of Lambda and ECS
+// turns into metrics without a collector. The dimensions of a data point are its attributes and
+// the service name. Counters and histograms use delta temporality, since CloudWatch adds up the
+// values it receives; histograms are written as statistic sets.
+type emfExporter struct {
+	namespace string
+
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// newEMFExporter creates an emfExporter writing metrics in namespace to w, or to os.Stdout
+// when w is nil.
+func newEMFExporter(w io.Writer, namespace string) *emfExporter {
+	if w == nil {
+		w = os.Stdout
+	}
+	return &emfExporter{namespace: namespace, encoder: json.NewEncoder(w)}
+}
+
+// Temporality returns delta for counters and histograms and cumulative for the other kinds.
+func (e *emfExporter) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	return temporalitySelector("delta")(kind)
+}
+
+// Aggregation returns the default aggregation of kind.
+func (e *emfExporter) Aggregation(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(kind)
+}
+
+// Export writes the data points of rm, one EMF object per line. Histograms without
+// measurements since the last export and non-finite values are skipped, as CloudWatch rejects
+// them.
+func (e *emfExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	var service []attribute.KeyValue
+	if rm.Resource != nil {
+		if name, ok := rm.Resource.Set().Value(semconv.ServiceNameKey); ok {
+			service = append(service, semconv.ServiceNameKey.String(name.Emit()))
+		}
+	}
+
+	var objects []map[string]any
+	add := func(m metricdata.Metrics, attrs attribute.Set, value any, timestamp int64) {
+		objects = append(objects, e.object(m, attribute.NewSet(append(attrs.ToSlice(), service...)...), value, timestamp))
+	}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					add(m, dp.Attributes, dp.Value, dp.Time.UnixMilli())
+				}
+			case metricdata.Sum[float64]:
+				for _, dp := range data.DataPoints {
+					if isFinite(dp.Value) {
+						add(m, dp.Attributes, dp.Value, dp.Time.UnixMilli())
+					}
+				}
+			case metricdata.Gauge[int64]:
+				for _, dp := range data.DataPoints {
+					add(m, dp.Attributes, dp.Value, dp.Time.UnixMilli())
+				}
+			case metricdata.Gauge[float64]:
+				for _, dp := range data.DataPoints {
+					if isFinite(dp.Value) {
+						add(m, dp.Attributes, dp.Value, dp.Time.UnixMilli())
+					}
+				}
+			case metricdata.Histogram[int64]:
+				for _, dp := range data.DataPoints {
+					if dp.Count == 0 {
+						continue
+					}
+					minimum, _ := dp.Min.Value()
+					maximum, _ := dp.Max.Value()
+					add(m, dp.Attributes, emfStatisticSet(float64(minimum), float64(maximum), float64(dp.Sum), dp.Count), dp.Time.UnixMilli())
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					if dp.Count == 0 || !isFinite(dp.Sum) {
+						continue
+					}
+					minimum, _ := dp.Min.Value()
+					maximum, _ := dp.Max.Value()
+					add(m, dp.Attributes, emfStatisticSet(minimum, maximum, dp.Sum, dp.Count), dp.Time.UnixMilli())
+				}
+			}
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, object := range objects {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := e.encoder.Encode(object); err != nil {
+			return fmt.Errorf("failed to write metrics: %w", err)
+		}
+	}
+	return nil
+}
+
+// object returns the EMF object of one data point of m with the dimensions of attrs, keeping
+// at most emfMaxDimensions of them.
+func (e *emfExporter) object(m metricdata.Metrics, attrs attribute.Set, value any, timestamp int64) map[string]any {
+	object := make(map[string]any, attrs.Len()+2)
+	dimensions := make([]string, 0, attrs.Len())
+	iter := attrs.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		if len(dimensions) == emfMaxDimensions {
+			break
+		}
+		dimensions = append(dimensions, string(kv.Key))
+		object[string(kv.Key)] = kv.Value.Emit()
+	}
+
+	definition := map[string]string{"Name": m.Name}
+	if unit, ok := emfUnits[m.Unit]; ok {
+		definition["Unit"] = unit
+	}
+	object[m.Name] = value
+	object["_aws"] = map[string]any{
+		"Timestamp": timestamp,
+		"CloudWatchMetrics": []map[string]any{{
+			"Namespace":  e.namespace,
+			"Dimensions": [][]string{dimensions},
+			"Metrics":    []map[string]string{definition},
+		}},
+	}
+	return object
+}
+
+// ForceFlush does nothing; every export is written immediately.
+func (e *emfExporter) ForceFlush(context.Context) error { return nil }
+
+// Shutdown does nothing.
+func (e *emfExporter) Shutdown(context.Context) error { return nil }
+
+// emfStatisticSet returns the EMF statistic set of a histogram data point.
+func emfStatisticSet(minimum, maximum, sum float64, count uint64) map[string]any {
+	return map[string]any{"Min": minimum, "Max": maximum, "Sum": sum, "Count": count}
+}
+
+// isFinite reports whether v is neither infinite nor NaN.
+func isFinite(v float64) bool {
+	return !math.IsInf(v, 0) && !math.IsNaN(v)
+}
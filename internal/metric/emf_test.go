@@ -0,0 +1,116 @@
+package metric
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestMetric_EMF_Export(t *testing.T) {
+	tests := []struct {
+		name          string
+		namespace     string
+		wantNamespace string
+	}{
+		{"service name namespace", "", "checkout"},
+		{"custom namespace", "Shop/Checkout", "Shop/Checkout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			metricInstance, err := NewMetric(
+				WithServiceName("checkout"),
+				WithStdoutFormat("emf"),
+				WithEMFNamespace(tt.namespace),
+				WithWriter(&buf),
+				WithReaderMode("manual"),
+			)
+			if err != nil {
+				t.Fatalf("NewMetric() error = %v", err)
+			}
+			defer func() {
+				_ = metricInstance.Shutdown(context.Background())
+			}()
+
+			ctx := context.Background()
+			counter, _ := metricInstance.CreateCounter("orders_total", "1", "Orders placed")
+			histogram, _ := metricInstance.CreateHistogram("payment_duration_ms", "ms", "Payment duration")
+			metricInstance.RecordCounter(ctx, counter, 2, attribute.String("region", "eu"))
+			metricInstance.RecordHistogram(ctx, histogram, 40)
+			metricInstance.RecordHistogram(ctx, histogram, 60)
+			if err := metricInstance.Collect(ctx); err != nil {
+				t.Fatalf("Collect() error = %v", err)
+			}
+
+			objects := decodeEMF(t, &buf)
+			if len(objects) != 2 {
+				t.Fatalf("wrote %d objects, want 2: %s", len(objects), buf.String())
+			}
+			orders := emfObject(t, objects, "orders_total")
+			if orders["orders_total"] != 2.0 || orders["region"] != "eu" || orders["service.name"] != "checkout" {
+				t.Errorf("orders_total object = %v", orders)
+			}
+			aws := orders["_aws"].(map[string]any)
+			if _, ok := aws["Timestamp"].(float64); !ok {
+				t.Errorf("_aws.Timestamp = %v, want a number", aws["Timestamp"])
+			}
+			directive := aws["CloudWatchMetrics"].([]any)[0].(map[string]any)
+			if directive["Namespace"] != tt.wantNamespace {
+				t.Errorf("Namespace = %v, want %s", directive["Namespace"], tt.wantNamespace)
+			}
+			if got, want := directive["Dimensions"], []any{[]any{"region", "service.name"}}; !reflect.DeepEqual(got, want) {
+				t.Errorf("Dimensions = %v, want %v", got, want)
+			}
+			if got, want := directive["Metrics"], []any{map[string]any{"Name": "orders_total", "Unit": "Count"}}; !reflect.DeepEqual(got, want) {
+				t.Errorf("Metrics = %v, want %v", got, want)
+			}
+
+			payment := emfObject(t, objects, "payment_duration_ms")
+			want := map[string]any{"Min": 40.0, "Max": 60.0, "Sum": 100.0, "Count": 2.0}
+			if !reflect.DeepEqual(payment["payment_duration_ms"], want) {
+				t.Errorf("payment_duration_ms = %v, want %v", payment["payment_duration_ms"], want)
+			}
+
+			// counters and histograms are deltas: nothing was recorded since the first export
+			buf.Reset()
+			if err := metricInstance.Collect(ctx); err != nil {
+				t.Fatalf("Collect() error = %v", err)
+			}
+			if buf.Len() != 0 {
+				t.Errorf("second export = %s, want nothing", buf.String())
+			}
+		})
+	}
+}
+
+// decodeEMF decodes the EMF objects written to buf, one per line.
+func decodeEMF(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var objects []map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(buf.Bytes()))
+	for decoder.More() {
+		var object map[string]any
+		if err := decoder.Decode(&object); err != nil {
+			t.Fatalf("invalid EMF output %q: %v", buf.String(), err)
+		}
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+// emfObject returns the object of objects holding the metric name.
+func emfObject(t *testing.T, objects []map[string]any, name string) map[string]any {
+	t.Helper()
+	for _, object := range objects {
+		if _, ok := object[name]; ok {
+			return object
+		}
+	}
+	t.Fatalf("no EMF object for %s in %v", name, objects)
+	return nil
+}
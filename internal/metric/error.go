@@ -4,9 +4,26 @@ import "errors"
 
 var (
 	// ErrInvalidProvider is returned when an invalid provider type is specified.
-	ErrInvalidProvider      = errors.New("invalid provider")
-	ErrProviderHostRequired = errors.New("provider host is required")
-	ErrProviderPortRequired = errors.New("provider port is required")
-	ErrProviderPortInvalid  = errors.New("provider port must be greater than 0")
-	ErrIntervalInvalid      = errors.New("interval must be greater than 0")
+	ErrInvalidProvider          = errors.New("invalid provider")
+	ErrProviderHostRequired     = errors.New("provider host is required")
+	ErrProviderPortRequired     = errors.New("provider port is required")
+	ErrProviderPortInvalid      = errors.New("provider port must be greater than 0")
+	ErrPushgatewayJobRequired   = errors.New("pushgateway job is required")
+	ErrInfluxDBURLInvalid       = errors.New("influxdb url must be an http or https URL")
+	ErrInfluxDBOrgRequired      = errors.New("influxdb org is required")
+	ErrInfluxDBBucketRequired   = errors.New("influxdb bucket is required")
+	ErrIntervalInvalid          = errors.New("interval must be greater than 0")
+	ErrBreakerThresholdInvalid  = errors.New("circuit breaker threshold must not be negative")
+	ErrBreakerMaxBackoffInvalid = errors.New("circuit breaker max backoff must be greater than 0")
+	ErrEndpointInvalid          = errors.New("endpoint must be a URL with scheme grpc, grpcs, http, or https")
+	ErrInvalidReaderMode        = errors.New("reader mode must be periodic or manual")
+	ErrInvalidTemporality       = errors.New("temporality must be cumulative or delta")
+	ErrInvalidStdoutFormat      = errors.New("stdout format must be pretty, ndjson, or emf")
+	ErrInstrumentNameInvalid    = errors.New("invalid instrument name")
+	ErrInstrumentConflict       = errors.New("instrument already created with different metadata")
+	ErrDurationUnitUnsupported  = errors.New("duration unit must be ns, us, ms, s, min, or h")
+	ErrStatsDAddressInvalid     = errors.New("statsd address must be host:port")
+	ErrStatsDLineInvalid        = errors.New("invalid statsd line")
+	ErrStatsDSetUnsupported     = errors.New("statsd sets are not supported")
+	ErrStatsDLimitExceeded      = errors.New("statsd instrument or series limit exceeded")
 )
@@ -252,6 +252,8 @@ func TestMetric_Error_AllErrorsAreDistinct(t *testing.T) {
 		ErrProviderPortRequired,
 		ErrProviderPortInvalid,
 		ErrIntervalInvalid,
+		ErrInvalidReaderMode,
+		ErrInvalidTemporality,
 	}
 
 	for i, err1 := range errList {
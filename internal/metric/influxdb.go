@@ -0,0 +1,218 @@
+package metric
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// influxDBContentType is the content type of the line protocol the exporter writes.
+const influxDBContentType = "text/plain; charset=utf-8"
+
+// influxDBTimeout bounds a single write, so an unresponsive server cannot hold an export whose
+// context has no deadline.
+const influxDBTimeout = 10 * time.Second
+
+// influxDBExporter is a metric exporter that writes every export to the InfluxDB v2 write API
+// in the line protocol. Each data point becomes one line measured by the metric name, tagged
+// with its attributes and the service name, and timestamped in nanoseconds: counters and
+// gauges have a "value" field, histograms "count", "sum", and, when recorded, "min" and "max"
+// fields.
+type influxDBExporter struct {
+	url      string
+	token    string
+	selector sdkmetric.TemporalitySelector
+	client   *http.Client
+}
+
+// newInfluxDBExporter creates an influxDBExporter writing to the bucket of org on the InfluxDB
+// server at serverURL, authenticated with token when it is set.
+func newInfluxDBExporter(serverURL, org, bucket, token string, selector sdkmetric.TemporalitySelector) *influxDBExporter {
+	query := url.Values{}
+	query.Set("org", org)
+	query.Set("bucket", bucket)
+	query.Set("precision", "ns")
+	return &influxDBExporter{
+		url:      strings.TrimSuffix(serverURL, "/") + "/api/v2/write?" + query.Encode(),
+		token:    token,
+		selector: selector,
+		client:   &http.Client{Timeout: influxDBTimeout},
+	}
+}
+
+// Temporality returns the temporality selected by the Options.
+func (e *influxDBExporter) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	return e.selector(kind)
+}
+
+// Aggregation returns the default aggregation of kind.
+func (e *influxDBExporter) Aggregation(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(kind)
+}
+
+// Export writes rm to the InfluxDB bucket. An export without data points writes nothing.
+func (e *influxDBExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	body := encodeLineProtocol(rm)
+	if len(body) == 0 {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+	req.Header.Set("Content-Type", influxDBContentType)
+	if e.token != "" {
+		req.Header.Set("Authorization", "Token "+e.token)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("failed to write metrics: influxdb returned %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return nil
+}
+
+// ForceFlush does nothing; every export is written immediately.
+func (e *influxDBExporter) ForceFlush(context.Context) error { return nil }
+
+// Shutdown does nothing.
+func (e *influxDBExporter) Shutdown(context.Context) error { return nil }
+
+// encodeLineProtocol encodes the data points of rm in the InfluxDB line protocol, one line per
+// data point. The service name of the resource is added as a "service.name" tag. The line
+// protocol has no representation for NaN or infinite floats: such values are left out of a
+// histogram line, and a sum or gauge data point holding one is skipped.
+func encodeLineProtocol(rm *metricdata.ResourceMetrics) []byte {
+	var service []attribute.KeyValue
+	if rm.Resource != nil {
+		if name, ok := rm.Resource.Set().Value(semconv.ServiceNameKey); ok {
+			service = append(service, semconv.ServiceNameKey.String(name.Emit()))
+		}
+	}
+
+	var b bytes.Buffer
+	line := func(name string, attrs attribute.Set, fields string, t time.Time) {
+		b.WriteString(influxDBEscape(name, ", "))
+		// the line protocol recommends tags sorted by key, which a Set guarantees
+		tags := attribute.NewSet(append(attrs.ToSlice(), service...)...)
+		for _, kv := range tags.ToSlice() {
+			value := kv.Value.Emit()
+			if value == "" {
+				// the line protocol does not allow empty tag values
+				continue
+			}
+			b.WriteByte(',')
+			b.WriteString(influxDBEscape(string(kv.Key), ",= "))
+			b.WriteByte('=')
+			b.WriteString(influxDBEscape(value, ",= "))
+		}
+		b.WriteByte(' ')
+		b.WriteString(fields)
+		b.WriteByte(' ')
+		b.WriteString(strconv.FormatInt(t.UnixNano(), 10))
+		b.WriteByte('\n')
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					line(m.Name, dp.Attributes, "value="+influxDBInt(dp.Value), dp.Time)
+				}
+			case metricdata.Sum[float64]:
+				for _, dp := range data.DataPoints {
+					if !influxDBFinite(dp.Value) {
+						continue
+					}
+					line(m.Name, dp.Attributes, "value="+influxDBFloat(dp.Value), dp.Time)
+				}
+			case metricdata.Gauge[int64]:
+				for _, dp := range data.DataPoints {
+					line(m.Name, dp.Attributes, "value="+influxDBInt(dp.Value), dp.Time)
+				}
+			case metricdata.Gauge[float64]:
+				for _, dp := range data.DataPoints {
+					if !influxDBFinite(dp.Value) {
+						continue
+					}
+					line(m.Name, dp.Attributes, "value="+influxDBFloat(dp.Value), dp.Time)
+				}
+			case metricdata.Histogram[int64]:
+				for _, dp := range data.DataPoints {
+					fields := "count=" + strconv.FormatUint(dp.Count, 10) + "u,sum=" + influxDBInt(dp.Sum)
+					if v, ok := dp.Min.Value(); ok {
+						fields += ",min=" + influxDBInt(v)
+					}
+					if v, ok := dp.Max.Value(); ok {
+						fields += ",max=" + influxDBInt(v)
+					}
+					line(m.Name, dp.Attributes, fields, dp.Time)
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					fields := "count=" + strconv.FormatUint(dp.Count, 10) + "u"
+					if influxDBFinite(dp.Sum) {
+						fields += ",sum=" + influxDBFloat(dp.Sum)
+					}
+					if v, ok := dp.Min.Value(); ok && influxDBFinite(v) {
+						fields += ",min=" + influxDBFloat(v)
+					}
+					if v, ok := dp.Max.Value(); ok && influxDBFinite(v) {
+						fields += ",max=" + influxDBFloat(v)
+					}
+					line(m.Name, dp.Attributes, fields, dp.Time)
+				}
+			}
+		}
+	}
+	return b.Bytes()
+}
+
+// influxDBEscape escapes the backslashes and the given special characters of a measurement,
+// tag key, or tag value. Line breaks cannot be escaped in the line protocol and are replaced
+// with spaces, escaped when special holds a space.
+func influxDBEscape(s, special string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r == '\n' || r == '\r' {
+			r = ' '
+		}
+		if r == '\\' || strings.ContainsRune(special, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// influxDBInt formats an integer field value.
+func influxDBInt(v int64) string {
+	return strconv.FormatInt(v, 10) + "i"
+}
+
+// influxDBFinite reports whether v can be written as a float field value.
+func influxDBFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// influxDBFloat formats a float field value.
+func influxDBFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
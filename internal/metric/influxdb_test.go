@@ -0,0 +1,155 @@
+package metric
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMetric_InfluxDB_InfluxDBEscape(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		special string
+		want    string
+	}{
+		{"plain", "http_requests_total", ", ", "http_requests_total"},
+		{"measurement", "db query,time=x", ", ", `db\ query\,time=x`},
+		{"tag", `a=b c,d\e`, ",= ", `a\=b\ c\,d\\e`},
+		{"line breaks", "eu\r\norders", ",= ", `eu\ \ orders`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := influxDBEscape(tt.in, tt.special); got != tt.want {
+				t.Errorf("influxDBEscape(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_InfluxDB_Export(t *testing.T) {
+	type write struct {
+		method, path, org, bucket, precision, auth, body string
+	}
+	writes := make(chan write, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		q := r.URL.Query()
+		writes <- write{r.Method, r.URL.Path, q.Get("org"), q.Get("bucket"), q.Get("precision"), r.Header.Get("Authorization"), string(body)}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	metricInstance, err := NewMetric(
+		WithServiceName("edge-agent"),
+		WithProvider("influxdb", "", 0),
+		WithInfluxDB(server.URL+"/", "edge", "metrics", "secret"),
+		WithReaderMode("manual"),
+	)
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	counter, _ := metricInstance.CreateCounter("rows_exported", "1", "Rows exported")
+	histogram, _ := metricInstance.CreateHistogram("batch_duration_ms", "ms", "Batch duration")
+	metricInstance.RecordCounter(ctx, counter, 42, attribute.String("table", "eu orders"))
+	metricInstance.RecordHistogram(ctx, histogram, 7)
+	metricInstance.RecordHistogram(ctx, histogram, 3)
+	if err := metricInstance.Collect(ctx); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+
+	got := <-writes
+	if got.method != http.MethodPost || got.path != "/api/v2/write" {
+		t.Errorf("request = %s %s, want POST /api/v2/write", got.method, got.path)
+	}
+	if got.org != "edge" || got.bucket != "metrics" || got.precision != "ns" {
+		t.Errorf("query = org %q bucket %q precision %q, want edge, metrics, ns", got.org, got.bucket, got.precision)
+	}
+	if got.auth != "Token secret" {
+		t.Errorf("Authorization = %q, want %q", got.auth, "Token secret")
+	}
+	for _, want := range []string{
+		`^rows_exported,service\.name=edge-agent,table=eu\\ orders value=42i \d+$`,
+		`^batch_duration_ms,service\.name=edge-agent count=2u,sum=10i,min=3i,max=7i \d+$`,
+	} {
+		if !regexp.MustCompile("(?m)" + want).MatchString(got.body) {
+			t.Errorf("written body = %q, want a line matching %s", got.body, want)
+		}
+	}
+}
+
+func TestMetric_InfluxDB_EncodeLineProtocol_NonFinite(t *testing.T) {
+	now := time.Unix(0, 1)
+	rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: []metricdata.Metrics{
+		{Name: "ratio", Data: metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{
+			{Value: math.NaN(), Time: now},
+			{Value: 0.5, Time: now},
+		}}},
+		{Name: "total", Data: metricdata.Sum[float64]{DataPoints: []metricdata.DataPoint[float64]{
+			{Value: math.Inf(1), Time: now},
+		}}},
+		{Name: "latency", Data: metricdata.Histogram[float64]{DataPoints: []metricdata.HistogramDataPoint[float64]{
+			{Count: 2, Sum: math.Inf(1), Min: metricdata.NewExtrema(1.0), Max: metricdata.NewExtrema(math.Inf(1)), Time: now},
+		}}},
+	}}}}
+
+	got := string(encodeLineProtocol(rm))
+	want := "ratio value=0.5 1\nlatency count=2u,min=1 1\n"
+	if got != want {
+		t.Errorf("encodeLineProtocol() = %q, want %q", got, want)
+	}
+}
+
+func TestMetric_InfluxDB_Export_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"code":"unauthorized"}`, http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	exporter := newInfluxDBExporter(server.URL, "edge", "metrics", "wrong", temporalitySelector("cumulative"))
+	rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: []metricdata.Metrics{{
+		Name: "up",
+		Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Value: 1}}},
+	}}}}}
+	err := exporter.Export(context.Background(), rm)
+	if err == nil || !strings.Contains(err.Error(), "401 Unauthorized") {
+		t.Errorf("Export() error = %v, want the status of the response", err)
+	}
+}
+
+func TestMetric_InfluxDB_Export_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	exporter := newInfluxDBExporter(server.URL, "edge", "metrics", "", temporalitySelector("cumulative"))
+	if exporter.client.Timeout != influxDBTimeout {
+		t.Fatalf("client timeout = %v, want %v", exporter.client.Timeout, influxDBTimeout)
+	}
+	exporter.client.Timeout = 50 * time.Millisecond
+	rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: []metricdata.Metrics{{
+		Name: "requests",
+		Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Value: 1}}},
+	}}}}}
+	if err := exporter.Export(context.Background(), rm); err == nil {
+		t.Error("Export() error = nil, want a timeout from the unresponsive server")
+	}
+}
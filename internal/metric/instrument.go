@@ -0,0 +1,49 @@
+package metric
+
+import (
+	"fmt"
+	"sync"
+)
+
+// instrument is the metadata an instrument was first created with.
+type instrument struct {
+	kind        instrumentKind
+	unit        string
+	description string
+}
+
+// instrumentRegistry remembers the instruments created on one meter, so an instrument created
+// again with different metadata is reported instead of being silently reconciled by the SDK.
+type instrumentRegistry struct {
+	mu          sync.Mutex
+	instruments map[string]instrument
+}
+
+// newInstrumentRegistry returns an empty instrumentRegistry.
+func newInstrumentRegistry() *instrumentRegistry {
+	return &instrumentRegistry{instruments: make(map[string]instrument)}
+}
+
+// register records the instrument name with its metadata. It returns an error wrapping
+// ErrInstrumentConflict when name was already created with a different kind, unit, or
+// description; creating it again with the same metadata is allowed.
+func (r *instrumentRegistry) register(kind instrumentKind, name, unit, description string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	created := instrument{kind: kind, unit: unit, description: description}
+	existing, ok := r.instruments[name]
+	if !ok {
+		r.instruments[name] = created
+		return nil
+	}
+	switch {
+	case existing.kind != kind:
+		return fmt.Errorf("%w: %q was created as a %s, not a %s", ErrInstrumentConflict, name, existing.kind, kind)
+	case existing.unit != unit:
+		return fmt.Errorf("%w: %s %q was created with unit %q, not %q", ErrInstrumentConflict, kind, name, existing.unit, unit)
+	case existing.description != description:
+		return fmt.Errorf("%w: %s %q was created with description %q, not %q", ErrInstrumentConflict, kind, name, existing.description, description)
+	}
+	return nil
+}
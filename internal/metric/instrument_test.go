@@ -0,0 +1,83 @@
+package metric
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMetric_Instrument_Register(t *testing.T) {
+	tests := []struct {
+		name        string
+		kind        instrumentKind
+		unit        string
+		description string
+		wantErr     string
+	}{
+		{name: "same metadata", kind: kindHistogram, unit: "ms", description: "Request latency"},
+		{name: "different kind", kind: kindGauge, unit: "ms", description: "Request latency", wantErr: `"latency" was created as a histogram, not a gauge`},
+		{name: "different unit", kind: kindHistogram, unit: "s", description: "Request latency", wantErr: `histogram "latency" was created with unit "ms", not "s"`},
+		{name: "different description", kind: kindHistogram, unit: "ms", description: "Latency", wantErr: `histogram "latency" was created with description "Request latency", not "Latency"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newInstrumentRegistry()
+			if err := registry.register(kindHistogram, "latency", "ms", "Request latency"); err != nil {
+				t.Fatalf("register() error = %v", err)
+			}
+			err := registry.register(tt.kind, "latency", tt.unit, tt.description)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("register() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInstrumentConflict) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("register() error = %v, want ErrInstrumentConflict mentioning %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMetric_Instrument_Conflict(t *testing.T) {
+	metricInstance, err := NewMetric(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		_ = metricInstance.Shutdown(t.Context())
+	}()
+
+	if _, err := metricInstance.CreateHistogram("checkout_duration", "ms", "Checkout duration"); err != nil {
+		t.Fatalf("CreateHistogram() error = %v", err)
+	}
+	if _, err := metricInstance.CreateHistogram("checkout_duration", "ms", "Checkout duration"); err != nil {
+		t.Errorf("CreateHistogram() again error = %v, want nil", err)
+	}
+	if _, err := metricInstance.CreateHistogram("checkout_duration", "s", "Checkout duration"); !errors.Is(err, ErrInstrumentConflict) {
+		t.Errorf("CreateHistogram() with another unit error = %v, want ErrInstrumentConflict", err)
+	}
+	if _, err := metricInstance.CreateDurationHistogram("checkout_duration", "ms", "Checkout duration"); !errors.Is(err, ErrInstrumentConflict) {
+		t.Errorf("CreateDurationHistogram() of an int64 histogram name error = %v, want ErrInstrumentConflict", err)
+	}
+	if _, err := metricInstance.CreateDurationHistogram("refund_duration", "ms", "Refund duration"); err != nil {
+		t.Fatalf("CreateDurationHistogram() error = %v", err)
+	}
+	if _, err := metricInstance.CreateHistogram("refund_duration", "ms", "Refund duration"); !errors.Is(err, ErrInstrumentConflict) {
+		t.Errorf("CreateHistogram() of a float histogram name error = %v, want ErrInstrumentConflict", err)
+	}
+
+	// Namespaces share the meter, so the prefixed name is what conflicts.
+	payments := metricInstance.Namespace("payments_")
+	if _, err := payments.CreateCounter("checkout_duration", "1", ""); err != nil {
+		t.Errorf("CreateCounter() in a namespace error = %v, want nil", err)
+	}
+	if _, err := metricInstance.CreateGauge("payments_checkout_duration", "1", ""); !errors.Is(err, ErrInstrumentConflict) {
+		t.Errorf("CreateGauge() of a namespaced name error = %v, want ErrInstrumentConflict", err)
+	}
+
+	// A scope is a separate meter with its own instruments.
+	if _, err := metricInstance.Scoped("github.com/acme/payments", "").CreateHistogram("checkout_duration", "s", ""); err != nil {
+		t.Errorf("CreateHistogram() in another scope error = %v, want nil", err)
+	}
+}
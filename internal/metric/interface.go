@@ -2,6 +2,7 @@ package metric
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	otelmetric "go.opentelemetry.io/otel/metric"
@@ -10,9 +11,30 @@ import (
 type Metric interface {
 	CreateCounter(name, unit, description string) (otelmetric.Int64Counter, error)
 	RecordCounter(ctx context.Context, counter otelmetric.Int64Counter, value int64, labels ...attribute.KeyValue)
+	RecordCounterMap(ctx context.Context, counter otelmetric.Int64Counter, value int64, fields map[string]interface{})
 	CreateHistogram(name, unit, description string) (otelmetric.Int64Histogram, error)
 	RecordHistogram(ctx context.Context, histogram otelmetric.Int64Histogram, value int64, labels ...attribute.KeyValue)
+	RecordHistogramMap(ctx context.Context, histogram otelmetric.Int64Histogram, value int64, fields map[string]interface{})
+	CreateDurationHistogram(name, unit, description string) (otelmetric.Float64Histogram, error)
+	RecordDuration(ctx context.Context, histogram otelmetric.Float64Histogram, duration time.Duration, labels ...attribute.KeyValue)
+	CreateGauge(name, unit, description string) (otelmetric.Int64Gauge, error)
+	RecordGauge(ctx context.Context, gauge otelmetric.Int64Gauge, value int64, labels ...attribute.KeyValue)
 	CreateAttributeInt(key string, value int) attribute.KeyValue
 	CreateAttributeString(key string, value string) attribute.KeyValue
+	NewAttributeSet(kvs ...attribute.KeyValue) AttributeSet
+	RecordCounterSet(ctx context.Context, counter otelmetric.Int64Counter, value int64, set AttributeSet)
+	RecordHistogramSet(ctx context.Context, histogram otelmetric.Int64Histogram, value int64, set AttributeSet)
+	RecordGaugeSet(ctx context.Context, gauge otelmetric.Int64Gauge, value int64, set AttributeSet)
 	Shutdown(ctx context.Context) error
+	Collect(ctx context.Context) error
+	Snapshot(ctx context.Context) (*Snapshot, error)
+	Watch(instrument string, predicate func(value float64) bool, callback func(event WatchEvent)) func()
+	Provider() otelmetric.MeterProvider
+	Scoped(name, version string) Metric
+	Namespace(prefix string) Metric
+}
+
+// Reloader is implemented by metrics that can change their configuration at runtime.
+type Reloader interface {
+	Reload(opts ...Option) error
 }
@@ -2,10 +2,14 @@ package metric
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 )
 
@@ -14,10 +18,22 @@ import (
 type metric struct {
 	provider *sdkmetric.MeterProvider
 	meter    otelmetric.Meter
+	reader   *periodicReader
+	statsd   *statsdListener // statsd republishes StatsD packets on provider; nil when no listener runs.
+
+	mu          sync.Mutex          // mu serializes Reload calls.
+	options     *Options            // options is the configuration the metric is currently running with.
+	parent      *metric             // parent owns the provider of a metric created by Scoped or Namespace; nil otherwise.
+	prefix      string              // prefix is prepended to the names of the instruments created; set by Namespace.
+	names       *nameChecker        // names validates instrument names; nil when strict names are disabled.
+	instruments *instrumentRegistry // instruments are the instruments created on meter; shared by the namespaces of the meter.
 }
 
 // CreateCounter creates a new counter metric.
 // Counters are monotonically increasing metrics that track cumulative values.
+// Creating a counter again with the same name, unit, and description returns the same counter;
+// an instrument of that name with a different kind, unit, or description is rejected with an
+// error wrapping ErrInstrumentConflict.
 //
 // Parameters:
 //   - name: The metric name (should follow OpenTelemetry naming conventions)
@@ -36,8 +52,11 @@ type metric struct {
 //	    "Total number of HTTP requests",
 //	)
 func (m *metric) CreateCounter(name, unit, description string) (otelmetric.Int64Counter, error) {
+	if err := m.checkInstrument(kindCounter, name, unit, description); err != nil {
+		return nil, err
+	}
 	counter, err := m.meter.Int64Counter(
-		name,
+		m.prefix+name,
 		otelmetric.WithDescription(description),
 		otelmetric.WithUnit(unit),
 	)
@@ -66,8 +85,30 @@ func (m *metric) RecordCounter(ctx context.Context, counter otelmetric.Int64Coun
 	counter.Add(ctx, value, otelmetric.WithAttributes(labels...))
 }
 
+// RecordCounterMap increments a counter by a given value, taking its labels from a field map
+// in the same style as the Logger API. Map entries are converted to attributes sorted by key;
+// values without a matching attribute type are formatted with fmt.Sprint.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - counter: The counter metric to increment
+//   - value: The value to add to the counter (must be non-negative)
+//   - fields: Key-value pairs for metric dimensions (may be nil)
+//
+// Example:
+//
+//	metric.RecordCounterMap(ctx, counter, 1, map[string]interface{}{
+//	    "method": "GET",
+//	    "status": 200,
+//	})
+func (m *metric) RecordCounterMap(ctx context.Context, counter otelmetric.Int64Counter, value int64, fields map[string]interface{}) {
+	m.RecordCounter(ctx, counter, value, convertFields(fields)...)
+}
+
 // CreateHistogram creates a new histogram metric.
 // Histograms track the distribution of values over time.
+// Like CreateCounter, it rejects a name already created with other metadata with an error
+// wrapping ErrInstrumentConflict.
 //
 // Parameters:
 //   - name: The metric name (should follow OpenTelemetry naming conventions)
@@ -86,8 +127,11 @@ func (m *metric) RecordCounter(ctx context.Context, counter otelmetric.Int64Coun
 //	    "HTTP request duration in milliseconds",
 //	)
 func (m *metric) CreateHistogram(name, unit, description string) (otelmetric.Int64Histogram, error) {
+	if err := m.checkInstrument(kindHistogram, name, unit, description); err != nil {
+		return nil, err
+	}
 	histogram, err := m.meter.Int64Histogram(
-		name,
+		m.prefix+name,
 		otelmetric.WithDescription(description),
 		otelmetric.WithUnit(unit),
 	)
@@ -98,7 +142,8 @@ func (m *metric) CreateHistogram(name, unit, description string) (otelmetric.Int
 }
 
 // RecordHistogram records a value in a histogram.
-// The histogram must have been created using CreateHistogram.
+// The histogram must have been created using CreateHistogram. When exemplars are enabled and
+// ctx carries a sampled span, the span's trace and span IDs are kept as an exemplar.
 //
 // Parameters:
 //   - ctx: Context for the metric recording
@@ -118,6 +163,150 @@ func (m *metric) RecordHistogram(ctx context.Context, histogram otelmetric.Int64
 	histogram.Record(ctx, value, otelmetric.WithAttributes(labels...))
 }
 
+// RecordHistogramMap records a value in a histogram, taking its labels from a field map in the
+// same style as the Logger API. Map entries are converted to attributes sorted by key; values
+// without a matching attribute type are formatted with fmt.Sprint.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - histogram: The histogram metric to record to
+//   - value: The value to record (e.g., request duration, response size)
+//   - fields: Key-value pairs for metric dimensions (may be nil)
+//
+// Example:
+//
+//	metric.RecordHistogramMap(ctx, histogram, duration, map[string]interface{}{
+//	    "endpoint": "/api/users",
+//	})
+func (m *metric) RecordHistogramMap(ctx context.Context, histogram otelmetric.Int64Histogram, value int64, fields map[string]interface{}) {
+	m.RecordHistogram(ctx, histogram, value, convertFields(fields)...)
+}
+
+// CreateGauge creates a new gauge metric.
+// Gauges record the current value of something that can go up and down, such as a
+// queue depth or the timestamp of the last successful run.
+// Like CreateCounter, it rejects a name already created with other metadata with an error
+// wrapping ErrInstrumentConflict.
+//
+// Parameters:
+//   - name: The metric name (should follow OpenTelemetry naming conventions)
+//   - unit: The unit of measurement (e.g., "1", "s", "bytes")
+//   - description: A human-readable description of what the gauge measures
+//
+// Returns:
+//   - The created gauge metric
+//   - An error if gauge creation fails
+//
+// Example:
+//
+//	gauge, err := metric.CreateGauge(
+//	    "queue_depth",
+//	    "1",
+//	    "Number of messages waiting in the queue",
+//	)
+func (m *metric) CreateGauge(name, unit, description string) (otelmetric.Int64Gauge, error) {
+	if err := m.checkInstrument(kindGauge, name, unit, description); err != nil {
+		return nil, err
+	}
+	gauge, err := m.meter.Int64Gauge(
+		m.prefix+name,
+		otelmetric.WithDescription(description),
+		otelmetric.WithUnit(unit),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gauge: %w", err)
+	}
+	return gauge, nil
+}
+
+// RecordGauge sets a gauge to the given value.
+// The gauge must have been created using CreateGauge.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - gauge: The gauge metric to set
+//   - value: The current value of the gauge
+//   - labels: Optional key-value pairs for metric dimensions
+//
+// Example:
+//
+//	metric.RecordGauge(ctx, gauge, int64(len(queue)),
+//	    metric.CreateAttributeString("queue", "emails"),
+//	)
+func (m *metric) RecordGauge(ctx context.Context, gauge otelmetric.Int64Gauge, value int64, labels ...attribute.KeyValue) {
+	gauge.Record(ctx, value, otelmetric.WithAttributes(labels...))
+}
+
+// NewAttributeSet creates a reusable set of metric labels. The labels are sorted, de-duplicated,
+// and turned into measurement options once, so recording with the set through RecordCounterSet,
+// RecordHistogramSet, or RecordGaugeSet does not allocate. Use it for hot-path instruments
+// recorded with the same labels many times; when a key repeats, the last value wins.
+//
+// Parameters:
+//   - kvs: The key-value pairs for metric dimensions
+//
+// Returns:
+//   - An AttributeSet that can be shared across goroutines
+//
+// Example:
+//
+//	getOK := metric.NewAttributeSet(
+//	    metric.CreateAttributeString("method", "GET"),
+//	    metric.CreateAttributeInt("status", 200),
+//	)
+//	metric.RecordCounterSet(ctx, counter, 1, getOK)
+func (m *metric) NewAttributeSet(kvs ...attribute.KeyValue) AttributeSet {
+	return newAttributeSet(kvs...)
+}
+
+// RecordCounterSet increments a counter by a given value with labels from a set created by
+// NewAttributeSet.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - counter: The counter metric to increment
+//   - value: The value to add to the counter (must be non-negative)
+//   - set: The metric dimensions
+//
+// Example:
+//
+//	metric.RecordCounterSet(ctx, counter, 1, getOK)
+func (m *metric) RecordCounterSet(ctx context.Context, counter otelmetric.Int64Counter, value int64, set AttributeSet) {
+	counter.Add(ctx, value, set.add...)
+}
+
+// RecordHistogramSet records a value in a histogram with labels from a set created by
+// NewAttributeSet.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - histogram: The histogram metric to record to
+//   - value: The value to record
+//   - set: The metric dimensions
+//
+// Example:
+//
+//	metric.RecordHistogramSet(ctx, histogram, duration, getOK)
+func (m *metric) RecordHistogramSet(ctx context.Context, histogram otelmetric.Int64Histogram, value int64, set AttributeSet) {
+	histogram.Record(ctx, value, set.record...)
+}
+
+// RecordGaugeSet sets a gauge to the given value with labels from a set created by
+// NewAttributeSet.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - gauge: The gauge metric to set
+//   - value: The current value of the gauge
+//   - set: The metric dimensions
+//
+// Example:
+//
+//	metric.RecordGaugeSet(ctx, gauge, int64(len(queue)), emailsQueue)
+func (m *metric) RecordGaugeSet(ctx context.Context, gauge otelmetric.Int64Gauge, value int64, set AttributeSet) {
+	gauge.Record(ctx, value, set.record...)
+}
+
 // CreateAttributeInt creates an integer attribute for metric labels.
 // Attributes are used to add dimensions to metrics for filtering and aggregation.
 //
@@ -171,5 +360,306 @@ func (m *metric) CreateAttributeString(key string, value string) attribute.KeyVa
 //	    log.Printf("Failed to shutdown metric: %v", err)
 //	}
 func (m *metric) Shutdown(ctx context.Context) error {
-	return m.provider.Shutdown(ctx)
+	if m.provider == nil || m.parent != nil {
+		return nil
+	}
+	// The StatsD listener stops feeding instruments before the final export, and the reader
+	// performs the final export, so it must stop before the provider does.
+	var statsdErr error
+	if m.statsd != nil {
+		statsdErr = m.statsd.close()
+	}
+	return errors.Join(
+		statsdErr,
+		m.reader.shutdown(ctx),
+		m.provider.Shutdown(ctx),
+	)
+}
+
+// Collect collects the current value of every instrument and exports it once. It is the
+// export trigger of a metric created with ReaderMode "manual", for short-lived CLIs and cron
+// jobs that exit before a periodic export would fire; with the periodic reader it exports
+// immediately without waiting for the next interval. Collect is a no-op on a noop metric, and
+// on a metric created by Scoped it collects the parent metric and all its scopes.
+//
+// Parameters:
+//   - ctx: Context for controlling the collection and export timeout
+//
+// Returns an error if collection or export fails, or sdkmetric.ErrReaderShutdown after Shutdown.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	if err := metric.Collect(ctx); err != nil {
+//	    log.Printf("Failed to export metrics: %v", err)
+//	}
+func (m *metric) Collect(ctx context.Context) error {
+	if m.parent != nil {
+		return m.parent.Collect(ctx)
+	}
+	if m.provider == nil {
+		return nil
+	}
+	return m.reader.collectAndExport(ctx)
+}
+
+// Snapshot returns the current value of every instrument as Go structs, so tests and admin
+// endpoints can read metrics without scraping an exporter: counter totals, last gauge values,
+// and histogram counts, sums, and bucket counts, from which HistogramSnapshot.Percentile
+// estimates percentiles. It includes the instruments of every scope and namespace, and of
+// third-party libraries using Provider. Values are not exported, except with delta
+// temporality, where collecting resets them and they are exported so none is lost. Snapshot
+// returns an empty snapshot on a noop metric, and on a metric created by Scoped or Namespace it
+// snapshots the parent metric.
+//
+// Parameters:
+//   - ctx: Context for controlling the collection timeout
+//
+// Returns an error if collection fails, or sdkmetric.ErrReaderShutdown after Shutdown.
+//
+// Example:
+//
+//	snapshot, err := metric.Snapshot(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	if latency, ok := snapshot.Histogram("request_duration_ms", attribute.String("route", "/orders")); ok {
+//	    fmt.Printf("p99: %.0fms over %d requests\n", latency.Percentile(0.99), latency.Count)
+//	}
+func (m *metric) Snapshot(ctx context.Context) (*Snapshot, error) {
+	if m.parent != nil {
+		return m.parent.Snapshot(ctx)
+	}
+	if m.provider == nil {
+		return &Snapshot{}, nil
+	}
+	rm, err := m.reader.snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newSnapshot(rm), nil
+}
+
+// Provider returns the meter provider backing this metric, so third-party instrumentation
+// (otelhttp, otelgrpc, otelsql) can record metrics with the same exporter and resource
+// instead of the OpenTelemetry globals. A noop metric returns a noop provider.
+//
+// Example:
+//
+//	handler := otelhttp.NewHandler(mux, "server",
+//	    otelhttp.WithMeterProvider(metric.Provider()),
+//	)
+func (m *metric) Provider() otelmetric.MeterProvider {
+	if m.provider == nil {
+		return noop.NewMeterProvider()
+	}
+	return m.provider
+}
+
+// Scoped returns a metric that shares this metric's provider and exporter but creates
+// instruments under its own instrumentation scope, so a library embedded in the application can
+// be told apart in the exported data without creating another exporter.
+// Shutting down a scoped metric is a no-op; the provider is shut down with the parent, and
+// reloading a scoped metric reloads the parent.
+//
+// Parameters:
+//   - name: The instrumentation scope name, conventionally the library's import path
+//   - version: The instrumentation scope version (may be empty)
+//
+// Returns:
+//   - A Metric whose instruments are attributed to the given scope
+//
+// Example:
+//
+//	paymentsMetric := metric.Scoped("github.com/acme/payments", "v1.4.0")
+//	counter, _ := paymentsMetric.CreateCounter("charges_total", "1", "Charges attempted")
+func (m *metric) Scoped(name, version string) Metric {
+	parent := m
+	if m.parent != nil {
+		parent = m.parent
+	}
+	return &metric{
+		provider:    m.provider,
+		meter:       m.Provider().Meter(name, otelmetric.WithInstrumentationVersion(version)),
+		parent:      parent,
+		prefix:      m.prefix,
+		names:       m.names,
+		instruments: newInstrumentRegistry(),
+	}
+}
+
+// checkInstrument validates the prefixed name of an instrument of kind when strict names are
+// enabled, and registers it with its metadata to detect conflicting re-creations.
+func (m *metric) checkInstrument(kind instrumentKind, name, unit, description string) error {
+	name = m.prefix + name
+	if m.names != nil {
+		if err := m.names.check(kind, name, unit); err != nil {
+			return err
+		}
+	}
+	if m.instruments == nil {
+		return nil
+	}
+	return m.instruments.register(kind, name, unit, description)
+}
+
+// Namespace returns a metric that shares this metric's provider, exporter, and
+// instrumentation scope but prepends prefix to the name of every instrument it creates, so
+// teams sharing one binary cannot collide on instrument names. The prefix is used verbatim,
+// separator included, and namespaces nest: Namespace("payments_").Namespace("refunds_") creates
+// "payments_refunds_" instruments. Watch on a namespaced metric watches the prefixed name.
+// Shutting down a namespaced metric is a no-op, and reloading it reloads the parent.
+//
+// Parameters:
+//   - prefix: The prefix of the instrument names, e.g. "payments_"
+//
+// Returns:
+//   - A Metric whose instrument names start with prefix
+//
+// Example:
+//
+//	payments := metric.Namespace("payments_")
+//	counter, _ := payments.CreateCounter("charges_total", "1", "Charges attempted") // payments_charges_total
+func (m *metric) Namespace(prefix string) Metric {
+	parent := m
+	if m.parent != nil {
+		parent = m.parent
+	}
+	return &metric{
+		provider:    m.provider,
+		meter:       m.meter,
+		parent:      parent,
+		prefix:      m.prefix + prefix,
+		names:       m.names,
+		instruments: m.instruments,
+	}
+}
+
+// Watch evaluates predicate against the values of the named instrument every time metrics are
+// collected for export, and calls callback when the predicate starts holding for a data point
+// (Firing true) and again when it stops holding (Firing false), for lightweight in-process
+// alerting. Each attribute set of the instrument is a separate data point. Counters and gauges
+// are evaluated with their value, which for counters is the running total with cumulative
+// temporality and the increase since the last export with delta temporality; histograms are
+// evaluated with the mean of the recorded values. The callback runs on the export path, so it
+// should return quickly. Watch returns a function that removes the watch. On a noop metric
+// nothing is ever collected, and on a metric created by Scoped or Namespace the watch is
+// evaluated by the parent's reader.
+//
+// Parameters:
+//   - instrument: The name of the instrument to watch
+//   - predicate: The threshold condition, called with each data point value
+//   - callback: Called with the data point whenever the predicate result changes
+//
+// Example:
+//
+//	stop := metric.Watch("queue_depth", func(v float64) bool { return v > 1000 }, func(e WatchEvent) {
+//	    healthy.Store(!e.Firing)
+//	    if e.Firing {
+//	        log.Printf("%s over threshold: %v", e.Instrument, e.Value)
+//	    }
+//	})
+//	defer stop()
+func (m *metric) Watch(instrument string, predicate func(value float64) bool, callback func(event WatchEvent)) func() {
+	if m.parent != nil {
+		return m.parent.Watch(m.prefix+instrument, predicate, callback)
+	}
+	if m.reader == nil {
+		return func() {}
+	}
+	return m.reader.watches.add(&watch{
+		instrument: instrument,
+		predicate:  predicate,
+		callback:   callback,
+		firing:     make(map[attribute.Distinct]bool),
+	})
+}
+
+// Reload applies opts on top of the metric's current configuration without recreating the
+// meter provider, so instruments created earlier keep recording. A new export interval takes
+// effect from the next tick. When the provider, endpoint, insecure flag, stdout format, or
+// circuit breaker settings change, a new exporter is created and swapped in and the previous
+// exporter is shut down. Identity options (service name, environment, instance) are part of
+// the metric resource, and the reader mode, temporality, exemplars, stdout writer, producers,
+// OpenCensus bridge, breaker state handler, strict names, and name warning handler are fixed
+// when the metric is created; they cannot be reloaded and are ignored. Reload is a no-op on a
+// noop metric. On a metric created by Scoped, Reload applies to the parent metric and all its
+// scopes.
+//
+// Returns the same validation errors as NewMetric; on error the running configuration is unchanged.
+//
+// Example:
+//
+//	if err := metric.Reload(WithInterval(10 * time.Second)); err != nil {
+//	    log.Printf("Failed to reload metric: %v", err)
+//	}
+func (m *metric) Reload(opts ...Option) error {
+	if m.parent != nil {
+		return m.parent.Reload(opts...)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.provider == nil {
+		return nil
+	}
+
+	options := *m.options
+	for _, opt := range opts {
+		opt(&options)
+	}
+	// identity is baked into the resource, keep the running values
+	options.ServiceName = m.options.ServiceName
+	options.Environment = m.options.Environment
+	options.InstanceName = m.options.InstanceName
+	options.InstanceHost = m.options.InstanceHost
+	options.ResourceAttributes = m.options.ResourceAttributes
+	options.ScopeName = m.options.ScopeName
+	options.ScopeVersion = m.options.ScopeVersion
+	options.ReaderMode = m.options.ReaderMode
+	options.Temporality = m.options.Temporality
+	options.Exemplars = m.options.Exemplars
+	options.Writer = m.options.Writer
+	options.StatsDAddress = m.options.StatsDAddress
+	options.Producers = m.options.Producers
+	options.OpenCensusBridge = m.options.OpenCensusBridge
+	options.BreakerStateHandler = m.options.BreakerStateHandler
+	options.StrictNames = m.options.StrictNames
+	options.NameWarningHandler = m.options.NameWarningHandler
+
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	if options.Provider != m.options.Provider ||
+		options.ProviderHost != m.options.ProviderHost ||
+		options.ProviderPort != m.options.ProviderPort ||
+		options.StdoutFormat != m.options.StdoutFormat ||
+		options.EMFNamespace != m.options.EMFNamespace ||
+		options.Insecure != m.options.Insecure ||
+		options.Endpoint != m.options.Endpoint ||
+		options.PushgatewayJob != m.options.PushgatewayJob ||
+		options.PushgatewayInstance != m.options.PushgatewayInstance ||
+		options.InfluxDBURL != m.options.InfluxDBURL ||
+		options.InfluxDBOrg != m.options.InfluxDBOrg ||
+		options.InfluxDBBucket != m.options.InfluxDBBucket ||
+		options.InfluxDBToken != m.options.InfluxDBToken ||
+		options.BreakerThreshold != m.options.BreakerThreshold ||
+		options.BreakerMaxBackoff != m.options.BreakerMaxBackoff {
+		exporter, err := newExporter(&options)
+		if err != nil {
+			return err
+		}
+		if err := m.reader.setExporter(context.Background(), exporter); err != nil {
+			otel.Handle(err)
+		}
+	}
+
+	if options.Interval != m.options.Interval {
+		m.reader.setInterval(options.Interval)
+	}
+
+	m.options = &options
+	return nil
 }
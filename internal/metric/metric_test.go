@@ -2,10 +2,15 @@ package metric
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/adityakw90/go-monitoring/internal/breaker"
 	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 func TestMetric_Metric_CreateCounter(t *testing.T) {
@@ -192,6 +197,91 @@ func TestMetric_Metric_RecordHistogram(t *testing.T) {
 	metricInstance.RecordHistogram(ctx, histogram, 999999)
 }
 
+func TestMetric_Metric_CreateGauge(t *testing.T) {
+	metricInstance, err := NewMetric(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = metricInstance.Shutdown(ctx)
+	}()
+
+	tests := []struct {
+		name        string
+		gaugeName   string
+		unit        string
+		description string
+		wantErr     bool
+	}{
+		{
+			name:        "valid gauge",
+			gaugeName:   "test_gauge",
+			unit:        "1",
+			description: "Test gauge description",
+			wantErr:     false,
+		},
+		{
+			name:        "gauge with seconds unit",
+			gaugeName:   "last_success_timestamp",
+			unit:        "s",
+			description: "Last success timestamp",
+			wantErr:     false,
+		},
+		{
+			name:        "gauge with empty name",
+			gaugeName:   "",
+			unit:        "1",
+			description: "Test gauge",
+			wantErr:     true, // OpenTelemetry doesn't allow empty names
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gauge, err := metricInstance.CreateGauge(tt.gaugeName, tt.unit, tt.description)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateGauge() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && gauge == nil {
+				t.Errorf("CreateGauge() returned nil gauge")
+			}
+		})
+	}
+}
+
+func TestMetric_Metric_RecordGauge(t *testing.T) {
+	metricInstance, err := NewMetric(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = metricInstance.Shutdown(ctx)
+	}()
+
+	gauge, err := metricInstance.CreateGauge("test_gauge", "1", "Test gauge")
+	if err != nil {
+		t.Fatalf("CreateGauge() error = %v", err)
+	}
+
+	ctx := context.Background()
+
+	// Test recording without labels
+	metricInstance.RecordGauge(ctx, gauge, 10)
+
+	// Test recording with labels
+	metricInstance.RecordGauge(ctx, gauge, 5,
+		attribute.String("queue", "emails"),
+	)
+
+	// Test recording a decreasing value
+	metricInstance.RecordGauge(ctx, gauge, -3)
+}
+
 func TestMetric_Metric_CreateAttributeInt(t *testing.T) {
 	metricInstance, err := NewMetric(WithServiceName("test-service"))
 	if err != nil {
@@ -448,3 +538,440 @@ func TestMetric_Metric_MultipleInstancesCoexist(t *testing.T) {
 		t.Errorf("CreateCounter() returned same instance from different metrics")
 	}
 }
+
+func TestMetric_Metric_Reload(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantErrIs error
+		check     func(*testing.T, *metric)
+	}{
+		{
+			name: "interval change",
+			opts: []Option{WithInterval(10 * time.Second)},
+			check: func(t *testing.T, m *metric) {
+				if m.options.Interval != 10*time.Second {
+					t.Errorf("expected Interval = 10s, got %v", m.options.Interval)
+				}
+			},
+		},
+		{
+			name: "identity options are ignored",
+			opts: []Option{WithServiceName("other-service")},
+			check: func(t *testing.T, m *metric) {
+				if m.options.ServiceName != "test-service" {
+					t.Errorf("expected ServiceName = 'test-service', got %q", m.options.ServiceName)
+				}
+			},
+		},
+		{
+			name:      "invalid interval",
+			opts:      []Option{WithInterval(0)},
+			wantErrIs: ErrIntervalInvalid,
+			check: func(t *testing.T, m *metric) {
+				if m.options.Interval != 60*time.Second {
+					t.Errorf("expected Interval to stay 60s, got %v", m.options.Interval)
+				}
+			},
+		},
+		{
+			name:      "otlp provider missing host",
+			opts:      []Option{WithProvider("otlp", "", 4317)},
+			wantErrIs: ErrProviderHostRequired,
+			check: func(t *testing.T, m *metric) {
+				if m.options.Provider != "stdout" {
+					t.Errorf("expected Provider to stay 'stdout', got %q", m.options.Provider)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricInstance, err := NewMetric(WithServiceName("test-service"))
+			if err != nil {
+				t.Fatalf("NewMetric() error = %v", err)
+			}
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_ = metricInstance.Shutdown(ctx)
+			}()
+
+			m := metricInstance.(*metric)
+			err = m.Reload(tt.opts...)
+			if err != tt.wantErrIs {
+				t.Fatalf("Reload() error = %v, want %v", err, tt.wantErrIs)
+			}
+			if tt.check != nil {
+				tt.check(t, m)
+			}
+		})
+	}
+}
+
+func TestMetric_Metric_Reload_CircuitBreaker(t *testing.T) {
+	var states []breaker.State
+	metricInstance, err := NewMetric(
+		WithServiceName("test-service"),
+		WithBreakerStateHandler(func(state breaker.State) { states = append(states, state) }),
+	)
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = metricInstance.Shutdown(ctx)
+	}()
+
+	m := metricInstance.(*metric)
+	previous := m.reader.currentExporter()
+	if err := m.Reload(WithCircuitBreaker(2, time.Minute), WithBreakerStateHandler(nil)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if m.reader.currentExporter() == previous {
+		t.Error("expected exporter to be replaced")
+	}
+	if m.options.BreakerThreshold != 2 || m.options.BreakerMaxBackoff != time.Minute {
+		t.Errorf("expected breaker 2/1m, got %d/%v", m.options.BreakerThreshold, m.options.BreakerMaxBackoff)
+	}
+	if m.options.BreakerStateHandler == nil {
+		t.Fatal("expected the breaker state handler to be kept")
+	}
+	if len(states) != 2 || states[1] != breaker.StateClosed {
+		t.Errorf("expected two closed state reports, got %v", states)
+	}
+}
+
+func TestMetric_Metric_Reload_NameWarningHandler(t *testing.T) {
+	var warnings []string
+	metricInstance, err := NewMetric(
+		WithServiceName("test-service"),
+		WithStrictNames(true),
+		WithNameWarningHandler(func(name, warning string) { warnings = append(warnings, name) }),
+	)
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	m := metricInstance.(*metric)
+	if err := m.Reload(WithStrictNames(false), WithNameWarningHandler(nil)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if !m.options.StrictNames || m.options.NameWarningHandler == nil {
+		t.Fatalf("expected strict names and the name warning handler to be kept, got %v, %v", m.options.StrictNames, m.options.NameWarningHandler != nil)
+	}
+	if _, err := m.CreateCounter("requests", "1", "Requests"); err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("expected one name warning after reload, got %v", warnings)
+	}
+}
+
+func TestMetric_Metric_Provider(t *testing.T) {
+	reader := newPeriodicReader(&recordingExporter{}, time.Hour, nil)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	if got := metricInstance.Provider(); got != provider {
+		t.Fatalf("Provider() = %v, want the metric's meter provider", got)
+	}
+
+	// Instruments created by third-party libraries through the provider share its reader.
+	counter, err := metricInstance.Provider().Meter("otelhttp").Int64Counter("http_requests_total")
+	if err != nil {
+		t.Fatalf("Int64Counter() error = %v", err)
+	}
+	counter.Add(context.Background(), 1)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(rm.ScopeMetrics) != 1 || rm.ScopeMetrics[0].Scope.Name != "otelhttp" {
+		t.Errorf("expected metrics from the otelhttp scope, got %+v", rm.ScopeMetrics)
+	}
+}
+
+func TestMetric_Metric_Provider_Noop(t *testing.T) {
+	provider := NewNoopMetric().Provider()
+	if provider == nil {
+		t.Fatal("Provider() = nil, want noop provider")
+	}
+	if _, err := provider.Meter("otelhttp").Int64Counter("http_requests_total"); err != nil {
+		t.Errorf("Int64Counter() error = %v", err)
+	}
+}
+
+func TestMetric_Metric_Scoped(t *testing.T) {
+	reader := newPeriodicReader(&recordingExporter{}, time.Hour, nil)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	scoped := metricInstance.Scoped("github.com/acme/payments", "v1.4.0")
+	counter, err := scoped.CreateCounter("charges_total", "1", "Charges attempted")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	scoped.RecordCounter(context.Background(), counter, 1)
+
+	// Shutting down a scope must leave the shared provider running.
+	if err := scoped.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(rm.ScopeMetrics) != 1 {
+		t.Fatalf("expected 1 scope, got %d", len(rm.ScopeMetrics))
+	}
+	scope := rm.ScopeMetrics[0].Scope
+	if scope.Name != "github.com/acme/payments" || scope.Version != "v1.4.0" {
+		t.Errorf("scope = %+v, want github.com/acme/payments v1.4.0", scope)
+	}
+	if nested := scoped.Scoped("nested", "").(*metric); nested.parent != metricInstance {
+		t.Error("expected a nested scope to keep the root metric as parent")
+	}
+}
+
+func TestMetric_Metric_Namespace(t *testing.T) {
+	reader := newPeriodicReader(&recordingExporter{}, time.Hour, nil)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	payments := metricInstance.Namespace("payments_")
+	counter, err := payments.CreateCounter("charges_total", "1", "Charges attempted")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	payments.RecordCounter(context.Background(), counter, 1)
+	histogram, err := payments.Namespace("refunds_").CreateHistogram("amount_cents", "1", "Refunded amounts")
+	if err != nil {
+		t.Fatalf("CreateHistogram() error = %v", err)
+	}
+	payments.RecordHistogram(context.Background(), histogram, 250)
+	gauge, err := payments.Scoped("github.com/acme/payments", "").CreateGauge("queue_depth", "1", "Pending charges")
+	if err != nil {
+		t.Fatalf("CreateGauge() error = %v", err)
+	}
+	payments.RecordGauge(context.Background(), gauge, 3)
+
+	var fired []string
+	remove := payments.Watch("charges_total", func(value float64) bool { return value > 0 }, func(event WatchEvent) {
+		fired = append(fired, event.Instrument)
+	})
+	defer remove()
+
+	// Shutting down a namespace must leave the shared provider running.
+	if err := payments.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+
+	if err := payments.Collect(context.Background()); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	var rm metricdata.ResourceMetrics
+	if err := reader.reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	var names []string
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			names = append(names, m.Name)
+		}
+	}
+	for _, want := range []string{"payments_charges_total", "payments_refunds_amount_cents", "payments_queue_depth"} {
+		if !slices.Contains(names, want) {
+			t.Errorf("exported instruments = %v, want %s", names, want)
+		}
+	}
+	if len(fired) != 1 || fired[0] != "payments_charges_total" {
+		t.Errorf("watch fired for %v, want [payments_charges_total]", fired)
+	}
+}
+
+func TestMetric_Metric_Collect(t *testing.T) {
+	exporter := &recordingExporter{}
+	reader := newManualReader(exporter)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+
+	counter, err := metricInstance.CreateCounter("jobs_total", "1", "Jobs run")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	metricInstance.RecordCounter(context.Background(), counter, 1)
+
+	if err := metricInstance.Collect(context.Background()); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if exports, _ := exporter.state(); exports != 1 {
+		t.Errorf("exports = %d after Collect, want 1", exports)
+	}
+
+	// A scoped metric collects through its parent's reader.
+	if err := metricInstance.Scoped("github.com/acme/payments", "").Collect(context.Background()); err != nil {
+		t.Fatalf("scoped Collect() error = %v", err)
+	}
+	if exports, _ := exporter.state(); exports != 2 {
+		t.Errorf("exports = %d after scoped Collect, want 2", exports)
+	}
+
+	if err := metricInstance.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+	if err := metricInstance.Collect(context.Background()); !errors.Is(err, sdkmetric.ErrReaderShutdown) {
+		t.Errorf("Collect() after Shutdown error = %v, want ErrReaderShutdown", err)
+	}
+}
+
+func TestMetric_Metric_Collect_Noop(t *testing.T) {
+	if err := NewNoopMetric().Collect(context.Background()); err != nil {
+		t.Errorf("Collect() error = %v, want nil", err)
+	}
+}
+
+func TestMetric_Metric_RecordMap(t *testing.T) {
+	reader := newManualReader(&recordingExporter{})
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	counter, err := metricInstance.CreateCounter("requests_total", "1", "Requests")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	histogram, err := metricInstance.CreateHistogram("request_duration_ms", "ms", "Request duration")
+	if err != nil {
+		t.Fatalf("CreateHistogram() error = %v", err)
+	}
+	fields := map[string]interface{}{"method": "GET", "status": 200}
+	metricInstance.RecordCounterMap(ctx, counter, 1, fields)
+	metricInstance.RecordHistogramMap(ctx, histogram, 150, fields)
+	metricInstance.RecordCounterMap(ctx, counter, 1, nil)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	want := attribute.NewSet(attribute.String("method", "GET"), attribute.Int("status", 200))
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		switch data := m.Data.(type) {
+		case metricdata.Sum[int64]:
+			if len(data.DataPoints) != 2 {
+				t.Fatalf("counter has %d data points, want 2", len(data.DataPoints))
+			}
+			found := false
+			for _, dp := range data.DataPoints {
+				if dp.Attributes.Equals(&want) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("counter data points %+v missing attributes %v", data.DataPoints, want)
+			}
+		case metricdata.Histogram[int64]:
+			if got := data.DataPoints[0].Attributes; !got.Equals(&want) {
+				t.Errorf("histogram attributes = %v, want %v", got, want)
+			}
+		}
+	}
+}
+
+func TestMetric_Metric_RecordSet(t *testing.T) {
+	reader := newManualReader(&recordingExporter{})
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	counter, err := metricInstance.CreateCounter("requests_total", "1", "Requests")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	histogram, err := metricInstance.CreateHistogram("request_duration_ms", "ms", "Request duration")
+	if err != nil {
+		t.Fatalf("CreateHistogram() error = %v", err)
+	}
+	gauge, err := metricInstance.CreateGauge("queue_depth", "1", "Queue depth")
+	if err != nil {
+		t.Fatalf("CreateGauge() error = %v", err)
+	}
+
+	set := metricInstance.NewAttributeSet(
+		metricInstance.CreateAttributeString("method", "GET"),
+		metricInstance.CreateAttributeInt("status", 200),
+	)
+	metricInstance.RecordCounterSet(ctx, counter, 1, set)
+	metricInstance.RecordCounterSet(ctx, counter, 2, set)
+	metricInstance.RecordHistogramSet(ctx, histogram, 150, set)
+	metricInstance.RecordGaugeSet(ctx, gauge, 7, set)
+	want := set.Set()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(rm.ScopeMetrics[0].Metrics) != 3 {
+		t.Fatalf("got %d metrics, want 3", len(rm.ScopeMetrics[0].Metrics))
+	}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		switch data := m.Data.(type) {
+		case metricdata.Sum[int64]:
+			if len(data.DataPoints) != 1 || data.DataPoints[0].Value != 3 || !data.DataPoints[0].Attributes.Equals(&want) {
+				t.Errorf("counter data points = %+v, want one point of 3 with %v", data.DataPoints, want)
+			}
+		case metricdata.Histogram[int64]:
+			if !data.DataPoints[0].Attributes.Equals(&want) {
+				t.Errorf("histogram attributes = %v, want %v", data.DataPoints[0].Attributes, want)
+			}
+		case metricdata.Gauge[int64]:
+			if data.DataPoints[0].Value != 7 || !data.DataPoints[0].Attributes.Equals(&want) {
+				t.Errorf("gauge data point = %+v, want 7 with %v", data.DataPoints[0], want)
+			}
+		}
+	}
+}
+
+func BenchmarkMetric_Metric_RecordCounterSet(b *testing.B) {
+	reader := newManualReader(&recordingExporter{})
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	counter, err := metricInstance.CreateCounter("requests_total", "1", "Requests")
+	if err != nil {
+		b.Fatalf("CreateCounter() error = %v", err)
+	}
+	set := metricInstance.NewAttributeSet(attribute.String("method", "GET"), attribute.Int("status", 200))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		metricInstance.RecordCounterSet(ctx, counter, 1, set)
+	}
+}
@@ -0,0 +1,128 @@
+package metric
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// maxNameLength is the maximum length of an OpenTelemetry instrument name.
+const maxNameLength = 255
+
+// instrumentKind is the kind of instrument a name is checked for.
+type instrumentKind string
+
+const (
+	kindCounter        instrumentKind = "counter"
+	kindHistogram      instrumentKind = "histogram"
+	kindFloatHistogram instrumentKind = "float histogram" // kindFloatHistogram is the Float64Histogram of CreateDurationHistogram.
+	kindGauge          instrumentKind = "gauge"
+)
+
+// unitSuffixes maps a unit to the name suffix Prometheus appends for it.
+var unitSuffixes = map[string]string{
+	"ms": "_milliseconds",
+	"s":  "_seconds",
+	"By": "_bytes",
+	"%":  "_percent",
+}
+
+// nameChecker validates instrument names when strict names are enabled.
+type nameChecker struct {
+	warn   func(name, warning string) // warn is called with every naming convention the name breaks; nil discards them.
+	warned sync.Map                   // warned holds the names already reported, as instruments are often created on every use.
+}
+
+// newNameChecker returns the checker for options, or nil when strict names are disabled.
+func newNameChecker(options *Options) *nameChecker {
+	if !options.StrictNames {
+		return nil
+	}
+	return &nameChecker{warn: options.NameWarningHandler}
+}
+
+// check returns an error wrapping ErrInstrumentNameInvalid when name is not a valid
+// OpenTelemetry instrument name, and reports the Prometheus naming conventions it breaks to the
+// warning handler the first time the name is checked.
+func (c *nameChecker) check(kind instrumentKind, name, unit string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	if c.warn == nil {
+		return nil
+	}
+	if _, seen := c.warned.LoadOrStore(name, struct{}{}); seen {
+		return nil
+	}
+	for _, warning := range nameWarnings(kind, name, unit) {
+		c.warn(name, warning)
+	}
+	return nil
+}
+
+// validateName returns an error wrapping ErrInstrumentNameInvalid when name does not follow the
+// OpenTelemetry instrument name syntax: a letter followed by up to 254 letters, digits, "_",
+// ".", "-", or "/".
+func validateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name is empty", ErrInstrumentNameInvalid)
+	}
+	if len(name) > maxNameLength {
+		return fmt.Errorf("%w: %q is longer than %d characters", ErrInstrumentNameInvalid, name, maxNameLength)
+	}
+	if !isLetter(name[0]) {
+		return fmt.Errorf("%w: %q must start with a letter", ErrInstrumentNameInvalid, name)
+	}
+	for i := 1; i < len(name); i++ {
+		if c := name[i]; !isLetter(c) && !isDigit(c) && !strings.ContainsRune("_.-/", rune(c)) {
+			return fmt.Errorf("%w: %q contains %q; use letters, digits, \"_\", \".\", \"-\", or \"/\"", ErrInstrumentNameInvalid, name, c)
+		}
+	}
+	return nil
+}
+
+// nameWarnings returns the Prometheus naming conventions name breaks for an instrument of kind
+// measured in unit.
+func nameWarnings(kind instrumentKind, name, unit string) []string {
+	var warnings []string
+	if strings.ContainsAny(name, ".-/") {
+		warnings = append(warnings, "contains \".\", \"-\", or \"/\", which Prometheus replaces with \"_\"; use snake_case")
+	}
+	if strings.ToLower(name) != name {
+		warnings = append(warnings, "contains upper case letters; use snake_case")
+	}
+
+	base, total := strings.CutSuffix(name, "_total")
+	switch {
+	case kind == kindCounter && !total:
+		warnings = append(warnings, "is a counter; end its name with \"_total\"")
+	case kind != kindCounter && total:
+		warnings = append(warnings, fmt.Sprintf("is a %s; \"_total\" is reserved for counters", kind))
+	}
+
+	if suffix, ok := unitSuffixes[unit]; ok && !hasUnitSuffix(base, unit, suffix) {
+		warnings = append(warnings, fmt.Sprintf("has unit %q; end its name with %q", unit, suffix))
+	}
+	for other, suffix := range unitSuffixes {
+		if other != unit && hasUnitSuffix(base, other, suffix) {
+			warnings = append(warnings, fmt.Sprintf("ends with the suffix of unit %q but has unit %q", other, unit))
+		}
+	}
+	return warnings
+}
+
+// hasUnitSuffix reports whether name ends with the suffix of unit, or with "_ms" for
+// milliseconds, the short form used across this library.
+func hasUnitSuffix(name, unit, suffix string) bool {
+	return strings.HasSuffix(name, suffix) || (unit == "ms" && strings.HasSuffix(name, "_ms"))
+}
+
+// isLetter reports whether c is an ASCII letter.
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,136 @@
+package metric
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMetric_Name_ValidateName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "snake case", input: "http_requests_total"},
+		{name: "dotted", input: "http.server.request.duration"},
+		{name: "all allowed characters", input: "a1_b.c-d/e"},
+		{name: "empty", input: "", wantErr: true},
+		{name: "leading digit", input: "1xx_responses_total", wantErr: true},
+		{name: "leading underscore", input: "_requests_total", wantErr: true},
+		{name: "space", input: "requests total", wantErr: true},
+		{name: "colon", input: "job:requests_total", wantErr: true},
+		{name: "too long", input: "a" + string(make([]byte, maxNameLength)), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateName(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInstrumentNameInvalid) {
+				t.Errorf("validateName(%q) error = %v, want ErrInstrumentNameInvalid", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestMetric_Name_NameWarnings(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  instrumentKind
+		input string
+		unit  string
+		want  []string
+	}{
+		{name: "conventional counter", kind: kindCounter, input: "http_requests_total", unit: "1"},
+		{name: "counter with unit", kind: kindCounter, input: "http_response_size_bytes_total", unit: "By"},
+		{name: "histogram in ms", kind: kindHistogram, input: "job_duration_ms", unit: "ms"},
+		{name: "gauge in seconds", kind: kindGauge, input: "job_last_success_timestamp_seconds", unit: "s"},
+		{
+			name:  "counter without _total",
+			kind:  kindCounter,
+			input: "http_requests",
+			unit:  "1",
+			want:  []string{`is a counter; end its name with "_total"`},
+		},
+		{
+			name:  "gauge with _total",
+			kind:  kindGauge,
+			input: "queue_depth_total",
+			unit:  "1",
+			want:  []string{`is a gauge; "_total" is reserved for counters`},
+		},
+		{
+			name:  "missing unit suffix",
+			kind:  kindHistogram,
+			input: "request_duration",
+			unit:  "s",
+			want:  []string{`has unit "s"; end its name with "_seconds"`},
+		},
+		{
+			name:  "suffix of another unit",
+			kind:  kindHistogram,
+			input: "request_duration_seconds",
+			unit:  "ms",
+			want:  []string{`has unit "ms"; end its name with "_milliseconds"`, `ends with the suffix of unit "s" but has unit "ms"`},
+		},
+		{
+			name:  "not snake case",
+			kind:  kindCounter,
+			input: "http.Requests_total",
+			unit:  "1",
+			want:  []string{`contains ".", "-", or "/", which Prometheus replaces with "_"; use snake_case`, "contains upper case letters; use snake_case"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nameWarnings(tt.kind, tt.input, tt.unit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("nameWarnings(%s, %q, %q) = %q, want %q", tt.kind, tt.input, tt.unit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_Name_StrictNames(t *testing.T) {
+	var warnings []string
+	metricInstance, err := NewMetric(
+		WithServiceName("test-service"),
+		WithStrictNames(true),
+		WithNameWarningHandler(func(name, warning string) {
+			warnings = append(warnings, name+": "+warning)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		_ = metricInstance.Shutdown(t.Context())
+	}()
+
+	if _, err := metricInstance.CreateCounter("requests total", "1", ""); !errors.Is(err, ErrInstrumentNameInvalid) {
+		t.Errorf("CreateCounter() error = %v, want ErrInstrumentNameInvalid", err)
+	}
+	if _, err := metricInstance.Namespace("9_").CreateGauge("queue_depth", "1", ""); !errors.Is(err, ErrInstrumentNameInvalid) {
+		t.Errorf("CreateGauge() with an invalid namespace error = %v, want ErrInstrumentNameInvalid", err)
+	}
+	for range 2 {
+		if _, err := metricInstance.Namespace("payments_").CreateCounter("charges", "1", ""); err != nil {
+			t.Fatalf("CreateCounter() error = %v", err)
+		}
+	}
+	if want := []string{`payments_charges: is a counter; end its name with "_total"`}; !reflect.DeepEqual(warnings, want) {
+		t.Errorf("warnings = %q, want %q reported once", warnings, want)
+	}
+
+	lenient, err := NewMetric(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		_ = lenient.Shutdown(t.Context())
+	}()
+	if _, err := lenient.CreateCounter("requests", "1", ""); err != nil {
+		t.Errorf("CreateCounter() without strict names error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,16 @@
+package metric
+
+import (
+	ocbridge "go.opentelemetry.io/otel/bridge/opencensus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// producers returns the producers the reader collects next to the meter provider:
+// options.Producers, and the OpenCensus bridge when options.OpenCensusBridge is set, so the
+// views registered with OpenCensus are exported with the other metrics.
+func producers(options *Options) []sdkmetric.Producer {
+	if !options.OpenCensusBridge {
+		return options.Producers
+	}
+	return append(append([]sdkmetric.Producer(nil), options.Producers...), ocbridge.NewMetricProducer())
+}
@@ -0,0 +1,73 @@
+package metric
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func TestMetric_Opencensus_Producers(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		want    int
+	}{
+		{"bridge disabled", Options{Producers: nil}, 0},
+		{"bridge disabled keeps producers", Options{Producers: []sdkmetric.Producer{staticProducer{}}}, 1},
+		{"bridge enabled", Options{OpenCensusBridge: true}, 1},
+		{"bridge enabled appends to producers", Options{OpenCensusBridge: true, Producers: []sdkmetric.Producer{staticProducer{}}}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := producers(&tt.options); len(got) != tt.want {
+				t.Errorf("producers() returned %d producers, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_Opencensus_NewMetric(t *testing.T) {
+	requests := stats.Int64("oc_bridge_requests", "Requests served", stats.UnitDimensionless)
+	requestsView := &view.View{Name: "oc_bridge_requests", Measure: requests, Aggregation: view.Sum()}
+	if err := view.Register(requestsView); err != nil {
+		t.Fatalf("view.Register() error = %v", err)
+	}
+	defer view.Unregister(requestsView)
+	stats.Record(context.Background(), requests.M(3), requests.M(4))
+
+	m, err := NewMetric(
+		WithServiceName("test-service"),
+		WithReaderMode("manual"),
+		WithWriter(&bytes.Buffer{}),
+		WithOpenCensusBridge(true),
+	)
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		_ = m.Shutdown(context.Background())
+	}()
+
+	// OpenCensus aggregates recorded measurements asynchronously.
+	deadline := time.Now().Add(time.Second)
+	for {
+		snapshot, err := m.Snapshot(context.Background())
+		if err != nil {
+			t.Fatalf("Snapshot() error = %v", err)
+		}
+		c, ok := snapshot.Counter("oc_bridge_requests")
+		if ok && c.Value == 7 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Snapshot() oc_bridge_requests = %v, %v, want the OpenCensus view's value 7; got %+v", c, ok, snapshot)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -1,25 +1,144 @@
 package metric
 
-import "time"
+import (
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	"github.com/adityakw90/go-monitoring/internal/endpoint"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
 
 // Options contains configuration options for creating a Metric.
 // All fields are optional and have sensible defaults.
 type Options struct {
-	ServiceName  string        // ServiceName is the name of the service collecting metrics.
-	Environment  string        // Environment is the deployment environment (e.g., "development", "production").
-	InstanceName string        // InstanceName is the unique identifier for this service instance.
-	InstanceHost string        // InstanceHost is the hostname where this service instance is running.
-	Provider     string        // Provider specifies the metric exporter to use ("stdout" or "otlp").
-	ProviderHost string        // ProviderHost is the hostname of the OTLP metric collector (only used when Provider is "otlp").
-	ProviderPort int           // ProviderPort is the port of the OTLP metric collector (only used when Provider is "otlp").
-	Interval     time.Duration // Interval is the time interval between metric exports.
-	Insecure     bool          // Insecure controls whether to use an insecure (non-TLS) connection for OTLP exporter. When true, connections are made without TLS. Default is false (secure TLS connection).
+	ServiceName         string                     // ServiceName is the name of the service collecting metrics.
+	Environment         string                     // Environment is the deployment environment (e.g., "development", "production").
+	InstanceName        string                     // InstanceName is the unique identifier for this service instance.
+	InstanceHost        string                     // InstanceHost is the hostname where this service instance is running.
+	ResourceAttributes  []attribute.KeyValue       // ResourceAttributes are added to the resource next to the service identity, e.g. Kubernetes or cloud metadata.
+	ScopeName           string                     // ScopeName is the instrumentation scope name of the meter. If empty, ServiceName is used.
+	ScopeVersion        string                     // ScopeVersion is the instrumentation scope version of the meter.
+	Provider            string                     // Provider specifies the metric exporter to use ("stdout", "otlp", "pushgateway", or "influxdb").
+	ProviderHost        string                     // ProviderHost is the hostname of the OTLP metric collector or Prometheus Pushgateway (only used when Provider is "otlp" or "pushgateway").
+	ProviderPort        int                        // ProviderPort is the port of the OTLP metric collector or Prometheus Pushgateway (only used when Provider is "otlp" or "pushgateway").
+	PushgatewayJob      string                     // PushgatewayJob is the job label of the grouping key the "pushgateway" provider pushes to.
+	PushgatewayInstance string                     // PushgatewayInstance is the instance label of the grouping key the "pushgateway" provider pushes to. If empty, the grouping key has no instance.
+	InfluxDBURL         string                     // InfluxDBURL is the base URL of the InfluxDB v2 server the "influxdb" provider writes to (e.g., "http://localhost:8086").
+	InfluxDBOrg         string                     // InfluxDBOrg is the organization owning the InfluxDB bucket.
+	InfluxDBBucket      string                     // InfluxDBBucket is the InfluxDB bucket the metrics are written to.
+	InfluxDBToken       string                     // InfluxDBToken is the API token authorizing the writes. If empty, no Authorization header is sent.
+	StdoutFormat        string                     // StdoutFormat selects how the "stdout" provider writes metrics: "pretty" (default) indented JSON, "ndjson" one compact JSON object per export per line, or "emf" one CloudWatch Embedded Metric Format object per data point per line.
+	EMFNamespace        string                     // EMFNamespace is the CloudWatch namespace of the metrics written in the "emf" stdout format. If empty, ServiceName is used.
+	Writer              io.Writer                  // Writer receives the metrics of the "stdout" provider. If nil, they are written to os.Stdout.
+	Interval            time.Duration              // Interval is the time interval between metric exports.
+	Insecure            bool                       // Insecure controls whether to use an insecure (non-TLS) connection for OTLP exporter. When true, connections are made without TLS. Default is false (secure TLS connection).
+	Endpoint            string                     // Endpoint is the OTLP collector URL (e.g., "https://collector:4318/v1/metrics"). When set it replaces Provider, ProviderHost, ProviderPort, and Insecure; the scheme selects gRPC or HTTP and TLS.
+	BreakerThreshold    int                        // BreakerThreshold is the number of consecutive export failures that opens the exporter circuit breaker. Zero disables the breaker.
+	BreakerMaxBackoff   time.Duration              // BreakerMaxBackoff caps the time the circuit breaker stays open before a trial export.
+	BreakerStateHandler func(state breaker.State)  // BreakerStateHandler is called on every circuit breaker state transition.
+	ReaderMode          string                     // ReaderMode selects how metrics are exported: "periodic" (default) exports every Interval, "manual" only exports when Collect is called.
+	Temporality         string                     // Temporality selects how counters and histograms are aggregated over time: "cumulative" (default) or "delta".
+	Exemplars           bool                       // Exemplars attaches the trace and span IDs of sampled spans to measurements as exemplars.
+	StrictNames         bool                       // StrictNames validates instrument names when instruments are created: invalid names are rejected with ErrInstrumentNameInvalid and Prometheus convention violations are reported to NameWarningHandler.
+	NameWarningHandler  func(name, warning string) // NameWarningHandler is called with every naming convention an instrument name breaks when StrictNames is set.
+	Producers           []sdkmetric.Producer       // Producers supply metrics from outside the meter provider, such as the OpenCensus bridge, to every collection.
+	OpenCensusBridge    bool                       // OpenCensusBridge collects the metrics of the views registered with OpenCensus with every collection.
+	StatsDAddress       string                     // StatsDAddress is the UDP address ("host:port") a StatsD listener receives packets on and republishes through the meter provider. If empty, no listener runs.
+	Clock               clock.Clock                // Clock drives the export interval. Defaults to the real clock; tests can use a fake clock to trigger exports without sleeping.
+}
+
+// Validate reports whether the options describe a valid metric without creating it.
+// It returns ErrIntervalInvalid, ErrInvalidReaderMode, ErrInvalidTemporality, ErrInvalidStdoutFormat, ErrBreakerThresholdInvalid,
+// ErrBreakerMaxBackoffInvalid, ErrStatsDAddressInvalid, ErrEndpointInvalid, ErrInvalidProvider, ErrProviderHostRequired,
+// ErrProviderPortRequired, ErrProviderPortInvalid, ErrPushgatewayJobRequired, ErrInfluxDBURLInvalid, ErrInfluxDBOrgRequired,
+// or ErrInfluxDBBucketRequired for the first invalid setting found.
+func (o *Options) Validate() error {
+	if o.Interval <= 0 {
+		return ErrIntervalInvalid
+	}
+	switch o.ReaderMode {
+	case "", "periodic", "manual":
+	default:
+		return ErrInvalidReaderMode
+	}
+	switch o.Temporality {
+	case "", "cumulative", "delta":
+	default:
+		return ErrInvalidTemporality
+	}
+	switch o.StdoutFormat {
+	case "", "pretty", "ndjson", "emf":
+	default:
+		return ErrInvalidStdoutFormat
+	}
+	if o.BreakerThreshold < 0 {
+		return ErrBreakerThresholdInvalid
+	}
+	if o.BreakerThreshold > 0 && o.BreakerMaxBackoff <= 0 {
+		return ErrBreakerMaxBackoffInvalid
+	}
+	if o.StatsDAddress != "" {
+		if _, _, err := net.SplitHostPort(o.StatsDAddress); err != nil {
+			return ErrStatsDAddressInvalid
+		}
+	}
+
+	if o.Endpoint != "" {
+		if _, err := endpoint.Parse(o.Endpoint); err != nil {
+			return ErrEndpointInvalid
+		}
+	} else {
+		switch o.Provider {
+		case "stdout":
+		case "otlp", "pushgateway":
+			if o.ProviderHost == "" {
+				return ErrProviderHostRequired
+			}
+			if o.ProviderPort == 0 {
+				return ErrProviderPortRequired
+			}
+			if o.ProviderPort < 0 {
+				return ErrProviderPortInvalid
+			}
+			if o.Provider == "pushgateway" && strings.TrimSpace(o.PushgatewayJob) == "" {
+				return ErrPushgatewayJobRequired
+			}
+		case "influxdb":
+			u, err := url.Parse(o.InfluxDBURL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return ErrInfluxDBURLInvalid
+			}
+			if o.InfluxDBOrg == "" {
+				return ErrInfluxDBOrgRequired
+			}
+			if o.InfluxDBBucket == "" {
+				return ErrInfluxDBBucketRequired
+			}
+		default:
+			return ErrInvalidProvider
+		}
+	}
+	return nil
 }
 
 // Option is a function that configures Options.
 // It follows the functional options pattern for flexible metric configuration.
 type Option func(*Options)
 
+// WithOptions returns an Option that replaces every setting with options, as the root package
+// does with the metric Options it holds before adding the settings shared with the tracer.
+func WithOptions(options Options) Option {
+	return func(o *Options) {
+		*o = options
+	}
+}
+
 // WithServiceName returns an Option that sets the ServiceName field used to identify the service collecting metrics.
 func WithServiceName(name string) Option {
 	return func(o *Options) {
@@ -44,6 +163,24 @@ func WithInstance(name, host string) Option {
 	}
 }
 
+// WithInstrumentationScope returns an Option that sets the instrumentation scope name and version
+// of the meter, which backends use to tell the library's telemetry apart. An empty name uses
+// the service name.
+func WithInstrumentationScope(name, version string) Option {
+	return func(o *Options) {
+		o.ScopeName = name
+		o.ScopeVersion = version
+	}
+}
+
+// WithResourceAttributes returns an Option that adds attrs to the metric resource. The service
+// name, environment, and instance attributes take precedence over attrs with the same key.
+func WithResourceAttributes(attrs ...attribute.KeyValue) Option {
+	return func(o *Options) {
+		o.ResourceAttributes = attrs
+	}
+}
+
 // WithProvider sets the metric exporter provider and the OTLP collector host and port on an Options value.
 // The returned Option assigns Provider, ProviderHost, and ProviderPort when applied.
 func WithProvider(provider, host string, port int) Option {
@@ -67,4 +204,169 @@ func WithInsecure(insecure bool) Option {
 	return func(o *Options) {
 		o.Insecure = insecure
 	}
-}
\ No newline at end of file
+}
+
+// WithCircuitBreaker returns an Option that guards the exporter with a circuit breaker.
+// After threshold consecutive failed exports the breaker opens and metrics are dropped without
+// contacting the collector; trial exports are then attempted with an exponential backoff
+// starting at one second and capped at maxBackoff. A threshold of 0 disables the breaker.
+func WithCircuitBreaker(threshold int, maxBackoff time.Duration) Option {
+	return func(o *Options) {
+		o.BreakerThreshold = threshold
+		o.BreakerMaxBackoff = maxBackoff
+	}
+}
+
+// WithBreakerStateHandler returns an Option that sets the function notified of circuit breaker
+// state transitions, e.g. to expose the state as a metric.
+func WithBreakerStateHandler(handler func(state breaker.State)) Option {
+	return func(o *Options) {
+		o.BreakerStateHandler = handler
+	}
+}
+
+// WithStrictNames returns an Option that sets whether instrument names are validated when
+// instruments are created. Names that are not valid OpenTelemetry instrument names are rejected
+// with an error wrapping ErrInstrumentNameInvalid; names breaking a Prometheus convention
+// (snake_case, "_total" on counters only, a unit suffix matching the unit) are created and
+// reported to the name warning handler.
+func WithStrictNames(enabled bool) Option {
+	return func(o *Options) {
+		o.StrictNames = enabled
+	}
+}
+
+// WithNameWarningHandler returns an Option that sets the function called with every naming
+// convention an instrument name breaks when strict names are enabled, e.g. to log it.
+func WithNameWarningHandler(handler func(name, warning string)) Option {
+	return func(o *Options) {
+		o.NameWarningHandler = handler
+	}
+}
+
+// WithEndpoint returns an Option that sets the OTLP collector URL.
+// The scheme selects the transport and TLS: grpc and grpcs use gRPC, http and https use HTTP,
+// and grpc and http connect without TLS. When set, Provider, ProviderHost, ProviderPort, and
+// Insecure are ignored.
+func WithEndpoint(url string) Option {
+	return func(o *Options) {
+		o.Endpoint = url
+	}
+}
+
+// WithReaderMode returns an Option that sets how metrics are exported.
+// "periodic" (default) exports every Interval. "manual" never exports on its own: metrics are
+// exported when Collect is called and once more on Shutdown, which suits short-lived CLIs and
+// cron jobs that exit before a periodic export would fire.
+func WithReaderMode(mode string) Option {
+	return func(o *Options) {
+		o.ReaderMode = mode
+	}
+}
+
+// WithStdoutFormat returns an Option that sets how the "stdout" provider writes metrics.
+// "pretty" (default) writes indented JSON for reading in a terminal. "ndjson" writes every export
+// as one compact JSON object per line, with the field names of the OpenTelemetry SDK metric data,
+// for local tooling and CI log scrapers to parse. "emf" writes every data point as one
+// CloudWatch Embedded Metric Format object per line, which CloudWatch Logs turns into metrics
+// on Lambda and ECS; counters and histograms then use delta temporality whatever the
+// temporality option, since CloudWatch adds up the values it receives.
+func WithStdoutFormat(format string) Option {
+	return func(o *Options) {
+		o.StdoutFormat = format
+	}
+}
+
+// WithWriter returns an Option that sets where the "stdout" provider writes metrics, e.g. a
+// file, a buffer, or a test sink, instead of os.Stdout. A nil w writes to os.Stdout.
+func WithWriter(w io.Writer) Option {
+	return func(o *Options) {
+		o.Writer = w
+	}
+}
+
+// WithTemporality returns an Option that sets the aggregation temporality of exported metrics.
+// "cumulative" (default) reports totals since the metric was created. "delta" reports the change
+// since the previous export for counters and histograms, which backends such as Datadog require;
+// up-down counters and gauges remain cumulative.
+func WithTemporality(temporality string) Option {
+	return func(o *Options) {
+		o.Temporality = temporality
+	}
+}
+
+// WithExemplars returns an Option that sets the Options.Exemplars field.
+// When true, measurements recorded with a context carrying a sampled span keep that span's trace
+// and span IDs as exemplars, so a dashboard can jump from a latency spike to representative
+// traces. When false (default), no exemplars are collected.
+func WithExemplars(enabled bool) Option {
+	return func(o *Options) {
+		o.Exemplars = enabled
+	}
+}
+
+// WithClock returns an Option that sets the clock driving the periodic export interval.
+// A nil clock uses the real clock.
+func WithClock(c clock.Clock) Option {
+	return func(o *Options) {
+		o.Clock = c
+	}
+}
+
+// WithEMFNamespace returns an Option that sets the CloudWatch namespace of the metrics written
+// in the "emf" stdout format. An empty namespace (default) uses the service name.
+func WithEMFNamespace(namespace string) Option {
+	return func(o *Options) {
+		o.EMFNamespace = namespace
+	}
+}
+
+// WithStatsDListener returns an Option that starts a StatsD listener on the UDP address
+// ("host:port", e.g. ":8125") and republishes the counters, gauges, timers, and histograms it
+// receives through the meter provider under the "statsd" instrumentation scope, so legacy
+// applications sending StatsD can migrate incrementally. An empty address (default) starts
+// no listener.
+func WithStatsDListener(address string) Option {
+	return func(o *Options) {
+		o.StatsDAddress = address
+	}
+}
+
+// WithPushgateway returns an Option that sets the grouping key the "pushgateway" provider
+// pushes to: the job label, required with that provider, and the instance label, omitted
+// when empty. Every export replaces the metrics of the grouping key.
+func WithPushgateway(job, instance string) Option {
+	return func(o *Options) {
+		o.PushgatewayJob = job
+		o.PushgatewayInstance = instance
+	}
+}
+
+// WithInfluxDB returns an Option that sets where the "influxdb" provider writes metrics: the
+// base URL of an InfluxDB v2 server ("http" or "https"), the organization and bucket, both
+// required with that provider, and the API token, omitted when empty.
+func WithInfluxDB(url, org, bucket, token string) Option {
+	return func(o *Options) {
+		o.InfluxDBURL = url
+		o.InfluxDBOrg = org
+		o.InfluxDBBucket = bucket
+		o.InfluxDBToken = token
+	}
+}
+
+// WithProducers returns an Option that adds the metrics of producers to every collection, so
+// metrics recorded outside the meter provider, e.g. through the OpenCensus bridge, are
+// exported with the others.
+func WithProducers(producers ...sdkmetric.Producer) Option {
+	return func(o *Options) {
+		o.Producers = producers
+	}
+}
+
+// WithOpenCensusBridge returns an Option that collects the metrics of the views registered
+// with OpenCensus, by dependencies still instrumented with it, with every collection.
+func WithOpenCensusBridge(enabled bool) Option {
+	return func(o *Options) {
+		o.OpenCensusBridge = enabled
+	}
+}
@@ -1,8 +1,11 @@
 package metric
 
 import (
+	"errors"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func TestMetric_Option_WithServiceName(t *testing.T) {
@@ -489,3 +492,169 @@ func TestMetric_Option_MultipleOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestMetric_Option_WithCircuitBreaker(t *testing.T) {
+	opts := &Options{}
+	WithCircuitBreaker(3, time.Minute)(opts)
+	if opts.BreakerThreshold != 3 {
+		t.Errorf("WithCircuitBreaker() set BreakerThreshold = %d, want %d", opts.BreakerThreshold, 3)
+	}
+	if opts.BreakerMaxBackoff != time.Minute {
+		t.Errorf("WithCircuitBreaker() set BreakerMaxBackoff = %v, want %v", opts.BreakerMaxBackoff, time.Minute)
+	}
+}
+
+func TestMetric_Option_WithReaderMode(t *testing.T) {
+	opts := &Options{}
+	WithReaderMode("manual")(opts)
+	if opts.ReaderMode != "manual" {
+		t.Errorf("WithReaderMode() set ReaderMode = %q, want %q", opts.ReaderMode, "manual")
+	}
+}
+
+func TestMetric_Option_WithTemporality(t *testing.T) {
+	opts := &Options{}
+	WithTemporality("delta")(opts)
+	if opts.Temporality != "delta" {
+		t.Errorf("WithTemporality() set Temporality = %q, want %q", opts.Temporality, "delta")
+	}
+}
+
+func TestMetric_Option_WithStdoutFormat(t *testing.T) {
+	opts := &Options{}
+	WithStdoutFormat("ndjson")(opts)
+	if opts.StdoutFormat != "ndjson" {
+		t.Errorf("WithStdoutFormat() set StdoutFormat = %q, want %q", opts.StdoutFormat, "ndjson")
+	}
+}
+
+func TestMetric_Option_WithExemplars(t *testing.T) {
+	opts := &Options{}
+	WithExemplars(true)(opts)
+	if !opts.Exemplars {
+		t.Error("WithExemplars(true) did not set Exemplars")
+	}
+}
+
+func TestMetric_Option_WithStatsDListener(t *testing.T) {
+	opts := &Options{}
+	WithStatsDListener(":8125")(opts)
+	if opts.StatsDAddress != ":8125" {
+		t.Errorf("WithStatsDListener() set StatsDAddress = %q, want %q", opts.StatsDAddress, ":8125")
+	}
+}
+
+func TestMetric_Option_WithEMFNamespace(t *testing.T) {
+	opts := &Options{}
+	WithEMFNamespace("Checkout")(opts)
+	if opts.EMFNamespace != "Checkout" {
+		t.Errorf("WithEMFNamespace() set EMFNamespace = %q, want %q", opts.EMFNamespace, "Checkout")
+	}
+}
+
+func TestMetric_Option_WithPushgateway(t *testing.T) {
+	opts := &Options{}
+	WithPushgateway("nightly-export", "worker-1")(opts)
+	if opts.PushgatewayJob != "nightly-export" || opts.PushgatewayInstance != "worker-1" {
+		t.Errorf("WithPushgateway() set %q, %q, want %q, %q", opts.PushgatewayJob, opts.PushgatewayInstance, "nightly-export", "worker-1")
+	}
+}
+
+func TestMetric_Option_WithInfluxDB(t *testing.T) {
+	opts := &Options{}
+	WithInfluxDB("http://localhost:8086", "edge", "metrics", "secret")(opts)
+	if opts.InfluxDBURL != "http://localhost:8086" || opts.InfluxDBOrg != "edge" || opts.InfluxDBBucket != "metrics" || opts.InfluxDBToken != "secret" {
+		t.Errorf("WithInfluxDB() set %q, %q, %q, %q", opts.InfluxDBURL, opts.InfluxDBOrg, opts.InfluxDBBucket, opts.InfluxDBToken)
+	}
+}
+
+func TestMetric_Option_WithProducers(t *testing.T) {
+	opts := &Options{}
+	WithProducers(staticProducer{})(opts)
+	if len(opts.Producers) != 1 {
+		t.Errorf("WithProducers() set %d producers, want 1", len(opts.Producers))
+	}
+}
+
+func TestMetric_Option_WithOpenCensusBridge(t *testing.T) {
+	opts := &Options{}
+	WithOpenCensusBridge(true)(opts)
+	if !opts.OpenCensusBridge {
+		t.Error("WithOpenCensusBridge(true) did not set OpenCensusBridge")
+	}
+}
+
+func TestMetric_Option_WithResourceAttributes(t *testing.T) {
+	opts := &Options{}
+	WithResourceAttributes(attribute.String("k8s.pod.name", "api-0"))(opts)
+	if len(opts.ResourceAttributes) != 1 || opts.ResourceAttributes[0].Value.AsString() != "api-0" {
+		t.Errorf("WithResourceAttributes() set ResourceAttributes = %v", opts.ResourceAttributes)
+	}
+}
+
+func TestMetric_Option_Validate(t *testing.T) {
+	valid := Options{Provider: "stdout", Interval: time.Second}
+	tests := []struct {
+		name    string
+		modify  func(o *Options)
+		wantErr error
+	}{
+		{"valid", func(o *Options) {}, nil},
+		{"invalid interval", func(o *Options) { o.Interval = 0 }, ErrIntervalInvalid},
+		{"manual reader mode", func(o *Options) { o.ReaderMode = "manual" }, nil},
+		{"invalid reader mode", func(o *Options) { o.ReaderMode = "push" }, ErrInvalidReaderMode},
+		{"delta temporality", func(o *Options) { o.Temporality = "delta" }, nil},
+		{"invalid temporality", func(o *Options) { o.Temporality = "lowmemory" }, ErrInvalidTemporality},
+		{"ndjson stdout format", func(o *Options) { o.StdoutFormat = "ndjson" }, nil},
+		{"emf stdout format", func(o *Options) { o.StdoutFormat = "emf" }, nil},
+		{"invalid stdout format", func(o *Options) { o.StdoutFormat = "yaml" }, ErrInvalidStdoutFormat},
+		{"negative breaker threshold", func(o *Options) { o.BreakerThreshold = -1 }, ErrBreakerThresholdInvalid},
+		{"breaker without max backoff", func(o *Options) { o.BreakerThreshold = 3 }, ErrBreakerMaxBackoffInvalid},
+		{"statsd address", func(o *Options) { o.StatsDAddress = ":8125" }, nil},
+		{"statsd address without port", func(o *Options) { o.StatsDAddress = "localhost" }, ErrStatsDAddressInvalid},
+		{"invalid provider", func(o *Options) { o.Provider = "invalid" }, ErrInvalidProvider},
+		{"endpoint replaces provider", func(o *Options) { o.Provider, o.Endpoint = "invalid", "https://collector:4318" }, nil},
+		{"invalid endpoint", func(o *Options) { o.Endpoint = "collector:4317" }, ErrEndpointInvalid},
+		{"otlp without host", func(o *Options) { o.Provider, o.ProviderPort = "otlp", 4318 }, ErrProviderHostRequired},
+		{"otlp without port", func(o *Options) { o.Provider, o.ProviderHost = "otlp", "localhost" }, ErrProviderPortRequired},
+		{"otlp with negative port", func(o *Options) { o.Provider, o.ProviderHost, o.ProviderPort = "otlp", "localhost", -1 }, ErrProviderPortInvalid},
+		{"pushgateway", func(o *Options) {
+			o.Provider, o.ProviderHost, o.ProviderPort, o.PushgatewayJob = "pushgateway", "gw", 9091, "job"
+		}, nil},
+		{"pushgateway without host", func(o *Options) { o.Provider, o.ProviderPort, o.PushgatewayJob = "pushgateway", 9091, "job" }, ErrProviderHostRequired},
+		{"pushgateway without job", func(o *Options) { o.Provider, o.ProviderHost, o.ProviderPort = "pushgateway", "gw", 9091 }, ErrPushgatewayJobRequired},
+		{"pushgateway with blank job", func(o *Options) {
+			o.Provider, o.ProviderHost, o.ProviderPort, o.PushgatewayJob = "pushgateway", "gw", 9091, "  "
+		}, ErrPushgatewayJobRequired},
+		{"influxdb", func(o *Options) {
+			o.Provider, o.InfluxDBURL, o.InfluxDBOrg, o.InfluxDBBucket = "influxdb", "http://localhost:8086", "edge", "metrics"
+		}, nil},
+		{"influxdb without scheme", func(o *Options) {
+			o.Provider, o.InfluxDBURL, o.InfluxDBOrg, o.InfluxDBBucket = "influxdb", "localhost:8086", "edge", "metrics"
+		}, ErrInfluxDBURLInvalid},
+		{"influxdb without org", func(o *Options) {
+			o.Provider, o.InfluxDBURL, o.InfluxDBBucket = "influxdb", "http://localhost:8086", "metrics"
+		}, ErrInfluxDBOrgRequired},
+		{"influxdb without bucket", func(o *Options) {
+			o.Provider, o.InfluxDBURL, o.InfluxDBOrg = "influxdb", "http://localhost:8086", "edge"
+		}, ErrInfluxDBBucketRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := valid
+			tt.modify(&opts)
+			if err := opts.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMetric_Option_WithEndpoint(t *testing.T) {
+	opts := &Options{}
+	WithEndpoint("grpcs://collector:4317")(opts)
+	if opts.Endpoint != "grpcs://collector:4317" {
+		t.Errorf("WithEndpoint() set Endpoint = %q, want %q", opts.Endpoint, "grpcs://collector:4317")
+	}
+}
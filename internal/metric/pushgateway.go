@@ -0,0 +1,279 @@
+package metric
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// pushgatewayContentType is the Prometheus text exposition format the exporter pushes.
+const pushgatewayContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// pushgatewayTimeout bounds a single push, so an unresponsive Pushgateway cannot hold an export
+// whose context has no deadline.
+const pushgatewayTimeout = 10 * time.Second
+
+// pushgatewayExporter is a metric exporter that pushes every export to a Prometheus
+// Pushgateway in the text exposition format. Each push replaces the metrics of its grouping
+// key (job and, when set, instance), so the Pushgateway always holds the latest values.
+// Values are always cumulative, whatever temporality the Options select, since the
+// Pushgateway keeps only the last push.
+type pushgatewayExporter struct {
+	url    string
+	client *http.Client
+}
+
+// newPushgatewayExporter creates a pushgatewayExporter for the Pushgateway at host:port,
+// over HTTP when insecure is set and HTTPS otherwise.
+func newPushgatewayExporter(host string, port int, insecure bool, job, instance string) *pushgatewayExporter {
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+	url := fmt.Sprintf("%s://%s/metrics/%s", scheme, net.JoinHostPort(host, strconv.Itoa(port)), pushgatewayLabel("job", job))
+	if instance != "" {
+		url += "/" + pushgatewayLabel("instance", instance)
+	}
+	return &pushgatewayExporter{url: url, client: &http.Client{Timeout: pushgatewayTimeout}}
+}
+
+// pushgatewayLabel encodes a grouping key label as a URL path segment pair. Values that are not
+// safe as a path segment (a slash, a space, a reserved character, or a "." or ".." segment
+// that clients clean away) are base64 encoded, as the Pushgateway requires.
+func pushgatewayLabel(name, value string) string {
+	if value == "." || value == ".." || url.PathEscape(value) != value {
+		return name + "@base64/" + base64.RawURLEncoding.EncodeToString([]byte(value))
+	}
+	return name + "/" + value
+}
+
+// Temporality returns cumulative for every instrument kind.
+func (e *pushgatewayExporter) Temporality(sdkmetric.InstrumentKind) metricdata.Temporality {
+	return metricdata.CumulativeTemporality
+}
+
+// Aggregation returns the default aggregation of kind.
+func (e *pushgatewayExporter) Aggregation(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(kind)
+}
+
+// Export pushes rm to the Pushgateway, replacing the metrics of the grouping key.
+func (e *pushgatewayExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, e.url, bytes.NewReader(encodePrometheusText(rm)))
+	if err != nil {
+		return fmt.Errorf("failed to push metrics: %w", err)
+	}
+	req.Header.Set("Content-Type", pushgatewayContentType)
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to push metrics: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("failed to push metrics: pushgateway returned %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return nil
+}
+
+// ForceFlush does nothing; every export is pushed immediately.
+func (e *pushgatewayExporter) ForceFlush(context.Context) error { return nil }
+
+// Shutdown does nothing.
+func (e *pushgatewayExporter) Shutdown(context.Context) error { return nil }
+
+// prometheusFamily is the text exposition of the data points of one metric name.
+type prometheusFamily struct {
+	help    string
+	kind    string
+	samples []string
+}
+
+// encodePrometheusText encodes rm in the Prometheus text exposition format. Metric names are
+// sanitized, monotonic sums become counters with a "_total" suffix, non-monotonic sums and
+// gauges become gauges, and histograms keep their buckets. Families are sorted by name.
+// Metrics of the same name from different instrumentation scopes share a family, and every
+// sample carries an otel_scope_name label, as in the OpenTelemetry Prometheus exporter, so
+// their series stay distinct.
+func encodePrometheusText(rm *metricdata.ResourceMetrics) []byte {
+	families := make(map[string]*prometheusFamily)
+	family := func(name, help, kind string) *prometheusFamily {
+		f, ok := families[name]
+		if !ok {
+			f = &prometheusFamily{help: help, kind: kind}
+			families[name] = f
+		}
+		return f
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			name := prometheusName(m.Name)
+			labels := func(attrs attribute.Set) []string { return prometheusLabels(sm.Scope.Name, attrs) }
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				name, kind := prometheusSumName(name, data.IsMonotonic)
+				f := family(name, m.Description, kind)
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, prometheusSample(name, labels(dp.Attributes), float64(dp.Value)))
+				}
+			case metricdata.Sum[float64]:
+				name, kind := prometheusSumName(name, data.IsMonotonic)
+				f := family(name, m.Description, kind)
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, prometheusSample(name, labels(dp.Attributes), dp.Value))
+				}
+			case metricdata.Gauge[int64]:
+				f := family(name, m.Description, "gauge")
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, prometheusSample(name, labels(dp.Attributes), float64(dp.Value)))
+				}
+			case metricdata.Gauge[float64]:
+				f := family(name, m.Description, "gauge")
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, prometheusSample(name, labels(dp.Attributes), dp.Value))
+				}
+			case metricdata.Histogram[int64]:
+				f := family(name, m.Description, "histogram")
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, prometheusHistogram(name, labels(dp.Attributes), dp)...)
+				}
+			case metricdata.Histogram[float64]:
+				f := family(name, m.Description, "histogram")
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, prometheusHistogram(name, labels(dp.Attributes), dp)...)
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	for _, name := range names {
+		f := families[name]
+		if f.help != "" {
+			fmt.Fprintf(&b, "# HELP %s %s\n", name, strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(f.help))
+		}
+		fmt.Fprintf(&b, "# TYPE %s %s\n", name, f.kind)
+		for _, sample := range f.samples {
+			b.WriteString(sample)
+			b.WriteByte('\n')
+		}
+	}
+	return b.Bytes()
+}
+
+// prometheusSumName returns the name and type of a sum: a counter ending in "_total" when it
+// is monotonic, a gauge otherwise.
+func prometheusSumName(name string, monotonic bool) (string, string) {
+	if !monotonic {
+		return name, "gauge"
+	}
+	if !strings.HasSuffix(name, "_total") {
+		name += "_total"
+	}
+	return name, "counter"
+}
+
+// prometheusHistogram returns the bucket, sum, and count samples of a histogram data point
+// with the given labels.
+func prometheusHistogram[N int64 | float64](name string, labels []string, dp metricdata.HistogramDataPoint[N]) []string {
+	samples := make([]string, 0, len(dp.Bounds)+3)
+	bucket := func(le string, count uint64) string {
+		return prometheusSample(name+"_bucket", append(labels[:len(labels):len(labels)], prometheusLabel("le", le)), float64(count))
+	}
+	var cumulative uint64
+	for i, bound := range dp.Bounds {
+		if i < len(dp.BucketCounts) {
+			cumulative += dp.BucketCounts[i]
+		}
+		samples = append(samples, bucket(prometheusFloat(bound), cumulative))
+	}
+	samples = append(samples,
+		bucket("+Inf", dp.Count),
+		prometheusSample(name+"_sum", labels, float64(dp.Sum)),
+		prometheusSample(name+"_count", labels, float64(dp.Count)),
+	)
+	return samples
+}
+
+// prometheusLabels returns the label pairs of a data point: an otel_scope_name label when
+// scope is set, followed by the attributes.
+func prometheusLabels(scope string, attrs attribute.Set) []string {
+	labels := make([]string, 0, attrs.Len()+2)
+	if scope != "" {
+		labels = append(labels, prometheusLabel("otel_scope_name", scope))
+	}
+	iter := attrs.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		// colons are reserved for metric names
+		labels = append(labels, prometheusLabel(strings.ReplaceAll(prometheusName(string(kv.Key)), ":", "_"), kv.Value.Emit()))
+	}
+	return labels
+}
+
+// prometheusSample formats one sample line with the given labels.
+func prometheusSample(name string, labels []string, value float64) string {
+	if len(labels) == 0 {
+		return name + " " + prometheusFloat(value)
+	}
+	return name + "{" + strings.Join(labels, ",") + "} " + prometheusFloat(value)
+}
+
+// prometheusLabel formats a label pair, escaping the value.
+func prometheusLabel(name, value string) string {
+	return name + `="` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+// prometheusFloat formats a sample value or bucket bound.
+func prometheusFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// prometheusName replaces the characters not allowed in Prometheus metric and label names
+// with underscores, and prefixes names starting with a digit with an underscore.
+func prometheusName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_', r == ':':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
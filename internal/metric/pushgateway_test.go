@@ -0,0 +1,158 @@
+package metric
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMetric_Pushgateway_PushgatewayLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"plain value", "nightly-export", "job/nightly-export"},
+		{"value with slash", "etl/orders", "job@base64/ZXRsL29yZGVycw"},
+		{"value with space", "nightly export", "job@base64/bmlnaHRseSBleHBvcnQ"},
+		{"value with query character", "export?v=2", "job@base64/ZXhwb3J0P3Y9Mg"},
+		{"dot segment", "..", "job@base64/Li4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pushgatewayLabel("job", tt.value); got != tt.want {
+				t.Errorf("pushgatewayLabel(job, %q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_Pushgateway_PrometheusName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"valid name", "http_requests_total", "http_requests_total"},
+		{"dots and dashes", "db.query-time", "db_query_time"},
+		{"leading digit", "5xx_errors", "_5xx_errors"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := prometheusName(tt.in); got != tt.want {
+				t.Errorf("prometheusName(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_Pushgateway_Export(t *testing.T) {
+	type push struct {
+		method, path, contentType, body string
+	}
+	pushes := make(chan push, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		pushes <- push{r.Method, r.URL.Path, r.Header.Get("Content-Type"), string(body)}
+	}))
+	defer server.Close()
+	host, rawPort, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	port, _ := strconv.Atoi(rawPort)
+
+	metricInstance, err := NewMetric(
+		WithServiceName("test-service"),
+		WithProvider("pushgateway", host, port),
+		WithInsecure(true),
+		WithPushgateway("nightly-export", "worker-1"),
+		WithReaderMode("manual"),
+	)
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	counter, _ := metricInstance.CreateCounter("rows_exported", "1", "Rows exported")
+	gauge, _ := metricInstance.CreateGauge("last_run_seconds", "s", "Unix time of the last run")
+	histogram, _ := metricInstance.CreateHistogram("batch_duration_ms", "ms", "Batch duration")
+	metricInstance.RecordCounter(ctx, counter, 42, attribute.String("table", `orders "eu"`))
+	metricInstance.RecordGauge(ctx, gauge, 1700000000)
+	metricInstance.RecordHistogram(ctx, histogram, 7)
+	if err := metricInstance.Collect(ctx); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+
+	got := <-pushes
+	if got.method != http.MethodPut {
+		t.Errorf("method = %s, want PUT", got.method)
+	}
+	if got.path != "/metrics/job/nightly-export/instance/worker-1" {
+		t.Errorf("path = %s, want /metrics/job/nightly-export/instance/worker-1", got.path)
+	}
+	if got.contentType != pushgatewayContentType {
+		t.Errorf("Content-Type = %s, want %s", got.contentType, pushgatewayContentType)
+	}
+	for _, want := range []string{
+		"# HELP rows_exported_total Rows exported\n# TYPE rows_exported_total counter\n",
+		`rows_exported_total{otel_scope_name="test-service",table="orders \"eu\""} 42` + "\n",
+		"# TYPE last_run_seconds gauge\n" + `last_run_seconds{otel_scope_name="test-service"} 1.7e+09` + "\n",
+		"# TYPE batch_duration_ms histogram\n",
+		`batch_duration_ms_bucket{otel_scope_name="test-service",le="5"} 0` + "\n",
+		`batch_duration_ms_bucket{otel_scope_name="test-service",le="10"} 1` + "\n",
+		`batch_duration_ms_bucket{otel_scope_name="test-service",le="+Inf"} 1` + "\n",
+		`batch_duration_ms_sum{otel_scope_name="test-service"} 7` + "\n" + `batch_duration_ms_count{otel_scope_name="test-service"} 1` + "\n",
+	} {
+		if !strings.Contains(got.body, want) {
+			t.Errorf("pushed body = %q, want it to contain %q", got.body, want)
+		}
+	}
+}
+
+func TestMetric_Pushgateway_EncodePrometheusText_Scopes(t *testing.T) {
+	sum := func(value int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name: "requests",
+			Data: metricdata.Sum[int64]{
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: value}},
+			},
+		}
+	}
+	rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{
+		{Scope: instrumentation.Scope{Name: "github.com/acme/http"}, Metrics: []metricdata.Metrics{sum(3)}},
+		{Scope: instrumentation.Scope{Name: "github.com/acme/grpc"}, Metrics: []metricdata.Metrics{sum(5)}},
+	}}
+
+	want := "# TYPE requests_total counter\n" +
+		`requests_total{otel_scope_name="github.com/acme/http"} 3` + "\n" +
+		`requests_total{otel_scope_name="github.com/acme/grpc"} 5` + "\n"
+	if got := string(encodePrometheusText(rm)); got != want {
+		t.Errorf("encodePrometheusText() = %q, want %q", got, want)
+	}
+}
+
+func TestMetric_Pushgateway_Export_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid metric", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	exporter := &pushgatewayExporter{url: server.URL + "/metrics/job/test", client: server.Client()}
+	err := exporter.Export(context.Background(), &metricdata.ResourceMetrics{})
+	if err == nil || !strings.Contains(err.Error(), "400 Bad Request: invalid metric") {
+		t.Errorf("Export() error = %v, want the status and body of the response", err)
+	}
+}
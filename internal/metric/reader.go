@@ -0,0 +1,195 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// exportTimeout bounds each export of the collection loop, as sdkmetric.PeriodicReader does by
+// default.
+const exportTimeout = 30 * time.Second
+
+// periodicReader collects metrics on an interval and pushes them to an exporter.
+// It plays the role of sdkmetric.PeriodicReader, but both the interval and the exporter can
+// be replaced while the meter provider is running, which a PeriodicReader does not allow.
+// A reader created by newManualReader has no collection loop and only exports when
+// collectAndExport is called and on shutdown.
+type periodicReader struct {
+	reader  *sdkmetric.ManualReader
+	watches watchSet // watches are evaluated against every collection before it is exported.
+
+	mu       sync.Mutex                         // mu serializes exports and exporter replacements.
+	exporter atomic.Pointer[sdkmetric.Exporter] // exporter is the current exporter, read without mu by the temporality and aggregation selectors.
+
+	ticker clock.Ticker // ticker is nil for a manual reader.
+	stop   chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+// newPeriodicReader creates a periodicReader exporting to exporter every interval and starts
+// its collection loop. The interval is measured by clk, or by the real clock when clk is nil.
+// The reader uses the exporter's temporality and aggregation preferences, and adds the metrics
+// of producers to every collection.
+func newPeriodicReader(exporter sdkmetric.Exporter, interval time.Duration, clk clock.Clock, producers ...sdkmetric.Producer) *periodicReader {
+	if clk == nil {
+		clk = clock.Real()
+	}
+	r := &periodicReader{
+		ticker: clk.NewTicker(interval),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	r.exporter.Store(&exporter)
+	r.reader = r.newSDKReader(producers)
+	go r.run()
+	return r
+}
+
+// newManualReader creates a reader exporting to exporter only when collectAndExport is called
+// and on shutdown. The reader uses the exporter's temporality and aggregation preferences, and
+// adds the metrics of producers to every collection.
+func newManualReader(exporter sdkmetric.Exporter, producers ...sdkmetric.Producer) *periodicReader {
+	r := &periodicReader{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	r.exporter.Store(&exporter)
+	r.reader = r.newSDKReader(producers)
+	close(r.done)
+	return r
+}
+
+// newSDKReader creates the SDK reader collecting for r, with the temporality and aggregation
+// preferences of r's current exporter, so instruments created after setExporter follow the
+// new exporter, and the given producers.
+func (r *periodicReader) newSDKReader(producers []sdkmetric.Producer) *sdkmetric.ManualReader {
+	opts := []sdkmetric.ManualReaderOption{
+		sdkmetric.WithTemporalitySelector(func(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+			return r.currentExporter().Temporality(kind)
+		}),
+		sdkmetric.WithAggregationSelector(func(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+			return r.currentExporter().Aggregation(kind)
+		}),
+	}
+	for _, p := range producers {
+		opts = append(opts, sdkmetric.WithProducer(p))
+	}
+	return sdkmetric.NewManualReader(opts...)
+}
+
+// currentExporter returns the exporter metrics are currently pushed to.
+func (r *periodicReader) currentExporter() sdkmetric.Exporter {
+	return *r.exporter.Load()
+}
+
+// run exports collected metrics on every tick until shutdown is called. Each export is
+// bounded by exportTimeout, so a hung exporter cannot stop the loop.
+// Export errors are reported to the global OpenTelemetry error handler, as PeriodicReader does.
+func (r *periodicReader) run() {
+	defer close(r.done)
+	for {
+		select {
+		case <-r.ticker.C():
+			r.exportOnTick()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// exportOnTick collects and exports the metrics within exportTimeout.
+func (r *periodicReader) exportOnTick() {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	if err := r.collectAndExport(ctx); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// collectAndExport collects the current metrics, evaluates the watches against them, and
+// pushes them to the current exporter.
+func (r *periodicReader) collectAndExport(ctx context.Context) error {
+	var rm metricdata.ResourceMetrics
+	if err := r.reader.Collect(ctx, &rm); err != nil {
+		return err
+	}
+	r.watches.evaluate(&rm)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.currentExporter().Export(ctx, &rm)
+}
+
+// snapshot collects the current metrics without exporting them. Collecting resets delta
+// aggregations, so a collection holding delta data is also evaluated by the watches and
+// exported, as by collectAndExport, for its values not to be lost.
+func (r *periodicReader) snapshot(ctx context.Context) (*metricdata.ResourceMetrics, error) {
+	var rm metricdata.ResourceMetrics
+	if err := r.reader.Collect(ctx, &rm); err != nil {
+		return nil, err
+	}
+	if !hasDelta(&rm) {
+		return &rm, nil
+	}
+	r.watches.evaluate(&rm)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &rm, r.currentExporter().Export(ctx, &rm)
+}
+
+// setInterval changes the time between exports. The next export happens one full interval
+// after the call. It has no effect on a manual reader.
+func (r *periodicReader) setInterval(interval time.Duration) {
+	if r.ticker == nil {
+		return
+	}
+	r.ticker.Reset(interval)
+}
+
+// setExporter replaces the exporter used for subsequent exports and shuts down the previous
+// one. An export in progress completes on the previous exporter before it is replaced.
+func (r *periodicReader) setExporter(ctx context.Context, exporter sdkmetric.Exporter) error {
+	r.mu.Lock()
+	previous := r.currentExporter()
+	r.exporter.Store(&exporter)
+	r.mu.Unlock()
+
+	return previous.Shutdown(ctx)
+}
+
+// shutdown stops the collection loop, performs a final export so no recorded value is lost,
+// and shuts down the exporter. It must be called before the meter provider is shut down,
+// since a shut down reader can no longer collect. When ctx is done before an export in
+// progress on the collection loop completes, shutdown skips the final export and returns
+// ctx.Err(), still shutting down the exporter so its connections are released. Calling
+// shutdown more than once returns sdkmetric.ErrReaderShutdown.
+func (r *periodicReader) shutdown(ctx context.Context) error {
+	err := sdkmetric.ErrReaderShutdown
+	r.once.Do(func() {
+		if r.ticker != nil {
+			r.ticker.Stop()
+		}
+		close(r.stop)
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			// The exporter is not locked, as the export in progress holds the lock.
+			err = errors.Join(ctx.Err(), r.currentExporter().Shutdown(ctx))
+			return
+		}
+
+		err = errors.Join(
+			r.collectAndExport(ctx),
+			r.currentExporter().Shutdown(ctx),
+		)
+	})
+	return err
+}
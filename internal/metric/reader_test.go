@@ -0,0 +1,241 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// recordingExporter is an exporter that counts exports and remembers whether it was shut down.
+// When exported is set, every export is also signaled on it.
+type recordingExporter struct {
+	mu       sync.Mutex
+	exports  int
+	shutdown bool
+	exported chan struct{}
+}
+
+func (e *recordingExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(k)
+}
+
+func (e *recordingExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(k)
+}
+
+func (e *recordingExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.exports++
+	if e.exported != nil {
+		e.exported <- struct{}{}
+	}
+	return nil
+}
+
+func (e *recordingExporter) ForceFlush(ctx context.Context) error { return nil }
+
+func (e *recordingExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown = true
+	return nil
+}
+
+func (e *recordingExporter) state() (int, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.exports, e.shutdown
+}
+
+// waitForExport blocks until exporter signals an export, failing the test after a timeout.
+func waitForExport(t *testing.T, exporter *recordingExporter) {
+	t.Helper()
+	select {
+	case <-exporter.exported:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for export")
+	}
+}
+
+func TestMetric_Reader_PeriodicExport(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	exporter := &recordingExporter{exported: make(chan struct{}, 1)}
+	reader := newPeriodicReader(exporter, time.Minute, clk)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	defer func() {
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	clk.Advance(59 * time.Second)
+	select {
+	case <-exporter.exported:
+		t.Fatal("exported before the interval elapsed")
+	default:
+	}
+
+	clk.Advance(time.Second)
+	waitForExport(t, exporter)
+	clk.Advance(time.Minute)
+	waitForExport(t, exporter)
+
+	// The final export performed by shutdown fits in the channel buffer.
+	if err := reader.shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+	if exports, shutdown := exporter.state(); exports != 3 || !shutdown {
+		t.Errorf("state() = (%d, %v), want (3, true)", exports, shutdown)
+	}
+	if err := reader.shutdown(context.Background()); !errors.Is(err, sdkmetric.ErrReaderShutdown) {
+		t.Errorf("second shutdown() error = %v, want ErrReaderShutdown", err)
+	}
+}
+
+func TestMetric_Reader_SetInterval(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	exporter := &recordingExporter{exported: make(chan struct{}, 1)}
+	reader := newPeriodicReader(exporter, time.Hour, clk)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	defer func() {
+		_ = reader.shutdown(context.Background())
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	reader.setInterval(time.Minute)
+	clk.Advance(time.Minute)
+	waitForExport(t, exporter)
+}
+
+func TestMetric_Reader_SetExporter(t *testing.T) {
+	previous := &recordingExporter{}
+	reader := newPeriodicReader(previous, time.Hour, nil)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	defer func() {
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	next := &recordingExporter{}
+	if err := reader.setExporter(context.Background(), next); err != nil {
+		t.Fatalf("setExporter() error = %v", err)
+	}
+	if _, shutdown := previous.state(); !shutdown {
+		t.Error("expected previous exporter to be shut down")
+	}
+
+	// The final export on shutdown must go to the new exporter.
+	if err := reader.shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+	if exports, _ := next.state(); exports != 1 {
+		t.Errorf("expected 1 export on new exporter, got %d", exports)
+	}
+	if exports, _ := previous.state(); exports != 0 {
+		t.Errorf("expected no export on previous exporter, got %d", exports)
+	}
+}
+
+func TestMetric_Reader_Manual(t *testing.T) {
+	exporter := &recordingExporter{}
+	reader := newManualReader(exporter)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	defer func() {
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	// A manual reader ignores interval changes and never exports on its own.
+	reader.setInterval(time.Millisecond)
+	if exports, _ := exporter.state(); exports != 0 {
+		t.Fatalf("exports = %d before collectAndExport, want 0", exports)
+	}
+
+	if err := reader.collectAndExport(context.Background()); err != nil {
+		t.Fatalf("collectAndExport() error = %v", err)
+	}
+	if exports, _ := exporter.state(); exports != 1 {
+		t.Errorf("exports = %d after collectAndExport, want 1", exports)
+	}
+
+	if err := reader.shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+	if exports, shutdown := exporter.state(); exports != 2 || !shutdown {
+		t.Errorf("state() = (%d, %v), want (2, true)", exports, shutdown)
+	}
+}
+
+// blockingExporter is an exporter whose exports block until release is closed.
+type blockingExporter struct {
+	recordingExporter
+	release chan struct{}
+}
+
+func (e *blockingExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if err := e.recordingExporter.Export(ctx, rm); err != nil {
+		return err
+	}
+	<-e.release
+	return nil
+}
+
+func TestMetric_Reader_ShutdownContext(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	exporter := &blockingExporter{
+		recordingExporter: recordingExporter{exported: make(chan struct{}, 1)},
+		release:           make(chan struct{}),
+	}
+	defer close(exporter.release)
+	reader := newPeriodicReader(exporter, time.Minute, clk)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	defer func() {
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	clk.Advance(time.Minute)
+	waitForExport(t, &exporter.recordingExporter)
+
+	// The export on the collection loop hangs, so shutdown must give up when ctx is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := reader.shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("shutdown() error = %v, want context.DeadlineExceeded", err)
+	}
+	if _, shutdown := exporter.state(); !shutdown {
+		t.Error("exporter was not shut down when ctx was done")
+	}
+}
+
+func TestMetric_Reader_SetExporterSelectors(t *testing.T) {
+	reader := newManualReader(&recordingExporter{})
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	defer func() {
+		_ = reader.shutdown(context.Background())
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	if err := reader.setExporter(context.Background(), &deltaExporter{}); err != nil {
+		t.Fatalf("setExporter() error = %v", err)
+	}
+	counter, err := provider.Meter("test").Int64Counter("requests")
+	if err != nil {
+		t.Fatalf("Int64Counter() error = %v", err)
+	}
+	counter.Add(context.Background(), 1)
+
+	rm, err := reader.snapshot(context.Background())
+	if err != nil {
+		t.Fatalf("snapshot() error = %v", err)
+	}
+	sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	if !ok {
+		t.Fatalf("data = %T, want metricdata.Sum[int64]", rm.ScopeMetrics[0].Metrics[0].Data)
+	}
+	if sum.Temporality != metricdata.DeltaTemporality {
+		t.Errorf("temporality = %v, want %v", sum.Temporality, metricdata.DeltaTemporality)
+	}
+}
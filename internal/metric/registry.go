@@ -5,74 +5,142 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+	"github.com/adityakw90/go-monitoring/internal/endpoint"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"google.golang.org/grpc/credentials"
 )
 
 // NewMetric creates and returns a Metric configured according to the provided Options.
-// It builds an OpenTelemetry MeterProvider backed by a PeriodicReader and an exporter
-// selected by the Options.Provider (supported: "stdout", "otlp"), and attaches a Resource
-// populated from the service attributes in Options.
+// It builds an OpenTelemetry MeterProvider backed by a periodic (or, with ReaderMode "manual",
+// an on-demand) reader and an exporter
+// selected by the Options.Provider (supported: "stdout", "otlp", "pushgateway", "influxdb"), and attaches a Resource
+// populated from the service attributes in Options. The resource and the meter carry the
+// schema URL of the semconv version the attributes follow (v1.26.0).
 //
 // Errors returned include:
 // - ErrIntervalInvalid when Options.Interval is less than or equal to zero.
-// - ErrProviderHostRequired, ErrProviderPortRequired, ErrProviderPortInvalid for missing/invalid OTLP host/port.
+// - ErrInvalidReaderMode when Options.ReaderMode is not "periodic" or "manual".
+// - ErrInvalidTemporality when Options.Temporality is not "cumulative" or "delta".
+// - ErrBreakerThresholdInvalid, ErrBreakerMaxBackoffInvalid for a misconfigured circuit breaker.
+// - ErrProviderHostRequired, ErrProviderPortRequired, ErrProviderPortInvalid for missing/invalid OTLP or Pushgateway host/port.
+// - ErrPushgatewayJobRequired when Options.Provider is "pushgateway" with a blank Options.PushgatewayJob.
+// - ErrInfluxDBURLInvalid, ErrInfluxDBOrgRequired, ErrInfluxDBBucketRequired for a missing/invalid InfluxDB target.
 // - ErrInvalidProvider when Options.Provider is not supported.
 // Other errors wrap failures that occur while creating the resource or the exporter.
 func NewMetric(opts ...Option) (Metric, error) {
 	options := &Options{
-		Provider: "stdout",
-		Interval: 60 * time.Second,
+		Provider:          "stdout",
+		Interval:          60 * time.Second,
+		ReaderMode:        "periodic",
+		Temporality:       "cumulative",
+		BreakerThreshold:  5,
+		BreakerMaxBackoff: 5 * time.Minute,
 	}
 
 	for _, opt := range opts {
 		opt(options)
 	}
 
-	// validate interval
-	if options.Interval <= 0 {
-		return nil, ErrIntervalInvalid
+	if err := options.Validate(); err != nil {
+		return nil, err
 	}
 
 	// Create resource with service name and other attributes
 	res, err := resource.New(
 		context.Background(),
-		resource.WithAttributes(
-			semconv.ServiceInstanceIDKey.String(options.InstanceName),
-			semconv.HostNameKey.String(options.InstanceHost),
-			semconv.DeploymentEnvironmentKey.String(options.Environment),
-			semconv.ServiceNameKey.String(options.ServiceName),
-		),
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(options.ResourceAttributes...),
+		resource.WithAttributes(identityAttributes(options)...),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource: %w", err)
 	}
 
-	// Select the exporter based on the config
-	var exporter sdkmetric.Exporter
-	switch options.Provider {
-	case "stdout":
-		exporter, err = stdoutmetric.New(
-			stdoutmetric.WithPrettyPrint(),
-		)
-	case "otlp":
-		if options.ProviderHost == "" {
-			return nil, ErrProviderHostRequired
+	exporter, err := newExporter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the MeterProvider with the exporter
+	var reader *periodicReader
+	if options.ReaderMode == "manual" {
+		reader = newManualReader(exporter, producers(options)...)
+	} else {
+		reader = newPeriodicReader(exporter, options.Interval, options.Clock, producers(options)...)
+	}
+	exemplarFilter := exemplar.AlwaysOffFilter
+	if options.Exemplars {
+		exemplarFilter = exemplar.TraceBasedFilter
+	}
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(reader.reader),
+		sdkmetric.WithExemplarFilter(exemplarFilter),
+	)
+
+	var statsd *statsdListener
+	if options.StatsDAddress != "" {
+		if statsd, err = newStatsDListener(options.StatsDAddress, mp.Meter(statsdScopeName)); err != nil {
+			_ = mp.Shutdown(context.Background())
+			return nil, err
 		}
-		if options.ProviderPort == 0 {
-			return nil, ErrProviderPortRequired
+	}
+
+	return &metric{
+		provider:    mp,
+		statsd:      statsd,
+		meter:       mp.Meter(scopeName(options), otelmetric.WithInstrumentationVersion(options.ScopeVersion), otelmetric.WithSchemaURL(semconv.SchemaURL)),
+		reader:      reader,
+		options:     options,
+		names:       newNameChecker(options),
+		instruments: newInstrumentRegistry(),
+	}, nil
+}
+
+// newExporter creates the metric exporter selected by options.Endpoint or options.Provider,
+// guarded by a circuit breaker when options.BreakerThreshold is set. The exporter reports the
+// temporality selected by options.Temporality, which the reader adopts.
+// options must have passed Validate. It returns ErrInvalidProvider for an unsupported provider
+// and a wrapped error if the exporter itself cannot be created.
+func newExporter(options *Options) (sdkmetric.Exporter, error) {
+	var (
+		exporter sdkmetric.Exporter
+		err      error
+	)
+	selector := temporalitySelector(options.Temporality)
+	switch {
+	case options.Endpoint != "":
+		exporter, err = newEndpointExporter(options.Endpoint, selector)
+	case options.Provider == "stdout" && options.StdoutFormat == "emf":
+		namespace := options.EMFNamespace
+		if namespace == "" {
+			namespace = options.ServiceName
+		}
+		exporter = newEMFExporter(options.Writer, namespace)
+	case options.Provider == "stdout":
+		stdoutOpts := []stdoutmetric.Option{stdoutmetric.WithTemporalitySelector(selector)}
+		if options.Writer != nil {
+			stdoutOpts = append(stdoutOpts, stdoutmetric.WithWriter(options.Writer))
 		}
-		if options.ProviderPort < 0 {
-			return nil, ErrProviderPortInvalid
+		if options.StdoutFormat != "ndjson" {
+			stdoutOpts = append(stdoutOpts, stdoutmetric.WithPrettyPrint())
 		}
+		exporter, err = stdoutmetric.New(stdoutOpts...)
+	case options.Provider == "otlp":
 		otlpOpts := []otlpmetricgrpc.Option{
 			otlpmetricgrpc.WithEndpoint(
 				fmt.Sprintf("%s:%d", options.ProviderHost, options.ProviderPort),
 			),
+			otlpmetricgrpc.WithTemporalitySelector(selector),
 		}
 		if options.Insecure {
 			otlpOpts = append(otlpOpts, otlpmetricgrpc.WithInsecure())
@@ -80,6 +148,10 @@ func NewMetric(opts ...Option) (Metric, error) {
 			otlpOpts = append(otlpOpts, otlpmetricgrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, options.ProviderHost)))
 		}
 		exporter, err = otlpmetricgrpc.New(context.Background(), otlpOpts...)
+	case options.Provider == "pushgateway":
+		exporter = newPushgatewayExporter(options.ProviderHost, options.ProviderPort, options.Insecure, options.PushgatewayJob, options.PushgatewayInstance)
+	case options.Provider == "influxdb":
+		exporter = newInfluxDBExporter(options.InfluxDBURL, options.InfluxDBOrg, options.InfluxDBBucket, options.InfluxDBToken, selector)
 	default:
 		return nil, ErrInvalidProvider
 	}
@@ -87,20 +159,70 @@ func NewMetric(opts ...Option) (Metric, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create exporter: %w", err)
 	}
+	if options.BreakerThreshold > 0 {
+		exporter = breaker.WrapMetricExporter(exporter, newBreaker(options))
+	}
+	return exporter, nil
+}
 
-	// Create the MeterProvider with the exporter
-	mp := sdkmetric.NewMeterProvider(
-		sdkmetric.WithResource(res),
-		sdkmetric.WithReader(
-			sdkmetric.NewPeriodicReader(
-				exporter,
-				sdkmetric.WithInterval(options.Interval),
-			),
-		),
-	)
-
+// NewNoopMetric returns a Metric whose instruments record nothing.
+// It is used when metrics are disabled but callers still expect a non-nil Metric.
+func NewNoopMetric() Metric {
 	return &metric{
-		provider: mp,
-		meter:    mp.Meter(options.ServiceName),
-	}, nil
+		meter:       noop.NewMeterProvider().Meter(""),
+		instruments: newInstrumentRegistry(),
+	}
+}
+
+// newEndpointExporter creates an OTLP metric exporter for a collector URL. The scheme selects
+// gRPC or HTTP and whether TLS is used; see WithEndpoint.
+func newEndpointExporter(rawURL string, selector sdkmetric.TemporalitySelector) (sdkmetric.Exporter, error) {
+	ep, err := endpoint.Parse(rawURL)
+	if err != nil {
+		return nil, ErrEndpointInvalid
+	}
+
+	if ep.Protocol == endpoint.ProtocolHTTP {
+		httpOpts := []otlpmetrichttp.Option{
+			otlpmetrichttp.WithEndpoint(ep.Address),
+			otlpmetrichttp.WithTemporalitySelector(selector),
+		}
+		if ep.Path != "" {
+			httpOpts = append(httpOpts, otlpmetrichttp.WithURLPath(ep.Path))
+		}
+		if ep.Insecure {
+			httpOpts = append(httpOpts, otlpmetrichttp.WithInsecure())
+		}
+		return otlpmetrichttp.New(context.Background(), httpOpts...)
+	}
+
+	grpcOpts := []otlpmetricgrpc.Option{
+		otlpmetricgrpc.WithEndpoint(ep.Address),
+		otlpmetricgrpc.WithTemporalitySelector(selector),
+	}
+	if ep.Insecure {
+		grpcOpts = append(grpcOpts, otlpmetricgrpc.WithInsecure())
+	} else {
+		grpcOpts = append(grpcOpts, otlpmetricgrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, ep.Host)))
+	}
+	return otlpmetricgrpc.New(context.Background(), grpcOpts...)
+}
+
+// newBreaker creates the exporter circuit breaker described by options and reports its
+// initial closed state, so a handler tracking the state is reset when the exporter is replaced.
+func newBreaker(options *Options) *breaker.Breaker {
+	b := breaker.New(options.BreakerThreshold, options.BreakerMaxBackoff, options.BreakerStateHandler)
+	if options.BreakerStateHandler != nil {
+		options.BreakerStateHandler(breaker.StateClosed)
+	}
+	return b
+}
+
+// scopeName returns the instrumentation scope name of the meter: options.ScopeName, or else
+// the service name.
+func scopeName(options *Options) string {
+	if options.ScopeName != "" {
+		return options.ScopeName
+	}
+	return options.ServiceName
 }
@@ -1,10 +1,18 @@
 package metric
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestMetric_NewMetric(t *testing.T) {
@@ -38,6 +46,22 @@ func TestMetric_NewMetric(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:    "with grpc endpoint",
+			opts:    []Option{WithServiceName("test-service"), WithEndpoint("grpc://localhost:4317")},
+			wantErr: false,
+		},
+		{
+			name:    "with https endpoint",
+			opts:    []Option{WithServiceName("test-service"), WithEndpoint("https://localhost:4318/custom/path")},
+			wantErr: false,
+		},
+		{
+			name:      "with invalid endpoint",
+			opts:      []Option{WithServiceName("test-service"), WithEndpoint("localhost:4317")},
+			wantErr:   true,
+			wantErrIs: ErrEndpointInvalid,
+		},
 		{
 			name:      "with invalid provider",
 			opts:      []Option{WithServiceName("test-service"), WithProvider("invalid", "", 0)},
@@ -132,3 +156,226 @@ func TestMetric_NewMetric(t *testing.T) {
 		})
 	}
 }
+
+func TestMetric_Registry_NewNoopMetric(t *testing.T) {
+	metricInstance := NewNoopMetric()
+	if metricInstance == nil {
+		t.Fatal("NewNoopMetric() returned nil")
+	}
+
+	ctx := context.Background()
+	counter, err := metricInstance.CreateCounter("noop_counter", "1", "Noop counter")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	metricInstance.RecordCounter(ctx, counter, 1)
+
+	histogram, err := metricInstance.CreateHistogram("noop_histogram", "ms", "Noop histogram")
+	if err != nil {
+		t.Fatalf("CreateHistogram() error = %v", err)
+	}
+	metricInstance.RecordHistogram(ctx, histogram, 10)
+
+	if err := metricInstance.(Reloader).Reload(WithInterval(time.Second)); err != nil {
+		t.Errorf("Reload() error = %v", err)
+	}
+	if err := metricInstance.Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+}
+
+func TestMetric_Registry_NewMetric_Exemplars(t *testing.T) {
+	spanCtx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	tests := []struct {
+		name          string
+		enabled       bool
+		wantExemplars int
+	}{
+		{"disabled by default", false, 0},
+		{"enabled", true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMetric(WithServiceName("test-service"), WithReaderMode("manual"), WithExemplars(tt.enabled))
+			if err != nil {
+				t.Fatalf("NewMetric() error = %v", err)
+			}
+			metricInstance := m.(*metric)
+			defer func() {
+				_ = metricInstance.provider.Shutdown(context.Background())
+			}()
+
+			histogram, err := m.CreateHistogram("request_duration_ms", "ms", "Request duration")
+			if err != nil {
+				t.Fatalf("CreateHistogram() error = %v", err)
+			}
+			m.RecordHistogram(spanCtx, histogram, 150)
+
+			var rm metricdata.ResourceMetrics
+			if err := metricInstance.reader.reader.Collect(context.Background(), &rm); err != nil {
+				t.Fatalf("Collect() error = %v", err)
+			}
+			data := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[int64])
+			exemplars := data.DataPoints[0].Exemplars
+			if len(exemplars) != tt.wantExemplars {
+				t.Fatalf("got %d exemplars, want %d", len(exemplars), tt.wantExemplars)
+			}
+			if tt.wantExemplars > 0 && trace.TraceID(exemplars[0].TraceID) != (trace.TraceID{0x01}) {
+				t.Errorf("exemplar TraceID = %x, want the span's trace ID", exemplars[0].TraceID)
+			}
+		})
+	}
+}
+
+func TestMetric_Registry_NewMetric_SchemaURL(t *testing.T) {
+	m, err := NewMetric(WithServiceName("test-service"), WithReaderMode("manual"))
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	metricInstance := m.(*metric)
+	defer func() {
+		_ = metricInstance.provider.Shutdown(context.Background())
+	}()
+
+	counter, err := m.CreateCounter("requests_total", "1", "Requests")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	m.RecordCounter(context.Background(), counter, 1)
+
+	var rm metricdata.ResourceMetrics
+	if err := metricInstance.reader.reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if rm.Resource.SchemaURL() != semconv.SchemaURL {
+		t.Errorf("resource SchemaURL() = %q, want %q", rm.Resource.SchemaURL(), semconv.SchemaURL)
+	}
+	if got := rm.ScopeMetrics[0].Scope.SchemaURL; got != semconv.SchemaURL {
+		t.Errorf("scope SchemaURL = %q, want %q", got, semconv.SchemaURL)
+	}
+}
+
+func TestMetric_Registry_NewMetric_InstrumentationScope(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []Option
+		wantName    string
+		wantVersion string
+	}{
+		{"service name by default", nil, "test-service", ""},
+		{"custom scope", []Option{WithInstrumentationScope("github.com/acme/api", "v1.8.0")}, "github.com/acme/api", "v1.8.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMetric(append([]Option{WithServiceName("test-service"), WithReaderMode("manual")}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("NewMetric() error = %v", err)
+			}
+			metricInstance := m.(*metric)
+			defer func() {
+				_ = metricInstance.provider.Shutdown(context.Background())
+			}()
+
+			counter, err := m.CreateCounter("requests_total", "1", "Requests")
+			if err != nil {
+				t.Fatalf("CreateCounter() error = %v", err)
+			}
+			m.RecordCounter(context.Background(), counter, 1)
+
+			var rm metricdata.ResourceMetrics
+			if err := metricInstance.reader.reader.Collect(context.Background(), &rm); err != nil {
+				t.Fatalf("Collect() error = %v", err)
+			}
+			scope := rm.ScopeMetrics[0].Scope
+			if scope.Name != tt.wantName || scope.Version != tt.wantVersion {
+				t.Errorf("scope = %q %q, want %q %q", scope.Name, scope.Version, tt.wantName, tt.wantVersion)
+			}
+		})
+	}
+}
+
+// staticProducer is a metric producer returning a fixed counter, standing in for a bridge.
+type staticProducer struct{}
+
+func (staticProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	return []metricdata.ScopeMetrics{{
+		Scope: instrumentation.Scope{Name: "opencensus"},
+		Metrics: []metricdata.Metrics{{
+			Name: "oc_requests",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 7}},
+			},
+		}},
+	}}, nil
+}
+
+func TestMetric_Registry_NewMetric_Producers(t *testing.T) {
+	for _, mode := range []string{"manual", "periodic"} {
+		t.Run(mode, func(t *testing.T) {
+			m, err := NewMetric(
+				WithServiceName("test-service"),
+				WithReaderMode(mode),
+				WithWriter(&bytes.Buffer{}),
+				WithProducers(staticProducer{}),
+			)
+			if err != nil {
+				t.Fatalf("NewMetric() error = %v", err)
+			}
+			defer func() {
+				_ = m.Shutdown(context.Background())
+			}()
+
+			snapshot, err := m.Snapshot(context.Background())
+			if err != nil {
+				t.Fatalf("Snapshot() error = %v", err)
+			}
+			if c, ok := snapshot.Counter("oc_requests"); !ok || c.Value != 7 {
+				t.Errorf("Snapshot() oc_requests = %v, %v, want the producer's value 7", c, ok)
+			}
+		})
+	}
+}
+
+func TestMetric_Registry_NewMetric_Writer(t *testing.T) {
+	var out bytes.Buffer
+	m, err := NewMetric(
+		WithServiceName("test-service"),
+		WithReaderMode("manual"),
+		WithStdoutFormat("ndjson"),
+		WithWriter(&out),
+	)
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	counter, err := m.CreateCounter("requests_total", "1", "Requests")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	m.RecordCounter(context.Background(), counter, 1)
+	if err := m.Collect(context.Background()); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if err := m.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	// Collect and the final export on shutdown each write one line.
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("writer got %d lines, want 2: %q", len(lines), out.String())
+	}
+	for _, line := range lines {
+		var export struct{ ScopeMetrics []json.RawMessage }
+		if err := json.Unmarshal([]byte(line), &export); err != nil || len(export.ScopeMetrics) == 0 {
+			t.Errorf("line %q is not a JSON export (error %v)", line, err)
+		}
+	}
+}
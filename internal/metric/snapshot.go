@@ -0,0 +1,229 @@
+package metric
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Snapshot holds the values of every instrument at the time Metric.Snapshot was called, for
+// tests and admin endpoints that read metrics without scraping an exporter. Each attribute set
+// of an instrument is a separate entry.
+type Snapshot struct {
+	Counters   []CounterSnapshot   // Counters are the data points of counters and other sums.
+	Gauges     []GaugeSnapshot     // Gauges are the data points of gauges.
+	Histograms []HistogramSnapshot // Histograms are the data points of histograms.
+}
+
+// CounterSnapshot is the value of one data point of a counter.
+type CounterSnapshot struct {
+	Name       string        // Name is the instrument name.
+	Scope      string        // Scope is the instrumentation scope the instrument was created in.
+	Attributes attribute.Set // Attributes identify the data point.
+	Value      float64       // Value is the running total, or the increase since the last export with delta temporality.
+}
+
+// GaugeSnapshot is the last value recorded for one data point of a gauge.
+type GaugeSnapshot struct {
+	Name       string        // Name is the instrument name.
+	Scope      string        // Scope is the instrumentation scope the instrument was created in.
+	Attributes attribute.Set // Attributes identify the data point.
+	Value      float64       // Value is the last recorded value.
+}
+
+// HistogramSnapshot summarizes the values recorded for one data point of a histogram.
+type HistogramSnapshot struct {
+	Name         string        // Name is the instrument name.
+	Scope        string        // Scope is the instrumentation scope the instrument was created in.
+	Attributes   attribute.Set // Attributes identify the data point.
+	Count        uint64        // Count is the number of recorded values.
+	Sum          float64       // Sum is the sum of the recorded values.
+	Bounds       []float64     // Bounds are the upper bounds of the buckets, in increasing order.
+	BucketCounts []uint64      // BucketCounts are the number of values in each bucket; the last bucket counts the values above the last bound.
+
+	min, max   float64
+	hasExtrema bool
+}
+
+// Counter returns the data point of the named counter with exactly the given attributes.
+// It reports false when there is none.
+func (s *Snapshot) Counter(name string, attrs ...attribute.KeyValue) (CounterSnapshot, bool) {
+	set := attribute.NewSet(attrs...)
+	for _, c := range s.Counters {
+		if c.Name == name && c.Attributes.Equals(&set) {
+			return c, true
+		}
+	}
+	return CounterSnapshot{}, false
+}
+
+// Gauge returns the data point of the named gauge with exactly the given attributes.
+// It reports false when there is none.
+func (s *Snapshot) Gauge(name string, attrs ...attribute.KeyValue) (GaugeSnapshot, bool) {
+	set := attribute.NewSet(attrs...)
+	for _, g := range s.Gauges {
+		if g.Name == name && g.Attributes.Equals(&set) {
+			return g, true
+		}
+	}
+	return GaugeSnapshot{}, false
+}
+
+// Histogram returns the data point of the named histogram with exactly the given attributes.
+// It reports false when there is none.
+func (s *Snapshot) Histogram(name string, attrs ...attribute.KeyValue) (HistogramSnapshot, bool) {
+	set := attribute.NewSet(attrs...)
+	for _, h := range s.Histograms {
+		if h.Name == name && h.Attributes.Equals(&set) {
+			return h, true
+		}
+	}
+	return HistogramSnapshot{}, false
+}
+
+// Mean returns the mean of the recorded values, or 0 when none were recorded.
+func (h HistogramSnapshot) Mean() float64 {
+	if h.Count == 0 {
+		return 0
+	}
+	return h.Sum / float64(h.Count)
+}
+
+// Percentile estimates the value below which the fraction p (between 0 and 1) of the recorded
+// values fall, e.g. 0.99 for the p99, by interpolating linearly within the bucket containing
+// it. The estimate is only as precise as the bucket bounds, and is clamped to the smallest and
+// largest recorded values. It returns 0 when no values were recorded.
+func (h HistogramSnapshot) Percentile(p float64) float64 {
+	if h.Count == 0 || len(h.BucketCounts) == 0 {
+		return 0
+	}
+	p = min(max(p, 0), 1)
+	rank := p * float64(h.Count)
+	var seen float64
+	for i, count := range h.BucketCounts {
+		if count == 0 || seen+float64(count) < rank {
+			seen += float64(count)
+			continue
+		}
+		lower, upper := h.bucketRange(i)
+		return lower + (upper-lower)*(rank-seen)/float64(count)
+	}
+	_, upper := h.bucketRange(len(h.BucketCounts) - 1)
+	return upper
+}
+
+// bucketRange returns the lower and upper bound of bucket i, narrowed to the recorded extrema
+// when they are known. The first bucket starts at 0 and the last one ends at the last bound
+// when they are not.
+func (h HistogramSnapshot) bucketRange(i int) (float64, float64) {
+	var lower, upper float64
+	if i > 0 && i-1 < len(h.Bounds) {
+		lower = h.Bounds[i-1]
+	}
+	switch {
+	case i < len(h.Bounds):
+		upper = h.Bounds[i]
+	case len(h.Bounds) > 0:
+		upper = h.Bounds[len(h.Bounds)-1]
+	}
+	if h.hasExtrema {
+		if i == 0 || lower < h.min {
+			lower = h.min
+		}
+		if i >= len(h.Bounds) || upper > h.max {
+			upper = h.max
+		}
+	}
+	return lower, max(lower, upper)
+}
+
+// newSnapshot converts collected metrics to a Snapshot.
+func newSnapshot(rm *metricdata.ResourceMetrics) *Snapshot {
+	s := &Snapshot{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			s.add(scope.Scope.Name, m)
+		}
+	}
+	return s
+}
+
+// add appends the data points of the instrument m, created in scope, to the snapshot.
+func (s *Snapshot) add(scope string, m metricdata.Metrics) {
+	switch data := m.Data.(type) {
+	case metricdata.Sum[int64]:
+		for _, p := range data.DataPoints {
+			s.Counters = append(s.Counters, CounterSnapshot{m.Name, scope, p.Attributes, float64(p.Value)})
+		}
+	case metricdata.Sum[float64]:
+		for _, p := range data.DataPoints {
+			s.Counters = append(s.Counters, CounterSnapshot{m.Name, scope, p.Attributes, p.Value})
+		}
+	case metricdata.Gauge[int64]:
+		for _, p := range data.DataPoints {
+			s.Gauges = append(s.Gauges, GaugeSnapshot{m.Name, scope, p.Attributes, float64(p.Value)})
+		}
+	case metricdata.Gauge[float64]:
+		for _, p := range data.DataPoints {
+			s.Gauges = append(s.Gauges, GaugeSnapshot{m.Name, scope, p.Attributes, p.Value})
+		}
+	case metricdata.Histogram[int64]:
+		for _, p := range data.DataPoints {
+			h := HistogramSnapshot{
+				Name:         m.Name,
+				Scope:        scope,
+				Attributes:   p.Attributes,
+				Count:        p.Count,
+				Sum:          float64(p.Sum),
+				Bounds:       p.Bounds,
+				BucketCounts: p.BucketCounts,
+			}
+			minValue, hasMin := p.Min.Value()
+			maxValue, hasMax := p.Max.Value()
+			h.min, h.max, h.hasExtrema = float64(minValue), float64(maxValue), hasMin && hasMax
+			s.Histograms = append(s.Histograms, h)
+		}
+	case metricdata.Histogram[float64]:
+		for _, p := range data.DataPoints {
+			h := HistogramSnapshot{
+				Name:         m.Name,
+				Scope:        scope,
+				Attributes:   p.Attributes,
+				Count:        p.Count,
+				Sum:          p.Sum,
+				Bounds:       p.Bounds,
+				BucketCounts: p.BucketCounts,
+			}
+			minValue, hasMin := p.Min.Value()
+			maxValue, hasMax := p.Max.Value()
+			h.min, h.max, h.hasExtrema = minValue, maxValue, hasMin && hasMax
+			s.Histograms = append(s.Histograms, h)
+		}
+	}
+}
+
+// hasDelta reports whether any sum or histogram in rm was aggregated with delta temporality.
+func hasDelta(rm *metricdata.ResourceMetrics) bool {
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				if data.Temporality == metricdata.DeltaTemporality {
+					return true
+				}
+			case metricdata.Sum[float64]:
+				if data.Temporality == metricdata.DeltaTemporality {
+					return true
+				}
+			case metricdata.Histogram[int64]:
+				if data.Temporality == metricdata.DeltaTemporality {
+					return true
+				}
+			case metricdata.Histogram[float64]:
+				if data.Temporality == metricdata.DeltaTemporality {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package metric
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// deltaExporter is a recordingExporter preferring delta temporality.
+type deltaExporter struct {
+	recordingExporter
+}
+
+func (e *deltaExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return temporalitySelector("delta")(k)
+}
+
+func TestMetric_Snapshot_Snapshot(t *testing.T) {
+	exporter := &recordingExporter{}
+	reader := newManualReader(exporter)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	route := attribute.String("route", "/orders")
+	counter, _ := metricInstance.CreateCounter("requests_total", "1", "Requests")
+	histogram, _ := metricInstance.CreateHistogram("request_duration_ms", "ms", "Request duration")
+	gauge, _ := metricInstance.CreateGauge("queue_depth", "1", "Queue depth")
+	metricInstance.RecordCounter(ctx, counter, 2, route)
+	metricInstance.RecordCounter(ctx, counter, 3, route)
+	metricInstance.RecordHistogram(ctx, histogram, 10, route)
+	metricInstance.RecordHistogram(ctx, histogram, 30, route)
+	metricInstance.RecordGauge(ctx, gauge, 7)
+
+	// A scoped metric snapshots the parent, including instruments of other scopes.
+	snapshot, err := metricInstance.Scoped("github.com/acme/payments", "").Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	if c, ok := snapshot.Counter("requests_total", route); !ok || c.Value != 5 || c.Scope != "test-service" {
+		t.Errorf("Counter() = %+v, %v, want value 5 in scope test-service", c, ok)
+	}
+	if _, ok := snapshot.Counter("requests_total"); ok {
+		t.Error("Counter() without attributes found a data point, want none")
+	}
+	if g, ok := snapshot.Gauge("queue_depth"); !ok || g.Value != 7 {
+		t.Errorf("Gauge() = %+v, %v, want value 7", g, ok)
+	}
+	h, ok := snapshot.Histogram("request_duration_ms", route)
+	if !ok {
+		t.Fatal("Histogram() found no data point")
+	}
+	if h.Count != 2 || h.Sum != 40 || h.Mean() != 20 {
+		t.Errorf("Histogram() count = %d, sum = %v, mean = %v, want 2, 40, 20", h.Count, h.Sum, h.Mean())
+	}
+	if len(h.BucketCounts) != len(h.Bounds)+1 {
+		t.Errorf("Histogram() has %d buckets for %d bounds, want %d", len(h.BucketCounts), len(h.Bounds), len(h.Bounds)+1)
+	}
+
+	if exports, _ := exporter.state(); exports != 0 {
+		t.Errorf("Snapshot() exported %d times with cumulative temporality, want 0", exports)
+	}
+}
+
+func TestMetric_Snapshot_Snapshot_Delta(t *testing.T) {
+	exporter := &deltaExporter{}
+	reader := newManualReader(exporter)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	metricInstance := &metric{provider: provider, meter: provider.Meter("test-service"), reader: reader}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	ctx := context.Background()
+	counter, _ := metricInstance.CreateCounter("requests_total", "1", "Requests")
+	metricInstance.RecordCounter(ctx, counter, 4)
+
+	snapshot, err := metricInstance.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	if c, ok := snapshot.Counter("requests_total"); !ok || c.Value != 4 {
+		t.Errorf("Counter() = %+v, %v, want value 4", c, ok)
+	}
+	// Collecting resets delta values, so the snapshot exports them.
+	if exports, _ := exporter.state(); exports != 1 {
+		t.Errorf("Snapshot() exported %d times with delta temporality, want 1", exports)
+	}
+}
+
+func TestMetric_Snapshot_Snapshot_Noop(t *testing.T) {
+	snapshot, err := NewNoopMetric().Snapshot(context.Background())
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	if len(snapshot.Counters)+len(snapshot.Gauges)+len(snapshot.Histograms) != 0 {
+		t.Errorf("Snapshot() = %+v, want an empty snapshot", snapshot)
+	}
+}
+
+func TestMetric_Snapshot_Percentile(t *testing.T) {
+	histogram := HistogramSnapshot{
+		Count:        10,
+		Bounds:       []float64{10, 20, 50},
+		BucketCounts: []uint64{2, 4, 2, 2},
+	}
+	withExtrema := histogram
+	withExtrema.min, withExtrema.max, withExtrema.hasExtrema = 4, 80, true
+
+	tests := []struct {
+		name      string
+		histogram HistogramSnapshot
+		p         float64
+		want      float64
+	}{
+		{name: "median", histogram: histogram, p: 0.5, want: 17.5},
+		{name: "first bucket starts at zero", histogram: histogram, p: 0.1, want: 5},
+		{name: "overflow bucket ends at last bound", histogram: histogram, p: 1, want: 50},
+		{name: "first bucket starts at min", histogram: withExtrema, p: 0.1, want: 7},
+		{name: "overflow bucket ends at max", histogram: withExtrema, p: 1, want: 80},
+		{name: "p99", histogram: withExtrema, p: 0.99, want: 78.5},
+		{name: "out of range is clamped", histogram: withExtrema, p: 2, want: 80},
+		{name: "empty", histogram: HistogramSnapshot{}, p: 0.5, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.histogram.Percentile(tt.p); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Percentile(%v) = %v, want %v", tt.p, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+)
+
+// statsdScopeName is the instrumentation scope of the instruments fed by the StatsD listener,
+// kept apart from the service meter so StatsD names cannot conflict with its instruments.
+const statsdScopeName = "statsd"
+
+// statsdMaxPacketSize is the largest UDP payload read from the StatsD socket.
+const statsdMaxPacketSize = 65535
+
+// statsdMaxInstruments and statsdMaxSeries bound the instruments and the series (name and tags)
+// the listener creates, as every new name or tag value sent to it is kept for the life of the
+// Metric. Lines past the limits are dropped and reported.
+const (
+	statsdMaxInstruments = 1000
+	statsdMaxSeries      = 10000
+)
+
+// statsdMaxTagLength is the longest tag key or value accepted.
+const statsdMaxTagLength = 255
+
+// statsdSample is one parsed StatsD line.
+type statsdSample struct {
+	name     string
+	value    float64
+	kind     string // kind is the StatsD type: "c", "g", "ms", "h", "d", or "s".
+	relative bool   // relative is set for gauges given as a signed change ("+3", "-1").
+	labels   []attribute.KeyValue
+}
+
+// statsdListener receives StatsD packets from a UDP socket and records them on meter:
+// counters ("c") on float64 counters, corrected for their sample rate; gauges ("g") on
+// float64 gauges, a signed value changing the last one; timers ("ms") on millisecond
+// histograms and histograms and distributions ("h", "d") on unitless histograms. DogStatsD
+// tags ("#env:prod,canary") become attributes. Sets ("s") are not supported: they are dropped
+// and reported with ErrStatsDSetUnsupported.
+type statsdListener struct {
+	conn  net.PacketConn
+	meter otelmetric.Meter
+	done  chan struct{}
+
+	mu         sync.Mutex
+	counters   map[string]otelmetric.Float64Counter
+	gauges     map[string]otelmetric.Float64Gauge
+	histograms map[string]otelmetric.Float64Histogram
+	series     map[string]struct{} // series holds the name and tags of every series recorded.
+	values     map[string]float64  // values are the last values of the gauges, by name and tags.
+}
+
+// newStatsDListener listens for StatsD packets on the UDP address and starts recording them
+// on meter.
+func newStatsDListener(address string, meter otelmetric.Meter) (*statsdListener, error) {
+	conn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start statsd listener: %w", err)
+	}
+	l := &statsdListener{
+		conn:       conn,
+		meter:      meter,
+		done:       make(chan struct{}),
+		counters:   make(map[string]otelmetric.Float64Counter),
+		gauges:     make(map[string]otelmetric.Float64Gauge),
+		histograms: make(map[string]otelmetric.Float64Histogram),
+		series:     make(map[string]struct{}),
+		values:     make(map[string]float64),
+	}
+	go l.serve()
+	return l, nil
+}
+
+// serve reads packets until the socket is closed. A packet holds one line per metric.
+func (l *statsdListener) serve() {
+	defer close(l.done)
+	buf := make([]byte, statsdMaxPacketSize)
+	for {
+		n, _, err := l.conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			otel.Handle(fmt.Errorf("statsd listener: %w", err))
+			continue
+		}
+		for _, line := range strings.Split(string(buf[:n]), "\n") {
+			if line = strings.TrimSpace(line); line == "" {
+				continue
+			}
+			if err := l.handle(line); err != nil {
+				otel.Handle(err)
+			}
+		}
+	}
+}
+
+// handle parses line and records its value. It returns an error wrapping
+// ErrStatsDLimitExceeded when the line would create an instrument or series past the limits.
+func (l *statsdListener) handle(line string) error {
+	sample, err := parseStatsD(line)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	set := otelmetric.WithAttributes(sample.labels...)
+	key := statsdSeriesKey(sample)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.series[key]; !ok {
+		if len(l.series) >= statsdMaxSeries {
+			return fmt.Errorf("%w: %d series: %q", ErrStatsDLimitExceeded, statsdMaxSeries, line)
+		}
+		if !l.created(sample) && len(l.counters)+len(l.gauges)+len(l.histograms) >= statsdMaxInstruments {
+			return fmt.Errorf("%w: %d instruments: %q", ErrStatsDLimitExceeded, statsdMaxInstruments, line)
+		}
+	}
+
+	switch sample.kind {
+	case "c":
+		counter, ok := l.counters[sample.name]
+		if !ok {
+			if counter, err = l.meter.Float64Counter(sample.name); err != nil {
+				return fmt.Errorf("statsd counter %q: %w", sample.name, err)
+			}
+			l.counters[sample.name] = counter
+		}
+		counter.Add(ctx, sample.value, set)
+	case "g":
+		gauge, ok := l.gauges[sample.name]
+		if !ok {
+			if gauge, err = l.meter.Float64Gauge(sample.name); err != nil {
+				return fmt.Errorf("statsd gauge %q: %w", sample.name, err)
+			}
+			l.gauges[sample.name] = gauge
+		}
+		value := sample.value
+		if sample.relative {
+			value += l.values[key]
+		}
+		l.values[key] = value
+		gauge.Record(ctx, value, set)
+	case "ms", "h", "d":
+		histogram, ok := l.histograms[sample.name]
+		if !ok {
+			var histOpts []otelmetric.Float64HistogramOption
+			if sample.kind == "ms" {
+				histOpts = append(histOpts, otelmetric.WithUnit("ms"))
+			}
+			if histogram, err = l.meter.Float64Histogram(sample.name, histOpts...); err != nil {
+				return fmt.Errorf("statsd histogram %q: %w", sample.name, err)
+			}
+			l.histograms[sample.name] = histogram
+		}
+		histogram.Record(ctx, sample.value, set)
+	}
+	l.series[key] = struct{}{}
+	return nil
+}
+
+// created reports whether the instrument sample is recorded on was already created.
+func (l *statsdListener) created(sample statsdSample) bool {
+	var ok bool
+	switch sample.kind {
+	case "c":
+		_, ok = l.counters[sample.name]
+	case "g":
+		_, ok = l.gauges[sample.name]
+	default:
+		_, ok = l.histograms[sample.name]
+	}
+	return ok
+}
+
+// close stops the listener and waits for the packet being handled.
+func (l *statsdListener) close() error {
+	err := l.conn.Close()
+	<-l.done
+	return err
+}
+
+// addr returns the address the listener receives packets on.
+func (l *statsdListener) addr() net.Addr {
+	return l.conn.LocalAddr()
+}
+
+// parseStatsD parses a StatsD line of the form "<name>:<value>|<type>[|@<rate>][|#<tags>]".
+// It returns an error wrapping ErrStatsDLineInvalid for a malformed line, a name that is not a
+// valid instrument name, a NaN or infinite value or sample rate, a negative counter value, or an
+// invalid tag, and an error wrapping ErrStatsDSetUnsupported for a set.
+func parseStatsD(line string) (statsdSample, error) {
+	invalid := func(reason string) (statsdSample, error) {
+		return statsdSample{}, fmt.Errorf("%w: %s: %q", ErrStatsDLineInvalid, reason, line)
+	}
+
+	name, rest, ok := strings.Cut(line, ":")
+	if !ok || name == "" {
+		return invalid("missing name")
+	}
+	if validateName(name) != nil {
+		return invalid("invalid name")
+	}
+	fields := strings.Split(rest, "|")
+	if len(fields) < 2 {
+		return invalid("missing type")
+	}
+	sample := statsdSample{name: name, kind: fields[1]}
+	switch sample.kind {
+	case "c", "g", "ms", "h", "d", "s":
+	default:
+		return invalid("unknown type")
+	}
+	if sample.kind == "s" {
+		return statsdSample{}, fmt.Errorf("%w: %q", ErrStatsDSetUnsupported, line)
+	}
+
+	raw := fields[0]
+	sample.relative = sample.kind == "g" && (strings.HasPrefix(raw, "+") || strings.HasPrefix(raw, "-"))
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		// A NaN or infinite value would poison the sum of the instrument for good.
+		return invalid("invalid value")
+	}
+	if sample.kind == "c" && value < 0 {
+		// OpenTelemetry counters are monotonic and drop negative increments.
+		return invalid("negative counter value")
+	}
+	sample.value = value
+
+	for _, field := range fields[2:] {
+		switch {
+		case strings.HasPrefix(field, "@"):
+			rate, err := strconv.ParseFloat(field[1:], 64)
+			if err != nil || math.IsNaN(rate) || rate <= 0 || rate > 1 {
+				return invalid("invalid sample rate")
+			}
+			if sample.kind == "c" {
+				sample.value /= rate
+				if math.IsInf(sample.value, 0) {
+					return invalid("invalid value")
+				}
+			}
+		case strings.HasPrefix(field, "#"):
+			for _, tag := range strings.Split(field[1:], ",") {
+				if tag == "" {
+					continue
+				}
+				key, value, _ := strings.Cut(tag, ":")
+				if !validStatsDTag(key) || (value != "" && !validStatsDTag(value)) {
+					return invalid("invalid tag")
+				}
+				sample.labels = append(sample.labels, attribute.String(key, value))
+			}
+		}
+	}
+	return sample, nil
+}
+
+// validStatsDTag reports whether s is a usable tag key or value: non-empty UTF-8 of at most
+// statsdMaxTagLength bytes.
+func validStatsDTag(s string) bool {
+	return s != "" && len(s) <= statsdMaxTagLength && utf8.ValidString(s)
+}
+
+// statsdSeriesKey identifies the series of sample by its name and tags.
+func statsdSeriesKey(sample statsdSample) string {
+	tags := make([]string, len(sample.labels))
+	for i, label := range sample.labels {
+		tags[i] = string(label.Key) + "=" + label.Value.AsString()
+	}
+	sort.Strings(tags)
+	return sample.name + "|" + strings.Join(tags, ",")
+}
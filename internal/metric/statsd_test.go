@@ -0,0 +1,175 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func TestMetric_Statsd_ParseStatsD(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    statsdSample
+		wantErr error
+	}{
+		{
+			name: "counter",
+			line: "requests:3|c",
+			want: statsdSample{name: "requests", value: 3, kind: "c"},
+		},
+		{
+			name: "sampled counter with tags",
+			line: "requests:1|c|@0.5|#route:/orders,canary",
+			want: statsdSample{name: "requests", value: 2, kind: "c", labels: []attribute.KeyValue{
+				attribute.String("route", "/orders"),
+				attribute.String("canary", ""),
+			}},
+		},
+		{
+			name: "gauge",
+			line: "queue.depth:7|g",
+			want: statsdSample{name: "queue.depth", value: 7, kind: "g"},
+		},
+		{
+			name: "relative gauge",
+			line: "queue.depth:-2|g",
+			want: statsdSample{name: "queue.depth", value: -2, kind: "g", relative: true},
+		},
+		{
+			name: "timer keeps its value when sampled",
+			line: "db.query:12.5|ms|@0.1",
+			want: statsdSample{name: "db.query", value: 12.5, kind: "ms"},
+		},
+		{name: "set", line: "users:alice|s", wantErr: ErrStatsDSetUnsupported},
+		{name: "missing name", line: ":1|c", wantErr: ErrStatsDLineInvalid},
+		{name: "invalid name", line: "1requests:1|c", wantErr: ErrStatsDLineInvalid},
+		{name: "missing type", line: "requests:1", wantErr: ErrStatsDLineInvalid},
+		{name: "unknown type", line: "requests:1|x", wantErr: ErrStatsDLineInvalid},
+		{name: "invalid value", line: "requests:many|c", wantErr: ErrStatsDLineInvalid},
+		{name: "negative counter", line: "requests:-1|c", wantErr: ErrStatsDLineInvalid},
+		{name: "invalid sample rate", line: "requests:1|c|@2", wantErr: ErrStatsDLineInvalid},
+		{name: "NaN counter", line: "requests:NaN|c", wantErr: ErrStatsDLineInvalid},
+		{name: "infinite timing", line: "latency:Inf|ms", wantErr: ErrStatsDLineInvalid},
+		{name: "positive infinite gauge", line: "queue:+Inf|g", wantErr: ErrStatsDLineInvalid},
+		{name: "negative infinite histogram", line: "size:-Inf|h", wantErr: ErrStatsDLineInvalid},
+		{name: "NaN sample rate", line: "requests:1|c|@NaN", wantErr: ErrStatsDLineInvalid},
+		{name: "overflowing sampled counter", line: "requests:1e308|c|@0.001", wantErr: ErrStatsDLineInvalid},
+		{name: "empty tag key", line: "requests:1|c|#:prod", wantErr: ErrStatsDLineInvalid},
+		{name: "invalid tag value", line: "requests:1|c|#env:\xff", wantErr: ErrStatsDLineInvalid},
+		{name: "long tag value", line: "requests:1|c|#env:" + strings.Repeat("a", statsdMaxTagLength+1), wantErr: ErrStatsDLineInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStatsD(tt.line)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("parseStatsD(%q) error = %v, want %v", tt.line, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseStatsD(%q) error = %v", tt.line, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseStatsD(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_Statsd_Handle_Limits(t *testing.T) {
+	l := &statsdListener{
+		meter:      sdkmetric.NewMeterProvider().Meter(statsdScopeName),
+		counters:   make(map[string]otelmetric.Float64Counter),
+		gauges:     make(map[string]otelmetric.Float64Gauge),
+		histograms: make(map[string]otelmetric.Float64Histogram),
+		series:     make(map[string]struct{}),
+		values:     make(map[string]float64),
+	}
+	for i := 0; i < statsdMaxInstruments; i++ {
+		if err := l.handle(fmt.Sprintf("requests.%d:1|c", i)); err != nil {
+			t.Fatalf("handle() error = %v", err)
+		}
+	}
+	if err := l.handle("requests.new:1|c"); !errors.Is(err, ErrStatsDLimitExceeded) {
+		t.Errorf("handle() new instrument error = %v, want %v", err, ErrStatsDLimitExceeded)
+	}
+	if err := l.handle("requests.0:1|c"); err != nil {
+		t.Errorf("handle() existing series error = %v", err)
+	}
+
+	for i := 0; len(l.series) < statsdMaxSeries; i++ {
+		if err := l.handle(fmt.Sprintf("requests.0:1|c|#id:%d", i)); err != nil {
+			t.Fatalf("handle() error = %v", err)
+		}
+	}
+	if err := l.handle("requests.0:1|c|#id:new"); !errors.Is(err, ErrStatsDLimitExceeded) {
+		t.Errorf("handle() new series error = %v, want %v", err, ErrStatsDLimitExceeded)
+	}
+	if err := l.handle("requests.0:1|c|#id:0"); err != nil {
+		t.Errorf("handle() existing series error = %v", err)
+	}
+}
+
+func TestMetric_Statsd_Listener(t *testing.T) {
+	metricInstance, err := NewMetric(
+		WithServiceName("test-service"),
+		WithProvider("stdout", "", 0),
+		WithWriter(io.Discard),
+		WithReaderMode("manual"),
+		WithStatsDListener("127.0.0.1:0"),
+	)
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	defer func() {
+		_ = metricInstance.Shutdown(context.Background())
+	}()
+
+	conn, err := net.Dial("udp", metricInstance.(*metric).statsd.addr().String())
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte("requests:2|c|#route:/orders\nrequests:1|c|@0.5|#route:/orders\nqueue.depth:10|g\nqueue.depth:-3|g\ndb.query:12|ms\nnot a metric")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	ctx := context.Background()
+	route := attribute.String("route", "/orders")
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		snapshot, err := metricInstance.Snapshot(ctx)
+		if err != nil {
+			t.Fatalf("Snapshot() error = %v", err)
+		}
+		if _, ok := snapshot.Histogram("db.query"); ok {
+			if c, ok := snapshot.Counter("requests", route); !ok || c.Value != 4 || c.Scope != statsdScopeName {
+				t.Errorf("Counter() = %+v, %v, want value 4 in scope %s", c, ok, statsdScopeName)
+			}
+			if g, ok := snapshot.Gauge("queue.depth"); !ok || g.Value != 7 {
+				t.Errorf("Gauge() = %+v, %v, want value 7", g, ok)
+			}
+			if h, _ := snapshot.Histogram("db.query"); h.Count != 1 || h.Sum != 12 {
+				t.Errorf("Histogram() = %+v, want one value of 12", h)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the StatsD packet to be recorded")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
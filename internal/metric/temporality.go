@@ -0,0 +1,27 @@
+package metric
+
+import (
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// temporalitySelector returns the selector matching a Temporality option.
+// "delta" follows the OpenTelemetry delta preference: counters and histograms (synchronous and
+// observable) report deltas, while up-down counters and gauges stay cumulative because a delta
+// of a value that can decrease is not meaningful to delta backends. Any other value, including
+// the empty string, selects cumulative temporality for every instrument kind.
+func temporalitySelector(temporality string) sdkmetric.TemporalitySelector {
+	if temporality != "delta" {
+		return sdkmetric.DefaultTemporalitySelector
+	}
+	return func(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+		switch kind {
+		case sdkmetric.InstrumentKindCounter,
+			sdkmetric.InstrumentKindHistogram,
+			sdkmetric.InstrumentKindObservableCounter:
+			return metricdata.DeltaTemporality
+		default:
+			return metricdata.CumulativeTemporality
+		}
+	}
+}
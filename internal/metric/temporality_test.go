@@ -0,0 +1,50 @@
+package metric
+
+import (
+	"context"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMetric_Temporality_Selector(t *testing.T) {
+	tests := []struct {
+		name        string
+		temporality string
+		kind        sdkmetric.InstrumentKind
+		want        metricdata.Temporality
+	}{
+		{"default counter", "", sdkmetric.InstrumentKindCounter, metricdata.CumulativeTemporality},
+		{"cumulative histogram", "cumulative", sdkmetric.InstrumentKindHistogram, metricdata.CumulativeTemporality},
+		{"delta counter", "delta", sdkmetric.InstrumentKindCounter, metricdata.DeltaTemporality},
+		{"delta histogram", "delta", sdkmetric.InstrumentKindHistogram, metricdata.DeltaTemporality},
+		{"delta observable counter", "delta", sdkmetric.InstrumentKindObservableCounter, metricdata.DeltaTemporality},
+		{"delta up-down counter", "delta", sdkmetric.InstrumentKindUpDownCounter, metricdata.CumulativeTemporality},
+		{"delta observable up-down counter", "delta", sdkmetric.InstrumentKindObservableUpDownCounter, metricdata.CumulativeTemporality},
+		{"delta gauge", "delta", sdkmetric.InstrumentKindGauge, metricdata.CumulativeTemporality},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := temporalitySelector(tt.temporality)(tt.kind); got != tt.want {
+				t.Errorf("temporalitySelector(%q)(%v) = %v, want %v", tt.temporality, tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_Temporality_NewMetric(t *testing.T) {
+	m, err := NewMetric(WithServiceName("test-service"), WithTemporality("delta"))
+	if err != nil {
+		t.Fatalf("NewMetric() error = %v", err)
+	}
+	metricInstance := m.(*metric)
+	defer func() {
+		_ = metricInstance.provider.Shutdown(context.Background())
+	}()
+
+	if got := metricInstance.reader.currentExporter().Temporality(sdkmetric.InstrumentKindCounter); got != metricdata.DeltaTemporality {
+		t.Errorf("exporter temporality for counters = %v, want delta", got)
+	}
+}
@@ -0,0 +1,85 @@
+package metric
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// convertFields converts a map[string]interface{} into a slice of attribute.KeyValue,
+// producing one attribute for each map entry, sorted by key. Values without a matching
+// attribute type are formatted with fmt.Sprint. If the input is nil, convertFields returns nil.
+func convertFields(fields map[string]interface{}) []attribute.KeyValue {
+	if fields == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]attribute.KeyValue, 0, len(fields))
+	for _, k := range keys {
+		attrs = append(attrs, convertField(k, fields[k]))
+	}
+	return attrs
+}
+
+// convertField converts a single field into an attribute.KeyValue.
+func convertField(key string, value interface{}) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int32:
+		return attribute.Int64(key, int64(v))
+	case int64:
+		return attribute.Int64(key, v)
+	case float32:
+		return attribute.Float64(key, float64(v))
+	case float64:
+		return attribute.Float64(key, v)
+	case time.Duration:
+		return attribute.String(key, v.String())
+	case []string:
+		return attribute.StringSlice(key, v)
+	case []bool:
+		return attribute.BoolSlice(key, v)
+	case []int:
+		return attribute.IntSlice(key, v)
+	case []int64:
+		return attribute.Int64Slice(key, v)
+	case []float64:
+		return attribute.Float64Slice(key, v)
+	case error:
+		return attribute.String(key, v.Error())
+	case fmt.Stringer:
+		return attribute.String(key, v.String())
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
+
+// identityAttributes returns the resource attributes identifying the service: service.name, and
+// service.instance.id, host.name, and deployment.environment when they are set. Unset ones are
+// left out, so they do not hide the values of ResourceAttributes.
+func identityAttributes(options *Options) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{semconv.ServiceNameKey.String(options.ServiceName)}
+	if options.InstanceName != "" {
+		attrs = append(attrs, semconv.ServiceInstanceIDKey.String(options.InstanceName))
+	}
+	if options.InstanceHost != "" {
+		attrs = append(attrs, semconv.HostNameKey.String(options.InstanceHost))
+	}
+	if options.Environment != "" {
+		attrs = append(attrs, semconv.DeploymentEnvironmentKey.String(options.Environment))
+	}
+	return attrs
+}
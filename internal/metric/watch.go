@@ -0,0 +1,136 @@
+package metric
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// WatchEvent describes a threshold crossing reported to a Watch callback.
+type WatchEvent struct {
+	Instrument string        // Instrument is the name of the watched instrument.
+	Attributes attribute.Set // Attributes identify the data point that crossed the threshold.
+	Value      float64       // Value is the data point value the predicate was evaluated with.
+	Firing     bool          // Firing is true when the predicate started holding and false when it stopped.
+}
+
+// watch is a predicate on the data points of one instrument, with the points it currently holds for.
+type watch struct {
+	instrument string
+	predicate  func(value float64) bool
+	callback   func(event WatchEvent)
+	firing     map[attribute.Distinct]bool
+}
+
+// watchSet holds the watches evaluated by a reader on every collection.
+type watchSet struct {
+	mu      sync.Mutex
+	watches map[*watch]struct{}
+}
+
+// add registers w and returns a function removing it.
+func (s *watchSet) add(w *watch) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.watches == nil {
+		s.watches = make(map[*watch]struct{})
+	}
+	s.watches[w] = struct{}{}
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.watches, w)
+	}
+}
+
+// evaluate applies the watches to the collected metrics and invokes the callbacks of the data
+// points whose predicate changed. Callbacks run after the set is unlocked, so they may add or
+// remove watches.
+func (s *watchSet) evaluate(rm *metricdata.ResourceMetrics) {
+	type notification struct {
+		callback func(event WatchEvent)
+		event    WatchEvent
+	}
+	var notifications []notification
+
+	s.mu.Lock()
+	for w := range s.watches {
+		for _, scope := range rm.ScopeMetrics {
+			for _, m := range scope.Metrics {
+				if m.Name != w.instrument {
+					continue
+				}
+				for _, point := range dataPoints(m.Data) {
+					holds := w.predicate(point.value)
+					key := point.attributes.Equivalent()
+					if holds == w.firing[key] {
+						continue
+					}
+					if holds {
+						w.firing[key] = true
+					} else {
+						delete(w.firing, key)
+					}
+					notifications = append(notifications, notification{
+						callback: w.callback,
+						event: WatchEvent{
+							Instrument: m.Name,
+							Attributes: point.attributes,
+							Value:      point.value,
+							Firing:     holds,
+						},
+					})
+				}
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	for _, n := range notifications {
+		n.callback(n.event)
+	}
+}
+
+// dataPoint is the value a watch predicate is evaluated with and the attributes identifying it.
+type dataPoint struct {
+	attributes attribute.Set
+	value      float64
+}
+
+// dataPoints returns the points of an aggregation as watch values: the value of sums and
+// gauges, and the mean of histograms.
+func dataPoints(data metricdata.Aggregation) []dataPoint {
+	var points []dataPoint
+	switch data := data.(type) {
+	case metricdata.Sum[int64]:
+		for _, p := range data.DataPoints {
+			points = append(points, dataPoint{p.Attributes, float64(p.Value)})
+		}
+	case metricdata.Sum[float64]:
+		for _, p := range data.DataPoints {
+			points = append(points, dataPoint{p.Attributes, p.Value})
+		}
+	case metricdata.Gauge[int64]:
+		for _, p := range data.DataPoints {
+			points = append(points, dataPoint{p.Attributes, float64(p.Value)})
+		}
+	case metricdata.Gauge[float64]:
+		for _, p := range data.DataPoints {
+			points = append(points, dataPoint{p.Attributes, p.Value})
+		}
+	case metricdata.Histogram[int64]:
+		for _, p := range data.DataPoints {
+			if p.Count > 0 {
+				points = append(points, dataPoint{p.Attributes, float64(p.Sum) / float64(p.Count)})
+			}
+		}
+	case metricdata.Histogram[float64]:
+		for _, p := range data.DataPoints {
+			if p.Count > 0 {
+				points = append(points, dataPoint{p.Attributes, p.Sum / float64(p.Count)})
+			}
+		}
+	}
+	return points
+}
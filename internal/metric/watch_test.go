@@ -0,0 +1,108 @@
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// newWatchedMetric returns a metric on a manual reader, so tests decide when watches are evaluated.
+func newWatchedMetric(t *testing.T) *metric {
+	t.Helper()
+	reader := newManualReader(&recordingExporter{})
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader.reader))
+	t.Cleanup(func() {
+		_ = reader.shutdown(context.Background())
+		_ = provider.Shutdown(context.Background())
+	})
+	return &metric{provider: provider, meter: provider.Meter("test"), reader: reader}
+}
+
+func TestMetric_Watch_Watch(t *testing.T) {
+	ctx := context.Background()
+	m := newWatchedMetric(t)
+	gauge, err := m.CreateGauge("queue_depth", "1", "Queued jobs")
+	if err != nil {
+		t.Fatalf("CreateGauge() error = %v", err)
+	}
+
+	var events []WatchEvent
+	stop := m.Scoped("scope", "").Watch("queue_depth", func(v float64) bool { return v > 100 }, func(e WatchEvent) {
+		events = append(events, e)
+	})
+
+	emails := attribute.String("queue", "emails")
+	steps := []struct {
+		value      int64
+		wantEvents int
+		wantFiring bool
+	}{
+		{50, 0, false},
+		{150, 1, true},
+		{200, 1, false}, // still over the threshold, no new event
+		{80, 2, false},
+	}
+	for _, step := range steps {
+		m.RecordGauge(ctx, gauge, step.value, emails)
+		if err := m.Collect(ctx); err != nil {
+			t.Fatalf("Collect() error = %v", err)
+		}
+		if len(events) != step.wantEvents {
+			t.Fatalf("after value %d: %d events, want %d", step.value, len(events), step.wantEvents)
+		}
+	}
+
+	if e := events[0]; !e.Firing || e.Value != 150 || e.Instrument != "queue_depth" || !e.Attributes.HasValue("queue") {
+		t.Errorf("firing event = %+v, want queue_depth at 150 for the emails queue", e)
+	}
+	if e := events[1]; e.Firing || e.Value != 80 {
+		t.Errorf("resolved event = %+v, want not firing at 80", e)
+	}
+
+	stop()
+	m.RecordGauge(ctx, gauge, 500, emails)
+	if err := m.Collect(ctx); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Errorf("expected no events after stop, got %d", len(events))
+	}
+}
+
+func TestMetric_Watch_Histogram(t *testing.T) {
+	ctx := context.Background()
+	m := newWatchedMetric(t)
+	histogram, err := m.CreateHistogram("request_duration_ms", "ms", "Request duration")
+	if err != nil {
+		t.Fatalf("CreateHistogram() error = %v", err)
+	}
+
+	var events []WatchEvent
+	m.Watch("request_duration_ms", func(v float64) bool { return v > 250 }, func(e WatchEvent) {
+		events = append(events, e)
+	})
+
+	// Data points are watched per attribute set.
+	m.RecordHistogram(ctx, histogram, 100, attribute.String("route", "/orders"))
+	m.RecordHistogram(ctx, histogram, 600, attribute.String("route", "/search"))
+	m.RecordHistogram(ctx, histogram, 200, attribute.String("route", "/search"))
+	if err := m.Collect(ctx); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %+v", events)
+	}
+	if route, _ := events[0].Attributes.Value("route"); route.AsString() != "/search" || events[0].Value != 400 {
+		t.Errorf("event = %+v, want the /search mean of 400", events[0])
+	}
+}
+
+func TestMetric_Watch_Noop(t *testing.T) {
+	stop := NewNoopMetric().Watch("queue_depth", func(float64) bool { return true }, func(WatchEvent) {
+		t.Error("noop metric must never call the watch callback")
+	})
+	stop()
+}
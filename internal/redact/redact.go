@@ -0,0 +1,65 @@
+// Package redact implements the redaction policy shared by the logger and the tracer: the
+// values of the configured attribute and field keys are deleted, or replaced with a hash so
+// equal values still correlate across log entries and spans without being revealed.
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+// Actions accepted by New.
+const (
+	ActionDelete = "delete"
+	ActionHash   = "hash"
+)
+
+// ErrInvalidAction is returned by Validate for an action New does not support.
+var ErrInvalidAction = errors.New("redaction action must be delete or hash")
+
+// Validate returns ErrInvalidAction unless action is empty or one of the Action constants.
+func Validate(action string) error {
+	switch action {
+	case "", ActionDelete, ActionHash:
+		return nil
+	default:
+		return ErrInvalidAction
+	}
+}
+
+// Policy redacts the values of a set of keys. A nil Policy redacts nothing.
+type Policy struct {
+	hash bool
+	keys map[string]bool
+}
+
+// New returns the Policy redacting keys with action, ActionDelete when it is empty, or nil
+// when keys is empty. Keys are matched exactly.
+func New(action string, keys []string) *Policy {
+	if len(keys) == 0 {
+		return nil
+	}
+	p := &Policy{hash: action == ActionHash, keys: make(map[string]bool, len(keys))}
+	for _, key := range keys {
+		p.keys[key] = true
+	}
+	return p
+}
+
+// Redacts reports whether the value of key is redacted.
+func (p *Policy) Redacts(key string) bool {
+	return p != nil && p.keys[key]
+}
+
+// Hashes reports whether redacted values are replaced with their Hash instead of deleted.
+func (p *Policy) Hashes() bool {
+	return p != nil && p.hash
+}
+
+// Hash returns the hexadecimal SHA-256 hash of value, prefixed with "sha256:". The logger and
+// the tracer hash the same value to the same string.
+func Hash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
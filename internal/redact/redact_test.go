@@ -0,0 +1,70 @@
+package redact
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRedact_Redact_Validate(t *testing.T) {
+	tests := []struct {
+		action  string
+		wantErr error
+	}{
+		{"", nil},
+		{ActionDelete, nil},
+		{ActionHash, nil},
+		{"mask", ErrInvalidAction},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			if err := Validate(tt.action); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate(%q) error = %v, want %v", tt.action, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRedact_Redact_New(t *testing.T) {
+	tests := []struct {
+		name       string
+		action     string
+		keys       []string
+		wantRedact bool
+		wantHash   bool
+	}{
+		{"no keys redacts nothing", ActionHash, nil, false, false},
+		{"empty action deletes", "", []string{"user.email"}, true, false},
+		{"delete", ActionDelete, []string{"user.email"}, true, false},
+		{"hash", ActionHash, []string{"db.statement", "user.email"}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := New(tt.action, tt.keys)
+			if got := p.Redacts("user.email"); got != tt.wantRedact {
+				t.Errorf("Redacts(user.email) = %v, want %v", got, tt.wantRedact)
+			}
+			if p.Redacts("user.id") {
+				t.Error("Redacts(user.id) = true, want false")
+			}
+			if got := p.Hashes(); got != tt.wantHash {
+				t.Errorf("Hashes() = %v, want %v", got, tt.wantHash)
+			}
+		})
+	}
+}
+
+func TestRedact_Redact_Hash(t *testing.T) {
+	got := Hash("alice@example.com")
+	if !strings.HasPrefix(got, "sha256:") || len(got) != len("sha256:")+64 {
+		t.Errorf("Hash() = %q, want sha256: and 64 hex digits", got)
+	}
+	if Hash("alice@example.com") != got {
+		t.Error("Hash() is not deterministic")
+	}
+	if Hash("bob@example.com") == got {
+		t.Error("Hash() returned the same hash for different values")
+	}
+}
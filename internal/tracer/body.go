@@ -0,0 +1,121 @@
+package tracer
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// BodyCapture counts the bytes of an HTTP body written through it and keeps the first ones, up
+// to a limit, as a snippet for debugging.
+type BodyCapture struct {
+	size    int64
+	limit   int
+	snippet []byte
+}
+
+// NewBodyCapture returns a BodyCapture keeping a snippet of up to limit bytes. A limit of zero
+// or less keeps no snippet.
+func NewBodyCapture(limit int) *BodyCapture {
+	return &BodyCapture{limit: limit}
+}
+
+// Write counts p and appends as much of it to the snippet as the limit allows. It never fails,
+// so it can be used with io.TeeReader.
+func (c *BodyCapture) Write(p []byte) (int, error) {
+	c.size += int64(len(p))
+	if room := c.limit - len(c.snippet); room > 0 {
+		c.snippet = append(c.snippet, p[:min(room, len(p))]...)
+	}
+	return len(p), nil
+}
+
+// Size returns the number of bytes written.
+func (c *BodyCapture) Size() int64 {
+	return c.size
+}
+
+// Attributes returns the body attributes of one direction ("request" or "response") of an
+// HTTP exchange: http.<direction>.body.size when size is known (not negative), the content type
+// as http.<direction>.header.content-type, and the snippet captured so far as
+// http.<direction>.body.snippet.
+func (c *BodyCapture) Attributes(direction, contentType string, size int64) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if size >= 0 {
+		if direction == "request" {
+			attrs = append(attrs, semconv.HTTPRequestBodySize(int(size)))
+		} else {
+			attrs = append(attrs, semconv.HTTPResponseBodySize(int(size)))
+		}
+	}
+	if contentType != "" {
+		attrs = append(attrs, attribute.StringSlice("http."+direction+".header.content-type", []string{contentType}))
+	}
+	if len(c.snippet) > 0 {
+		attrs = append(attrs, attribute.String("http."+direction+".body.snippet", strings.ToValidUTF8(string(c.snippet), "")))
+	}
+	return attrs
+}
+
+// bodyRecording holds the body recording settings of RoundTripper.
+type bodyRecording struct {
+	snippetLimit int // snippetLimit is the maximum number of body bytes recorded as a snippet.
+}
+
+// setBodyRecording replaces the body recording settings of RoundTripper; it is disabled unless
+// options.BodyRecording is set.
+func (t *tracer) setBodyRecording(options *Options) {
+	if !options.BodyRecording {
+		t.body.Store(nil)
+		return
+	}
+	t.body.Store(&bodyRecording{snippetLimit: options.BodySnippetLimit})
+}
+
+// bodyRecording returns the body recording settings currently in use, or nil when disabled.
+// Scoped tracers read them through the parent.
+func (t *tracer) bodyRecording() *bodyRecording {
+	if t.parent != nil {
+		return t.parent.bodyRecording()
+	}
+	return t.body.Load()
+}
+
+// requestBodyAttributes returns the body attributes of an outgoing request. The snippet is read
+// from a fresh copy of the body obtained with req.GetBody, so the body sent is not consumed; it
+// is omitted when the request has no GetBody.
+func (b *bodyRecording) requestBodyAttributes(req *http.Request) []attribute.KeyValue {
+	capture := NewBodyCapture(b.snippetLimit)
+	if b.snippetLimit > 0 && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			_, _ = io.CopyN(capture, body, int64(b.snippetLimit))
+			_ = body.Close()
+		}
+	}
+	size := req.ContentLength
+	if size == 0 && req.Body != nil && req.Body != http.NoBody {
+		size = -1
+	}
+	return capture.Attributes("request", req.Header.Get("Content-Type"), size)
+}
+
+// responseBodyAttributes returns the body attributes of a received response. The snippet is
+// read ahead from resp.Body, which is replaced by a reader returning the snippet first, so the
+// caller still reads the complete body; reading ahead waits for up to the snippet limit bytes.
+func (b *bodyRecording) responseBodyAttributes(resp *http.Response) []attribute.KeyValue {
+	capture := NewBodyCapture(b.snippetLimit)
+	// The body of a protocol switch is the upgraded connection and must keep its io.Writer.
+	if b.snippetLimit > 0 && resp.Body != nil && resp.Body != http.NoBody && resp.StatusCode != http.StatusSwitchingProtocols {
+		var head bytes.Buffer
+		_, _ = io.CopyN(io.MultiWriter(&head, capture), resp.Body, int64(b.snippetLimit))
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(&head, resp.Body), resp.Body}
+	}
+	return capture.Attributes("response", resp.Header.Get("Content-Type"), resp.ContentLength)
+}
@@ -0,0 +1,133 @@
+package tracer
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestTracer_Body_BodyCapture(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       int
+		writes      []string
+		wantSize    int64
+		wantSnippet string
+	}{
+		{name: "no snippet", limit: 0, writes: []string{"hello"}, wantSize: 5},
+		{name: "snippet within limit", limit: 16, writes: []string{"hello ", "world"}, wantSize: 11, wantSnippet: "hello world"},
+		{name: "snippet truncated across writes", limit: 8, writes: []string{"hello ", "world"}, wantSize: 11, wantSnippet: "hello wo"},
+		{name: "invalid UTF-8 cut dropped", limit: 2, writes: []string{"hé"}, wantSize: 3, wantSnippet: "h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capture := NewBodyCapture(tt.limit)
+			for _, w := range tt.writes {
+				if n, err := capture.Write([]byte(w)); n != len(w) || err != nil {
+					t.Fatalf("expected Write to accept %d bytes, got %d, %v", len(w), n, err)
+				}
+			}
+			if capture.Size() != tt.wantSize {
+				t.Errorf("expected size %d, got %d", tt.wantSize, capture.Size())
+			}
+			attrs := attributeMap(capture.Attributes("response", "text/plain", capture.Size()))
+			if attrs["http.response.body.size"] != tt.wantSize {
+				t.Errorf("expected http.response.body.size %d, got %v", tt.wantSize, attrs["http.response.body.size"])
+			}
+			if got, _ := attrs["http.response.body.snippet"].(string); got != tt.wantSnippet {
+				t.Errorf("expected snippet %q, got %q", tt.wantSnippet, got)
+			}
+		})
+	}
+}
+
+func TestTracer_Body_BodyCapture_UnknownSize(t *testing.T) {
+	attrs := attributeMap(NewBodyCapture(0).Attributes("request", "", -1))
+	if len(attrs) != 0 {
+		t.Errorf("expected no attributes for an unknown size without content type, got %v", attrs)
+	}
+}
+
+func TestTracer_Body_RoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"echo":"` + string(body) + `"}`))
+	}))
+	defer server.Close()
+
+	tr, exporter := newRecordingTracer(t)
+	tr.setBodyRecording(&Options{BodyRecording: true, BodySnippetLimit: 8})
+	client := &http.Client{Transport: tr.RoundTripper(nil)}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, strings.NewReader("ping-pong"))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if string(body) != `{"echo":"ping-pong"}` {
+		t.Errorf("expected the complete response body, got %q", body)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	attrs := attributeMap(spans[0].Attributes)
+	want := map[string]interface{}{
+		"http.request.body.size":     int64(9),
+		"http.request.body.snippet":  "ping-pon",
+		"http.response.body.size":    int64(len(body)),
+		"http.response.body.snippet": `{"echo":`,
+	}
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("expected %s %v, got %v", key, value, attrs[key])
+		}
+	}
+	if got, _ := attrs["http.request.header.content-type"].([]string); len(got) != 1 || got[0] != "text/plain" {
+		t.Errorf("expected request content type text/plain, got %v", attrs["http.request.header.content-type"])
+	}
+	if got, _ := attrs["http.response.header.content-type"].([]string); len(got) != 1 || got[0] != "application/json" {
+		t.Errorf("expected response content type application/json, got %v", attrs["http.response.header.content-type"])
+	}
+}
+
+func TestTracer_Body_RoundTripper_Disabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	tr, exporter := newRecordingTracer(t)
+	client := &http.Client{Transport: tr.RoundTripper(nil)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	attrs := attributeMap(exporter.GetSpans()[0].Attributes)
+	if _, ok := attrs["http.response.body.size"]; ok {
+		t.Error("expected no body attributes when body recording is disabled")
+	}
+}
+
+// attributeMap returns attrs keyed by attribute name.
+func attributeMap(attrs []attribute.KeyValue) map[string]interface{} {
+	m := map[string]interface{}{}
+	for _, attr := range attrs {
+		m[string(attr.Key)] = attr.Value.AsInterface()
+	}
+	return m
+}
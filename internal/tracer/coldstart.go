@@ -0,0 +1,35 @@
+package tracer
+
+import (
+	"context"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// coldStartProcessor is a span processor that sets faas.coldstart on local root spans: true on
+// the first one it sees, false on every later one. In a serverless function the first root
+// span is the invocation that started the process.
+type coldStartProcessor struct {
+	started atomic.Bool
+}
+
+// OnStart annotates s when it is a local root span. It runs before the exporting processor
+// sees the span, since that processor is registered after this one.
+func (p *coldStartProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	if sc := trace.SpanContextFromContext(parent); sc.IsValid() && !sc.IsRemote() {
+		return
+	}
+	s.SetAttributes(semconv.FaaSColdstart(!p.started.Swap(true)))
+}
+
+// OnEnd does nothing.
+func (p *coldStartProcessor) OnEnd(sdktrace.ReadOnlySpan) {}
+
+// Shutdown does nothing.
+func (p *coldStartProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (p *coldStartProcessor) ForceFlush(context.Context) error { return nil }
@@ -0,0 +1,54 @@
+package tracer
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer_ColdStart_OnStart(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(&coldStartProcessor{}),
+		sdktrace.WithSyncer(exporter),
+	)
+	t.Cleanup(func() {
+		_ = tp.Shutdown(t.Context())
+	})
+	tr := tp.Tracer("test")
+
+	ctx, first := tr.Start(context.Background(), "first-invocation")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	first.End()
+
+	remote := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+	_, second := tr.Start(remote, "second-invocation")
+	second.End()
+
+	want := map[string]interface{}{"child": nil, "first-invocation": true, "second-invocation": false}
+	spans := exporter.GetSpans()
+	if len(spans) != len(want) {
+		t.Fatalf("expected %d spans, got %d", len(want), len(spans))
+	}
+	for _, span := range spans {
+		var got interface{}
+		for _, attr := range span.Attributes {
+			if attr.Key == semconv.FaaSColdstartKey {
+				got = attr.Value.AsBool()
+			}
+		}
+		if got != want[span.Name] {
+			t.Errorf("span %q faas.coldstart = %v, want %v", span.Name, got, want[span.Name])
+		}
+	}
+}
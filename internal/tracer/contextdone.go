@@ -0,0 +1,86 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attributes and event set by the contextDoneProcessor.
+const (
+	contextDeadlineRemainingKey = attribute.Key("context.deadline_remaining_ms")
+	contextErrorKey             = attribute.Key("context.error")
+	contextCauseKey             = attribute.Key("context.cause")
+	contextDoneEvent            = "context.done"
+)
+
+// contextDoneProcessor is a span processor that annotates spans whose parent context is
+// canceled or exceeds its deadline before they end: the span gets a "context.done" event at
+// that moment and a context.error attribute ("context canceled" or "context deadline
+// exceeded"), plus context.cause when a cause other than the error was given. Spans started
+// with a deadline also carry context.deadline_remaining_ms, the time left when they started.
+type contextDoneProcessor struct {
+	mu    sync.Mutex
+	stops map[trace.SpanID]func() bool // stops unregister the callbacks of the spans not ended.
+}
+
+// newContextDoneProcessor creates a contextDoneProcessor.
+func newContextDoneProcessor() *contextDoneProcessor {
+	return &contextDoneProcessor{stops: make(map[trace.SpanID]func() bool)}
+}
+
+// OnStart watches parent for the lifetime of s. Contexts that are never done, such as
+// context.Background, are not watched.
+func (p *contextDoneProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	if parent.Done() == nil {
+		return
+	}
+	if deadline, ok := parent.Deadline(); ok {
+		s.SetAttributes(contextDeadlineRemainingKey.Int64(time.Until(deadline).Milliseconds()))
+	}
+	if parent.Err() != nil {
+		annotateContextDone(parent, s)
+		return
+	}
+	stop := context.AfterFunc(parent, func() {
+		p.mu.Lock()
+		delete(p.stops, s.SpanContext().SpanID())
+		p.mu.Unlock()
+		annotateContextDone(parent, s)
+	})
+	p.mu.Lock()
+	p.stops[s.SpanContext().SpanID()] = stop
+	p.mu.Unlock()
+}
+
+// OnEnd stops watching the parent context of s.
+func (p *contextDoneProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.mu.Lock()
+	stop, ok := p.stops[s.SpanContext().SpanID()]
+	delete(p.stops, s.SpanContext().SpanID())
+	p.mu.Unlock()
+	if ok {
+		stop()
+	}
+}
+
+// Shutdown does nothing.
+func (p *contextDoneProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (p *contextDoneProcessor) ForceFlush(context.Context) error { return nil }
+
+// annotateContextDone records on s that ctx is done. It does nothing once s has ended.
+func annotateContextDone(ctx context.Context, s sdktrace.ReadWriteSpan) {
+	attrs := []attribute.KeyValue{contextErrorKey.String(ctx.Err().Error())}
+	if cause := context.Cause(ctx); cause != nil && !errors.Is(cause, ctx.Err()) {
+		attrs = append(attrs, contextCauseKey.String(cause.Error()))
+	}
+	s.AddEvent(contextDoneEvent, trace.WithAttributes(attrs...))
+	s.SetAttributes(attrs...)
+}
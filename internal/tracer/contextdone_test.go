@@ -0,0 +1,106 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// waitForContextDone waits for the context.done event the processor adds to span from another
+// goroutine.
+func waitForContextDone(t *testing.T, span trace.Span) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(span.(sdktrace.ReadOnlySpan).Events()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the context.done event")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestTracer_ContextDone_OnStart(t *testing.T) {
+	processor := newContextDoneProcessor()
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithSyncer(exporter),
+	)
+	t.Cleanup(func() {
+		_ = tp.Shutdown(t.Context())
+	})
+	tr := tp.Tracer("test")
+
+	canceled, cancel := context.WithCancel(context.Background())
+	_, span := tr.Start(canceled, "canceled")
+	cancel()
+	waitForContextDone(t, span)
+	span.End()
+
+	caused, cancelCause := context.WithCancelCause(context.Background())
+	_, span = tr.Start(caused, "caused")
+	cancelCause(errors.New("client went away"))
+	waitForContextDone(t, span)
+	span.End()
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	_, span = tr.Start(expired, "expired")
+	span.End()
+
+	live, cancelLive := context.WithTimeout(context.Background(), time.Hour)
+	_, span = tr.Start(live, "completed")
+	span.End()
+	cancelLive()
+
+	_, span = tr.Start(context.Background(), "background")
+	span.End()
+
+	tests := []struct {
+		name        string
+		wantError   string
+		wantCause   string
+		wantEvent   bool
+		wantTimeout bool
+	}{
+		{name: "canceled", wantError: "context canceled", wantEvent: true},
+		{name: "caused", wantError: "context canceled", wantCause: "client went away", wantEvent: true},
+		{name: "expired", wantError: "context deadline exceeded", wantEvent: true, wantTimeout: true},
+		{name: "completed", wantTimeout: true},
+		{name: "background"},
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != len(tests) {
+		t.Fatalf("expected %d spans, got %d", len(tests), len(spans))
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := spans[i]
+			attrs := attribute.NewSet(got.Attributes...)
+			if value, _ := attrs.Value(contextErrorKey); value.AsString() != tt.wantError {
+				t.Errorf("context.error = %q, want %q", value.AsString(), tt.wantError)
+			}
+			if value, _ := attrs.Value(contextCauseKey); value.AsString() != tt.wantCause {
+				t.Errorf("context.cause = %q, want %q", value.AsString(), tt.wantCause)
+			}
+			if _, ok := attrs.Value(contextDeadlineRemainingKey); ok != tt.wantTimeout {
+				t.Errorf("context.deadline_remaining_ms set = %v, want %v", ok, tt.wantTimeout)
+			}
+			if gotEvent := len(got.Events) == 1 && got.Events[0].Name == contextDoneEvent; gotEvent != tt.wantEvent {
+				t.Errorf("events = %v, want context.done event %v", got.Events, tt.wantEvent)
+			}
+		})
+	}
+
+	processor.mu.Lock()
+	defer processor.mu.Unlock()
+	if len(processor.stops) != 0 {
+		t.Errorf("processor still watches %d contexts after their spans ended", len(processor.stops))
+	}
+}
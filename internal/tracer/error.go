@@ -4,9 +4,24 @@ import "errors"
 
 var (
 	// ErrInvalidProvider is returned when an invalid provider type is specified.
-	ErrInvalidProvider      = errors.New("invalid provider")
-	ErrProviderHostRequired = errors.New("provider host is required")
-	ErrProviderPortRequired = errors.New("provider port is required")
-	ErrProviderPortInvalid  = errors.New("provider port must be greater than 0")
-	ErrBatchTimeoutInvalid  = errors.New("batch timeout must be greater than 0")
+	ErrInvalidProvider               = errors.New("invalid provider")
+	ErrProviderHostRequired          = errors.New("provider host is required")
+	ErrProviderPortRequired          = errors.New("provider port is required")
+	ErrProviderPortInvalid           = errors.New("provider port must be greater than 0")
+	ErrBatchTimeoutInvalid           = errors.New("batch timeout must be greater than 0")
+	ErrRemoteSamplingIntervalInvalid = errors.New("remote sampling interval must be greater than 0")
+	ErrInvalidFallbackProvider       = errors.New("invalid fallback provider")
+	ErrFallbackPathRequired          = errors.New("fallback path is required")
+	ErrBreakerThresholdInvalid       = errors.New("circuit breaker threshold must not be negative")
+	ErrBreakerMaxBackoffInvalid      = errors.New("circuit breaker max backoff must be greater than 0")
+	ErrEndpointInvalid               = errors.New("endpoint must be a URL with scheme grpc, grpcs, http, or https")
+	ErrTraceStateEntryInvalid        = errors.New("tracestate entry must have a valid W3C key and value")
+	ErrTraceStateSpanRequired        = errors.New("tracestate requires a valid span context in the context")
+	ErrBodySnippetLimitInvalid       = errors.New("body snippet limit must not be negative")
+	ErrInvalidStdoutFormat           = errors.New("stdout format must be pretty or ndjson")
+	ErrLongSpanThresholdInvalid      = errors.New("long span threshold must not be negative")
+	ErrTraceIDFormatConflict         = errors.New("64-bit trace IDs cannot be combined with X-Ray trace IDs")
+	ErrMirrorEndpointInvalid         = errors.New("mirror endpoint must be a URL with scheme grpc, grpcs, http, or https")
+	ErrMirrorRatioInvalid            = errors.New("mirror ratio must be greater than 0 and at most 1")
+	ErrDevViewerPortInvalid          = errors.New("dev viewer port must be between 0 and 65535")
 )
@@ -252,6 +252,8 @@ func TestTracer_Error_AllErrorsAreDistinct(t *testing.T) {
 		ErrProviderPortRequired,
 		ErrProviderPortInvalid,
 		ErrBatchTimeoutInvalid,
+		ErrTraceStateEntryInvalid,
+		ErrTraceStateSpanRequired,
 	}
 
 	for i, err1 := range errList {
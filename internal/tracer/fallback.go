@@ -0,0 +1,98 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// fallbackExporter sends spans to a primary exporter and spills them to a fallback exporter
+// when the primary export fails. The OTLP exporter already retries internally, so a failed
+// export means the collector has been unreachable for the whole retry window.
+type fallbackExporter struct {
+	primary  sdktrace.SpanExporter
+	fallback sdktrace.SpanExporter
+	closer   io.Closer                            // closer releases the fallback file; nil for stdout.
+	onSpill  func(ctx context.Context, spans int) // onSpill is notified of every spilled batch; may be nil.
+}
+
+// newFallbackExporter wraps primary with the fallback exporter selected by options.FallbackProvider.
+// It returns primary unchanged when no fallback provider is configured.
+func newFallbackExporter(primary sdktrace.SpanExporter, options *Options) (sdktrace.SpanExporter, error) {
+	var (
+		writer io.Writer
+		closer io.Closer
+	)
+	switch options.FallbackProvider {
+	case "":
+		return primary, nil
+	case "stdout":
+		writer = os.Stdout
+		if options.Writer != nil {
+			writer = options.Writer
+		}
+	case "file":
+		if options.FallbackPath == "" {
+			return nil, ErrFallbackPathRequired
+		}
+		file, err := os.OpenFile(options.FallbackPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open fallback file: %w", err)
+		}
+		writer, closer = file, file
+	default:
+		return nil, ErrInvalidFallbackProvider
+	}
+
+	fallback, err := stdouttrace.New(stdouttrace.WithWriter(writer))
+	if err != nil {
+		if closer != nil {
+			_ = closer.Close()
+		}
+		return nil, fmt.Errorf("failed to create fallback exporter: %w", err)
+	}
+
+	return &fallbackExporter{
+		primary:  primary,
+		fallback: fallback,
+		closer:   closer,
+		onSpill:  options.SpillHandler,
+	}, nil
+}
+
+// ExportSpans exports spans to the primary exporter, spilling them to the fallback exporter
+// if that fails. The primary error is reported to the global OpenTelemetry error handler;
+// an error is returned only when the spans could not be written anywhere.
+func (e *fallbackExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	err := e.primary.ExportSpans(ctx, spans)
+	if err == nil {
+		return nil
+	}
+	if fallbackErr := e.fallback.ExportSpans(ctx, spans); fallbackErr != nil {
+		return errors.Join(err, fallbackErr)
+	}
+
+	otel.Handle(fmt.Errorf("spilled %d spans to fallback exporter: %w", len(spans), err))
+	if e.onSpill != nil {
+		e.onSpill(ctx, len(spans))
+	}
+	return nil
+}
+
+// Shutdown shuts down both exporters and closes the fallback file.
+func (e *fallbackExporter) Shutdown(ctx context.Context) error {
+	err := errors.Join(
+		e.primary.Shutdown(ctx),
+		e.fallback.Shutdown(ctx),
+	)
+	if e.closer != nil {
+		err = errors.Join(err, e.closer.Close())
+	}
+	return err
+}
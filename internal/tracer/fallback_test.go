@@ -0,0 +1,145 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// failingExporter is a span exporter whose exports always fail, standing in for an
+// unreachable collector.
+type failingExporter struct{}
+
+func (failingExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	return errors.New("collector unavailable")
+}
+
+func (failingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestTracer_Fallback_NewFallbackExporter(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   Options
+		wantErrIs error
+		wantWrap  bool
+	}{
+		{
+			name:     "no fallback returns primary",
+			options:  Options{},
+			wantWrap: false,
+		},
+		{
+			name:     "stdout fallback",
+			options:  Options{FallbackProvider: "stdout"},
+			wantWrap: true,
+		},
+		{
+			name:     "file fallback",
+			options:  Options{FallbackProvider: "file", FallbackPath: filepath.Join(t.TempDir(), "spans.json")},
+			wantWrap: true,
+		},
+		{
+			name:      "file fallback without path",
+			options:   Options{FallbackProvider: "file"},
+			wantErrIs: ErrFallbackPathRequired,
+		},
+		{
+			name:      "invalid fallback provider",
+			options:   Options{FallbackProvider: "invalid"},
+			wantErrIs: ErrInvalidFallbackProvider,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := tracetest.NewInMemoryExporter()
+			exporter, err := newFallbackExporter(primary, &tt.options)
+			if tt.wantErrIs != nil {
+				if !errors.Is(err, tt.wantErrIs) {
+					t.Fatalf("newFallbackExporter() error = %v, want %v", err, tt.wantErrIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newFallbackExporter() error = %v", err)
+			}
+			defer func() {
+				_ = exporter.Shutdown(context.Background())
+			}()
+
+			_, wrapped := exporter.(*fallbackExporter)
+			if wrapped != tt.wantWrap {
+				t.Errorf("newFallbackExporter() wrapped = %v, want %v", wrapped, tt.wantWrap)
+			}
+		})
+	}
+}
+
+func TestTracer_Fallback_ExportSpans(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spans.json")
+	var spilled int
+	exporter, err := newFallbackExporter(failingExporter{}, &Options{
+		FallbackProvider: "file",
+		FallbackPath:     path,
+		SpillHandler: func(_ context.Context, spans int) {
+			spilled += spans
+		},
+	})
+	if err != nil {
+		t.Fatalf("newFallbackExporter() error = %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	_, span := provider.Tracer("test").Start(context.Background(), "spilled-span")
+	span.End()
+	if err := provider.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	if spilled != 1 {
+		t.Errorf("expected 1 spilled span, got %d", spilled)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read fallback file: %v", err)
+	}
+	if !strings.Contains(string(data), "spilled-span") {
+		t.Errorf("expected fallback file to contain the spilled span, got %q", data)
+	}
+}
+
+func TestTracer_Fallback_ExportSpans_PrimarySucceeds(t *testing.T) {
+	primary := tracetest.NewInMemoryExporter()
+	var spilled int
+	exporter, err := newFallbackExporter(primary, &Options{
+		FallbackProvider: "stdout",
+		SpillHandler: func(_ context.Context, spans int) {
+			spilled += spans
+		},
+	})
+	if err != nil {
+		t.Fatalf("newFallbackExporter() error = %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	_, span := provider.Tracer("test").Start(context.Background(), "exported-span")
+	span.End()
+
+	if got := len(primary.GetSpans()); got != 1 {
+		t.Errorf("expected 1 span on the primary exporter, got %d", got)
+	}
+	if spilled != 0 {
+		t.Errorf("expected no spilled spans, got %d", spilled)
+	}
+	if err := provider.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package tracer
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SpanFilter reports whether an ended span is exported. Spans it returns false for, such as
+// health checks or static assets, are dropped before they are batched.
+type SpanFilter func(span sdktrace.ReadOnlySpan) bool
+
+// filterProcessor is a span processor that passes to next only the ended spans filter keeps.
+// Sampling is not affected: a dropped span's children are still sampled and exported with
+// the dropped span as their parent.
+type filterProcessor struct {
+	next   sdktrace.SpanProcessor
+	filter SpanFilter
+}
+
+// newFilterProcessor returns next wrapped with filter, or next itself when filter is nil.
+func newFilterProcessor(next sdktrace.SpanProcessor, filter SpanFilter) sdktrace.SpanProcessor {
+	if filter == nil {
+		return next
+	}
+	return &filterProcessor{next: next, filter: filter}
+}
+
+// OnStart passes s to the next processor.
+func (p *filterProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd passes s to the next processor when the filter keeps it.
+func (p *filterProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if p.filter(s) {
+		p.next.OnEnd(s)
+	}
+}
+
+// Shutdown shuts down the next processor.
+func (p *filterProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next processor.
+func (p *filterProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
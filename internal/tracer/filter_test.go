@@ -0,0 +1,87 @@
+package tracer
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer_Filter_NewFilterProcessor(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter SpanFilter
+		want   []string
+	}{
+		{"nil filter exports every span", nil, []string{"child", "healthz", "request"}},
+		{"filter drops rejected spans only", func(s sdktrace.ReadOnlySpan) bool { return s.Name() != "healthz" }, []string{"child", "request"}},
+		{"filter rejecting a parent keeps its children", func(s sdktrace.ReadOnlySpan) bool { return s.Name() != "request" }, []string{"child", "healthz"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(newFilterProcessor(sdktrace.NewSimpleSpanProcessor(exporter), tt.filter)))
+			defer func() {
+				_ = provider.Shutdown(context.Background())
+			}()
+
+			tr := provider.Tracer("test")
+			_, health := tr.Start(context.Background(), "healthz")
+			health.End()
+			ctx, request := tr.Start(context.Background(), "request")
+			_, child := tr.Start(ctx, "child")
+			child.End()
+			request.End()
+
+			got := exporter.GetSpans()
+			names := make(map[string]bool, len(got))
+			for _, s := range got {
+				names[s.Name] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("exported %d spans, want %v", len(got), tt.want)
+			}
+			for _, name := range tt.want {
+				if !names[name] {
+					t.Errorf("span %q was not exported, want %v", name, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTracer_Filter_NewTracer(t *testing.T) {
+	observed := tracetest.NewInMemoryExporter()
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithProvider("stdout", "", 0),
+		WithWriter(io.Discard),
+		WithSpanProcessors(sdktrace.NewSimpleSpanProcessor(observed)),
+		WithSpanFilter(func(s sdktrace.ReadOnlySpan) bool { return s.Name() != "healthz" }),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tracerInstance.Shutdown(context.Background())
+	}()
+
+	if _, ok := tracerInstance.(*tracer).processor.(*filterProcessor); !ok {
+		t.Errorf("exporting processor = %T, want *filterProcessor", tracerInstance.(*tracer).processor)
+	}
+	if err := tracerInstance.(Reloader).Reload(WithSimpleProcessor(true)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if _, ok := tracerInstance.(*tracer).processor.(*filterProcessor); !ok {
+		t.Errorf("exporting processor after Reload = %T, want *filterProcessor", tracerInstance.(*tracer).processor)
+	}
+
+	_, span := tracerInstance.StartSpan(context.Background(), "healthz")
+	span.End()
+	if spans := observed.GetSpans(); len(spans) != 1 {
+		t.Errorf("span processors saw %d spans, want the filtered span too", len(spans))
+	}
+}
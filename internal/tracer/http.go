@@ -0,0 +1,220 @@
+package tracer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanFromRequest starts a server span for an incoming HTTP request.
+// The trace context is extracted from the request headers, so the span continues the caller's
+// trace. The span is named "<method> <route>" when the request was routed by an http.ServeMux
+// pattern, or just "<method>" otherwise, to keep span names low-cardinality. Standard HTTP
+// semantic convention attributes (method, route, path, query, scheme, server address, user agent)
+// are set on the span, along with the captured headers (see WithCapturedHeaders). Values of
+// scrubbed headers and query parameters are recorded as "REDACTED" (see WithHTTPScrubbing).
+//
+// Call finish with the response status code when the handler is done; it records the status
+// code, marks the span as failed for 5xx responses, and ends the span.
+//
+// Requests to an ignored route (see WithIgnoredRoutes) get no span: the returned span is the
+// non-recording span carrying the caller's trace context, if any, and finish does nothing.
+//
+// Parameters:
+//   - r: The incoming request
+//
+// Returns:
+//   - A new context derived from r.Context() containing the span
+//   - The created span
+//   - A function that records the response status and ends the span
+//
+// Example:
+//
+//	func handleOrder(w http.ResponseWriter, r *http.Request) {
+//	    ctx, span, finish := tracer.SpanFromRequest(r)
+//	    status := http.StatusOK
+//	    defer func() { finish(status) }()
+//
+//	    if err := processOrder(ctx); err != nil {
+//	        span.RecordError(err)
+//	        status = http.StatusInternalServerError
+//	        http.Error(w, "failed to process order", status)
+//	    }
+//	}
+func (t *tracer) SpanFromRequest(r *http.Request) (context.Context, trace.Span, func(status int)) {
+	ctx := t.propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	if t.ignored(r) {
+		return ctx, trace.SpanFromContext(ctx), func(int) {}
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(r.Method),
+		semconv.URLPath(r.URL.Path),
+		semconv.URLScheme(scheme),
+		semconv.ServerAddress(r.Host),
+	}
+	scrubber := t.httpScrubber()
+	if r.URL.RawQuery != "" {
+		attrs = append(attrs, semconv.URLQuery(scrubber.query(r.URL.RawQuery)))
+	}
+	if userAgent := r.UserAgent(); userAgent != "" {
+		attrs = append(attrs, semconv.UserAgentOriginal(userAgent))
+	}
+	attrs = append(attrs, scrubber.headerAttributes("request", r.Header)...)
+
+	name := r.Method
+	if route := HTTPRoute(r); route != "" {
+		name += " " + route
+		attrs = append(attrs, semconv.HTTPRoute(route))
+	}
+
+	ctx, span := t.StartSpan(ctx, name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...),
+	)
+
+	finish := func(status int) {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		span.End()
+	}
+	return ctx, span, finish
+}
+
+// RoundTripper returns an http.RoundTripper that traces every request sent through base with a
+// client span named after the request method and injects the span's trace context into the
+// request headers, so the server continues the trace. The full URL (with user info and scrubbed
+// query parameter values redacted), server address and port, captured headers, and response
+// status are set on the span; transport errors and 4xx/5xx responses mark it as failed. With
+// WithBodyRecording, body sizes, content types, and snippets are recorded too. The span ends
+// when the response headers are received. If base is nil, http.DefaultTransport is used.
+//
+// Parameters:
+//   - base: The RoundTripper sending the requests
+//
+// Returns:
+//   - The tracing RoundTripper
+//
+// Example:
+//
+//	client := &http.Client{Transport: tracer.RoundTripper(http.DefaultTransport)}
+//	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/orders", nil)
+//	resp, err := client.Do(req)
+func (t *tracer) RoundTripper(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &roundTripper{tracer: t, base: base}
+}
+
+// roundTripper is the http.RoundTripper returned by RoundTripper.
+type roundTripper struct {
+	tracer *tracer
+	base   http.RoundTripper
+}
+
+// RoundTrip sends req through the base RoundTripper within a client span.
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	scrubber := rt.tracer.httpScrubber()
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.URLFull(scrubber.url(req.URL)),
+		semconv.ServerAddress(req.URL.Hostname()),
+	}
+	if port, err := strconv.Atoi(req.URL.Port()); err == nil {
+		attrs = append(attrs, semconv.ServerPort(port))
+	}
+	attrs = append(attrs, scrubber.headerAttributes("request", req.Header)...)
+	body := rt.tracer.bodyRecording()
+	if body != nil {
+		attrs = append(attrs, body.requestBodyAttributes(req)...)
+	}
+
+	ctx, span := rt.tracer.StartSpan(req.Context(), req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	defer rt.tracer.EndSpan(span)
+
+	// A RoundTripper must not modify the request it was given.
+	req = req.Clone(ctx)
+	rt.tracer.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := rt.base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	span.SetAttributes(scrubber.headerAttributes("response", resp.Header)...)
+	if body != nil {
+		span.SetAttributes(body.responseBodyAttributes(resp)...)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	return resp, nil
+}
+
+// setIgnoredRoutes replaces the routes SpanFromRequest skips.
+func (t *tracer) setIgnoredRoutes(routes []string) {
+	routes = append([]string(nil), routes...)
+	t.ignoredRoutes.Store(&routes)
+}
+
+// ignored reports whether r targets one of the ignored routes, by path or ServeMux route.
+func (t *tracer) ignored(r *http.Request) bool {
+	if t.parent != nil {
+		return t.parent.ignored(r)
+	}
+	routes := t.ignoredRoutes.Load()
+	if routes == nil {
+		return false
+	}
+	return IgnoredRoute(*routes, r)
+}
+
+// IgnoredRoute reports whether r targets one of routes, as WithIgnoredRoutes matches them: by
+// exact path, http.ServeMux pattern (e.g. "GET /orders/{id}") or route (e.g. "/orders/{id}"),
+// or by path prefix for an entry ending with "*". Patterns and routes only match once r has
+// been routed, so r.Pattern is set.
+func IgnoredRoute(routes []string, r *http.Request) bool {
+	route := HTTPRoute(r)
+	for _, ignored := range routes {
+		if prefix, ok := strings.CutSuffix(ignored, "*"); ok {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				return true
+			}
+		} else if ignored == r.URL.Path || (route != "" && (ignored == route || ignored == r.Pattern)) {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPRoute returns the path template of the http.ServeMux pattern that matched r, without the
+// pattern's method and host, or "" if r was not routed by a ServeMux.
+func HTTPRoute(r *http.Request) string {
+	route := r.Pattern
+	if i := strings.IndexAny(route, " \t"); i >= 0 {
+		route = strings.TrimLeft(route[i:], " \t")
+	}
+	if i := strings.IndexByte(route, '/'); i > 0 {
+		route = route[i:]
+	}
+	return route
+}
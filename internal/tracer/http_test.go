@@ -0,0 +1,316 @@
+package tracer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newRecordingTracer returns a tracer that exports spans synchronously to an in-memory exporter.
+func newRecordingTracer(t *testing.T) (*tracer, *tracetest.InMemoryExporter) {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() {
+		_ = tp.Shutdown(t.Context())
+	})
+	return &tracer{
+		provider:   tp,
+		tracer:     tp.Tracer("test"),
+		propagator: propagation.TraceContext{},
+	}, exporter
+}
+
+func TestTracer_HTTP_SpanFromRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		pattern    string
+		target     string
+		status     int
+		wantName   string
+		wantRoute  string
+		wantStatus codes.Code
+	}{
+		{
+			name:       "routed request",
+			pattern:    "GET /orders/{id}",
+			target:     "/orders/42",
+			status:     http.StatusOK,
+			wantName:   "GET /orders/{id}",
+			wantRoute:  "/orders/{id}",
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "server error",
+			pattern:    "/orders/",
+			target:     "/orders/42",
+			status:     http.StatusInternalServerError,
+			wantName:   "GET /orders/",
+			wantRoute:  "/orders/",
+			wantStatus: codes.Error,
+		},
+		{
+			name:       "client error is not a span error",
+			pattern:    "/",
+			target:     "/missing",
+			status:     http.StatusNotFound,
+			wantName:   "GET /",
+			wantRoute:  "/",
+			wantStatus: codes.Unset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, exporter := newRecordingTracer(t)
+			mux := http.NewServeMux()
+			mux.HandleFunc(tt.pattern, func(w http.ResponseWriter, r *http.Request) {
+				_, _, finish := tr.SpanFromRequest(r)
+				w.WriteHeader(tt.status)
+				finish(tt.status)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+			mux.ServeHTTP(httptest.NewRecorder(), req)
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(spans))
+			}
+			span := spans[0]
+			if span.Name != tt.wantName {
+				t.Errorf("expected span name %q, got %q", tt.wantName, span.Name)
+			}
+			if span.SpanKind != trace.SpanKindServer {
+				t.Errorf("expected server span, got %v", span.SpanKind)
+			}
+			if got := span.Parent.TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+				t.Errorf("expected parent trace ID from traceparent header, got %s", got)
+			}
+			if span.Status.Code != tt.wantStatus {
+				t.Errorf("expected status %v, got %v", tt.wantStatus, span.Status.Code)
+			}
+
+			attrs := map[string]interface{}{}
+			for _, attr := range span.Attributes {
+				attrs[string(attr.Key)] = attr.Value.AsInterface()
+			}
+			if attrs["http.route"] != tt.wantRoute {
+				t.Errorf("expected http.route %q, got %v", tt.wantRoute, attrs["http.route"])
+			}
+			if attrs["http.request.method"] != http.MethodGet {
+				t.Errorf("expected http.request.method GET, got %v", attrs["http.request.method"])
+			}
+			if attrs["http.response.status_code"] != int64(tt.status) {
+				t.Errorf("expected http.response.status_code %d, got %v", tt.status, attrs["http.response.status_code"])
+			}
+		})
+	}
+}
+
+func TestTracer_HTTP_SpanFromRequest_Unrouted(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/orders/42", nil)
+	_, _, finish := tr.SpanFromRequest(req)
+	finish(http.StatusCreated)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Name != http.MethodPost {
+		t.Errorf("expected span name %q for an unrouted request, got %q", http.MethodPost, spans[0].Name)
+	}
+}
+
+func TestTracer_HTTP_SpanFromRequest_IgnoredRoutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		target   string
+		wantSpan bool
+	}{
+		{"ignored path", "/", "/healthz", false},
+		{"ignored route", "GET /internal/{check}", "/internal/ready", false},
+		{"ignored pattern", "GET /admin/{page}", "/admin/users", false},
+		{"route of an ignored pattern without its method", "/admin/{page}", "/admin/users", true},
+		{"ignored prefix", "/", "/metrics/prometheus", false},
+		{"other path", "/", "/orders", true},
+		{"path only shares a prefix", "/", "/healthz/deep", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, exporter := newRecordingTracer(t)
+			tr.setIgnoredRoutes([]string{"/healthz", "/internal/{check}", "GET /admin/{page}", "/metrics*"})
+			scoped := tr.Scoped("scope", "")
+			mux := http.NewServeMux()
+			mux.HandleFunc(tt.pattern, func(w http.ResponseWriter, r *http.Request) {
+				ctx, span, finish := scoped.SpanFromRequest(r)
+				if !tt.wantSpan {
+					if span.IsRecording() {
+						t.Error("expected a non-recording span for an ignored route")
+					}
+					if got := trace.SpanContextFromContext(ctx).TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+						t.Errorf("expected the caller's trace context to be propagated, got trace ID %s", got)
+					}
+				}
+				finish(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+			mux.ServeHTTP(httptest.NewRecorder(), req)
+
+			if got := len(exporter.GetSpans()) == 1; got != tt.wantSpan {
+				t.Errorf("span exported = %v, want %v", got, tt.wantSpan)
+			}
+		})
+	}
+}
+
+func TestTracer_HTTP_HTTPRoute(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"", ""},
+		{"/orders/{id}", "/orders/{id}"},
+		{"GET /orders/{id}", "/orders/{id}"},
+		{"example.com/orders/", "/orders/"},
+		{"POST example.com/orders/", "/orders/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			r := &http.Request{Pattern: tt.pattern}
+			if got := HTTPRoute(r); got != tt.want {
+				t.Errorf("HTTPRoute(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTracer_HTTP_SpanFromRequest_Scrubbing(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+	tr.setHTTPScrubber(&Options{
+		ScrubbedHeaders:     []string{"Authorization"},
+		ScrubbedQueryParams: []string{"token"},
+		CapturedHeaders:     []string{"Authorization", "X-Request-Id"},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/orders?token=s3cr3t&page=2", nil)
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	req.Header.Set("X-Request-Id", "req-1")
+	_, _, finish := tr.SpanFromRequest(req)
+	finish(http.StatusOK)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	attrs := map[string]interface{}{}
+	for _, attr := range spans[0].Attributes {
+		attrs[string(attr.Key)] = attr.Value.AsInterface()
+	}
+	if attrs["url.query"] != "token=REDACTED&page=2" {
+		t.Errorf("expected scrubbed url.query, got %v", attrs["url.query"])
+	}
+	if got, ok := attrs["http.request.header.authorization"].([]string); !ok || len(got) != 1 || got[0] != "REDACTED" {
+		t.Errorf("expected a redacted authorization header, got %v", attrs["http.request.header.authorization"])
+	}
+	if got, ok := attrs["http.request.header.x-request-id"].([]string); !ok || len(got) != 1 || got[0] != "req-1" {
+		t.Errorf("expected the x-request-id header, got %v", attrs["http.request.header.x-request-id"])
+	}
+}
+
+func TestTracer_HTTP_RoundTripper(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus codes.Code
+	}{
+		{name: "success", status: http.StatusOK, wantStatus: codes.Unset},
+		{name: "client error", status: http.StatusNotFound, wantStatus: codes.Error},
+		{name: "server error", status: http.StatusBadGateway, wantStatus: codes.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var traceparent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				traceparent = r.Header.Get("traceparent")
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			tr, exporter := newRecordingTracer(t)
+			client := &http.Client{Transport: tr.RoundTripper(nil)}
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/files?id=7&signature=abc", nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if req.Header.Get("traceparent") != "" {
+				t.Error("expected the caller's request to be left unchanged")
+			}
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(spans))
+			}
+			span := spans[0]
+			if span.SpanKind != trace.SpanKindClient {
+				t.Errorf("expected a client span, got %v", span.SpanKind)
+			}
+			if span.Status.Code != tt.wantStatus {
+				t.Errorf("expected status %v, got %v", tt.wantStatus, span.Status.Code)
+			}
+			wantParent := "00-" + span.SpanContext.TraceID().String() + "-" + span.SpanContext.SpanID().String() + "-01"
+			if traceparent != wantParent {
+				t.Errorf("expected traceparent %q, got %q", wantParent, traceparent)
+			}
+			attrs := map[string]interface{}{}
+			for _, attr := range span.Attributes {
+				attrs[string(attr.Key)] = attr.Value.AsInterface()
+			}
+			if want := server.URL + "/files?id=7&signature=REDACTED"; attrs["url.full"] != want {
+				t.Errorf("expected url.full %q, got %v", want, attrs["url.full"])
+			}
+			if attrs["http.response.status_code"] != int64(tt.status) {
+				t.Errorf("expected http.response.status_code %d, got %v", tt.status, attrs["http.response.status_code"])
+			}
+		})
+	}
+}
+
+func TestTracer_HTTP_RoundTripper_Error(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+	client := &http.Client{Transport: tr.RoundTripper(nil)}
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	if _, err := client.Get(url); err == nil {
+		t.Fatal("expected a request to a closed server to fail")
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Status.Code != codes.Error {
+		t.Errorf("expected status Error, got %v", spans[0].Status.Code)
+	}
+}
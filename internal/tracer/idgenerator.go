@@ -0,0 +1,56 @@
+package tracer
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// IDGenerator generates trace and span IDs for new spans.
+// It is the OpenTelemetry SDK ID generator interface.
+type IDGenerator = sdktrace.IDGenerator
+
+// sequentialIDGenerator generates predictable, increasing trace and span IDs.
+type sequentialIDGenerator struct {
+	mu      sync.Mutex
+	traceID uint64
+	spanID  uint64
+}
+
+// NewSequentialIDGenerator returns an IDGenerator producing sequential IDs, starting at 1 for
+// both trace and span IDs. The IDs are reproducible across runs, which makes exported spans
+// suitable for golden-file tests. It must not be used in production, since IDs collide across
+// processes.
+func NewSequentialIDGenerator() IDGenerator {
+	return &sequentialIDGenerator{}
+}
+
+// NewIDs returns the next trace ID and span ID.
+func (g *sequentialIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.traceID++
+	g.spanID++
+
+	var traceID trace.TraceID
+	binary.BigEndian.PutUint64(traceID[8:], g.traceID)
+	return traceID, g.spanIDFromCounter()
+}
+
+// NewSpanID returns the next span ID.
+func (g *sequentialIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.spanID++
+	return g.spanIDFromCounter()
+}
+
+// spanIDFromCounter encodes the current span counter. g.mu must be held.
+func (g *sequentialIDGenerator) spanIDFromCounter() trace.SpanID {
+	var spanID trace.SpanID
+	binary.BigEndian.PutUint64(spanID[:], g.spanID)
+	return spanID
+}
@@ -0,0 +1,69 @@
+package tracer
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer_IDGenerator_Sequential(t *testing.T) {
+	gen := NewSequentialIDGenerator()
+	ctx := context.Background()
+
+	traceID, spanID := gen.NewIDs(ctx)
+	if got := traceID.String(); got != "00000000000000000000000000000001" {
+		t.Errorf("expected first trace ID 1, got %s", got)
+	}
+	if got := spanID.String(); got != "0000000000000001" {
+		t.Errorf("expected first span ID 1, got %s", got)
+	}
+
+	if got := gen.NewSpanID(ctx, traceID).String(); got != "0000000000000002" {
+		t.Errorf("expected child span ID 2, got %s", got)
+	}
+
+	traceID, spanID = gen.NewIDs(ctx)
+	if got := traceID.String(); got != "00000000000000000000000000000002" {
+		t.Errorf("expected second trace ID 2, got %s", got)
+	}
+	if got := spanID.String(); got != "0000000000000003" {
+		t.Errorf("expected span ID 3, got %s", got)
+	}
+}
+
+func TestTracer_IDGenerator_WithIDGenerator(t *testing.T) {
+	tr, err := NewTracer(
+		WithServiceName("test-service"),
+		WithIDGenerator(NewSequentialIDGenerator()),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tr.Shutdown(context.Background())
+	}()
+
+	// Capture the spans synchronously in addition to the configured exporter.
+	exporter := tracetest.NewInMemoryExporter()
+	tr.(*tracer).provider.RegisterSpanProcessor(sdktrace.NewSimpleSpanProcessor(exporter))
+
+	ctx, parent := tr.StartSpan(context.Background(), "parent")
+	_, child := tr.StartSpan(ctx, "child")
+	tr.EndSpan(child)
+	tr.EndSpan(parent)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	for _, span := range spans {
+		if got := span.SpanContext.TraceID().String(); got != "00000000000000000000000000000001" {
+			t.Errorf("span %s: expected trace ID 1, got %s", span.Name, got)
+		}
+	}
+	if got := spans[0].SpanContext.SpanID().String(); got != "0000000000000002" {
+		t.Errorf("expected child span ID 2, got %s", got)
+	}
+}
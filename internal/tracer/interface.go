@@ -2,17 +2,47 @@ package tracer
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/opentracing/opentracing-go"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/metadata"
 )
 
 type Tracer interface {
 	StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span)
+	StartSpanWithLinks(ctx context.Context, name string, links []trace.SpanContext, opts ...trace.SpanStartOption) (context.Context, trace.Span)
+	EnsureSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span)
 	EndSpan(span trace.Span)
 	Shutdown(ctx context.Context) error
 	StartChildSpan(ctx context.Context, name string, parent trace.Span) (context.Context, trace.Span)
 	NewSpanFromContext(ctx context.Context) trace.Span
 	ExtractContext(ctx context.Context, md metadata.MD) context.Context
 	InjectContext(ctx context.Context) metadata.MD
+	SpanFromRequest(r *http.Request) (context.Context, trace.Span, func(status int))
+	RoundTripper(base http.RoundTripper) http.RoundTripper
+	AddEvent(span trace.Span, name string, fields map[string]interface{})
+	Provider() trace.TracerProvider
+	Scoped(name, version string) Tracer
+}
+
+// Reloader is implemented by tracers that can change their configuration at runtime.
+type Reloader interface {
+	Reload(opts ...Option) error
+}
+
+// SampleRatioReporter is implemented by tracers that report the sampling ratio they currently
+// apply, which differs from the configured ratio once remote sampling has updated it.
+type SampleRatioReporter interface {
+	SampleRatio() float64
+}
+
+// Flusher is implemented by tracers that can export buffered spans on demand.
+type Flusher interface {
+	ForceFlush(ctx context.Context) error
+}
+
+// OpenTracer is implemented by tracers that expose an OpenTracing bridge.
+type OpenTracer interface {
+	OpenTracing() opentracing.Tracer
 }
@@ -0,0 +1,128 @@
+package tracer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LongSpan describes a span that stayed open longer than the long span threshold.
+type LongSpan struct {
+	Name      string        // Name is the name of the span.
+	TraceID   trace.TraceID // TraceID is the trace the span belongs to.
+	SpanID    trace.SpanID  // SpanID identifies the span.
+	StartTime time.Time     // StartTime is when the span started.
+	Age       time.Duration // Age is how long the span had been open when it was reported.
+}
+
+// openSpan is a span watched by the longSpanProcessor.
+type openSpan struct {
+	span     sdktrace.ReadOnlySpan
+	reported bool
+}
+
+// longSpanProcessor is a span processor that watches the spans not yet ended and reports the
+// ones open longer than threshold to handler, once each. It checks every half threshold, so a
+// span is reported at most 1.5 thresholds after it started.
+type longSpanProcessor struct {
+	threshold time.Duration
+	handler   func(span LongSpan)
+	clock     clock.Clock
+
+	mu    sync.Mutex
+	spans map[trace.SpanID]*openSpan
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// newLongSpanProcessor creates a longSpanProcessor and starts its watchdog. A nil clock uses
+// the real time.
+func newLongSpanProcessor(threshold time.Duration, handler func(span LongSpan), c clock.Clock) *longSpanProcessor {
+	if c == nil {
+		c = clock.Real()
+	}
+	p := &longSpanProcessor{
+		threshold: threshold,
+		handler:   handler,
+		clock:     c,
+		spans:     make(map[trace.SpanID]*openSpan),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	ticker := c.NewTicker(threshold / 2)
+	go p.run(ticker)
+	return p
+}
+
+// run checks the open spans on every tick until the processor is shut down.
+func (p *longSpanProcessor) run(ticker clock.Ticker) {
+	defer close(p.done)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C():
+			p.check(now)
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// check reports the spans open longer than the threshold at now that were not reported yet.
+func (p *longSpanProcessor) check(now time.Time) {
+	var long []LongSpan
+	p.mu.Lock()
+	for _, open := range p.spans {
+		age := now.Sub(open.span.StartTime())
+		if open.reported || age < p.threshold {
+			continue
+		}
+		open.reported = true
+		sc := open.span.SpanContext()
+		long = append(long, LongSpan{
+			Name:      open.span.Name(),
+			TraceID:   sc.TraceID(),
+			SpanID:    sc.SpanID(),
+			StartTime: open.span.StartTime(),
+			Age:       age,
+		})
+	}
+	p.mu.Unlock()
+	// the handler may log or record metrics, call it without holding the lock
+	if p.handler == nil {
+		return
+	}
+	for _, span := range long {
+		p.handler(span)
+	}
+}
+
+// OnStart starts watching s.
+func (p *longSpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	p.mu.Lock()
+	p.spans[s.SpanContext().SpanID()] = &openSpan{span: s}
+	p.mu.Unlock()
+}
+
+// OnEnd stops watching s.
+func (p *longSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.mu.Lock()
+	delete(p.spans, s.SpanContext().SpanID())
+	p.mu.Unlock()
+}
+
+// Shutdown stops the watchdog.
+func (p *longSpanProcessor) Shutdown(context.Context) error {
+	p.stopOnce.Do(func() { close(p.stop) })
+	<-p.done
+	return nil
+}
+
+// ForceFlush does nothing.
+func (p *longSpanProcessor) ForceFlush(context.Context) error { return nil }
@@ -0,0 +1,139 @@
+package tracer
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer_LongSpan_Check(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+	var reported []LongSpan
+	processor := newLongSpanProcessor(time.Minute, func(span LongSpan) {
+		reported = append(reported, span)
+	}, fake)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(processor))
+	t.Cleanup(func() {
+		_ = tp.Shutdown(t.Context())
+	})
+	tr := tp.Tracer("test")
+
+	_, leaked := tr.Start(t.Context(), "leaked", trace.WithTimestamp(start))
+	_, ended := tr.Start(t.Context(), "ended", trace.WithTimestamp(start))
+	_, recent := tr.Start(t.Context(), "recent", trace.WithTimestamp(start.Add(30*time.Second)))
+	ended.End(trace.WithTimestamp(start.Add(10 * time.Second)))
+
+	processor.check(start.Add(59 * time.Second))
+	if len(reported) != 0 {
+		t.Fatalf("check() before the threshold reported %v, want none", reported)
+	}
+
+	processor.check(start.Add(time.Minute))
+	if len(reported) != 1 {
+		t.Fatalf("check() reported %d spans, want 1", len(reported))
+	}
+	got := reported[0]
+	if got.Name != "leaked" {
+		t.Errorf("LongSpan.Name = %q, want %q", got.Name, "leaked")
+	}
+	if got.SpanID != leaked.SpanContext().SpanID() || got.TraceID != leaked.SpanContext().TraceID() {
+		t.Errorf("LongSpan IDs = %v/%v, want %v/%v", got.TraceID, got.SpanID, leaked.SpanContext().TraceID(), leaked.SpanContext().SpanID())
+	}
+	if !got.StartTime.Equal(start) {
+		t.Errorf("LongSpan.StartTime = %v, want %v", got.StartTime, start)
+	}
+	if got.Age != time.Minute {
+		t.Errorf("LongSpan.Age = %v, want %v", got.Age, time.Minute)
+	}
+
+	// each span is reported once
+	processor.check(start.Add(2 * time.Minute))
+	if len(reported) != 2 || reported[1].Name != "recent" {
+		t.Fatalf("check() reported %v, want leaked then recent", reported)
+	}
+	processor.check(start.Add(time.Hour))
+	if len(reported) != 2 {
+		t.Errorf("check() reported %d spans, want 2", len(reported))
+	}
+	leaked.End()
+	recent.End()
+}
+
+func TestTracer_LongSpan_Watchdog(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+	var (
+		mu       sync.Mutex
+		reported []LongSpan
+	)
+	processor := newLongSpanProcessor(time.Minute, func(span LongSpan) {
+		mu.Lock()
+		defer mu.Unlock()
+		reported = append(reported, span)
+	}, fake)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(processor))
+	tr := tp.Tracer("test")
+	_, span := tr.Start(t.Context(), "leaked", trace.WithTimestamp(start))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		fake.Advance(30 * time.Second)
+		mu.Lock()
+		n := len(reported)
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the long span to be reported")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	span.End()
+
+	if err := tp.Shutdown(t.Context()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	// a second shutdown must not block or panic
+	if err := processor.Shutdown(t.Context()); err != nil {
+		t.Errorf("Shutdown() again error = %v", err)
+	}
+}
+
+func TestTracer_LongSpan_NewTracer(t *testing.T) {
+	reported := make(chan LongSpan, 1)
+	tr, err := NewTracer(
+		WithProvider("stdout", "", 0),
+		WithWriter(io.Discard),
+		WithLongSpanThreshold(10*time.Millisecond),
+		WithLongSpanHandler(func(span LongSpan) {
+			select {
+			case reported <- span:
+			default:
+			}
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = tr.Shutdown(t.Context())
+	})
+
+	_, span := tr.StartSpan(t.Context(), "slow")
+	defer span.End()
+	select {
+	case got := <-reported:
+		if got.Name != "slow" {
+			t.Errorf("LongSpan.Name = %q, want %q", got.Name, "slow")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the long span to be reported")
+	}
+}
@@ -0,0 +1,62 @@
+package tracer
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// mirrorProcessor is a span processor that passes every span to primary and the spans of a
+// ratio of traces to mirror, e.g. to compare a backend under evaluation with the current one
+// without paying for the full traffic twice. Traces are selected by their trace ID, like the
+// ratio sampler, so a mirrored trace is mirrored completely, in every service mirroring the
+// same ratio.
+type mirrorProcessor struct {
+	primary   sdktrace.SpanProcessor
+	mirror    sdktrace.SpanProcessor
+	threshold uint64 // threshold is the upper bound of the trace ID values mirrored.
+}
+
+// newMirrorProcessor returns primary paired with mirror receiving ratio of the traces, or
+// primary itself when mirror is nil.
+func newMirrorProcessor(primary, mirror sdktrace.SpanProcessor, ratio float64) sdktrace.SpanProcessor {
+	if mirror == nil {
+		return primary
+	}
+	return &mirrorProcessor{primary: primary, mirror: mirror, threshold: uint64(ratio * (1 << 63))}
+}
+
+// OnStart passes s to both processors.
+func (p *mirrorProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	p.primary.OnStart(parent, s)
+	if p.mirrored(s) {
+		p.mirror.OnStart(parent, s)
+	}
+}
+
+// OnEnd passes s to the primary processor, and to the mirror when its trace is mirrored.
+func (p *mirrorProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.primary.OnEnd(s)
+	if p.mirrored(s) {
+		p.mirror.OnEnd(s)
+	}
+}
+
+// mirrored reports whether the trace of s is mirrored: the low 63 bits of its trace ID, which
+// are random for every ID generator the tracer uses, are below the threshold.
+func (p *mirrorProcessor) mirrored(s sdktrace.ReadOnlySpan) bool {
+	traceID := s.SpanContext().TraceID()
+	return binary.BigEndian.Uint64(traceID[8:])>>1 < p.threshold
+}
+
+// Shutdown shuts down both processors.
+func (p *mirrorProcessor) Shutdown(ctx context.Context) error {
+	return errors.Join(p.primary.Shutdown(ctx), p.mirror.Shutdown(ctx))
+}
+
+// ForceFlush flushes both processors.
+func (p *mirrorProcessor) ForceFlush(ctx context.Context) error {
+	return errors.Join(p.primary.ForceFlush(ctx), p.mirror.ForceFlush(ctx))
+}
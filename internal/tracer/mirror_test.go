@@ -0,0 +1,95 @@
+package tracer
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer_Mirror_NewMirrorProcessor(t *testing.T) {
+	tests := []struct {
+		name    string
+		ratio   float64
+		wantMin int
+		wantMax int
+	}{
+		{"ratio one mirrors every trace", 1, 200, 200},
+		{"ratio mirrors a share of the traces", 0.25, 20, 80},
+		{"tiny ratio mirrors almost nothing", 1e-9, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := tracetest.NewInMemoryExporter()
+			mirror := tracetest.NewInMemoryExporter()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(newMirrorProcessor(
+				sdktrace.NewSimpleSpanProcessor(primary),
+				sdktrace.NewSimpleSpanProcessor(mirror),
+				tt.ratio,
+			)))
+			defer func() {
+				_ = provider.Shutdown(context.Background())
+			}()
+
+			tr := provider.Tracer("test")
+			for i := 0; i < 100; i++ {
+				ctx, parent := tr.Start(context.Background(), "parent")
+				_, child := tr.Start(ctx, "child")
+				child.End()
+				parent.End()
+			}
+
+			if got := len(primary.GetSpans()); got != 200 {
+				t.Errorf("primary exported %d spans, want 200", got)
+			}
+			mirrored := mirror.GetSpans()
+			if len(mirrored) < tt.wantMin || len(mirrored) > tt.wantMax {
+				t.Errorf("mirror exported %d spans, want %d to %d", len(mirrored), tt.wantMin, tt.wantMax)
+			}
+			traces := make(map[string]int)
+			for _, s := range mirrored {
+				traces[s.SpanContext.TraceID().String()]++
+			}
+			for traceID, spans := range traces {
+				if spans != 2 {
+					t.Errorf("trace %s mirrored with %d spans, want the whole trace", traceID, spans)
+				}
+			}
+		})
+	}
+}
+
+func TestTracer_Mirror_NewMirrorProcessor_Disabled(t *testing.T) {
+	primary := sdktrace.NewSimpleSpanProcessor(tracetest.NewInMemoryExporter())
+	if got := newMirrorProcessor(primary, nil, 0.5); got != primary {
+		t.Errorf("newMirrorProcessor() without mirror = %T, want the primary processor", got)
+	}
+}
+
+func TestTracer_Mirror_Reload(t *testing.T) {
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithProvider("stdout", "", 0),
+		WithWriter(io.Discard),
+		WithMirror("http://127.0.0.1:4318", 0.5),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tracerInstance.Shutdown(context.Background())
+	}()
+	if _, ok := tracerInstance.(*tracer).processor.(*mirrorProcessor); !ok {
+		t.Fatalf("exporting processor = %T, want *mirrorProcessor", tracerInstance.(*tracer).processor)
+	}
+
+	if err := tracerInstance.(Reloader).Reload(WithMirror("", 0)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if _, ok := tracerInstance.(*tracer).processor.(*mirrorProcessor); ok {
+		t.Error("exporting processor still mirrors after Reload disabled mirroring")
+	}
+}
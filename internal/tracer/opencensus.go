@@ -0,0 +1,15 @@
+package tracer
+
+import (
+	ocbridge "go.opentelemetry.io/otel/bridge/opencensus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// installOpenCensusBridge replaces the global OpenCensus tracer with a bridge starting spans on
+// provider, so the spans of dependencies still instrumented with OpenCensus are sampled and
+// exported with the spans of the tracer. OpenCensus has a single global tracer: the bridge
+// stays installed after the tracer is shut down, and the last tracer created with the bridge
+// receives the spans.
+func installOpenCensusBridge(provider trace.TracerProvider) {
+	ocbridge.InstallTraceBridge(ocbridge.WithTracerProvider(provider))
+}
@@ -0,0 +1,60 @@
+package tracer
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	octrace "go.opencensus.io/trace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer_Opencensus_NewTracer(t *testing.T) {
+	defaultTracer := octrace.DefaultTracer
+	defer func() {
+		octrace.DefaultTracer = defaultTracer
+	}()
+
+	tests := []struct {
+		name      string
+		enabled   bool
+		wantSpans int
+	}{
+		{"bridge disabled", false, 1},
+		{"bridge enabled", true, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			octrace.DefaultTracer = defaultTracer
+			exporter := tracetest.NewInMemoryExporter()
+			tracerInstance, err := NewTracer(
+				WithServiceName("test-service"),
+				WithProvider("stdout", "", 0),
+				WithWriter(io.Discard),
+				WithSpanProcessors(sdktrace.NewSimpleSpanProcessor(exporter)),
+				WithOpenCensusBridge(tt.enabled),
+			)
+			if err != nil {
+				t.Fatalf("NewTracer() error = %v", err)
+			}
+			defer func() {
+				_ = tracerInstance.Shutdown(context.Background())
+			}()
+
+			ctx, span := tracerInstance.StartSpan(context.Background(), "request")
+			_, ocSpan := octrace.StartSpan(ctx, "oc.query")
+			ocSpan.End()
+			tracerInstance.EndSpan(span)
+
+			spans := exporter.GetSpans()
+			if len(spans) != tt.wantSpans {
+				t.Fatalf("exported %d spans, want %d", len(spans), tt.wantSpans)
+			}
+			if tt.enabled && spans[0].Parent.SpanID() != spans[1].SpanContext.SpanID() {
+				t.Errorf("OpenCensus span parent = %s, want %s", spans[0].Parent.SpanID(), spans[1].SpanContext.SpanID())
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package tracer
+
+import (
+	"github.com/opentracing/opentracing-go"
+	otbridge "go.opentelemetry.io/otel/bridge/opentracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// enableOpenTracing creates the OpenTracing bridge of t on top of its OpenTelemetry tracer and
+// makes t start its own spans through the bridge as well, so the active span is shared: an
+// OpenTracing span put in a context with opentracing.ContextWithSpan parents the spans t
+// starts from that context, and opentracing.StartSpanFromContext continues the spans t started.
+func (t *tracer) enableOpenTracing() {
+	bridge := otbridge.NewBridgeTracer()
+	bridge.SetTextMapPropagator(t.propagator)
+	t.tracer = otbridge.NewWrapperTracer(bridge, t.tracer)
+	bridge.SetOpenTelemetryTracer(t.tracer)
+	t.bridge = bridge
+}
+
+// wrapOpenTracing returns otelTracer started through the OpenTracing bridge of t when it is
+// enabled, so spans of tracers created by Scoped share their context with OpenTracing too.
+func (t *tracer) wrapOpenTracing(otelTracer trace.Tracer) trace.Tracer {
+	if t.bridge == nil {
+		return otelTracer
+	}
+	return otbridge.NewWrapperTracer(t.bridge, otelTracer)
+}
+
+// OpenTracing returns an opentracing.Tracer whose spans are started by the tracer's provider,
+// so libraries still instrumented with the OpenTracing API are exported, sampled, and
+// propagated like the spans of the tracer. Spans are created under the tracer's
+// instrumentation scope, and Inject and Extract use the tracer's propagator.
+// It returns opentracing.NoopTracer unless the tracer was created with WithOpenTracingBridge.
+//
+// Example:
+//
+//	opentracing.SetGlobalTracer(tracer.OpenTracing())
+func (t *tracer) OpenTracing() opentracing.Tracer {
+	if t.bridge == nil {
+		return opentracing.NoopTracer{}
+	}
+	return t.bridge
+}
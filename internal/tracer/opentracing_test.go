@@ -0,0 +1,107 @@
+package tracer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer_Opentracing_OpenTracing(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		wantNoop bool
+	}{
+		{"bridge disabled returns noop tracer", false, true},
+		{"bridge enabled returns bridge", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracerInstance, err := NewTracer(
+				WithServiceName("test-service"),
+				WithProvider("stdout", "", 0),
+				WithWriter(io.Discard),
+				WithOpenTracingBridge(tt.enabled),
+			)
+			if err != nil {
+				t.Fatalf("NewTracer() error = %v", err)
+			}
+			defer func() {
+				_ = tracerInstance.Shutdown(context.Background())
+			}()
+
+			_, noop := tracerInstance.(OpenTracer).OpenTracing().(opentracing.NoopTracer)
+			if noop != tt.wantNoop {
+				t.Errorf("OpenTracing() = %T, want noop %v", tracerInstance.(OpenTracer).OpenTracing(), tt.wantNoop)
+			}
+		})
+	}
+
+	if _, ok := NewNoopTracer().(OpenTracer).OpenTracing().(opentracing.NoopTracer); !ok {
+		t.Error("OpenTracing() on noop tracer is not opentracing.NoopTracer")
+	}
+}
+
+func TestTracer_Opentracing_SharedContext(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithProvider("stdout", "", 0),
+		WithWriter(io.Discard),
+		WithSpanProcessors(sdktrace.NewSimpleSpanProcessor(exporter)),
+		WithOpenTracingBridge(true),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tracerInstance.Shutdown(context.Background())
+	}()
+	ot := tracerInstance.(OpenTracer).OpenTracing()
+	scoped := tracerInstance.Scoped("github.com/acme/kafka", "v1.0.0")
+
+	// OpenTelemetry parent, OpenTracing child, OpenTelemetry grandchild through a scoped tracer.
+	ctx, root := tracerInstance.StartSpan(context.Background(), "root")
+	otSpan, ctx := opentracing.StartSpanFromContextWithTracer(ctx, ot, "legacy")
+	_, leaf := scoped.StartSpan(ctx, "leaf")
+	tracerInstance.EndSpan(leaf)
+	otSpan.Finish()
+	tracerInstance.EndSpan(root)
+
+	// OpenTracing context propagated through HTTP headers.
+	header := http.Header{}
+	if err := ot.Inject(otSpan.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(header)); err != nil {
+		t.Fatalf("Inject() error = %v", err)
+	}
+	if header.Get("traceparent") == "" {
+		t.Errorf("Inject() headers = %v, want traceparent", header)
+	}
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, s := range exporter.GetSpans().Snapshots() {
+		spans[s.Name()] = s
+	}
+	if len(spans) != 3 {
+		t.Fatalf("exported %d spans, want 3", len(spans))
+	}
+	parents := map[string]string{"legacy": "root", "leaf": "legacy"}
+	for child, parent := range parents {
+		got := spans[child].Parent().SpanID()
+		if want := spans[parent].SpanContext().SpanID(); got != want {
+			t.Errorf("parent of %q = %s, want %q (%s)", child, got, parent, want)
+		}
+	}
+	if got := spans["leaf"].InstrumentationScope().Name; got != "github.com/acme/kafka" {
+		t.Errorf("leaf scope = %q, want github.com/acme/kafka", got)
+	}
+	if got := spans["legacy"].SpanContext().TraceID(); got != spans["root"].SpanContext().TraceID() || !got.IsValid() {
+		t.Errorf("legacy trace ID = %s, want %s", got, trace.SpanContextFromContext(ctx).TraceID())
+	}
+}
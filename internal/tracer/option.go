@@ -1,26 +1,169 @@
 package tracer
 
-import "time"
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	"github.com/adityakw90/go-monitoring/internal/endpoint"
+	"github.com/adityakw90/go-monitoring/internal/redact"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
 
 // Options contains configuration options for creating a Tracer.
 // All fields are optional and have sensible defaults.
 type Options struct {
-	ServiceName  string        // ServiceName is the name of the service being traced.
-	Environment  string        // Environment is the deployment environment (e.g., "development", "production").
-	InstanceName string        // InstanceName is the unique identifier for this service instance.
-	InstanceHost string        // InstanceHost is the hostname where this service instance is running.
-	Provider     string        // Provider specifies the trace exporter to use ("stdout" or "otlp").
-	ProviderHost string        // ProviderHost is the hostname of the OTLP trace collector (only used when Provider is "otlp").
-	ProviderPort int           // ProviderPort is the port of the OTLP trace collector (only used when Provider is "otlp").
-	SampleRatio  float64       // SampleRatio controls the sampling rate for traces (0.0 to 1.0). 0.0 means never sample, 1.0 means always sample, values in between use probabilistic sampling.
-	BatchTimeout time.Duration // BatchTimeout is the maximum time to wait before exporting a batch of spans.
-	Insecure     bool          // Insecure controls whether to use an insecure (non-TLS) connection for OTLP exporter. When true, connections are made without TLS. Default is false (secure TLS connection).
+	ServiceName            string                               // ServiceName is the name of the service being traced.
+	Environment            string                               // Environment is the deployment environment (e.g., "development", "production").
+	InstanceName           string                               // InstanceName is the unique identifier for this service instance.
+	InstanceHost           string                               // InstanceHost is the hostname where this service instance is running.
+	ResourceAttributes     []attribute.KeyValue                 // ResourceAttributes are added to the resource next to the service identity, e.g. Kubernetes or cloud metadata.
+	ScopeName              string                               // ScopeName is the instrumentation scope name of the tracer. If empty, ServiceName is used.
+	ScopeVersion           string                               // ScopeVersion is the instrumentation scope version of the tracer.
+	Provider               string                               // Provider specifies the trace exporter to use ("stdout" or "otlp").
+	ProviderHost           string                               // ProviderHost is the hostname of the OTLP trace collector (only used when Provider is "otlp").
+	ProviderPort           int                                  // ProviderPort is the port of the OTLP trace collector (only used when Provider is "otlp").
+	StdoutFormat           string                               // StdoutFormat selects how the "stdout" provider writes spans: "pretty" (default) indented JSON, or "ndjson" one compact JSON object per line.
+	Writer                 io.Writer                            // Writer receives the spans of the "stdout" provider and the "stdout" fallback provider. If nil, they are written to os.Stdout.
+	SampleRatio            float64                              // SampleRatio controls the sampling rate for traces (0.0 to 1.0). 0.0 means never sample, 1.0 means always sample, values in between use probabilistic sampling.
+	IgnoredRoutes          []string                             // IgnoredRoutes are the request paths, or the http.ServeMux patterns or routes, SpanFromRequest creates no span for, e.g. "/healthz". A trailing "*" matches any path with the preceding prefix.
+	ScrubbedHeaders        []string                             // ScrubbedHeaders are the headers whose values are recorded as "REDACTED", e.g. "Authorization". Names are case-insensitive.
+	ScrubbedQueryParams    []string                             // ScrubbedQueryParams are the query parameters whose values are recorded as "REDACTED", e.g. "token". Names are case-insensitive.
+	BodyRecording          bool                                 // BodyRecording records the body sizes and content types of the requests sent through RoundTripper.
+	BodySnippetLimit       int                                  // BodySnippetLimit is the maximum number of bytes of each body recorded as a snippet when BodyRecording is set. Zero records no snippet.
+	CapturedHeaders        []string                             // CapturedHeaders are the request and response headers recorded as http.request.header.<name> and http.response.header.<name> attributes.
+	SamplingRules          []SamplingRule                       // SamplingRules assign sampling ratios to root spans by name and attributes. The first matching rule applies; unmatched spans use SampleRatio.
+	BatchTimeout           time.Duration                        // BatchTimeout is the maximum time to wait before exporting a batch of spans.
+	SimpleProcessor        bool                                 // SimpleProcessor exports every span synchronously when it ends instead of batching, so no span waits in memory when the process is frozen or killed. BatchTimeout is then unused.
+	ColdStart              bool                                 // ColdStart sets faas.coldstart on local root spans: true on the first one of the process, false on the others.
+	ContextAnnotations     bool                                 // ContextAnnotations records on spans when their parent context is canceled or exceeds its deadline before they end.
+	LongSpanThreshold      time.Duration                        // LongSpanThreshold is the age past which a span not yet ended is reported to LongSpanHandler. Zero disables the watchdog.
+	LongSpanHandler        func(span LongSpan)                  // LongSpanHandler is called once for each span open longer than LongSpanThreshold.
+	SpanProcessors         []sdktrace.SpanProcessor             // SpanProcessors are registered with the provider next to the exporting processor, e.g. to observe spans in tests.
+	SpanFilter             SpanFilter                           // SpanFilter selects the ended spans that are exported. If nil, every sampled span is exported.
+	RedactedAttributes     []string                             // RedactedAttributes are the span and event attribute keys redacted before export, e.g. "db.statement".
+	RedactionAction        string                               // RedactionAction selects how RedactedAttributes are redacted: "delete" (default) or "hash".
+	Insecure               bool                                 // Insecure controls whether to use an insecure (non-TLS) connection for OTLP exporter. When true, connections are made without TLS. Default is false (secure TLS connection).
+	DevViewerPort          int                                  // DevViewerPort is the localhost port serving the spans collected in memory as an HTML waterfall, for development. Zero disables the viewer.
+	MirrorEndpoint         string                               // MirrorEndpoint is the OTLP collector URL a ratio of the traces is mirrored to, next to the primary exporter. If empty, nothing is mirrored.
+	MirrorRatio            float64                              // MirrorRatio is the ratio of the exported traces mirrored to MirrorEndpoint (greater than 0.0, up to 1.0).
+	Endpoint               string                               // Endpoint is the OTLP collector URL (e.g., "https://collector:4318/v1/traces"). When set it replaces Provider, ProviderHost, ProviderPort, and Insecure; the scheme selects gRPC or HTTP and TLS.
+	RemoteSamplingURL      string                               // RemoteSamplingURL is the Jaeger-compatible sampling strategy endpoint to poll. If empty, remote sampling is disabled.
+	RemoteSamplingInterval time.Duration                        // RemoteSamplingInterval is the time between polls of RemoteSamplingURL.
+	FallbackProvider       string                               // FallbackProvider is the exporter spans are spilled to when the primary export fails ("file" or "stdout"). If empty, failed spans are dropped.
+	FallbackPath           string                               // FallbackPath is the file spans are appended to when FallbackProvider is "file".
+	SpillHandler           func(ctx context.Context, spans int) // SpillHandler is called with the number of spans spilled to the fallback exporter.
+	BreakerThreshold       int                                  // BreakerThreshold is the number of consecutive export failures that opens the exporter circuit breaker. Zero disables the breaker.
+	BreakerMaxBackoff      time.Duration                        // BreakerMaxBackoff caps the time the circuit breaker stays open before a trial export.
+	BreakerStateHandler    func(state breaker.State)            // BreakerStateHandler is called on every circuit breaker state transition.
+	IDGenerator            IDGenerator                          // IDGenerator generates trace and span IDs. If nil, random IDs are used, or X-Ray compatible or 64-bit ones with XRayIDs or TraceID64.
+	XRayIDs                bool                                 // XRayIDs generates trace IDs starting with their creation time, as AWS X-Ray requires. Ignored when IDGenerator is set.
+	TraceID64              bool                                 // TraceID64 generates trace IDs whose high 64 bits are zero, for downstream systems that only keep 64-bit trace IDs. Ignored when IDGenerator is set.
+	XRayPropagation        bool                                 // XRayPropagation extracts and injects the X-Ray trace header (X-Amzn-Trace-Id) next to the W3C trace context.
+	OpenTracingBridge      bool                                 // OpenTracingBridge exposes the tracer as an opentracing.Tracer through OpenTracing, sharing the active span with it.
+	OpenCensusBridge       bool                                 // OpenCensusBridge replaces the global OpenCensus tracer with a bridge starting spans on the tracer provider.
+	Clock                  clock.Clock                          // Clock timestamps spans started and ended through StartSpan and EndSpan. If nil, the SDK uses the real time.
+}
+
+// Validate reports whether the options describe a valid tracer without creating it.
+// It returns ErrBatchTimeoutInvalid, ErrBreakerThresholdInvalid, ErrBreakerMaxBackoffInvalid,
+// ErrRemoteSamplingIntervalInvalid, ErrBodySnippetLimitInvalid, ErrInvalidStdoutFormat, ErrLongSpanThresholdInvalid,
+// ErrEndpointInvalid, ErrInvalidProvider, ErrProviderHostRequired, ErrProviderPortRequired, ErrProviderPortInvalid,
+// ErrInvalidFallbackProvider, ErrFallbackPathRequired, ErrTraceIDFormatConflict, redact.ErrInvalidAction,
+// ErrMirrorEndpointInvalid, ErrMirrorRatioInvalid, or ErrDevViewerPortInvalid for the first invalid setting found.
+func (o *Options) Validate() error {
+	if o.BatchTimeout <= 0 {
+		return ErrBatchTimeoutInvalid
+	}
+	if o.BreakerThreshold < 0 {
+		return ErrBreakerThresholdInvalid
+	}
+	if o.BreakerThreshold > 0 && o.BreakerMaxBackoff <= 0 {
+		return ErrBreakerMaxBackoffInvalid
+	}
+	if o.RemoteSamplingURL != "" && o.RemoteSamplingInterval <= 0 {
+		return ErrRemoteSamplingIntervalInvalid
+	}
+	if o.BodySnippetLimit < 0 {
+		return ErrBodySnippetLimitInvalid
+	}
+	switch o.StdoutFormat {
+	case "", "pretty", "ndjson":
+	default:
+		return ErrInvalidStdoutFormat
+	}
+	if o.LongSpanThreshold < 0 {
+		return ErrLongSpanThresholdInvalid
+	}
+
+	if o.Endpoint != "" {
+		if _, err := endpoint.Parse(o.Endpoint); err != nil {
+			return ErrEndpointInvalid
+		}
+	} else {
+		switch o.Provider {
+		case "stdout":
+		case "otlp":
+			if o.ProviderHost == "" {
+				return ErrProviderHostRequired
+			}
+			if o.ProviderPort == 0 {
+				return ErrProviderPortRequired
+			}
+			if o.ProviderPort < 0 {
+				return ErrProviderPortInvalid
+			}
+		default:
+			return ErrInvalidProvider
+		}
+	}
+
+	switch o.FallbackProvider {
+	case "", "stdout":
+	case "file":
+		if o.FallbackPath == "" {
+			return ErrFallbackPathRequired
+		}
+	default:
+		return ErrInvalidFallbackProvider
+	}
+	if o.IDGenerator == nil && o.XRayIDs && o.TraceID64 {
+		return ErrTraceIDFormatConflict
+	}
+	if len(o.RedactedAttributes) > 0 {
+		if err := redact.Validate(o.RedactionAction); err != nil {
+			return err
+		}
+	}
+	if o.MirrorEndpoint != "" {
+		if _, err := endpoint.Parse(o.MirrorEndpoint); err != nil {
+			return ErrMirrorEndpointInvalid
+		}
+		if o.MirrorRatio <= 0 || o.MirrorRatio > 1 {
+			return ErrMirrorRatioInvalid
+		}
+	}
+	if o.DevViewerPort < 0 || o.DevViewerPort > 65535 {
+		return ErrDevViewerPortInvalid
+	}
+	return nil
 }
 
 // Option is a function that configures Options.
 // It follows the functional options pattern for flexible tracer configuration.
 type Option func(*Options)
 
+// WithOptions returns an Option that replaces every setting with options, as the root package
+// does with the tracer Options it holds before adding the settings shared with the metric.
+func WithOptions(options Options) Option {
+	return func(o *Options) {
+		*o = options
+	}
+}
+
 // WithServiceName returns an Option that sets the tracer service name.
 // The provided name is applied to Options.ServiceName.
 func WithServiceName(name string) Option {
@@ -46,6 +189,24 @@ func WithInstance(name, host string) Option {
 	}
 }
 
+// WithInstrumentationScope returns an Option that sets the instrumentation scope name and version
+// of the tracer, which backends use to tell the library's telemetry apart. An empty name uses
+// the service name.
+func WithInstrumentationScope(name, version string) Option {
+	return func(o *Options) {
+		o.ScopeName = name
+		o.ScopeVersion = version
+	}
+}
+
+// WithResourceAttributes returns an Option that adds attrs to the tracer resource. The service
+// name, environment, and instance attributes take precedence over attrs with the same key.
+func WithResourceAttributes(attrs ...attribute.KeyValue) Option {
+	return func(o *Options) {
+		o.ResourceAttributes = attrs
+	}
+}
+
 // collector endpoint to use when the provider requires a network collector.
 func WithProvider(provider, host string, port int) Option {
 	return func(o *Options) {
@@ -55,6 +216,25 @@ func WithProvider(provider, host string, port int) Option {
 	}
 }
 
+// WithStdoutFormat returns an Option that sets how the "stdout" provider writes spans.
+// "pretty" (default) writes indented JSON for reading in a terminal. "ndjson" writes every span
+// as one compact JSON object per line, with the field names of the OpenTelemetry SDK span stubs,
+// for local tooling and CI log scrapers to parse.
+func WithStdoutFormat(format string) Option {
+	return func(o *Options) {
+		o.StdoutFormat = format
+	}
+}
+
+// WithWriter returns an Option that sets where the "stdout" provider and the "stdout" fallback
+// provider write spans, e.g. a file, a buffer, or a test sink, instead of os.Stdout. A nil w
+// writes to os.Stdout.
+func WithWriter(w io.Writer) Option {
+	return func(o *Options) {
+		o.Writer = w
+	}
+}
+
 // WithSampleRatio returns an Option that sets the tracer sampling ratio.
 // Valid values are between 0.0 and 1.0 inclusive — 0.0 means never sample and 1.0 means always sample.
 func WithSampleRatio(ratio float64) Option {
@@ -63,6 +243,55 @@ func WithSampleRatio(ratio float64) Option {
 	}
 }
 
+// WithSamplingRules returns an Option that sets the rules sampling root spans by name and
+// attributes. The first rule matching a span decides its ratio; spans no rule matches use the
+// sample ratio. Once rules are set, spans with a local parent follow the parent's decision.
+func WithSamplingRules(rules ...SamplingRule) Option {
+	return func(o *Options) {
+		o.SamplingRules = rules
+	}
+}
+
+// WithIgnoredRoutes returns an Option that sets the request paths or routes SpanFromRequest
+// creates no span for, such as health checks and scrape endpoints. An entry matches the
+// request path, or the http.ServeMux pattern or route of a routed request, exactly; a trailing
+// "*" matches by prefix.
+func WithIgnoredRoutes(routes ...string) Option {
+	return func(o *Options) {
+		o.IgnoredRoutes = routes
+	}
+}
+
+// WithHTTPScrubbing returns an Option that sets the headers and query parameters whose values
+// SpanFromRequest and RoundTripper record as "REDACTED", so credentials sent in them do not
+// reach the tracing backend. Names are case-insensitive. Nil or empty lists scrub nothing.
+func WithHTTPScrubbing(headers, queryParams []string) Option {
+	return func(o *Options) {
+		o.ScrubbedHeaders = headers
+		o.ScrubbedQueryParams = queryParams
+	}
+}
+
+// WithCapturedHeaders returns an Option that sets the request and response headers
+// SpanFromRequest and RoundTripper record as span attributes. Values of scrubbed headers are
+// recorded as "REDACTED".
+func WithCapturedHeaders(headers ...string) Option {
+	return func(o *Options) {
+		o.CapturedHeaders = headers
+	}
+}
+
+// WithBodyRecording returns an Option that sets whether RoundTripper records the body size and
+// content type of each request and response, and the maximum number of bytes of each body
+// recorded as a snippet for debugging content negotiation. A snippetLimit of zero records no
+// snippet; snippets are not scrubbed, so enable them only where bodies carry no credentials.
+func WithBodyRecording(enabled bool, snippetLimit int) Option {
+	return func(o *Options) {
+		o.BodyRecording = enabled
+		o.BodySnippetLimit = snippetLimit
+	}
+}
+
 // WithBatchTimeout returns an Option that sets the maximum time to wait before exporting a batch of spans.
 func WithBatchTimeout(timeout time.Duration) Option {
 	return func(o *Options) {
@@ -75,4 +304,217 @@ func WithInsecure(insecure bool) Option {
 	return func(o *Options) {
 		o.Insecure = insecure
 	}
-}
\ No newline at end of file
+}
+
+// WithRemoteSampling returns an Option that enables polling the sampling ratio from a remote
+// Jaeger-compatible sampling strategy endpoint every interval. The remote ratio overrides the
+// configured SampleRatio once it has been fetched.
+func WithRemoteSampling(url string, interval time.Duration) Option {
+	return func(o *Options) {
+		o.RemoteSamplingURL = url
+		o.RemoteSamplingInterval = interval
+	}
+}
+
+// WithFallbackProvider returns an Option that spills spans to a local exporter when the
+// primary exporter fails, instead of dropping them. provider is "file" (spans are appended
+// as JSON to path) or "stdout" (path is ignored).
+func WithFallbackProvider(provider, path string) Option {
+	return func(o *Options) {
+		o.FallbackProvider = provider
+		o.FallbackPath = path
+	}
+}
+
+// WithSpillHandler returns an Option that sets the function notified with the number of spans
+// spilled to the fallback exporter, e.g. to count them in a metric.
+func WithSpillHandler(handler func(ctx context.Context, spans int)) Option {
+	return func(o *Options) {
+		o.SpillHandler = handler
+	}
+}
+
+// WithCircuitBreaker returns an Option that guards the exporter with a circuit breaker.
+// After threshold consecutive failed exports the breaker opens and spans are dropped without
+// contacting the collector; trial exports are then attempted with an exponential backoff
+// starting at one second and capped at maxBackoff. A threshold of 0 disables the breaker.
+func WithCircuitBreaker(threshold int, maxBackoff time.Duration) Option {
+	return func(o *Options) {
+		o.BreakerThreshold = threshold
+		o.BreakerMaxBackoff = maxBackoff
+	}
+}
+
+// WithBreakerStateHandler returns an Option that sets the function notified of circuit breaker
+// state transitions, e.g. to expose the state as a metric.
+func WithBreakerStateHandler(handler func(state breaker.State)) Option {
+	return func(o *Options) {
+		o.BreakerStateHandler = handler
+	}
+}
+
+// WithSimpleProcessor returns an Option that exports every span synchronously when it ends
+// instead of batching, for short-lived processes such as serverless functions that may be
+// frozen between invocations. Each span end then waits for the export.
+func WithSimpleProcessor(enabled bool) Option {
+	return func(o *Options) {
+		o.SimpleProcessor = enabled
+	}
+}
+
+// WithColdStart returns an Option that annotates local root spans with faas.coldstart, true
+// for the first root span of the process and false afterwards.
+func WithColdStart(enabled bool) Option {
+	return func(o *Options) {
+		o.ColdStart = enabled
+	}
+}
+
+// WithContextAnnotations returns an Option that annotates spans whose parent context is
+// canceled or exceeds its deadline before they end, with a "context.done" event and the
+// context.error attribute ("context canceled" or "context deadline exceeded"), and records the
+// time left before the deadline when a span starts in context.deadline_remaining_ms.
+func WithContextAnnotations(enabled bool) Option {
+	return func(o *Options) {
+		o.ContextAnnotations = enabled
+	}
+}
+
+// WithLongSpanThreshold returns an Option that reports spans still open after threshold to
+// the LongSpanHandler, catching spans that are leaked and never ended. Each span is reported
+// once. Zero (default) disables the watchdog.
+func WithLongSpanThreshold(threshold time.Duration) Option {
+	return func(o *Options) {
+		o.LongSpanThreshold = threshold
+	}
+}
+
+// WithLongSpanHandler returns an Option that sets the function called for each span open
+// longer than the LongSpanThreshold.
+func WithLongSpanHandler(handler func(span LongSpan)) Option {
+	return func(o *Options) {
+		o.LongSpanHandler = handler
+	}
+}
+
+// WithSpanProcessors returns an Option that registers processors with the tracer provider,
+// before the processor exporting spans. They see every sampled span start and end.
+func WithSpanProcessors(processors ...sdktrace.SpanProcessor) Option {
+	return func(o *Options) {
+		o.SpanProcessors = processors
+	}
+}
+
+// WithSpanFilter returns an Option that exports only the ended spans filter returns true for,
+// so noise such as health checks never leaves the process. Sampling is not affected, and the
+// processors set with WithSpanProcessors still see every span.
+func WithSpanFilter(filter SpanFilter) Option {
+	return func(o *Options) {
+		o.SpanFilter = filter
+	}
+}
+
+// WithRedaction returns an Option that redacts the span and event attributes named keys before
+// export: action "delete" removes them and "hash" replaces their values with a SHA-256 hash,
+// so spans with equal values still correlate. Processors set with WithSpanProcessors still
+// see the original values.
+func WithRedaction(action string, keys ...string) Option {
+	return func(o *Options) {
+		o.RedactionAction = action
+		o.RedactedAttributes = keys
+	}
+}
+
+// WithEndpoint returns an Option that sets the OTLP collector URL.
+// The scheme selects the transport and TLS: grpc and grpcs use gRPC, http and https use HTTP,
+// and grpc and http connect without TLS. When set, Provider, ProviderHost, ProviderPort, and
+// Insecure are ignored.
+func WithEndpoint(url string) Option {
+	return func(o *Options) {
+		o.Endpoint = url
+	}
+}
+
+// WithDevViewer returns an Option that collects the spans of the last 200 traces in memory and
+// serves them on localhost at port as an HTML trace index and waterfall, so traces can be
+// inspected during development without running Jaeger. The viewer sees every sampled span,
+// before filtering and redaction. Zero (default) disables it.
+func WithDevViewer(port int) Option {
+	return func(o *Options) {
+		o.DevViewerPort = port
+	}
+}
+
+// WithMirror returns an Option that mirrors ratio of the exported traces to the OTLP collector
+// at url, whose scheme selects the transport and TLS as in WithEndpoint, while the primary
+// exporter keeps receiving every span. Traces are selected by trace ID, so they are mirrored
+// whole. An empty url (default) disables mirroring.
+func WithMirror(url string, ratio float64) Option {
+	return func(o *Options) {
+		o.MirrorEndpoint = url
+		o.MirrorRatio = ratio
+	}
+}
+
+// WithIDGenerator returns an Option that sets the generator of trace and span IDs.
+// A nil generator (default) uses random IDs; NewSequentialIDGenerator makes IDs reproducible in tests.
+func WithIDGenerator(generator IDGenerator) Option {
+	return func(o *Options) {
+		o.IDGenerator = generator
+	}
+}
+
+// WithClock returns an Option that sets the clock used to timestamp spans started with
+// StartSpan and ended with EndSpan, so span durations can be asserted in tests.
+// A nil clock (default) leaves timestamps to the SDK.
+func WithClock(c clock.Clock) Option {
+	return func(o *Options) {
+		o.Clock = c
+	}
+}
+
+// WithXRayIDs returns an Option that generates X-Ray compatible trace IDs, whose first 4 bytes
+// are the creation time in Unix seconds, for services exporting to AWS X-Ray through the ADOT
+// collector. It is ignored when an IDGenerator is set.
+func WithXRayIDs(enabled bool) Option {
+	return func(o *Options) {
+		o.XRayIDs = enabled
+	}
+}
+
+// WithXRayPropagation returns an Option that extracts and injects trace context in the X-Ray
+// trace header (X-Amzn-Trace-Id) too, so traces continue from and into load balancers, API
+// Gateway, and services instrumented with the X-Ray SDK. When a request carries both headers,
+// the W3C trace context is used.
+func WithXRayPropagation(enabled bool) Option {
+	return func(o *Options) {
+		o.XRayPropagation = enabled
+	}
+}
+
+// WithOpenTracingBridge returns an Option that creates an OpenTracing bridge over the tracer,
+// returned by OpenTracing, for libraries still instrumented with the OpenTracing API. Spans
+// started through either API share the active span of a context.
+func WithOpenTracingBridge(enabled bool) Option {
+	return func(o *Options) {
+		o.OpenTracingBridge = enabled
+	}
+}
+
+// WithOpenCensusBridge returns an Option that replaces the global OpenCensus tracer with a
+// bridge starting spans on the tracer provider, for dependencies still instrumented with
+// OpenCensus.
+func WithOpenCensusBridge(enabled bool) Option {
+	return func(o *Options) {
+		o.OpenCensusBridge = enabled
+	}
+}
+
+// WithTraceID64 returns an Option that generates trace IDs whose high 64 bits are zero, so
+// downstream systems that only keep 64-bit trace IDs refer to the same traces. It cannot be
+// combined with WithXRayIDs and is ignored when an IDGenerator is set.
+func WithTraceID64(enabled bool) Option {
+	return func(o *Options) {
+		o.TraceID64 = enabled
+	}
+}
@@ -1,8 +1,13 @@
 package tracer
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/redact"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func TestTracer_Option_WithServiceName(t *testing.T) {
@@ -556,3 +561,208 @@ func TestTracer_Option_MultipleOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestTracer_Option_WithRemoteSampling(t *testing.T) {
+	opts := &Options{}
+	WithRemoteSampling("http://localhost:5778/sampling", 30*time.Second)(opts)
+	if opts.RemoteSamplingURL != "http://localhost:5778/sampling" {
+		t.Errorf("WithRemoteSampling() set RemoteSamplingURL = %q, want %q", opts.RemoteSamplingURL, "http://localhost:5778/sampling")
+	}
+	if opts.RemoteSamplingInterval != 30*time.Second {
+		t.Errorf("WithRemoteSampling() set RemoteSamplingInterval = %v, want %v", opts.RemoteSamplingInterval, 30*time.Second)
+	}
+}
+
+func TestTracer_Option_WithCircuitBreaker(t *testing.T) {
+	opts := &Options{}
+	WithCircuitBreaker(3, time.Minute)(opts)
+	if opts.BreakerThreshold != 3 {
+		t.Errorf("WithCircuitBreaker() set BreakerThreshold = %d, want %d", opts.BreakerThreshold, 3)
+	}
+	if opts.BreakerMaxBackoff != time.Minute {
+		t.Errorf("WithCircuitBreaker() set BreakerMaxBackoff = %v, want %v", opts.BreakerMaxBackoff, time.Minute)
+	}
+}
+
+func TestTracer_Option_Validate(t *testing.T) {
+	valid := Options{Provider: "stdout", BatchTimeout: time.Second}
+	tests := []struct {
+		name    string
+		modify  func(o *Options)
+		wantErr error
+	}{
+		{"valid", func(o *Options) {}, nil},
+		{"invalid batch timeout", func(o *Options) { o.BatchTimeout = 0 }, ErrBatchTimeoutInvalid},
+		{"negative breaker threshold", func(o *Options) { o.BreakerThreshold = -1 }, ErrBreakerThresholdInvalid},
+		{"breaker without max backoff", func(o *Options) { o.BreakerThreshold = 3 }, ErrBreakerMaxBackoffInvalid},
+		{"remote sampling without interval", func(o *Options) { o.RemoteSamplingURL = "http://localhost:5778/sampling" }, ErrRemoteSamplingIntervalInvalid},
+		{"negative body snippet limit", func(o *Options) { o.BodyRecording, o.BodySnippetLimit = true, -1 }, ErrBodySnippetLimitInvalid},
+		{"ndjson stdout format", func(o *Options) { o.StdoutFormat = "ndjson" }, nil},
+		{"invalid stdout format", func(o *Options) { o.StdoutFormat = "yaml" }, ErrInvalidStdoutFormat},
+		{"negative long span threshold", func(o *Options) { o.LongSpanThreshold = -time.Second }, ErrLongSpanThresholdInvalid},
+		{"invalid provider", func(o *Options) { o.Provider = "invalid" }, ErrInvalidProvider},
+		{"endpoint replaces provider", func(o *Options) { o.Provider, o.Endpoint = "invalid", "https://collector:4318" }, nil},
+		{"invalid endpoint", func(o *Options) { o.Endpoint = "collector:4317" }, ErrEndpointInvalid},
+		{"otlp without host", func(o *Options) { o.Provider, o.ProviderPort = "otlp", 4317 }, ErrProviderHostRequired},
+		{"otlp without port", func(o *Options) { o.Provider, o.ProviderHost = "otlp", "localhost" }, ErrProviderPortRequired},
+		{"otlp with negative port", func(o *Options) { o.Provider, o.ProviderHost, o.ProviderPort = "otlp", "localhost", -1 }, ErrProviderPortInvalid},
+		{"invalid fallback provider", func(o *Options) { o.FallbackProvider = "invalid" }, ErrInvalidFallbackProvider},
+		{"file fallback without path", func(o *Options) { o.FallbackProvider = "file" }, ErrFallbackPathRequired},
+		{"64-bit and x-ray trace ids", func(o *Options) { o.TraceID64, o.XRayIDs = true, true }, ErrTraceIDFormatConflict},
+		{"id generator replaces trace id formats", func(o *Options) {
+			o.TraceID64, o.XRayIDs, o.IDGenerator = true, true, NewSequentialIDGenerator()
+		}, nil},
+		{"invalid redaction action", func(o *Options) { WithRedaction("mask", "user.email")(o) }, redact.ErrInvalidAction},
+		{"redaction action without keys", func(o *Options) { o.RedactionAction = "mask" }, nil},
+		{"mirror", func(o *Options) { WithMirror("https://vendor:4318/v1/traces", 0.1)(o) }, nil},
+		{"invalid mirror endpoint", func(o *Options) { WithMirror("collector:4317", 0.1)(o) }, ErrMirrorEndpointInvalid},
+		{"zero mirror ratio", func(o *Options) { WithMirror("grpc://vendor:4317", 0)(o) }, ErrMirrorRatioInvalid},
+		{"mirror ratio above one", func(o *Options) { WithMirror("grpc://vendor:4317", 1.5)(o) }, ErrMirrorRatioInvalid},
+		{"dev viewer", func(o *Options) { o.DevViewerPort = 16686 }, nil},
+		{"negative dev viewer port", func(o *Options) { o.DevViewerPort = -1 }, ErrDevViewerPortInvalid},
+		{"dev viewer port out of range", func(o *Options) { o.DevViewerPort = 70000 }, ErrDevViewerPortInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := valid
+			tt.modify(&opts)
+			if err := opts.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTracer_Option_WithEndpoint(t *testing.T) {
+	opts := &Options{}
+	WithEndpoint("grpcs://collector:4317")(opts)
+	if opts.Endpoint != "grpcs://collector:4317" {
+		t.Errorf("WithEndpoint() set Endpoint = %q, want %q", opts.Endpoint, "grpcs://collector:4317")
+	}
+}
+
+func TestTracer_Option_WithStdoutFormat(t *testing.T) {
+	opts := &Options{}
+	WithStdoutFormat("ndjson")(opts)
+	if opts.StdoutFormat != "ndjson" {
+		t.Errorf("expected StdoutFormat %q, got %q", "ndjson", opts.StdoutFormat)
+	}
+}
+
+func TestTracer_Option_WithSimpleProcessor(t *testing.T) {
+	opts := &Options{}
+	WithSimpleProcessor(true)(opts)
+	if !opts.SimpleProcessor {
+		t.Error("WithSimpleProcessor(true) did not set SimpleProcessor")
+	}
+}
+
+func TestTracer_Option_WithColdStart(t *testing.T) {
+	opts := &Options{}
+	WithColdStart(true)(opts)
+	if !opts.ColdStart {
+		t.Error("WithColdStart(true) did not set ColdStart")
+	}
+}
+
+func TestTracer_Option_WithXRayIDs(t *testing.T) {
+	opts := &Options{}
+	WithXRayIDs(true)(opts)
+	if !opts.XRayIDs {
+		t.Error("WithXRayIDs(true) did not set XRayIDs")
+	}
+}
+
+func TestTracer_Option_WithTraceID64(t *testing.T) {
+	opts := &Options{}
+	WithTraceID64(true)(opts)
+	if !opts.TraceID64 {
+		t.Error("WithTraceID64(true) did not set TraceID64")
+	}
+}
+
+func TestTracer_Option_WithSpanFilter(t *testing.T) {
+	opts := &Options{}
+	WithSpanFilter(func(sdktrace.ReadOnlySpan) bool { return true })(opts)
+	if opts.SpanFilter == nil {
+		t.Error("WithSpanFilter() did not set SpanFilter")
+	}
+}
+
+func TestTracer_Option_WithDevViewer(t *testing.T) {
+	opts := &Options{}
+	WithDevViewer(16686)(opts)
+	if opts.DevViewerPort != 16686 {
+		t.Errorf("WithDevViewer() set DevViewerPort = %d, want 16686", opts.DevViewerPort)
+	}
+}
+
+func TestTracer_Option_WithMirror(t *testing.T) {
+	opts := &Options{}
+	WithMirror("grpc://vendor:4317", 0.1)(opts)
+	if opts.MirrorEndpoint != "grpc://vendor:4317" || opts.MirrorRatio != 0.1 {
+		t.Errorf("WithMirror() set %q %v, want grpc://vendor:4317 0.1", opts.MirrorEndpoint, opts.MirrorRatio)
+	}
+}
+
+func TestTracer_Option_WithRedaction(t *testing.T) {
+	opts := &Options{}
+	WithRedaction("hash", "db.statement", "user.email")(opts)
+	if opts.RedactionAction != "hash" || !reflect.DeepEqual(opts.RedactedAttributes, []string{"db.statement", "user.email"}) {
+		t.Errorf("WithRedaction() set %q %v, want hash [db.statement user.email]", opts.RedactionAction, opts.RedactedAttributes)
+	}
+}
+
+func TestTracer_Option_WithXRayPropagation(t *testing.T) {
+	opts := &Options{}
+	WithXRayPropagation(true)(opts)
+	if !opts.XRayPropagation {
+		t.Error("WithXRayPropagation(true) did not set XRayPropagation")
+	}
+}
+
+func TestTracer_Option_WithOpenTracingBridge(t *testing.T) {
+	opts := &Options{}
+	WithOpenTracingBridge(true)(opts)
+	if !opts.OpenTracingBridge {
+		t.Error("WithOpenTracingBridge(true) did not set OpenTracingBridge")
+	}
+}
+
+func TestTracer_Option_WithOpenCensusBridge(t *testing.T) {
+	opts := &Options{}
+	WithOpenCensusBridge(true)(opts)
+	if !opts.OpenCensusBridge {
+		t.Error("WithOpenCensusBridge(true) did not set OpenCensusBridge")
+	}
+}
+
+func TestTracer_Option_WithLongSpanThreshold(t *testing.T) {
+	opts := &Options{}
+	WithLongSpanThreshold(time.Minute)(opts)
+	if opts.LongSpanThreshold != time.Minute {
+		t.Errorf("WithLongSpanThreshold() set LongSpanThreshold = %v, want %v", opts.LongSpanThreshold, time.Minute)
+	}
+	WithLongSpanHandler(func(LongSpan) {})(opts)
+	if opts.LongSpanHandler == nil {
+		t.Error("WithLongSpanHandler() did not set LongSpanHandler")
+	}
+}
+
+func TestTracer_Option_WithSamplingRules(t *testing.T) {
+	opts := &Options{}
+	rules := []SamplingRule{{Name: "GET /healthz", Ratio: 0}}
+	WithSamplingRules(rules...)(opts)
+	if !reflect.DeepEqual(opts.SamplingRules, rules) {
+		t.Errorf("WithSamplingRules() set SamplingRules = %v, want %v", opts.SamplingRules, rules)
+	}
+}
+
+func TestTracer_Option_WithIgnoredRoutes(t *testing.T) {
+	opts := &Options{}
+	WithIgnoredRoutes("/healthz", "/metrics")(opts)
+	if want := []string{"/healthz", "/metrics"}; !reflect.DeepEqual(opts.IgnoredRoutes, want) {
+		t.Errorf("WithIgnoredRoutes() set IgnoredRoutes = %v, want %v", opts.IgnoredRoutes, want)
+	}
+}
@@ -0,0 +1,91 @@
+package tracer
+
+import (
+	"context"
+
+	"github.com/adityakw90/go-monitoring/internal/redact"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// redactProcessor is a span processor that redacts the attributes of ended spans and their
+// events according to policy before passing them to next, so the redacted values are never
+// exported. Processors registered with WithSpanProcessors still see the original values.
+type redactProcessor struct {
+	next   sdktrace.SpanProcessor
+	policy *redact.Policy
+}
+
+// newRedactProcessor returns next wrapped with policy, or next itself when policy is nil.
+func newRedactProcessor(next sdktrace.SpanProcessor, policy *redact.Policy) sdktrace.SpanProcessor {
+	if policy == nil {
+		return next
+	}
+	return &redactProcessor{next: next, policy: policy}
+}
+
+// OnStart passes s to the next processor.
+func (p *redactProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd passes s to the next processor with its attributes redacted.
+func (p *redactProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.next.OnEnd(&redactedSpan{ReadOnlySpan: s, policy: p.policy})
+}
+
+// Shutdown shuts down the next processor.
+func (p *redactProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next processor.
+func (p *redactProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// redactedSpan is an ended span whose attributes and event attributes are redacted.
+type redactedSpan struct {
+	sdktrace.ReadOnlySpan
+	policy *redact.Policy
+}
+
+// Attributes returns the redacted attributes of the span.
+func (s *redactedSpan) Attributes() []attribute.KeyValue {
+	return redactAttributes(s.ReadOnlySpan.Attributes(), s.policy)
+}
+
+// Events returns the events of the span with their attributes redacted.
+func (s *redactedSpan) Events() []sdktrace.Event {
+	events := s.ReadOnlySpan.Events()
+	redacted := make([]sdktrace.Event, len(events))
+	for i, event := range events {
+		redacted[i] = event
+		redacted[i].Attributes = redactAttributes(event.Attributes, s.policy)
+	}
+	return redacted
+}
+
+// redactAttributes returns attrs without the attributes policy deletes and with the values it
+// hashes replaced by their hash. attrs is returned unchanged when nothing is redacted.
+func redactAttributes(attrs []attribute.KeyValue, policy *redact.Policy) []attribute.KeyValue {
+	var redacted []attribute.KeyValue
+	for i, kv := range attrs {
+		if !policy.Redacts(string(kv.Key)) {
+			if redacted != nil {
+				redacted = append(redacted, kv)
+			}
+			continue
+		}
+		if redacted == nil {
+			redacted = append(make([]attribute.KeyValue, 0, len(attrs)), attrs[:i]...)
+		}
+		if policy.Hashes() {
+			redacted = append(redacted, kv.Key.String(redact.Hash(kv.Value.Emit())))
+		}
+	}
+	if redacted == nil {
+		return attrs
+	}
+	return redacted
+}
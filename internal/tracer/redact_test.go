@@ -0,0 +1,77 @@
+package tracer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/adityakw90/go-monitoring/internal/redact"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer_Redact_NewRedactProcessor(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     *redact.Policy
+		wantAttrs  []attribute.KeyValue
+		wantEvents []attribute.KeyValue
+	}{
+		{
+			name:       "nil policy exports attributes unchanged",
+			policy:     nil,
+			wantAttrs:  []attribute.KeyValue{attribute.String("db.statement", "SELECT 1"), attribute.String("user.email", "alice@example.com"), attribute.Int("user.id", 7)},
+			wantEvents: []attribute.KeyValue{attribute.String("user.email", "alice@example.com")},
+		},
+		{
+			name:       "delete removes the keys",
+			policy:     redact.New(redact.ActionDelete, []string{"db.statement", "user.email"}),
+			wantAttrs:  []attribute.KeyValue{attribute.Int("user.id", 7)},
+			wantEvents: []attribute.KeyValue{},
+		},
+		{
+			name:       "hash replaces the values",
+			policy:     redact.New(redact.ActionHash, []string{"user.email"}),
+			wantAttrs:  []attribute.KeyValue{attribute.String("db.statement", "SELECT 1"), attribute.String("user.email", redact.Hash("alice@example.com")), attribute.Int("user.id", 7)},
+			wantEvents: []attribute.KeyValue{attribute.String("user.email", redact.Hash("alice@example.com"))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			observed := tracetest.NewInMemoryExporter()
+			provider := sdktrace.NewTracerProvider(
+				sdktrace.WithSpanProcessor(sdktrace.NewSimpleSpanProcessor(observed)),
+				sdktrace.WithSpanProcessor(newRedactProcessor(sdktrace.NewSimpleSpanProcessor(exporter), tt.policy)),
+			)
+			defer func() {
+				_ = provider.Shutdown(context.Background())
+			}()
+
+			_, span := provider.Tracer("test").Start(context.Background(), "query", trace.WithAttributes(
+				attribute.String("db.statement", "SELECT 1"),
+				attribute.String("user.email", "alice@example.com"),
+				attribute.Int("user.id", 7),
+			))
+			span.AddEvent("login", trace.WithAttributes(attribute.String("user.email", "alice@example.com")))
+			span.End()
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("exported %d spans, want 1", len(spans))
+			}
+			if got := spans[0].Attributes; !reflect.DeepEqual(got, tt.wantAttrs) {
+				t.Errorf("span attributes = %v, want %v", got, tt.wantAttrs)
+			}
+			if got := spans[0].Events[0].Attributes; !reflect.DeepEqual(got, tt.wantEvents) {
+				t.Errorf("event attributes = %v, want %v", got, tt.wantEvents)
+			}
+			if got := observed.GetSpans()[0].Attributes; len(got) != 3 {
+				t.Errorf("other processors saw attributes %v, want the original ones", got)
+			}
+		})
+	}
+}
@@ -5,66 +5,213 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+	"github.com/adityakw90/go-monitoring/internal/endpoint"
+	"github.com/adityakw90/go-monitoring/internal/redact"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc/credentials"
 )
 
 // NewTracer creates and configures an OpenTelemetry Tracer according to the provided Options.
-// Defaults are provider "stdout", sample ratio 1.0 (always sample), and a 5s batch timeout.
+// Defaults are provider "stdout", sample ratio 1.0 (always sample), a 5s batch timeout, a
+// circuit breaker that opens after 5 consecutive export failures with a backoff of up to 5 minutes,
+// and the DefaultScrubbedHeaders and DefaultScrubbedQueryParams scrubbed from HTTP attributes.
+// When a remote sampling URL is set, the sampling ratio is additionally polled from that endpoint.
+// The resource and the tracer carry the schema URL of the semconv version the attributes follow (v1.26.0).
 // It returns an initialized Tracer or an error if validation fails (for example invalid batch timeout,
 // missing/invalid OTLP host or port, or an unsupported provider) or if resource/exporter creation fails.
 func NewTracer(opts ...Option) (Tracer, error) {
 	options := &Options{
-		Provider:     "stdout",
-		SampleRatio:  1.0,
-		BatchTimeout: 5 * time.Second,
+		Provider:            "stdout",
+		SampleRatio:         1.0,
+		BatchTimeout:        5 * time.Second,
+		BreakerThreshold:    5,
+		BreakerMaxBackoff:   5 * time.Minute,
+		ScrubbedHeaders:     DefaultScrubbedHeaders(),
+		ScrubbedQueryParams: DefaultScrubbedQueryParams(),
 	}
 
 	for _, opt := range opts {
 		opt(options)
 	}
 
-	// validate batch timeout
-	if options.BatchTimeout <= 0 {
-		return nil, ErrBatchTimeoutInvalid
+	if err := options.Validate(); err != nil {
+		return nil, err
 	}
 
 	// Create resource with service name
 	res, err := resource.New(
 		context.Background(),
-		resource.WithAttributes(
-			semconv.ServiceInstanceIDKey.String(options.InstanceName),
-			semconv.HostNameKey.String(options.InstanceHost),
-			semconv.DeploymentEnvironmentKey.String(options.Environment),
-			semconv.ServiceNameKey.String(options.ServiceName),
-		),
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(options.ResourceAttributes...),
+		resource.WithAttributes(identityAttributes(options)...),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource: %w", err)
 	}
 
-	// Select the exporter based on the config
-	var exporter sdktrace.SpanExporter
-	switch options.Provider {
-	case "stdout":
-		exporter, err = stdouttrace.New(
-			stdouttrace.WithPrettyPrint(),
-		)
-	case "otlp":
-		if options.ProviderHost == "" {
-			return nil, ErrProviderHostRequired
+	exporter, err := newExporter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	mirror, err := newMirrorExporter(options)
+	if err != nil {
+		_ = exporter.Shutdown(context.Background())
+		return nil, err
+	}
+
+	processor := newSpanProcessor(exporter, mirror, options)
+	sampler := newDynamicSampler(options.SampleRatio)
+	sampler.setRules(options.SamplingRules)
+
+	var remote *remoteSampling
+	if options.RemoteSamplingURL != "" {
+		remote, err = newRemoteSampling(options.RemoteSamplingURL, options.ServiceName, options.RemoteSamplingInterval, sampler)
+		if err != nil {
+			_ = processor.Shutdown(context.Background())
+			return nil, err
 		}
-		if options.ProviderPort == 0 {
-			return nil, ErrProviderPortRequired
+	}
+
+	var viewer *traceViewer
+	if options.DevViewerPort > 0 {
+		if viewer, err = newTraceViewer(options.DevViewerPort); err != nil {
+			if remote != nil {
+				_ = remote.shutdown(context.Background())
+			}
+			_ = processor.Shutdown(context.Background())
+			return nil, err
 		}
-		if options.ProviderPort < 0 {
-			return nil, ErrProviderPortInvalid
+	}
+
+	var providerOpts []sdktrace.TracerProviderOption
+	if options.ColdStart {
+		// registered first so the attribute is set before the exporting processor sees the span
+		providerOpts = append(providerOpts, sdktrace.WithSpanProcessor(&coldStartProcessor{}))
+	}
+	if options.ContextAnnotations {
+		providerOpts = append(providerOpts, sdktrace.WithSpanProcessor(newContextDoneProcessor()))
+	}
+	if options.LongSpanThreshold > 0 {
+		providerOpts = append(providerOpts, sdktrace.WithSpanProcessor(newLongSpanProcessor(options.LongSpanThreshold, options.LongSpanHandler, options.Clock)))
+	}
+	for _, p := range options.SpanProcessors {
+		providerOpts = append(providerOpts, sdktrace.WithSpanProcessor(p))
+	}
+	if viewer != nil {
+		providerOpts = append(providerOpts, sdktrace.WithSpanProcessor(viewer))
+	}
+	swap := newSwapProcessor(processor)
+	providerOpts = append(providerOpts,
+		sdktrace.WithSpanProcessor(swap),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sampler),
+	)
+	if options.IDGenerator != nil {
+		providerOpts = append(providerOpts, sdktrace.WithIDGenerator(options.IDGenerator))
+	} else if options.XRayIDs {
+		providerOpts = append(providerOpts, sdktrace.WithIDGenerator(NewXRayIDGenerator()))
+	} else if options.TraceID64 {
+		providerOpts = append(providerOpts, sdktrace.WithIDGenerator(NewTraceID64Generator()))
+	}
+	tp := sdktrace.NewTracerProvider(providerOpts...)
+
+	t := &tracer{
+		provider:   tp,
+		tracer:     tp.Tracer(scopeName(options), trace.WithInstrumentationVersion(options.ScopeVersion), trace.WithSchemaURL(semconv.SchemaURL)),
+		propagator: NewPropagator(options.XRayPropagation),
+		options:    options,
+		processor:  processor,
+		swap:       swap,
+		sampler:    sampler,
+		remote:     remote,
+		clock:      options.Clock,
+	}
+	if options.OpenTracingBridge {
+		t.enableOpenTracing()
+	}
+	if options.OpenCensusBridge {
+		installOpenCensusBridge(tp)
+	}
+	t.setIgnoredRoutes(options.IgnoredRoutes)
+	t.setHTTPScrubber(options)
+	t.setBodyRecording(options)
+	return t, nil
+}
+
+// newSpanProcessor returns the processor feeding exporter, and mirror when it is not nil: a
+// simple processor exporting each span as it ends when options.SimpleProcessor is set, a batch
+// processor otherwise, behind options.SpanFilter and the redaction of
+// options.RedactedAttributes when they are set.
+func newSpanProcessor(exporter, mirror sdktrace.SpanExporter, options *Options) sdktrace.SpanProcessor {
+	processor := newExportingProcessor(exporter, options)
+	if mirror != nil {
+		processor = newMirrorProcessor(processor, newExportingProcessor(mirror, options), options.MirrorRatio)
+	}
+	processor = newRedactProcessor(processor, redact.New(options.RedactionAction, options.RedactedAttributes))
+	return newFilterProcessor(processor, options.SpanFilter)
+}
+
+// newExportingProcessor returns a simple processor feeding exporter when
+// options.SimpleProcessor is set, a batch processor otherwise.
+func newExportingProcessor(exporter sdktrace.SpanExporter, options *Options) sdktrace.SpanProcessor {
+	if options.SimpleProcessor {
+		return sdktrace.NewSimpleSpanProcessor(exporter)
+	}
+	return sdktrace.NewBatchSpanProcessor(
+		exporter,
+		sdktrace.WithBatchTimeout(options.BatchTimeout),
+	)
+}
+
+// newMirrorExporter creates the OTLP exporter for options.MirrorEndpoint, or returns nil when
+// it is not set. Mirrored spans are neither guarded by the circuit breaker nor spilled to the
+// fallback exporter: a failing mirror only loses its own copy.
+func newMirrorExporter(options *Options) (sdktrace.SpanExporter, error) {
+	if options.MirrorEndpoint == "" {
+		return nil, nil
+	}
+	exporter, err := newEndpointExporter(options.MirrorEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mirror exporter: %w", err)
+	}
+	return exporter, nil
+}
+
+// newExporter creates the span exporter selected by options.Endpoint or options.Provider,
+// guarded by a circuit breaker when options.BreakerThreshold is set and wrapped with the
+// fallback exporter when options.FallbackProvider is set, so spans rejected by an open breaker
+// are spilled as well.
+// options must have passed Validate. It returns ErrInvalidProvider for an unsupported provider,
+// ErrInvalidFallbackProvider or ErrFallbackPathRequired for a misconfigured fallback, and a
+// wrapped error if an exporter itself cannot be created.
+func newExporter(options *Options) (sdktrace.SpanExporter, error) {
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch {
+	case options.Endpoint != "":
+		exporter, err = newEndpointExporter(options.Endpoint)
+	case options.Provider == "stdout":
+		var stdoutOpts []stdouttrace.Option
+		if options.Writer != nil {
+			stdoutOpts = append(stdoutOpts, stdouttrace.WithWriter(options.Writer))
 		}
+		if options.StdoutFormat != "ndjson" {
+			stdoutOpts = append(stdoutOpts, stdouttrace.WithPrettyPrint())
+		}
+		exporter, err = stdouttrace.New(stdoutOpts...)
+	case options.Provider == "otlp":
 		otlpOpts := []otlptracegrpc.Option{
 			otlptracegrpc.WithEndpoint(
 				fmt.Sprintf("%s:%d", options.ProviderHost, options.ProviderPort),
@@ -83,30 +230,76 @@ func NewTracer(opts ...Option) (Tracer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create exporter: %w", err)
 	}
-
-	// Create a sampler with the ratio from config
-	var sampler sdktrace.Sampler
-	switch {
-	case options.SampleRatio <= 0:
-		sampler = sdktrace.NeverSample()
-	case options.SampleRatio >= 1.0:
-		sampler = sdktrace.AlwaysSample()
-	default:
-		sampler = sdktrace.TraceIDRatioBased(options.SampleRatio)
+	if options.BreakerThreshold > 0 {
+		exporter = breaker.WrapSpanExporter(exporter, newBreaker(options))
 	}
 
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(
-			exporter,
-			sdktrace.WithBatchTimeout(options.BatchTimeout),
-		),
-		sdktrace.WithResource(res),
-		sdktrace.WithSampler(sampler),
-	)
+	wrapped, err := newFallbackExporter(exporter, options)
+	if err != nil {
+		_ = exporter.Shutdown(context.Background())
+		return nil, err
+	}
+	return wrapped, nil
+}
 
+// NewNoopTracer returns a Tracer that records and exports nothing.
+// It is used when tracing is disabled but callers still expect a non-nil Tracer. Trace context
+// is still extracted and injected, so a disabled service does not break propagation between
+// the services around it.
+func NewNoopTracer() Tracer {
 	return &tracer{
-		provider:   tp,
-		tracer:     tp.Tracer(options.ServiceName),
+		tracer:     noop.NewTracerProvider().Tracer(""),
 		propagator: propagation.TraceContext{},
-	}, nil
+	}
+}
+
+// newEndpointExporter creates an OTLP span exporter for a collector URL. The scheme selects
+// gRPC or HTTP and whether TLS is used; see WithEndpoint.
+func newEndpointExporter(rawURL string) (sdktrace.SpanExporter, error) {
+	ep, err := endpoint.Parse(rawURL)
+	if err != nil {
+		return nil, ErrEndpointInvalid
+	}
+
+	if ep.Protocol == endpoint.ProtocolHTTP {
+		httpOpts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(ep.Address),
+		}
+		if ep.Path != "" {
+			httpOpts = append(httpOpts, otlptracehttp.WithURLPath(ep.Path))
+		}
+		if ep.Insecure {
+			httpOpts = append(httpOpts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(context.Background(), httpOpts...)
+	}
+
+	grpcOpts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(ep.Address),
+	}
+	if ep.Insecure {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithInsecure())
+	} else {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, ep.Host)))
+	}
+	return otlptracegrpc.New(context.Background(), grpcOpts...)
+}
+
+// newBreaker creates the exporter circuit breaker described by options and reports its
+// initial closed state, so a handler tracking the state is reset when the exporter is replaced.
+func newBreaker(options *Options) *breaker.Breaker {
+	b := breaker.New(options.BreakerThreshold, options.BreakerMaxBackoff, options.BreakerStateHandler)
+	if options.BreakerStateHandler != nil {
+		options.BreakerStateHandler(breaker.StateClosed)
+	}
+	return b
+}
+
+// scopeName returns the instrumentation scope name of the tracer: options.ScopeName, or else
+// the service name.
+func scopeName(options *Options) string {
+	if options.ScopeName != "" {
+		return options.ScopeName
+	}
+	return options.ServiceName
 }
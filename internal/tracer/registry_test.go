@@ -1,10 +1,20 @@
 package tracer
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"strings"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestTracer_NewTracer(t *testing.T) {
@@ -49,6 +59,22 @@ func TestTracer_NewTracer(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:    "with grpc endpoint",
+			opts:    []Option{WithServiceName("test-service"), WithEndpoint("grpc://localhost:4317")},
+			wantErr: false,
+		},
+		{
+			name:    "with https endpoint",
+			opts:    []Option{WithServiceName("test-service"), WithEndpoint("https://localhost:4318/custom/path")},
+			wantErr: false,
+		},
+		{
+			name:      "with invalid endpoint",
+			opts:      []Option{WithServiceName("test-service"), WithEndpoint("localhost:4317")},
+			wantErr:   true,
+			wantErrIs: ErrEndpointInvalid,
+		},
 		{
 			name:      "with invalid provider",
 			opts:      []Option{WithServiceName("test-service"), WithProvider("invalid", "", 0)},
@@ -152,3 +178,210 @@ func TestTracer_NewTracer(t *testing.T) {
 		})
 	}
 }
+
+func TestTracer_Registry_NewTracer_ResourceAttributes(t *testing.T) {
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithProvider("stdout", "", 0),
+		WithResourceAttributes(
+			attribute.String("k8s.pod.name", "api-0"),
+			attribute.String("service.name", "overridden"),
+			attribute.String("host.name", "node-7"),
+			attribute.String("deployment.environment", "prod"),
+		),
+		WithInstance("api-1", ""),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tracerInstance.Shutdown(context.Background())
+	}()
+
+	_, span := tracerInstance.StartSpan(context.Background(), "resource")
+	defer span.End()
+	res := span.(sdktrace.ReadOnlySpan).Resource()
+	if value, ok := res.Set().Value("k8s.pod.name"); !ok || value.AsString() != "api-0" {
+		t.Errorf("resource k8s.pod.name = %v, want api-0", value)
+	}
+	if value, _ := res.Set().Value("service.name"); value.AsString() != "test-service" {
+		t.Errorf("resource service.name = %v, want the service identity to take precedence", value)
+	}
+	if value, _ := res.Set().Value("service.instance.id"); value.AsString() != "api-1" {
+		t.Errorf("resource service.instance.id = %v, want the configured instance", value)
+	}
+	if value, _ := res.Set().Value("host.name"); value.AsString() != "node-7" {
+		t.Errorf("resource host.name = %v, want the unset host to keep the attribute", value)
+	}
+	if value, _ := res.Set().Value("deployment.environment"); value.AsString() != "prod" {
+		t.Errorf("resource deployment.environment = %v, want the unset environment to keep the attribute", value)
+	}
+	if res.SchemaURL() != semconv.SchemaURL {
+		t.Errorf("resource SchemaURL() = %q, want %q", res.SchemaURL(), semconv.SchemaURL)
+	}
+	if scope := span.(sdktrace.ReadOnlySpan).InstrumentationScope(); scope.SchemaURL != semconv.SchemaURL {
+		t.Errorf("scope SchemaURL = %q, want %q", scope.SchemaURL, semconv.SchemaURL)
+	}
+}
+
+func TestTracer_Registry_NewTracer_InstrumentationScope(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []Option
+		wantName    string
+		wantVersion string
+	}{
+		{"service name by default", nil, "test-service", ""},
+		{"custom scope", []Option{WithInstrumentationScope("github.com/acme/api", "v1.8.0")}, "github.com/acme/api", "v1.8.0"},
+		{"version only", []Option{WithInstrumentationScope("", "v1.8.0")}, "test-service", "v1.8.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracerInstance, err := NewTracer(append([]Option{WithServiceName("test-service"), WithProvider("stdout", "", 0)}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("NewTracer() error = %v", err)
+			}
+			defer func() {
+				_ = tracerInstance.Shutdown(context.Background())
+			}()
+
+			_, span := tracerInstance.StartSpan(context.Background(), "scope")
+			defer span.End()
+			scope := span.(sdktrace.ReadOnlySpan).InstrumentationScope()
+			if scope.Name != tt.wantName || scope.Version != tt.wantVersion {
+				t.Errorf("scope = %q %q, want %q %q", scope.Name, scope.Version, tt.wantName, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestTracer_Registry_NewNoopTracer(t *testing.T) {
+	tracerInstance := NewNoopTracer()
+	if tracerInstance == nil {
+		t.Fatal("NewNoopTracer() returned nil")
+	}
+
+	ctx, span := tracerInstance.StartSpan(context.Background(), "noop-span")
+	if span.SpanContext().IsValid() {
+		t.Error("expected noop span to have an invalid span context")
+	}
+	tracerInstance.EndSpan(span)
+
+	// Incoming trace context must still propagate through a noop tracer.
+	incoming := metadata.Pairs("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx = tracerInstance.ExtractContext(ctx, incoming)
+	_, child := tracerInstance.StartSpan(ctx, "noop-child")
+	if child.SpanContext().TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected trace ID to propagate, got %s", child.SpanContext().TraceID())
+	}
+	outgoing := tracerInstance.InjectContext(ctx)
+	if len(outgoing.Get("traceparent")) == 0 {
+		t.Error("expected traceparent to be injected")
+	}
+
+	if err := tracerInstance.(Reloader).Reload(WithSampleRatio(0)); err != nil {
+		t.Errorf("Reload() error = %v", err)
+	}
+	if err := tracerInstance.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+}
+
+func TestTracer_Registry_NewTracer_Serverless(t *testing.T) {
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithProvider("stdout", "", 0),
+		WithSimpleProcessor(true),
+		WithColdStart(true),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tracerInstance.Shutdown(context.Background())
+	}()
+
+	_, span := tracerInstance.StartSpan(context.Background(), "invocation")
+	defer span.End()
+	want := semconv.FaaSColdstart(true)
+	for _, attr := range span.(sdktrace.ReadOnlySpan).Attributes() {
+		if attr == want {
+			return
+		}
+	}
+	t.Errorf("span attributes = %v, want %v", span.(sdktrace.ReadOnlySpan).Attributes(), want)
+}
+
+func TestTracer_Registry_NewTracer_SpanProcessors(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithProvider("stdout", "", 0),
+		WithWriter(io.Discard),
+		WithSpanProcessors(sdktrace.NewSimpleSpanProcessor(exporter)),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tracerInstance.Shutdown(context.Background())
+	}()
+
+	_, span := tracerInstance.StartSpan(context.Background(), "observed")
+	span.End()
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || spans[0].Name != "observed" {
+		t.Errorf("processor saw spans %v, want [observed]", spans.Snapshots())
+	}
+}
+
+func TestTracer_Registry_NewTracer_Writer(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		wantLines int
+	}{
+		{"ndjson writes one line per span", "ndjson", 2},
+		{"pretty writes indented JSON", "pretty", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			tracerInstance, err := NewTracer(
+				WithServiceName("test-service"),
+				WithProvider("stdout", "", 0),
+				WithStdoutFormat(tt.format),
+				WithWriter(&out),
+				WithSimpleProcessor(true),
+			)
+			if err != nil {
+				t.Fatalf("NewTracer() error = %v", err)
+			}
+			for _, name := range []string{"first", "second"} {
+				_, span := tracerInstance.StartSpan(context.Background(), name)
+				span.End()
+			}
+			if err := tracerInstance.Shutdown(context.Background()); err != nil {
+				t.Fatalf("Shutdown() error = %v", err)
+			}
+
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			if tt.wantLines == 0 {
+				if len(lines) <= 2 {
+					t.Errorf("expected indented output, got %q", out.String())
+				}
+				return
+			}
+			if len(lines) != tt.wantLines {
+				t.Fatalf("expected %d lines, got %d: %q", tt.wantLines, len(lines), out.String())
+			}
+			for _, line := range lines {
+				var span struct{ Name string }
+				if err := json.Unmarshal([]byte(line), &span); err != nil || span.Name == "" {
+					t.Errorf("expected a JSON span per line, got %q (error %v)", line, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package tracer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// remoteSamplingTimeout bounds a single request to the remote sampling endpoint.
+const remoteSamplingTimeout = 10 * time.Second
+
+// samplingStrategy is the subset of the Jaeger sampling strategy response used by remote sampling.
+// Only probabilistic strategies are supported, e.g.:
+//
+//	{"strategyType": "PROBABILISTIC", "probabilisticSampling": {"samplingRate": 0.25}}
+type samplingStrategy struct {
+	StrategyType          string `json:"strategyType"`
+	ProbabilisticSampling *struct {
+		SamplingRate float64 `json:"samplingRate"`
+	} `json:"probabilisticSampling"`
+}
+
+// remoteSampling periodically fetches the sampling ratio from a remote endpoint and applies
+// it to a dynamicSampler. When a fetch fails the current ratio is kept.
+type remoteSampling struct {
+	url     string
+	client  *http.Client
+	sampler *dynamicSampler
+
+	ctx    context.Context    // ctx is the context of the polls, canceled by shutdown.
+	cancel context.CancelFunc // cancel stops polling and aborts an in-flight poll.
+	done   chan struct{}      // done is closed when the poller has stopped.
+}
+
+// newRemoteSampling creates a poller for rawURL and starts polling immediately and then every
+// interval. If rawURL has no "service" query parameter, serviceName is added so Jaeger-style
+// endpoints can return the per-service strategy.
+func newRemoteSampling(rawURL, serviceName string, interval time.Duration, sampler *dynamicSampler) (*remoteSampling, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote sampling url: %w", err)
+	}
+	query := u.Query()
+	if query.Get("service") == "" && serviceName != "" {
+		query.Set("service", serviceName)
+		u.RawQuery = query.Encode()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &remoteSampling{
+		url:     u.String(),
+		client:  &http.Client{Timeout: remoteSamplingTimeout},
+		sampler: sampler,
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go r.run(interval)
+	return r, nil
+}
+
+// run polls the endpoint until shutdown is called.
+// Fetch errors are reported to the global OpenTelemetry error handler, except the one of a
+// poll aborted by shutdown.
+func (r *remoteSampling) run(interval time.Duration) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.poll(r.ctx); err != nil && r.ctx.Err() == nil {
+			otel.Handle(err)
+		}
+		select {
+		case <-ticker.C:
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+// poll fetches the current strategy and applies its sampling rate.
+func (r *remoteSampling) poll(ctx context.Context) error {
+	ratio, err := r.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch remote sampling strategy: %w", err)
+	}
+	r.sampler.setRatio(ratio)
+	return nil
+}
+
+// fetch requests the sampling strategy and returns its probabilistic sampling rate.
+func (r *remoteSampling) fetch(ctx context.Context) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var strategy samplingStrategy
+	if err := json.NewDecoder(resp.Body).Decode(&strategy); err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if strategy.ProbabilisticSampling == nil {
+		return 0, errors.New("unsupported sampling strategy, only probabilistic sampling is supported")
+	}
+	return strategy.ProbabilisticSampling.SamplingRate, nil
+}
+
+// shutdown stops polling, aborting an in-flight poll, and waits for the poller to stop or ctx
+// to be done, whichever comes first. It returns ctx.Err() when ctx is done first.
+func (r *remoteSampling) shutdown(ctx context.Context) error {
+	r.cancel()
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
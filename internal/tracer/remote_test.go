@@ -0,0 +1,208 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTracer_Remote_Fetch(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantRatio float64
+		wantErr   bool
+	}{
+		{
+			name:      "probabilistic strategy",
+			status:    http.StatusOK,
+			body:      `{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":0.25}}`,
+			wantRatio: 0.25,
+		},
+		{
+			name:    "unsupported strategy",
+			status:  http.StatusOK,
+			body:    `{"strategyType":"RATE_LIMITING","rateLimitingSampling":{"maxTracesPerSecond":10}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			status:  http.StatusOK,
+			body:    `not json`,
+			wantErr: true,
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			body:    ``,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotService atomic.Value
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotService.Store(r.URL.Query().Get("service"))
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			sampler := newDynamicSampler(1.0)
+			remote, err := newRemoteSampling(server.URL, "test-service", time.Hour, sampler)
+			if err != nil {
+				t.Fatalf("newRemoteSampling() error = %v", err)
+			}
+			defer func() {
+				_ = remote.shutdown(context.Background())
+			}()
+
+			ratio, err := remote.fetch(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fetch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && ratio != tt.wantRatio {
+				t.Errorf("fetch() ratio = %v, want %v", ratio, tt.wantRatio)
+			}
+			if gotService.Load() != "test-service" {
+				t.Errorf("expected service query parameter 'test-service', got %v", gotService.Load())
+			}
+		})
+	}
+}
+
+func TestTracer_Remote_Poll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":0}}`))
+	}))
+	defer server.Close()
+
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithRemoteSampling(server.URL+"?service=custom", time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tracerInstance.Shutdown(ctx)
+	}()
+
+	tr := tracerInstance.(*tracer)
+	if tr.remote.url != server.URL+"?service=custom" {
+		t.Errorf("expected explicit service parameter to be kept, got %q", tr.remote.url)
+	}
+
+	// The first poll happens immediately; wait for it to switch sampling off.
+	deadline := time.Now().Add(5 * time.Second)
+	for tr.sampler.Description() != sdktrace.NeverSample().Description() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for remote sampling ratio to apply")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestTracer_Remote_NewTracer_InvalidInterval(t *testing.T) {
+	_, err := NewTracer(
+		WithServiceName("test-service"),
+		WithRemoteSampling("http://localhost:5778/sampling", 0),
+	)
+	if !errors.Is(err, ErrRemoteSamplingIntervalInvalid) {
+		t.Errorf("NewTracer() error = %v, want ErrRemoteSamplingIntervalInvalid", err)
+	}
+}
+
+func TestTracer_Remote_Shutdown(t *testing.T) {
+	polled := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polled <- struct{}{}
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	remote, err := newRemoteSampling(server.URL, "test-service", time.Hour, newDynamicSampler(1.0))
+	if err != nil {
+		t.Fatalf("newRemoteSampling() error = %v", err)
+	}
+	<-polled
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := remote.shutdown(ctx); err != nil {
+		t.Errorf("shutdown() error = %v, want nil", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("shutdown() took %v with a hanging poll, want it aborted", elapsed)
+	}
+}
+
+func TestTracer_Remote_Reload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":0.25}}`))
+	}))
+	defer server.Close()
+
+	tracerInstance, err := NewTracer(WithServiceName("test-service"), WithWriter(io.Discard))
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tracerInstance.Shutdown(context.Background())
+	}()
+	tr := tracerInstance.(*tracer)
+
+	if err := tr.Reload(WithRemoteSampling(server.URL, time.Hour)); err != nil {
+		t.Fatalf("Reload() enabling remote sampling error = %v", err)
+	}
+	if tr.remote == nil {
+		t.Fatal("Reload() enabling remote sampling did not start the poller")
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for tr.SampleRatio() != 0.25 {
+		if time.Now().After(deadline) {
+			t.Fatalf("SampleRatio() = %v after Reload, want the remote ratio 0.25", tr.SampleRatio())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	previous := tr.remote
+	if err := tr.Reload(WithRemoteSampling(server.URL, time.Minute)); err != nil {
+		t.Fatalf("Reload() changing the interval error = %v", err)
+	}
+	if tr.remote == previous {
+		t.Error("Reload() changing the interval did not restart the poller")
+	}
+	select {
+	case <-previous.done:
+	default:
+		t.Error("Reload() did not stop the previous poller")
+	}
+
+	if err := tr.Reload(WithRemoteSampling("", 0)); err != nil {
+		t.Fatalf("Reload() disabling remote sampling error = %v", err)
+	}
+	if tr.remote != nil {
+		t.Error("Reload() disabling remote sampling kept the poller")
+	}
+	if got := tr.SampleRatio(); got != 1.0 {
+		t.Errorf("SampleRatio() = %v after disabling remote sampling, want the configured 1.0", got)
+	}
+}
@@ -0,0 +1,82 @@
+package tracer
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// SamplingRule assigns a sampling ratio to the root spans it matches, for example to drop
+// health checks while sampling everything else. A span matches when both its name and its
+// attributes match; a rule with neither set matches every span.
+type SamplingRule struct {
+	Name       string            // Name is the span name to match, e.g. "GET /healthz". A trailing "*" matches any name with the preceding prefix. Empty matches every name. A server span started before routing, whose name is only the method, also matches by its method and url.path attribute.
+	Attributes map[string]string // Attributes must all be set when the span starts, with these values in their string form. Attributes added after the start are not seen.
+	Ratio      float64           // Ratio is the sampling ratio of matching spans (0.0 to 1.0).
+}
+
+// matches reports whether the span described by p matches the rule.
+func (r SamplingRule) matches(p sdktrace.SamplingParameters) bool {
+	if !r.matchesName(p.Name) && !r.matchesName(requestName(p.Attributes)) {
+		return false
+	}
+	for key, want := range r.Attributes {
+		if value, ok := attributeValue(p.Attributes, key); !ok || value != want {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesName reports whether name matches the rule's Name.
+func (r SamplingRule) matchesName(name string) bool {
+	if prefix, ok := strings.CutSuffix(r.Name, "*"); ok {
+		return strings.HasPrefix(name, prefix)
+	}
+	return r.Name == "" || r.Name == name
+}
+
+// requestName returns "<method> <path>" from the http.request.method and url.path attributes,
+// the name a server span gets once routed, or "" when either is missing. SpanFromRequest
+// starts a span before the request is routed, so the span name alone is only the method.
+func requestName(attrs []attribute.KeyValue) string {
+	method, ok := attributeValue(attrs, string(semconv.HTTPRequestMethodKey))
+	if !ok {
+		return ""
+	}
+	path, ok := attributeValue(attrs, string(semconv.URLPathKey))
+	if !ok {
+		return ""
+	}
+	return method + " " + path
+}
+
+// attributeValue returns the string form of the value of key in attrs.
+func attributeValue(attrs []attribute.KeyValue, key string) (string, bool) {
+	for _, attr := range attrs {
+		if string(attr.Key) == key {
+			return attr.Value.Emit(), true
+		}
+	}
+	return "", false
+}
+
+// samplingRule is a SamplingRule with the sampler for its ratio built once.
+type samplingRule struct {
+	SamplingRule
+	sampler sdktrace.Sampler
+}
+
+// compileRules returns rules with their samplers, or nil when there are none.
+func compileRules(rules []SamplingRule) []samplingRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	compiled := make([]samplingRule, len(rules))
+	for i, rule := range rules {
+		compiled[i] = samplingRule{SamplingRule: rule, sampler: samplerForRatio(rule.Ratio)}
+	}
+	return compiled
+}
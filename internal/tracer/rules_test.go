@@ -0,0 +1,75 @@
+package tracer
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTracer_Rules_SamplingRule_Matches(t *testing.T) {
+	params := sdktrace.SamplingParameters{
+		Name: "GET /healthz",
+		Attributes: []attribute.KeyValue{
+			attribute.String("http.route", "/healthz"),
+			attribute.Int("http.request.size", 0),
+		},
+	}
+
+	tests := []struct {
+		name string
+		rule SamplingRule
+		want bool
+	}{
+		{"empty rule matches everything", SamplingRule{}, true},
+		{"exact name", SamplingRule{Name: "GET /healthz"}, true},
+		{"other name", SamplingRule{Name: "GET /orders"}, false},
+		{"name prefix", SamplingRule{Name: "GET /health*"}, true},
+		{"other prefix", SamplingRule{Name: "POST *"}, false},
+		{"attribute", SamplingRule{Attributes: map[string]string{"http.route": "/healthz"}}, true},
+		{"non-string attribute", SamplingRule{Attributes: map[string]string{"http.request.size": "0"}}, true},
+		{"attribute with other value", SamplingRule{Attributes: map[string]string{"http.route": "/orders"}}, false},
+		{"missing attribute", SamplingRule{Attributes: map[string]string{"rpc.method": "Check"}}, false},
+		{"name and attribute", SamplingRule{Name: "GET *", Attributes: map[string]string{"http.route": "/healthz"}}, true},
+		{"name matches, attribute does not", SamplingRule{Name: "GET *", Attributes: map[string]string{"http.route": "/orders"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.matches(params); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTracer_Rules_SamplingRule_Matches_Unrouted(t *testing.T) {
+	// SpanFromRequest starts the span before routing, so its name is only the method.
+	params := sdktrace.SamplingParameters{
+		Name: "GET",
+		Attributes: []attribute.KeyValue{
+			attribute.String("http.request.method", "GET"),
+			attribute.String("url.path", "/healthz"),
+		},
+	}
+
+	tests := []struct {
+		name string
+		rule SamplingRule
+		want bool
+	}{
+		{"method and path", SamplingRule{Name: "GET /healthz"}, true},
+		{"method and path prefix", SamplingRule{Name: "GET /health*"}, true},
+		{"span name", SamplingRule{Name: "GET"}, true},
+		{"other path", SamplingRule{Name: "GET /orders"}, false},
+		{"other method", SamplingRule{Name: "POST /healthz"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.matches(params); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
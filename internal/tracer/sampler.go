@@ -0,0 +1,96 @@
+package tracer
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// samplerForRatio returns the sampler matching a sampling ratio.
+// Ratios <= 0 never sample, ratios >= 1.0 always sample, and values in between use
+// trace ID ratio based sampling.
+func samplerForRatio(ratio float64) sdktrace.Sampler {
+	switch {
+	case ratio <= 0:
+		return sdktrace.NeverSample()
+	case ratio >= 1.0:
+		return sdktrace.AlwaysSample()
+	default:
+		return sdktrace.TraceIDRatioBased(ratio)
+	}
+}
+
+// dynamicSampler is a sampler whose ratio and rules can be changed while the tracer provider
+// is running. The tracer provider keeps a single sampler for its lifetime, so dynamicSampler
+// delegates to a replaceable inner sampler instead.
+type dynamicSampler struct {
+	current atomic.Pointer[sdktrace.Sampler]
+	ratio   atomic.Uint64                  // ratio holds the math.Float64bits of the ratio current was built from.
+	rules   atomic.Pointer[[]samplingRule] // rules are the sampling rules checked before current; nil when there are none.
+}
+
+// newDynamicSampler returns a dynamicSampler initialized with the given ratio.
+func newDynamicSampler(ratio float64) *dynamicSampler {
+	s := &dynamicSampler{}
+	s.setRatio(ratio)
+	return s
+}
+
+// setRatio replaces the inner sampler with one matching ratio.
+// It is safe to call concurrently with sampling decisions.
+func (s *dynamicSampler) setRatio(ratio float64) {
+	sampler := samplerForRatio(ratio)
+	s.current.Store(&sampler)
+	s.ratio.Store(math.Float64bits(ratio))
+}
+
+// getRatio returns the ratio most recently passed to setRatio.
+func (s *dynamicSampler) getRatio() float64 {
+	return math.Float64frombits(s.ratio.Load())
+}
+
+// setRules replaces the sampling rules. It is safe to call concurrently with sampling decisions.
+func (s *dynamicSampler) setRules(rules []SamplingRule) {
+	compiled := compileRules(rules)
+	if compiled == nil {
+		s.rules.Store(nil)
+		return
+	}
+	s.rules.Store(&compiled)
+}
+
+// ShouldSample delegates the sampling decision to the current inner sampler. When rules are
+// set, a span with a parent, local or remote, follows the parent's sampled flag, as with
+// sdktrace.ParentBased, so a trace is kept or dropped as a whole across services, and a root
+// span is sampled by the first rule matching it, falling back to the inner sampler when none
+// does.
+func (s *dynamicSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	if rules := s.rules.Load(); rules != nil {
+		parent := trace.SpanContextFromContext(p.ParentContext)
+		if parent.IsValid() {
+			decision := sdktrace.Drop
+			if parent.IsSampled() {
+				decision = sdktrace.RecordAndSample
+			}
+			return sdktrace.SamplingResult{Decision: decision, Tracestate: parent.TraceState()}
+		}
+		for _, rule := range *rules {
+			if rule.matches(p) {
+				return rule.sampler.ShouldSample(p)
+			}
+		}
+	}
+	return (*s.current.Load()).ShouldSample(p)
+}
+
+// Description returns the description of the current inner sampler, noting the rules if any.
+func (s *dynamicSampler) Description() string {
+	current := (*s.current.Load()).Description()
+	if rules := s.rules.Load(); rules != nil {
+		return fmt.Sprintf("RuleBased{rules:%d,default:%s}", len(*rules), current)
+	}
+	return current
+}
@@ -0,0 +1,101 @@
+package tracer
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer_Sampler_SamplerForRatio(t *testing.T) {
+	tests := []struct {
+		name  string
+		ratio float64
+		want  string
+	}{
+		{"zero never samples", 0, sdktrace.NeverSample().Description()},
+		{"negative never samples", -0.5, sdktrace.NeverSample().Description()},
+		{"one always samples", 1.0, sdktrace.AlwaysSample().Description()},
+		{"above one always samples", 1.5, sdktrace.AlwaysSample().Description()},
+		{"fraction uses ratio", 0.25, sdktrace.TraceIDRatioBased(0.25).Description()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := samplerForRatio(tt.ratio).Description(); got != tt.want {
+				t.Errorf("samplerForRatio(%v) = %q, want %q", tt.ratio, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTracer_Sampler_DynamicSampler(t *testing.T) {
+	sampler := newDynamicSampler(0)
+	params := sdktrace.SamplingParameters{
+		TraceID: trace.TraceID{0x01},
+		Name:    "test-span",
+	}
+
+	if got := sampler.ShouldSample(params).Decision; got != sdktrace.Drop {
+		t.Errorf("ShouldSample() with ratio 0 = %v, want Drop", got)
+	}
+
+	sampler.setRatio(1.0)
+	if got := sampler.getRatio(); got != 1.0 {
+		t.Errorf("getRatio() = %v, want 1.0", got)
+	}
+	if got := sampler.ShouldSample(params).Decision; got != sdktrace.RecordAndSample {
+		t.Errorf("ShouldSample() with ratio 1.0 = %v, want RecordAndSample", got)
+	}
+	if got := sampler.Description(); got != sdktrace.AlwaysSample().Description() {
+		t.Errorf("Description() = %q, want %q", got, sdktrace.AlwaysSample().Description())
+	}
+}
+
+func TestTracer_Sampler_DynamicSampler_Rules(t *testing.T) {
+	sampler := newDynamicSampler(1.0)
+	sampler.setRules([]SamplingRule{
+		{Name: "GET /healthz", Ratio: 0},
+		{Name: "GET *", Ratio: 1.0},
+	})
+
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{0x01}, SpanID: trace.SpanID{0x01}, TraceFlags: trace.FlagsSampled})
+	dropped := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{0x01}, SpanID: trace.SpanID{0x01}})
+	tests := []struct {
+		name   string
+		span   string
+		parent trace.SpanContext
+		want   sdktrace.SamplingDecision
+	}{
+		{"matching rule drops", "GET /healthz", trace.SpanContext{}, sdktrace.Drop},
+		{"first matching rule wins", "GET /orders", trace.SpanContext{}, sdktrace.RecordAndSample},
+		{"unmatched span uses the ratio", "consume", trace.SpanContext{}, sdktrace.RecordAndSample},
+		{"sampled remote parent is followed", "GET /healthz", sampled.WithRemote(true), sdktrace.RecordAndSample},
+		{"dropped remote parent is followed", "GET /orders", dropped.WithRemote(true), sdktrace.Drop},
+		{"local child of a dropped root is dropped", "db.query", dropped, sdktrace.Drop},
+		{"local child of a sampled root is sampled", "GET /healthz", sampled, sdktrace.RecordAndSample},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := sdktrace.SamplingParameters{
+				ParentContext: trace.ContextWithSpanContext(context.Background(), tt.parent),
+				TraceID:       trace.TraceID{0x01},
+				Name:          tt.span,
+			}
+			if got := sampler.ShouldSample(params).Decision; got != tt.want {
+				t.Errorf("ShouldSample(%q) = %v, want %v", tt.span, got, tt.want)
+			}
+		})
+	}
+
+	sampler.setRules(nil)
+	params := sdktrace.SamplingParameters{TraceID: trace.TraceID{0x01}, Name: "GET /healthz"}
+	if got := sampler.ShouldSample(params).Decision; got != sdktrace.RecordAndSample {
+		t.Errorf("ShouldSample() after clearing rules = %v, want RecordAndSample", got)
+	}
+	if got := sampler.Description(); got != sdktrace.AlwaysSample().Description() {
+		t.Errorf("Description() = %q, want %q", got, sdktrace.AlwaysSample().Description())
+	}
+}
@@ -0,0 +1,115 @@
+package tracer
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// redacted replaces the value of a scrubbed header or query parameter.
+const redacted = "REDACTED"
+
+// DefaultScrubbedHeaders returns the headers scrubbed by default: the ones carrying
+// credentials or session cookies.
+func DefaultScrubbedHeaders() []string {
+	return []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+}
+
+// DefaultScrubbedQueryParams returns the query parameters scrubbed by default: the ones
+// commonly carrying tokens, keys, or signatures.
+func DefaultScrubbedQueryParams() []string {
+	return []string{"access_token", "api_key", "apikey", "code", "key", "password", "secret", "sig", "signature", "token"}
+}
+
+// httpScrubber removes credentials from the HTTP attributes recorded on spans.
+type httpScrubber struct {
+	captured []string        // captured are the canonical names of the headers recorded as attributes.
+	headers  map[string]bool // headers are the canonical names of the scrubbed headers.
+	params   map[string]bool // params are the lowercase names of the scrubbed query parameters.
+}
+
+// newHTTPScrubber returns the scrubber for the scrubbed and captured headers and query
+// parameters of options.
+func newHTTPScrubber(options *Options) *httpScrubber {
+	s := &httpScrubber{
+		headers: make(map[string]bool, len(options.ScrubbedHeaders)),
+		params:  make(map[string]bool, len(options.ScrubbedQueryParams)),
+	}
+	for _, header := range options.CapturedHeaders {
+		s.captured = append(s.captured, http.CanonicalHeaderKey(header))
+	}
+	for _, header := range options.ScrubbedHeaders {
+		s.headers[http.CanonicalHeaderKey(header)] = true
+	}
+	for _, param := range options.ScrubbedQueryParams {
+		s.params[strings.ToLower(param)] = true
+	}
+	return s
+}
+
+// query returns the raw query with the values of scrubbed parameters replaced by "REDACTED".
+// Other parameters are kept as they were sent, in order.
+func (s *httpScrubber) query(raw string) string {
+	if raw == "" || len(s.params) == 0 {
+		return raw
+	}
+	pairs := strings.Split(raw, "&")
+	for i, pair := range pairs {
+		key, _, hasValue := strings.Cut(pair, "=")
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			name = key
+		}
+		if hasValue && s.params[strings.ToLower(name)] {
+			pairs[i] = key + "=" + redacted
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// url returns u as a string with its user info and scrubbed query parameter values redacted.
+func (s *httpScrubber) url(u *url.URL) string {
+	scrubbed := *u
+	if scrubbed.User != nil {
+		scrubbed.User = url.UserPassword(redacted, redacted)
+	}
+	scrubbed.RawQuery = s.query(scrubbed.RawQuery)
+	return scrubbed.String()
+}
+
+// headerAttributes returns the captured headers present in header as
+// "http.<direction>.header.<name>" attributes, with the values of scrubbed headers replaced by
+// "REDACTED". direction is "request" or "response".
+func (s *httpScrubber) headerAttributes(direction string, header http.Header) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	for _, name := range s.captured {
+		values := header.Values(name)
+		if len(values) == 0 {
+			continue
+		}
+		if s.headers[name] {
+			values = []string{redacted}
+		}
+		key := "http." + direction + ".header." + strings.ToLower(name)
+		attrs = append(attrs, attribute.StringSlice(key, values))
+	}
+	return attrs
+}
+
+// setHTTPScrubber replaces the scrubber used by SpanFromRequest and RoundTripper.
+func (t *tracer) setHTTPScrubber(options *Options) {
+	t.scrubber.Store(newHTTPScrubber(options))
+}
+
+// httpScrubber returns the scrubber currently in use, read through the parent on scoped tracers.
+func (t *tracer) httpScrubber() *httpScrubber {
+	if t.parent != nil {
+		return t.parent.httpScrubber()
+	}
+	if s := t.scrubber.Load(); s != nil {
+		return s
+	}
+	return newHTTPScrubber(&Options{ScrubbedHeaders: DefaultScrubbedHeaders(), ScrubbedQueryParams: DefaultScrubbedQueryParams()})
+}
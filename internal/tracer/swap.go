@@ -0,0 +1,48 @@
+package tracer
+
+import (
+	"context"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// swapProcessor is a span processor passing spans to a processor that Reload can replace. It
+// is registered with the provider once, so replacing the exporting processor keeps its place
+// after the other processors and never feeds a span to both the old and the new one.
+type swapProcessor struct {
+	current atomic.Pointer[sdktrace.SpanProcessor]
+}
+
+// newSwapProcessor returns a swapProcessor passing spans to processor.
+func newSwapProcessor(processor sdktrace.SpanProcessor) *swapProcessor {
+	p := &swapProcessor{}
+	p.current.Store(&processor)
+	return p
+}
+
+// swap passes the spans ending from now on to processor and returns the previous processor,
+// which the caller shuts down to export the spans it still buffers.
+func (p *swapProcessor) swap(processor sdktrace.SpanProcessor) sdktrace.SpanProcessor {
+	return *p.current.Swap(&processor)
+}
+
+// OnStart passes s to the current processor.
+func (p *swapProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	(*p.current.Load()).OnStart(parent, s)
+}
+
+// OnEnd passes s to the current processor.
+func (p *swapProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	(*p.current.Load()).OnEnd(s)
+}
+
+// Shutdown shuts down the current processor.
+func (p *swapProcessor) Shutdown(ctx context.Context) error {
+	return (*p.current.Load()).Shutdown(ctx)
+}
+
+// ForceFlush flushes the current processor.
+func (p *swapProcessor) ForceFlush(ctx context.Context) error {
+	return (*p.current.Load()).ForceFlush(ctx)
+}
@@ -0,0 +1,36 @@
+package tracer
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer_Swap_SwapProcessor(t *testing.T) {
+	previous := tracetest.NewInMemoryExporter()
+	current := tracetest.NewInMemoryExporter()
+	previousProcessor := sdktrace.NewSimpleSpanProcessor(previous)
+	swap := newSwapProcessor(previousProcessor)
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(swap))
+	defer func() {
+		_ = provider.Shutdown(context.Background())
+	}()
+	tr := provider.Tracer("test")
+
+	_, before := tr.Start(context.Background(), "before")
+	before.End()
+	_, during := tr.Start(context.Background(), "during")
+	if got := swap.swap(sdktrace.NewSimpleSpanProcessor(current)); got != previousProcessor {
+		t.Errorf("swap() = %v, want the previous processor", got)
+	}
+	during.End()
+
+	if got := previous.GetSpans(); len(got) != 1 || got[0].Name != "before" {
+		t.Errorf("previous processor exported %v, want only before", got)
+	}
+	if got := current.GetSpans(); len(got) != 1 || got[0].Name != "during" {
+		t.Errorf("current processor exported %v, want only during", got)
+	}
+}
@@ -0,0 +1,60 @@
+package tracer
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand/v2"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceID64Generator generates random trace IDs whose high 64 bits are zero, and random span
+// IDs.
+type traceID64Generator struct{}
+
+// NewTraceID64Generator returns an IDGenerator producing trace IDs that fit in 64 bits: the
+// high 8 bytes are zero and the low 8 bytes are random. Systems that only keep 64-bit trace
+// IDs, such as older Zipkin and Jaeger deployments, then refer to the same trace as the
+// W3C trace context.
+func NewTraceID64Generator() IDGenerator {
+	return traceID64Generator{}
+}
+
+// NewIDs returns a new 64-bit trace ID and a random span ID.
+func (g traceID64Generator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	var traceID trace.TraceID
+	for !traceID.IsValid() {
+		binary.BigEndian.PutUint64(traceID[8:], rand.Uint64())
+	}
+	return traceID, g.NewSpanID(ctx, traceID)
+}
+
+// NewSpanID returns a random, non-zero span ID.
+func (g traceID64Generator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	var spanID trace.SpanID
+	for !spanID.IsValid() {
+		binary.BigEndian.PutUint64(spanID[:], rand.Uint64())
+	}
+	return spanID
+}
+
+// TraceID128 returns the trace ID of the span context in ctx as 32 hexadecimal digits, or ""
+// if ctx holds no valid span context.
+func TraceID128(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
+
+// TraceID64 returns the low 64 bits of the trace ID of the span context in ctx as 16
+// hexadecimal digits, the form 64-bit tracing systems use, or "" if ctx holds no valid span
+// context. Trace IDs generated with TraceID64 enabled lose nothing in this form.
+func TraceID64(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ""
+	}
+	return sc.TraceID().String()[16:]
+}
@@ -0,0 +1,72 @@
+package tracer
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer_TraceID_TraceID64Generator(t *testing.T) {
+	gen := NewTraceID64Generator()
+	seen := make(map[trace.TraceID]bool)
+	for range 100 {
+		traceID, spanID := gen.NewIDs(context.Background())
+		if !traceID.IsValid() || !spanID.IsValid() {
+			t.Fatalf("NewIDs() = %s/%s, want valid IDs", traceID, spanID)
+		}
+		if got := traceID.String()[:16]; got != "0000000000000000" {
+			t.Fatalf("trace ID %s has high bits %s, want zero", traceID, got)
+		}
+		if seen[traceID] {
+			t.Fatalf("NewIDs() returned %s twice", traceID)
+		}
+		seen[traceID] = true
+	}
+}
+
+func TestTracer_TraceID_Helpers(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	withSpan := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want128 string
+		want64  string
+	}{
+		{"span context", withSpan, "4bf92f3577b34da6a3ce929d0e0e4736", "a3ce929d0e0e4736"},
+		{"no span context", context.Background(), "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TraceID128(tt.ctx); got != tt.want128 {
+				t.Errorf("TraceID128() = %q, want %q", got, tt.want128)
+			}
+			if got := TraceID64(tt.ctx); got != tt.want64 {
+				t.Errorf("TraceID64() = %q, want %q", got, tt.want64)
+			}
+		})
+	}
+}
+
+func TestTracer_TraceID_WithTraceID64(t *testing.T) {
+	tr, err := NewTracer(WithServiceName("test-service"), WithTraceID64(true))
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tr.Shutdown(context.Background())
+	}()
+
+	ctx, span := tr.StartSpan(context.Background(), "operation")
+	defer tr.EndSpan(span)
+	if got, want := TraceID128(ctx), "0000000000000000"+TraceID64(ctx); got != want {
+		t.Errorf("TraceID128() = %s, want the 64-bit trace ID %s zero-padded", got, want)
+	}
+}
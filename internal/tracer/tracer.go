@@ -2,11 +2,18 @@ package tracer
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"sync"
+	"sync/atomic"
 
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	"go.opentelemetry.io/otel"
+	otbridge "go.opentelemetry.io/otel/bridge/opentracing"
 	"go.opentelemetry.io/otel/propagation"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -16,6 +23,20 @@ type tracer struct {
 	provider   *sdktrace.TracerProvider
 	tracer     trace.Tracer
 	propagator propagation.TextMapPropagator
+
+	mu        sync.Mutex             // mu serializes Reload calls.
+	options   *Options               // options is the configuration the tracer is currently running with.
+	processor sdktrace.SpanProcessor // processor is the batch or simple processor feeding the current exporter.
+	swap      *swapProcessor         // swap is the processor registered with the provider, passing spans to processor.
+	sampler   *dynamicSampler        // sampler is the provider sampler, adjustable at runtime.
+	remote    *remoteSampling        // remote polls the sample ratio from a remote endpoint; nil when disabled.
+	clock     clock.Clock            // clock timestamps spans; nil leaves timestamps to the SDK.
+	parent    *tracer                // parent owns the provider of a tracer created by Scoped; nil otherwise.
+	bridge    *otbridge.BridgeTracer // bridge is the OpenTracing bridge over the tracer; nil when disabled.
+
+	ignoredRoutes atomic.Pointer[[]string]      // ignoredRoutes are the routes SpanFromRequest skips; read through the parent on scoped tracers.
+	scrubber      atomic.Pointer[httpScrubber]  // scrubber redacts credentials from HTTP attributes; read through the parent on scoped tracers.
+	body          atomic.Pointer[bodyRecording] // body holds the RoundTripper body recording settings, nil when disabled; read through the parent on scoped tracers.
 }
 
 // StartSpan starts a new span with the given name and context.
@@ -36,9 +57,84 @@ type tracer struct {
 //	ctx, span := tracer.StartSpan(ctx, "process-payment")
 //	defer tracer.EndSpan(span)
 func (t *tracer) StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if t.clock != nil {
+		// Prepended so an explicit trace.WithTimestamp from the caller still wins.
+		opts = append([]trace.SpanStartOption{trace.WithTimestamp(t.clock.Now())}, opts...)
+	}
 	return t.tracer.Start(ctx, name, opts...)
 }
 
+// StartSpanWithLinks starts a new span that is linked to the given span contexts.
+// Links relate a span to other traces without making them its parent, e.g. a batch consumer
+// span linked to the trace of every message in the batch. Invalid span contexts are skipped.
+//
+// Parameters:
+//   - ctx: The parent context (may contain a parent span)
+//   - name: The name of the span
+//   - links: The span contexts to link to
+//   - opts: Optional span start options (e.g., trace.WithSpanKind)
+//
+// Returns:
+//   - A new context containing the span
+//   - The created span
+//
+// Example:
+//
+//	links := make([]trace.SpanContext, 0, len(messages))
+//	for _, msg := range messages {
+//	    links = append(links, trace.SpanContextFromContext(tracer.ExtractContext(ctx, msg.Metadata)))
+//	}
+//	ctx, span := tracer.StartSpanWithLinks(ctx, "process-batch", links)
+//	defer tracer.EndSpan(span)
+func (t *tracer) StartSpanWithLinks(ctx context.Context, name string, links []trace.SpanContext, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	spanLinks := make([]trace.Link, 0, len(links))
+	for _, sc := range links {
+		if sc.IsValid() {
+			spanLinks = append(spanLinks, trace.Link{SpanContext: sc})
+		}
+	}
+	if len(spanLinks) > 0 {
+		opts = append(opts, trace.WithLinks(spanLinks...))
+	}
+	return t.StartSpan(ctx, name, opts...)
+}
+
+// EnsureSpan starts a span named name, unless the span active in ctx already has that name, in
+// which case it returns ctx and that span instead, so middleware and a handler that both
+// instrument "handle-request" record a single span. Ending the returned span is a no-op when it
+// was reused, leaving the span to the code that started it, so callers always end it.
+// Only the span active in ctx is compared, not its ancestors, and spans that are not recorded
+// (noop or sampled out) are never reused.
+//
+// Parameters:
+//   - ctx: The parent context (may contain a parent span)
+//   - name: The name of the span
+//   - opts: Optional span start options, applied only when a span is started
+//
+// Returns:
+//   - A context containing the span
+//   - The started or reused span
+//
+// Example:
+//
+//	ctx, span := tracer.EnsureSpan(ctx, "handle-request")
+//	defer tracer.EndSpan(span)
+func (t *tracer) EnsureSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	active := trace.SpanFromContext(ctx)
+	if named, ok := active.(interface{ Name() string }); ok && active.IsRecording() && named.Name() == name {
+		return ctx, reusedSpan{Span: active}
+	}
+	return t.StartSpan(ctx, name, opts...)
+}
+
+// reusedSpan is a span returned by EnsureSpan for a span started elsewhere. Ending it is a
+// no-op; the code that started the span ends it.
+type reusedSpan struct {
+	trace.Span
+}
+
+func (reusedSpan) End(...trace.SpanEndOption) {}
+
 // EndSpan ends the given span, recording its completion time.
 // This should be called when the operation represented by the span is complete.
 // Typically used with defer to ensure spans are always ended.
@@ -48,6 +144,10 @@ func (t *tracer) StartSpan(ctx context.Context, name string, opts ...trace.SpanS
 //	ctx, span := tracer.StartSpan(ctx, "operation")
 //	defer tracer.EndSpan(span)
 func (t *tracer) EndSpan(span trace.Span) {
+	if t.clock != nil {
+		span.End(trace.WithTimestamp(t.clock.Now()))
+		return
+	}
 	span.End()
 }
 
@@ -68,7 +168,89 @@ func (t *tracer) EndSpan(span trace.Span) {
 //	    log.Printf("Failed to shutdown tracer: %v", err)
 //	}
 func (t *tracer) Shutdown(ctx context.Context) error {
-	return t.provider.Shutdown(ctx)
+	if t.provider == nil || t.parent != nil {
+		return nil
+	}
+	t.mu.Lock()
+	remote := t.remote
+	t.mu.Unlock()
+	var remoteErr error
+	if remote != nil {
+		remoteErr = remote.shutdown(ctx)
+	}
+	return errors.Join(remoteErr, t.provider.Shutdown(ctx))
+}
+
+// Provider returns the tracer provider backing this tracer, so third-party instrumentation
+// (otelhttp, otelgrpc, otelsql) can create spans with the same exporter, sampler, and resource
+// instead of the OpenTelemetry globals. A noop tracer returns a noop provider.
+//
+// Example:
+//
+//	handler := otelhttp.NewHandler(mux, "server",
+//	    otelhttp.WithTracerProvider(tracer.Provider()),
+//	)
+func (t *tracer) Provider() trace.TracerProvider {
+	if t.provider == nil {
+		return noop.NewTracerProvider()
+	}
+	return t.provider
+}
+
+// ForceFlush exports the spans that have ended but are still buffered by the span processor,
+// blocking until the export completes or ctx is done. Serverless functions call it at the end
+// of each invocation, before the runtime may freeze the process. On a tracer created by Scoped
+// it flushes the shared provider; on a noop tracer it is a no-op.
+func (t *tracer) ForceFlush(ctx context.Context) error {
+	if t.provider == nil {
+		return nil
+	}
+	return t.provider.ForceFlush(ctx)
+}
+
+// SampleRatio returns the sampling ratio the tracer currently applies: the configured ratio,
+// or the latest one fetched by remote sampling. A tracer created by Scoped reports its parent's.
+func (t *tracer) SampleRatio() float64 {
+	if t.parent != nil {
+		return t.parent.SampleRatio()
+	}
+	if t.sampler == nil {
+		return 0
+	}
+	return t.sampler.getRatio()
+}
+
+// Scoped returns a tracer that shares this tracer's provider, exporter, and sampler but creates
+// spans under its own instrumentation scope, so a library embedded in the application can be
+// told apart in the exported data without creating another exporter.
+// Shutting down a scoped tracer is a no-op; the provider is shut down with the parent, and
+// reloading a scoped tracer reloads the parent.
+//
+// Parameters:
+//   - name: The instrumentation scope name, conventionally the library's import path
+//   - version: The instrumentation scope version (may be empty)
+//
+// Returns:
+//   - A Tracer whose spans are attributed to the given scope
+//
+// Example:
+//
+//	paymentsTracer := tracer.Scoped("github.com/acme/payments", "v1.4.0")
+//	ctx, span := paymentsTracer.StartSpan(ctx, "charge")
+//	defer paymentsTracer.EndSpan(span)
+func (t *tracer) Scoped(name, version string) Tracer {
+	parent := t
+	if t.parent != nil {
+		parent = t.parent
+	}
+	return &tracer{
+		provider:   t.provider,
+		tracer:     t.wrapOpenTracing(t.Provider().Tracer(name, trace.WithInstrumentationVersion(version))),
+		propagator: t.propagator,
+		clock:      t.clock,
+		parent:     parent,
+		bridge:     t.bridge,
+	}
 }
 
 // StartChildSpan creates a new child span from a parent span.
@@ -169,3 +351,175 @@ func (t *tracer) InjectContext(ctx context.Context) metadata.MD {
 
 	return mdLower
 }
+
+// AddEvent records an event with the given name on span.
+// fields are converted to span attributes the same way the logger converts its fields, so
+// business milestones can be recorded without building attributes by hand. Strings, booleans,
+// integers, floats, and slices of those keep their type; other values are recorded as strings.
+//
+// Parameters:
+//   - span: The span to record the event on
+//   - name: The name of the event (e.g., "payment-authorized")
+//   - fields: The event attributes (may be nil)
+//
+// Example:
+//
+//	tracer.AddEvent(span, "payment-authorized", map[string]interface{}{
+//	    "order_id": orderID,
+//	    "amount":   49.90,
+//	})
+func (t *tracer) AddEvent(span trace.Span, name string, fields map[string]interface{}) {
+	span.AddEvent(name, trace.WithAttributes(convertFields(fields)...))
+}
+
+// Reload applies opts on top of the tracer's current configuration without recreating the
+// tracer provider, so spans already in flight and tracers handed out earlier keep working.
+// The sample ratio, sampling rules, and ignored routes take effect immediately. When the remote
+// sampling URL or interval change, the poller is restarted; disabling remote sampling restores
+// the configured sample ratio. When the provider, endpoint, insecure flag, batch timeout,
+// simple processor setting, stdout format, mirror, circuit breaker settings, or fallback
+// provider and path change, a new exporter is created and swapped in; the previous exporter is
+// flushed and shut down. The breaker state and spill handlers are fixed when the tracer is created. Identity options (service name, environment, instance) are part of
+// the tracer resource and cannot be reloaded; they are ignored, as are the cold start setting and
+// the stdout writer. The OpenTracing and OpenCensus bridges are fixed when the tracer is created.
+// Reload is a no-op on a noop tracer.
+// On a tracer created by Scoped, Reload applies to the parent tracer and all its scopes.
+//
+// Returns the same validation errors as NewTracer; on error the running configuration is unchanged.
+//
+// Example:
+//
+//	// Raise sampling during an incident
+//	if err := tracer.Reload(WithSampleRatio(1.0)); err != nil {
+//	    log.Printf("Failed to reload tracer: %v", err)
+//	}
+func (t *tracer) Reload(opts ...Option) error {
+	if t.parent != nil {
+		return t.parent.Reload(opts...)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.provider == nil {
+		return nil
+	}
+
+	options := *t.options
+	for _, opt := range opts {
+		opt(&options)
+	}
+	// identity is baked into the resource, keep the running values
+	options.ServiceName = t.options.ServiceName
+	options.Environment = t.options.Environment
+	options.InstanceName = t.options.InstanceName
+	options.InstanceHost = t.options.InstanceHost
+	options.ResourceAttributes = t.options.ResourceAttributes
+	options.ScopeName = t.options.ScopeName
+	options.ScopeVersion = t.options.ScopeVersion
+	// spans already in flight were timestamped by the running clock
+	options.Clock = t.options.Clock
+	// the writer may be shared with exports still in flight on the previous exporter
+	options.Writer = t.options.Writer
+	// the cold start, context annotation, long span, and custom processors are registered with the provider
+	options.ColdStart = t.options.ColdStart
+	options.ContextAnnotations = t.options.ContextAnnotations
+	options.LongSpanThreshold = t.options.LongSpanThreshold
+	options.LongSpanHandler = t.options.LongSpanHandler
+	options.SpanProcessors = t.options.SpanProcessors
+	// the filter wraps the exporting processor, which is only replaced with the exporter
+	options.SpanFilter = t.options.SpanFilter
+	options.RedactedAttributes = t.options.RedactedAttributes
+	options.RedactionAction = t.options.RedactionAction
+	// the dev viewer is registered with the provider and keeps its port
+	options.DevViewerPort = t.options.DevViewerPort
+	// the ID generator and the propagator are fixed when the tracer is created
+	options.IDGenerator = t.options.IDGenerator
+	options.XRayIDs = t.options.XRayIDs
+	options.TraceID64 = t.options.TraceID64
+	options.XRayPropagation = t.options.XRayPropagation
+	options.OpenTracingBridge = t.options.OpenTracingBridge
+	options.OpenCensusBridge = t.options.OpenCensusBridge
+	// the handlers are wired by the caller that created the tracer and report on every exporter
+	options.BreakerStateHandler = t.options.BreakerStateHandler
+	options.SpillHandler = t.options.SpillHandler
+
+	err := options.Validate()
+	if err != nil {
+		return err
+	}
+
+	remote := t.remote
+	if options.RemoteSamplingURL != t.options.RemoteSamplingURL ||
+		options.RemoteSamplingInterval != t.options.RemoteSamplingInterval {
+		if remote, err = t.newRemoteSampling(&options); err != nil {
+			return err
+		}
+	}
+
+	if options.Provider != t.options.Provider ||
+		options.ProviderHost != t.options.ProviderHost ||
+		options.ProviderPort != t.options.ProviderPort ||
+		options.StdoutFormat != t.options.StdoutFormat ||
+		options.Insecure != t.options.Insecure ||
+		options.Endpoint != t.options.Endpoint ||
+		options.BatchTimeout != t.options.BatchTimeout ||
+		options.SimpleProcessor != t.options.SimpleProcessor ||
+		options.MirrorEndpoint != t.options.MirrorEndpoint ||
+		options.MirrorRatio != t.options.MirrorRatio ||
+		options.BreakerThreshold != t.options.BreakerThreshold ||
+		options.BreakerMaxBackoff != t.options.BreakerMaxBackoff ||
+		options.FallbackProvider != t.options.FallbackProvider ||
+		options.FallbackPath != t.options.FallbackPath {
+		exporter, err := newExporter(&options)
+		if err != nil {
+			t.discardRemoteSampling(remote)
+			return err
+		}
+		mirror, err := newMirrorExporter(&options)
+		if err != nil {
+			_ = exporter.Shutdown(context.Background())
+			t.discardRemoteSampling(remote)
+			return err
+		}
+		processor := newSpanProcessor(exporter, mirror, &options)
+		// Spans ending from now on go to the new processor; shutting down the old one exports
+		// the spans it still buffers and shuts down its exporter.
+		if err := t.swap.swap(processor).Shutdown(context.Background()); err != nil {
+			otel.Handle(err)
+		}
+		t.processor = processor
+	}
+
+	if options.SampleRatio != t.options.SampleRatio || (remote != t.remote && options.RemoteSamplingURL == "") {
+		t.sampler.setRatio(options.SampleRatio)
+	}
+	if remote != t.remote {
+		if t.remote != nil {
+			_ = t.remote.shutdown(context.Background())
+		}
+		t.remote = remote
+	}
+	t.sampler.setRules(options.SamplingRules)
+	t.setIgnoredRoutes(options.IgnoredRoutes)
+	t.setHTTPScrubber(&options)
+	t.setBodyRecording(&options)
+
+	t.options = &options
+	return nil
+}
+
+// newRemoteSampling starts the remote sampling poller options describe for t's sampler, or
+// returns nil when remote sampling is disabled.
+func (t *tracer) newRemoteSampling(options *Options) (*remoteSampling, error) {
+	if options.RemoteSamplingURL == "" {
+		return nil, nil
+	}
+	return newRemoteSampling(options.RemoteSamplingURL, options.ServiceName, options.RemoteSamplingInterval, t.sampler)
+}
+
+// discardRemoteSampling stops remote when Reload started it and then failed.
+func (t *tracer) discardRemoteSampling(remote *remoteSampling) {
+	if remote != nil && remote != t.remote {
+		_ = remote.shutdown(context.Background())
+	}
+}
@@ -2,10 +2,18 @@ package tracer
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+	"github.com/adityakw90/go-monitoring/internal/clock"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/metadata"
 )
@@ -350,3 +358,413 @@ func TestTracer_Tracer_MultipleTracersCoexist(t *testing.T) {
 		t.Errorf("tracer2.(*tracer).InjectContext() returned empty metadata")
 	}
 }
+
+func TestTracer_Tracer_Reload(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantErrIs error
+		check     func(*testing.T, *tracer, sdktrace.SpanProcessor)
+	}{
+		{
+			name: "sample ratio changes without new exporter",
+			opts: []Option{WithSampleRatio(0)},
+			check: func(t *testing.T, tr *tracer, previous sdktrace.SpanProcessor) {
+				if tr.processor != previous {
+					t.Error("expected span processor to be kept")
+				}
+				_, span := tr.StartSpan(context.Background(), "after-reload")
+				defer span.End()
+				if span.SpanContext().IsSampled() {
+					t.Error("expected span not to be sampled after reload to ratio 0")
+				}
+			},
+		},
+		{
+			name: "batch timeout change swaps processor",
+			opts: []Option{WithBatchTimeout(time.Second)},
+			check: func(t *testing.T, tr *tracer, previous sdktrace.SpanProcessor) {
+				if tr.processor == previous {
+					t.Error("expected span processor to be replaced")
+				}
+				if tr.options.BatchTimeout != time.Second {
+					t.Errorf("expected BatchTimeout = 1s, got %v", tr.options.BatchTimeout)
+				}
+			},
+		},
+		{
+			name: "identity options are ignored",
+			opts: []Option{WithServiceName("other-service"), WithEnvironment("production")},
+			check: func(t *testing.T, tr *tracer, previous sdktrace.SpanProcessor) {
+				if tr.options.ServiceName != "test-service" {
+					t.Errorf("expected ServiceName = 'test-service', got %q", tr.options.ServiceName)
+				}
+				if tr.options.Environment != "" {
+					t.Errorf("expected Environment to stay empty, got %q", tr.options.Environment)
+				}
+			},
+		},
+		{
+			name:      "invalid batch timeout",
+			opts:      []Option{WithBatchTimeout(0)},
+			wantErrIs: ErrBatchTimeoutInvalid,
+		},
+		{
+			name:      "invalid provider keeps configuration",
+			opts:      []Option{WithProvider("invalid", "", 0), WithSampleRatio(0.5)},
+			wantErrIs: ErrInvalidProvider,
+			check: func(t *testing.T, tr *tracer, previous sdktrace.SpanProcessor) {
+				if tr.options.SampleRatio != 1.0 {
+					t.Errorf("expected SampleRatio to stay 1.0, got %v", tr.options.SampleRatio)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracerInstance, err := NewTracer(WithServiceName("test-service"))
+			if err != nil {
+				t.Fatalf("NewTracer() error = %v", err)
+			}
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_ = tracerInstance.Shutdown(ctx)
+			}()
+
+			tr := tracerInstance.(*tracer)
+			previous := tr.processor
+			err = tr.Reload(tt.opts...)
+			if err != tt.wantErrIs {
+				t.Fatalf("Reload() error = %v, want %v", err, tt.wantErrIs)
+			}
+			if tt.check != nil {
+				tt.check(t, tr, previous)
+			}
+		})
+	}
+}
+
+func TestTracer_Tracer_Reload_CircuitBreaker(t *testing.T) {
+	var states []breaker.State
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithBreakerStateHandler(func(state breaker.State) { states = append(states, state) }),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tracerInstance.Shutdown(ctx)
+	}()
+
+	tr := tracerInstance.(*tracer)
+	previous := tr.processor
+	if err := tr.Reload(WithCircuitBreaker(2, time.Minute), WithBreakerStateHandler(nil)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if tr.processor == previous {
+		t.Error("expected span processor to be replaced")
+	}
+	if tr.options.BreakerThreshold != 2 || tr.options.BreakerMaxBackoff != time.Minute {
+		t.Errorf("expected breaker 2/1m, got %d/%v", tr.options.BreakerThreshold, tr.options.BreakerMaxBackoff)
+	}
+	if tr.options.BreakerStateHandler == nil {
+		t.Fatal("expected the breaker state handler to be kept")
+	}
+	// the new exporter's breaker reports its initial state to the handler set at creation
+	if len(states) != 2 || states[1] != breaker.StateClosed {
+		t.Errorf("expected two closed state reports, got %v", states)
+	}
+
+	previous = tr.processor
+	if err := tr.Reload(WithCircuitBreaker(2, time.Minute)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if tr.processor != previous {
+		t.Error("expected span processor to be kept when breaker settings are unchanged")
+	}
+}
+
+func TestTracer_Tracer_Reload_FallbackProvider(t *testing.T) {
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithSpillHandler(func(ctx context.Context, spans int) {}),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tracerInstance.Shutdown(ctx)
+	}()
+
+	tr := tracerInstance.(*tracer)
+	previous := tr.processor
+	path := filepath.Join(t.TempDir(), "spans.json")
+	if err := tr.Reload(WithFallbackProvider("file", path), WithSpillHandler(nil)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if tr.processor == previous {
+		t.Error("expected span processor to be replaced")
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("expected the fallback file to be opened, got %v", err)
+	}
+	if tr.options.SpillHandler == nil {
+		t.Error("expected the spill handler to be kept")
+	}
+
+	previous = tr.processor
+	if err := tr.Reload(WithFallbackProvider("file", path)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if tr.processor != previous {
+		t.Error("expected span processor to be kept when the fallback is unchanged")
+	}
+}
+
+func TestTracer_Tracer_AddEvent(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+
+	_, span := tr.StartSpan(context.Background(), "checkout")
+	tr.AddEvent(span, "payment-authorized", map[string]interface{}{
+		"order_id": "order-1",
+		"amount":   49.9,
+	})
+	tr.EndSpan(span)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	events := spans[0].Events
+	if len(events) != 1 || events[0].Name != "payment-authorized" {
+		t.Fatalf("expected a single payment-authorized event, got %v", events)
+	}
+	want := []attribute.KeyValue{
+		attribute.Float64("amount", 49.9),
+		attribute.String("order_id", "order-1"),
+	}
+	if !reflect.DeepEqual(events[0].Attributes, want) {
+		t.Errorf("expected event attributes %v, got %v", want, events[0].Attributes)
+	}
+}
+
+func TestTracer_Tracer_StartSpanWithLinks(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+
+	_, producer1 := tr.StartSpan(context.Background(), "produce-1")
+	tr.EndSpan(producer1)
+	_, producer2 := tr.StartSpan(context.Background(), "produce-2")
+	tr.EndSpan(producer2)
+
+	links := []trace.SpanContext{
+		producer1.SpanContext(),
+		trace.SpanContext{}, // invalid, skipped
+		producer2.SpanContext(),
+	}
+	_, consumer := tr.StartSpanWithLinks(context.Background(), "consume-batch", links, trace.WithSpanKind(trace.SpanKindConsumer))
+	tr.EndSpan(consumer)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+	got := spans[2]
+	if got.SpanKind != trace.SpanKindConsumer {
+		t.Errorf("expected consumer span, got %v", got.SpanKind)
+	}
+	if len(got.Links) != 2 {
+		t.Fatalf("expected 2 links, got %d", len(got.Links))
+	}
+	if !got.Links[0].SpanContext.Equal(producer1.SpanContext()) || !got.Links[1].SpanContext.Equal(producer2.SpanContext()) {
+		t.Errorf("expected links to the producer spans, got %v", got.Links)
+	}
+}
+
+func TestTracer_Tracer_EnsureSpan(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+
+	ctx, outer := tr.EnsureSpan(context.Background(), "handle-request")
+	innerCtx, inner := tr.EnsureSpan(ctx, "handle-request", trace.WithSpanKind(trace.SpanKindServer))
+	if !inner.SpanContext().Equal(outer.SpanContext()) || innerCtx != ctx {
+		t.Errorf("EnsureSpan() with an active span of the same name started a new span")
+	}
+	tr.EndSpan(inner)
+	if !outer.IsRecording() {
+		t.Fatal("ending the reused span ended the active span")
+	}
+
+	_, child := tr.EnsureSpan(ctx, "query-db")
+	if child.SpanContext().SpanID() == outer.SpanContext().SpanID() {
+		t.Errorf("EnsureSpan() with a different name reused the active span")
+	}
+	tr.EndSpan(child)
+	tr.EndSpan(outer)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	if spans[0].Name != "query-db" || spans[1].Name != "handle-request" || spans[1].SpanKind != trace.SpanKindInternal {
+		t.Errorf("got spans %q and %q, want query-db and handle-request started once", spans[0].Name, spans[1].Name)
+	}
+
+	// A span of the same name further up is not reused.
+	ctx, outer = tr.EnsureSpan(context.Background(), "retry")
+	ctx, middle := tr.StartSpan(ctx, "attempt")
+	_, again := tr.EnsureSpan(ctx, "retry")
+	if again.SpanContext().SpanID() == outer.SpanContext().SpanID() {
+		t.Errorf("EnsureSpan() reused an ancestor span")
+	}
+	tr.EndSpan(again)
+	tr.EndSpan(middle)
+	tr.EndSpan(outer)
+}
+
+func TestTracer_Tracer_Clock(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(start)
+	tr, exporter := newRecordingTracer(t)
+	tr.clock = clk
+
+	_, span := tr.StartSpan(context.Background(), "timed")
+	clk.Advance(250 * time.Millisecond)
+	tr.EndSpan(span)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if !spans[0].StartTime.Equal(start) {
+		t.Errorf("StartTime = %v, want %v", spans[0].StartTime, start)
+	}
+	if got := spans[0].EndTime.Sub(spans[0].StartTime); got != 250*time.Millisecond {
+		t.Errorf("span duration = %v, want %v", got, 250*time.Millisecond)
+	}
+
+	// An explicit start timestamp overrides the clock.
+	explicit := start.Add(-time.Hour)
+	_, span = tr.StartSpan(context.Background(), "explicit", trace.WithTimestamp(explicit))
+	tr.EndSpan(span)
+	if got := exporter.GetSpans()[1].StartTime; !got.Equal(explicit) {
+		t.Errorf("StartTime = %v, want %v", got, explicit)
+	}
+}
+
+func TestTracer_Tracer_Provider(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+	if got := tr.Provider(); got != tr.provider {
+		t.Fatalf("Provider() = %v, want the tracer's provider", got)
+	}
+
+	// Spans started by third-party libraries through the provider share its exporter.
+	_, span := tr.Provider().Tracer("otelhttp").Start(context.Background(), "GET /orders")
+	span.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || spans[0].InstrumentationScope.Name != "otelhttp" {
+		t.Errorf("expected 1 span from the otelhttp scope, got %+v", spans)
+	}
+}
+
+func TestTracer_Tracer_Provider_Noop(t *testing.T) {
+	provider := NewNoopTracer().Provider()
+	if provider == nil {
+		t.Fatal("Provider() = nil, want noop provider")
+	}
+	_, span := provider.Tracer("otelhttp").Start(context.Background(), "GET /orders")
+	if span.IsRecording() {
+		t.Error("expected noop provider spans not to record")
+	}
+}
+
+func TestTracer_Tracer_Scoped(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+
+	scoped := tr.Scoped("github.com/acme/payments", "v1.4.0")
+	_, span := scoped.StartSpan(context.Background(), "charge")
+	scoped.EndSpan(span)
+
+	// Shutting down a scope must leave the shared provider running.
+	if err := scoped.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+	_, span = tr.StartSpan(context.Background(), "after-scope-shutdown")
+	tr.EndSpan(span)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	scope := spans[0].InstrumentationScope
+	if scope.Name != "github.com/acme/payments" || scope.Version != "v1.4.0" {
+		t.Errorf("scope = %+v, want github.com/acme/payments v1.4.0", scope)
+	}
+	if scoped.Provider() != tr.Provider() {
+		t.Error("expected the scoped tracer to share the parent provider")
+	}
+	if nested := scoped.Scoped("nested", "").(*tracer); nested.parent != tr {
+		t.Error("expected a nested scope to keep the root tracer as parent")
+	}
+}
+
+func TestTracer_Tracer_SampleRatio(t *testing.T) {
+	tr, _ := newRecordingTracer(t)
+	tr.sampler = newDynamicSampler(1.0)
+
+	// Remote sampling updates the sampler directly, bypassing the configured options.
+	tr.sampler.setRatio(0.25)
+	if got := tr.SampleRatio(); got != 0.25 {
+		t.Errorf("SampleRatio() = %v, want 0.25", got)
+	}
+	if got := tr.Scoped("scope", "").(SampleRatioReporter).SampleRatio(); got != 0.25 {
+		t.Errorf("scoped SampleRatio() = %v, want 0.25", got)
+	}
+	if got := NewNoopTracer().(SampleRatioReporter).SampleRatio(); got != 0 {
+		t.Errorf("noop SampleRatio() = %v, want 0", got)
+	}
+}
+
+func TestTracer_Tracer_Scoped_Noop(t *testing.T) {
+	scoped := NewNoopTracer().Scoped("github.com/acme/payments", "v1.4.0")
+	_, span := scoped.StartSpan(context.Background(), "charge")
+	scoped.EndSpan(span)
+	if span.IsRecording() {
+		t.Error("expected noop scoped tracer spans not to record")
+	}
+	if err := scoped.(Reloader).Reload(WithSampleRatio(0.5)); err != nil {
+		t.Errorf("Reload() error = %v", err)
+	}
+}
+
+func TestTracer_Tracer_ForceFlush(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(time.Hour)))
+	t.Cleanup(func() {
+		_ = tp.Shutdown(t.Context())
+	})
+	tr := &tracer{provider: tp, tracer: tp.Tracer("test")}
+
+	_, span := tr.Scoped("scope", "").StartSpan(context.Background(), "invocation")
+	span.End()
+	if got := len(exporter.GetSpans()); got != 0 {
+		t.Fatalf("expected the span to be buffered, got %d exported", got)
+	}
+
+	// Flushing a scope flushes the shared provider.
+	if err := tr.Scoped("scope", "").(Flusher).ForceFlush(context.Background()); err != nil {
+		t.Fatalf("ForceFlush() error = %v", err)
+	}
+	if got := len(exporter.GetSpans()); got != 1 {
+		t.Errorf("expected 1 span after ForceFlush, got %d", got)
+	}
+	if err := NewNoopTracer().(Flusher).ForceFlush(context.Background()); err != nil {
+		t.Errorf("noop ForceFlush() error = %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package tracer
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceStateValue returns the value of the W3C tracestate entry key of the span context in
+// ctx, or "" if the entry or span context is absent.
+func TraceStateValue(ctx context.Context, key string) string {
+	return trace.SpanContextFromContext(ctx).TraceState().Get(key)
+}
+
+// ContextWithTraceStateEntry returns a copy of ctx whose span context carries the tracestate
+// entry key=value, moved to the front as the W3C spec requires for updated entries. Spans
+// started from the returned context inherit the entry and InjectContext and the global
+// propagator send it downstream; the span already in ctx is not modified, but remains the
+// span ended, annotated, and returned by trace.SpanFromContext through the returned context.
+// It returns ErrTraceStateEntryInvalid if key or value is not valid per the W3C Trace Context
+// spec, or ErrTraceStateSpanRequired if ctx holds no valid span context.
+func ContextWithTraceStateEntry(ctx context.Context, key, value string) (context.Context, error) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ctx, ErrTraceStateSpanRequired
+	}
+	ts, err := sc.TraceState().Insert(key, value)
+	if err != nil {
+		return ctx, fmt.Errorf("%w: %v", ErrTraceStateEntryInvalid, err)
+	}
+	return withTraceState(ctx, sc, ts), nil
+}
+
+// ContextWithoutTraceStateEntry returns a copy of ctx whose span context no longer carries
+// the tracestate entry key. It returns ctx unchanged if the entry is absent.
+func ContextWithoutTraceStateEntry(ctx context.Context, key string) context.Context {
+	sc := trace.SpanContextFromContext(ctx)
+	if sc.TraceState().Get(key) == "" {
+		return ctx
+	}
+	return withTraceState(ctx, sc, sc.TraceState().Delete(key))
+}
+
+// withTraceState returns a copy of ctx whose span context is sc with tracestate ts. A remote
+// span context is replaced; a local span is wrapped so it keeps recording.
+func withTraceState(ctx context.Context, sc trace.SpanContext, ts trace.TraceState) context.Context {
+	sc = sc.WithTraceState(ts)
+	if sc.IsRemote() {
+		return trace.ContextWithRemoteSpanContext(ctx, sc)
+	}
+	return trace.ContextWithSpan(ctx, traceStateSpan{Span: trace.SpanFromContext(ctx), sc: sc})
+}
+
+// traceStateSpan is a span reporting a span context with an updated tracestate. Every other
+// method is forwarded to the wrapped span.
+type traceStateSpan struct {
+	trace.Span
+	sc trace.SpanContext
+}
+
+// SpanContext returns the span context with the updated tracestate.
+func (s traceStateSpan) SpanContext() trace.SpanContext {
+	return s.sc
+}
@@ -0,0 +1,91 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer_TraceState_ContextWithTraceStateEntry(t *testing.T) {
+	tr, exporter := newRecordingTracer(t)
+
+	ctx, _ := tr.StartSpan(context.Background(), "parent")
+	ctx, err := ContextWithTraceStateEntry(ctx, "vendor", "priority:high")
+	if err != nil {
+		t.Fatalf("ContextWithTraceStateEntry() error = %v", err)
+	}
+	if got := TraceStateValue(ctx, "vendor"); got != "priority:high" {
+		t.Errorf("TraceStateValue() = %q, want %q", got, "priority:high")
+	}
+
+	// The span in ctx keeps recording through the returned context.
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		t.Error("span in returned context is not recording")
+	}
+
+	// Children inherit the entry, and it is injected downstream.
+	_, child := tr.StartSpan(ctx, "child")
+	tr.EndSpan(child)
+	if got := child.SpanContext().TraceState().Get("vendor"); got != "priority:high" {
+		t.Errorf("child tracestate vendor = %q, want %q", got, "priority:high")
+	}
+	if md := tr.InjectContext(ctx); len(md.Get("tracestate")) != 1 || md.Get("tracestate")[0] != "vendor=priority:high" {
+		t.Errorf("injected tracestate = %v, want vendor=priority:high", md.Get("tracestate"))
+	}
+
+	trace.SpanFromContext(ctx).End()
+	if spans := exporter.GetSpans(); len(spans) != 2 || spans[1].Name != "parent" {
+		t.Errorf("exported spans = %v, want child then parent", spans)
+	}
+}
+
+func TestTracer_TraceState_Remote(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), sc)
+
+	ctx, err := ContextWithTraceStateEntry(ctx, "a", "1")
+	if err != nil {
+		t.Fatalf("ContextWithTraceStateEntry() error = %v", err)
+	}
+	ctx, err = ContextWithTraceStateEntry(ctx, "b", "2")
+	if err != nil {
+		t.Fatalf("ContextWithTraceStateEntry() error = %v", err)
+	}
+	got := trace.SpanContextFromContext(ctx)
+	if !got.IsRemote() || got.TraceState().String() != "b=2,a=1" {
+		t.Errorf("span context = remote %v, tracestate %q, want remote with b=2,a=1", got.IsRemote(), got.TraceState().String())
+	}
+
+	ctx = ContextWithoutTraceStateEntry(ctx, "a")
+	if got := trace.SpanContextFromContext(ctx).TraceState().String(); got != "b=2" {
+		t.Errorf("tracestate after delete = %q, want b=2", got)
+	}
+	if same := ContextWithoutTraceStateEntry(ctx, "missing"); same != ctx {
+		t.Error("ContextWithoutTraceStateEntry() of an absent key should return ctx unchanged")
+	}
+}
+
+func TestTracer_TraceState_Errors(t *testing.T) {
+	if _, err := ContextWithTraceStateEntry(context.Background(), "vendor", "x"); !errors.Is(err, ErrTraceStateSpanRequired) {
+		t.Errorf("without span: error = %v, want ErrTraceStateSpanRequired", err)
+	}
+	if got := TraceStateValue(context.Background(), "vendor"); got != "" {
+		t.Errorf("TraceStateValue() without span = %q, want empty", got)
+	}
+
+	tr, _ := newRecordingTracer(t)
+	ctx, span := tr.StartSpan(context.Background(), "parent")
+	defer tr.EndSpan(span)
+	for _, entry := range [][2]string{{"Invalid Key", "x"}, {"vendor", "bad,value"}} {
+		if _, err := ContextWithTraceStateEntry(ctx, entry[0], entry[1]); !errors.Is(err, ErrTraceStateEntryInvalid) {
+			t.Errorf("ContextWithTraceStateEntry(%q, %q) error = %v, want ErrTraceStateEntryInvalid", entry[0], entry[1], err)
+		}
+	}
+}
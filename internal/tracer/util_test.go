@@ -0,0 +1,63 @@
+package tracer
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestTracer_Util_ConvertFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		want   []attribute.KeyValue
+	}{
+		{
+			name:   "nil fields",
+			fields: nil,
+			want:   nil,
+		},
+		{
+			name:   "empty fields",
+			fields: map[string]interface{}{},
+			want:   []attribute.KeyValue{},
+		},
+		{
+			name: "typed values sorted by key",
+			fields: map[string]interface{}{
+				"string":   "value",
+				"bool":     true,
+				"int":      42,
+				"int64":    int64(7),
+				"float64":  1.5,
+				"duration": 2 * time.Second,
+				"strings":  []string{"a", "b"},
+				"error":    errors.New("boom"),
+				"struct":   struct{ ID int }{ID: 1},
+			},
+			want: []attribute.KeyValue{
+				attribute.Bool("bool", true),
+				attribute.String("duration", "2s"),
+				attribute.String("error", "boom"),
+				attribute.Float64("float64", 1.5),
+				attribute.Int("int", 42),
+				attribute.Int64("int64", 7),
+				attribute.String("string", "value"),
+				attribute.StringSlice("strings", []string{"a", "b"}),
+				attribute.String("struct", "{1}"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertFields(tt.fields)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("convertFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// viewerMaxTraces is the number of traces the dev viewer keeps; older traces are evicted.
+const viewerMaxTraces = 200
+
+// viewerTrace is a trace collected by the dev viewer.
+type viewerTrace struct {
+	id    trace.TraceID
+	spans []sdktrace.ReadOnlySpan
+}
+
+// traceViewer is a span processor that keeps the spans of the last viewerMaxTraces traces in
+// memory and serves them on localhost as HTML: an index of the traces and a waterfall of each
+// one. It is a development aid replacing a local Jaeger, not meant for production, where the
+// spans would only use memory.
+type traceViewer struct {
+	server *http.Server
+	done   chan struct{} // done is closed when the server stops serving.
+
+	mu     sync.Mutex
+	traces map[trace.TraceID]*viewerTrace
+	order  []trace.TraceID // order lists the traces by arrival of their first ended span.
+}
+
+// newTraceViewer creates a traceViewer and starts serving it on localhost at port.
+func newTraceViewer(port int) (*traceViewer, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start trace viewer: %w", err)
+	}
+	v := newTraceViewerStore()
+	v.server = &http.Server{Handler: v.handler(), ReadHeaderTimeout: 5 * time.Second}
+	v.done = make(chan struct{})
+	go func() {
+		defer close(v.done)
+		if err := v.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			otel.Handle(fmt.Errorf("trace viewer: %w", err))
+		}
+	}()
+	return v, nil
+}
+
+// newTraceViewerStore creates a traceViewer collecting spans without serving them.
+func newTraceViewerStore() *traceViewer {
+	return &traceViewer{traces: make(map[trace.TraceID]*viewerTrace)}
+}
+
+// OnStart does nothing; spans are collected when they end.
+func (v *traceViewer) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd adds s to its trace, evicting the oldest trace when the viewer is full.
+func (v *traceViewer) OnEnd(s sdktrace.ReadOnlySpan) {
+	id := s.SpanContext().TraceID()
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	t, ok := v.traces[id]
+	if !ok {
+		if len(v.order) == viewerMaxTraces {
+			delete(v.traces, v.order[0])
+			v.order = v.order[1:]
+		}
+		t = &viewerTrace{id: id}
+		v.traces[id] = t
+		v.order = append(v.order, id)
+	}
+	t.spans = append(t.spans, s)
+}
+
+// Shutdown stops the server.
+func (v *traceViewer) Shutdown(ctx context.Context) error {
+	if v.server == nil {
+		return nil
+	}
+	if err := v.server.Shutdown(ctx); err != nil {
+		return err
+	}
+	<-v.done
+	return nil
+}
+
+// ForceFlush does nothing; spans are viewable as soon as they end.
+func (v *traceViewer) ForceFlush(context.Context) error { return nil }
+
+// handler returns the routes of the viewer: "/" lists the traces, newest first, and
+// "/traces/<trace id>" shows the waterfall of one trace.
+func (v *traceViewer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = viewerIndexTemplate.Execute(w, v.summaries())
+	})
+	mux.HandleFunc("/traces/", func(w http.ResponseWriter, r *http.Request) {
+		id, err := trace.TraceIDFromHex(strings.TrimPrefix(r.URL.Path, "/traces/"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		rows, ok := v.waterfall(id)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = viewerTraceTemplate.Execute(w, struct {
+			ID   string
+			Rows []viewerRow
+		}{id.String(), rows})
+	})
+	return mux
+}
+
+// viewerSummary is a line of the trace index.
+type viewerSummary struct {
+	ID       string
+	Name     string // Name is the name of the root span, or of the earliest span while the root is open.
+	Start    time.Time
+	Duration time.Duration
+	Spans    int
+	Error    bool
+}
+
+// summaries returns the index of the collected traces, newest first.
+func (v *traceViewer) summaries() []viewerSummary {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	summaries := make([]viewerSummary, 0, len(v.order))
+	for i := len(v.order) - 1; i >= 0; i-- {
+		t := v.traces[v.order[i]]
+		start, end := viewerBounds(t.spans)
+		summary := viewerSummary{ID: t.id.String(), Start: start, Duration: end.Sub(start), Spans: len(t.spans)}
+		var first time.Time
+		root := false
+		for _, s := range t.spans {
+			if !s.Parent().IsValid() || s.Parent().IsRemote() {
+				summary.Name, root = s.Name(), true
+			} else if !root && (first.IsZero() || s.StartTime().Before(first)) {
+				summary.Name, first = s.Name(), s.StartTime()
+			}
+			if s.Status().Code == codes.Error {
+				summary.Error = true
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+// viewerRow is a span of the waterfall, positioned in percent of the trace duration.
+type viewerRow struct {
+	Name       string
+	Depth      int
+	Offset     float64
+	Width      float64
+	Duration   time.Duration
+	Error      bool
+	Attributes string
+}
+
+// waterfall returns the spans of the trace id in tree order, children after their parent by
+// start time, or false when the trace is unknown. Spans whose parent is not collected are
+// shown at the top level.
+func (v *traceViewer) waterfall(id trace.TraceID) ([]viewerRow, bool) {
+	v.mu.Lock()
+	t, ok := v.traces[id]
+	var spans []sdktrace.ReadOnlySpan
+	if ok {
+		spans = append(spans, t.spans...)
+	}
+	v.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].StartTime().Before(spans[j].StartTime()) })
+	known := make(map[trace.SpanID]bool, len(spans))
+	for _, s := range spans {
+		known[s.SpanContext().SpanID()] = true
+	}
+	children := make(map[trace.SpanID][]sdktrace.ReadOnlySpan)
+	var roots []sdktrace.ReadOnlySpan
+	for _, s := range spans {
+		if parent := s.Parent().SpanID(); known[parent] {
+			children[parent] = append(children[parent], s)
+		} else {
+			roots = append(roots, s)
+		}
+	}
+
+	start, end := viewerBounds(spans)
+	total := float64(end.Sub(start))
+	if total <= 0 {
+		total = 1
+	}
+	rows := make([]viewerRow, 0, len(spans))
+	var add func(s sdktrace.ReadOnlySpan, depth int)
+	add = func(s sdktrace.ReadOnlySpan, depth int) {
+		duration := s.EndTime().Sub(s.StartTime())
+		attrs := make([]string, 0, len(s.Attributes()))
+		for _, kv := range s.Attributes() {
+			attrs = append(attrs, string(kv.Key)+"="+kv.Value.Emit())
+		}
+		rows = append(rows, viewerRow{
+			Name:       s.Name(),
+			Depth:      depth,
+			Offset:     float64(s.StartTime().Sub(start)) / total * 100,
+			Width:      max(float64(duration)/total*100, 0.2),
+			Duration:   duration,
+			Error:      s.Status().Code == codes.Error,
+			Attributes: strings.Join(attrs, "\n"),
+		})
+		for _, child := range children[s.SpanContext().SpanID()] {
+			add(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		add(root, 0)
+	}
+	return rows, true
+}
+
+// viewerBounds returns the earliest start and the latest end of spans.
+func viewerBounds(spans []sdktrace.ReadOnlySpan) (time.Time, time.Time) {
+	var start, end time.Time
+	for _, s := range spans {
+		if start.IsZero() || s.StartTime().Before(start) {
+			start = s.StartTime()
+		}
+		if s.EndTime().After(end) {
+			end = s.EndTime()
+		}
+	}
+	return start, end
+}
+
+// viewerStyle is the stylesheet of the viewer pages.
+const viewerStyle = `<style>
+body { font: 14px sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; width: 100%; }
+td, th { padding: 4px 8px; text-align: left; border-bottom: 1px solid #eee; white-space: nowrap; }
+.error { color: #c62828; }
+.name { width: 30%; overflow: hidden; text-overflow: ellipsis; max-width: 400px; }
+.track { width: 60%; position: relative; }
+.bar { position: absolute; top: 6px; height: 12px; background: #1e88e5; border-radius: 2px; }
+.bar.error { background: #e53935; }
+</style>`
+
+var viewerIndexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Traces</title>` + viewerStyle + `</head><body>
+<h1>Traces</h1>
+{{if not .}}<p>No spans have ended yet.</p>{{else}}
+<table><tr><th>Trace</th><th>Started</th><th>Duration</th><th>Spans</th></tr>
+{{range .}}<tr{{if .Error}} class="error"{{end}}><td><a href="/traces/{{.ID}}">{{.Name}}</a></td><td>{{.Start.Format "15:04:05.000"}}</td><td>{{.Duration}}</td><td>{{.Spans}}</td></tr>
+{{end}}</table>{{end}}
+</body></html>
+`))
+
+var viewerTraceTemplate = template.Must(template.New("trace").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Trace {{.ID}}</title>` + viewerStyle + `</head><body>
+<p><a href="/">All traces</a></p>
+<h1>Trace {{.ID}}</h1>
+<table><tr><th>Span</th><th>Timeline</th><th>Duration</th></tr>
+{{range .Rows}}<tr title="{{.Attributes}}"{{if .Error}} class="error"{{end}}><td class="name" style="padding-left: {{.Depth}}em">{{.Name}}</td><td class="track"><div class="bar{{if .Error}} error{{end}}" style="left: {{printf "%.2f" .Offset}}%; width: {{printf "%.2f" .Width}}%"></div></td><td>{{.Duration}}</td></tr>
+{{end}}</table>
+</body></html>
+`))
@@ -0,0 +1,120 @@
+package tracer
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTracer_Viewer_Handler(t *testing.T) {
+	viewer := newTraceViewerStore()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(viewer))
+	defer func() {
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	tr := provider.Tracer("test")
+	ctx, root := tr.Start(context.Background(), "GET /orders")
+	_, child := tr.Start(ctx, "SELECT orders")
+	child.SetStatus(codes.Error, "timeout")
+	child.End()
+	root.End()
+	traceID := root.SpanContext().TraceID().String()
+
+	handler := viewer.handler()
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantBody   []string
+	}{
+		{"index lists traces", "/", http.StatusOK, []string{`href="/traces/` + traceID + `"`, "GET /orders", "<td>2</td>"}},
+		{"trace shows waterfall", "/traces/" + traceID, http.StatusOK, []string{"GET /orders", `padding-left: 1em">SELECT orders`, `class="bar error"`}},
+		{"unknown trace", "/traces/0102030405060708090a0b0c0d0e0f10", http.StatusNotFound, nil},
+		{"invalid trace id", "/traces/orders", http.StatusNotFound, nil},
+		{"unknown path", "/favicon.ico", http.StatusNotFound, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			for _, want := range tt.wantBody {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Errorf("body does not contain %q:\n%s", want, rec.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestTracer_Viewer_OnEnd_Evicts(t *testing.T) {
+	viewer := newTraceViewerStore()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(viewer))
+	defer func() {
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	tr := provider.Tracer("test")
+	_, first := tr.Start(context.Background(), "first")
+	first.End()
+	for i := 0; i < viewerMaxTraces; i++ {
+		_, span := tr.Start(context.Background(), "next")
+		span.End()
+	}
+
+	if got := len(viewer.summaries()); got != viewerMaxTraces {
+		t.Errorf("viewer keeps %d traces, want %d", got, viewerMaxTraces)
+	}
+	if _, ok := viewer.waterfall(first.SpanContext().TraceID()); ok {
+		t.Error("oldest trace was not evicted")
+	}
+}
+
+func TestTracer_Viewer_NewTracer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	tracerInstance, err := NewTracer(
+		WithServiceName("test-service"),
+		WithProvider("stdout", "", 0),
+		WithWriter(io.Discard),
+		WithDevViewer(port),
+	)
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	_, span := tracerInstance.StartSpan(context.Background(), "checkout")
+	span.End()
+
+	resp, err := http.Get("http://" + listener.Addr().String() + "/")
+	if err != nil {
+		t.Fatalf("GET / error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if !strings.Contains(string(body), "checkout") {
+		t.Errorf("index does not list the span:\n%s", body)
+	}
+
+	if err := tracerInstance.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if _, err := http.Get("http://" + listener.Addr().String() + "/"); err == nil {
+		t.Error("viewer still serving after Shutdown")
+	}
+}
@@ -0,0 +1,126 @@
+package tracer
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// xrayHeader is the header AWS X-Ray, load balancers, and API Gateway carry trace context in.
+const xrayHeader = "X-Amzn-Trace-Id"
+
+// NewPropagator returns the propagator the tracer extracts and injects trace context with:
+// the W3C trace context, and with xray also the X-Ray trace header. When a request carries
+// both, the W3C trace context is used.
+func NewPropagator(xray bool) propagation.TextMapPropagator {
+	if !xray {
+		return propagation.TraceContext{}
+	}
+	// the last propagator extracting a valid context wins
+	return propagation.NewCompositeTextMapPropagator(xrayPropagator{}, propagation.TraceContext{})
+}
+
+// xrayPropagator propagates trace context in the X-Ray trace header, in the form
+// "Root=1-<epoch>-<unique>;Parent=<span id>;Sampled=<0|1>". The other fields of the header,
+// such as Lineage, are ignored.
+type xrayPropagator struct{}
+
+// Inject sets the X-Ray trace header from the span context in ctx, if it is valid.
+func (xrayPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	traceID := sc.TraceID().String()
+	sampled := "0"
+	if sc.IsSampled() {
+		sampled = "1"
+	}
+	carrier.Set(xrayHeader, "Root=1-"+traceID[:8]+"-"+traceID[8:]+";Parent="+sc.SpanID().String()+";Sampled="+sampled)
+}
+
+// Extract returns ctx with the remote span context of the X-Ray trace header in carrier, or
+// ctx unchanged when the header is missing or malformed.
+func (xrayPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	sc, ok := parseXRayHeader(carrier.Get(xrayHeader))
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the X-Ray trace header.
+func (xrayPropagator) Fields() []string {
+	return []string{xrayHeader}
+}
+
+// parseXRayHeader parses an X-Ray trace header. A header without Parent, such as the one a
+// load balancer adds to a request starting a trace, is not a span context to continue.
+func parseXRayHeader(header string) (trace.SpanContext, bool) {
+	var (
+		config trace.SpanContextConfig
+		err    error
+	)
+	for _, field := range strings.Split(header, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		switch key {
+		case "Root":
+			version, rest, _ := strings.Cut(value, "-")
+			epoch, unique, _ := strings.Cut(rest, "-")
+			if version != "1" || len(epoch) != 8 || len(unique) != 24 {
+				return trace.SpanContext{}, false
+			}
+			if config.TraceID, err = trace.TraceIDFromHex(epoch + unique); err != nil {
+				return trace.SpanContext{}, false
+			}
+		case "Parent":
+			if config.SpanID, err = trace.SpanIDFromHex(value); err != nil {
+				return trace.SpanContext{}, false
+			}
+		case "Sampled":
+			if value == "1" {
+				config.TraceFlags = trace.FlagsSampled
+			}
+		}
+	}
+	config.Remote = true
+	sc := trace.NewSpanContext(config)
+	return sc, sc.IsValid()
+}
+
+// xrayIDGenerator generates random trace IDs starting with their creation time in Unix seconds,
+// as X-Ray requires, and random span IDs.
+type xrayIDGenerator struct {
+	now func() time.Time
+}
+
+// NewXRayIDGenerator returns an IDGenerator producing X-Ray compatible trace IDs: the first 4
+// bytes are the Unix time in seconds and the other 12 are random. X-Ray rejects trace IDs
+// whose time is not within the last 30 days, so spans with random trace IDs exported through
+// the ADOT collector never reach it.
+func NewXRayIDGenerator() IDGenerator {
+	return &xrayIDGenerator{now: time.Now}
+}
+
+// NewIDs returns a new X-Ray compatible trace ID and a random span ID.
+func (g *xrayIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	var traceID trace.TraceID
+	binary.BigEndian.PutUint32(traceID[:4], uint32(g.now().Unix()))
+	binary.BigEndian.PutUint32(traceID[4:8], rand.Uint32())
+	binary.BigEndian.PutUint64(traceID[8:], rand.Uint64())
+	return traceID, g.NewSpanID(ctx, traceID)
+}
+
+// NewSpanID returns a random, non-zero span ID.
+func (g *xrayIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	var spanID trace.SpanID
+	for !spanID.IsValid() {
+		binary.BigEndian.PutUint64(spanID[:], rand.Uint64())
+	}
+	return spanID
+}
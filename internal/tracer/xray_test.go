@@ -0,0 +1,152 @@
+package tracer
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTracer_XRay_ParseXRayHeader(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      string
+		wantOK      bool
+		wantTraceID string
+		wantSpanID  string
+		wantSampled bool
+	}{
+		{
+			name:        "sampled",
+			header:      "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+			wantOK:      true,
+			wantTraceID: "5759e988bd862e3fe1be46a994272793",
+			wantSpanID:  "53995c3f42cd8ad8",
+			wantSampled: true,
+		},
+		{
+			name:        "not sampled with lineage",
+			header:      "Root=1-5759e988-bd862e3fe1be46a994272793; Parent=53995c3f42cd8ad8; Sampled=0; Lineage=a87bd80c:1",
+			wantOK:      true,
+			wantTraceID: "5759e988bd862e3fe1be46a994272793",
+			wantSpanID:  "53995c3f42cd8ad8",
+		},
+		{name: "root only", header: "Root=1-5759e988-bd862e3fe1be46a994272793", wantOK: false},
+		{name: "unknown version", header: "Root=2-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8", wantOK: false},
+		{name: "invalid parent", header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=xyz", wantOK: false},
+		{name: "empty", header: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, ok := parseXRayHeader(tt.header)
+			if ok != tt.wantOK {
+				t.Fatalf("parseXRayHeader() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if sc.TraceID().String() != tt.wantTraceID || sc.SpanID().String() != tt.wantSpanID {
+				t.Errorf("parseXRayHeader() = %s/%s, want %s/%s", sc.TraceID(), sc.SpanID(), tt.wantTraceID, tt.wantSpanID)
+			}
+			if sc.IsSampled() != tt.wantSampled || !sc.IsRemote() {
+				t.Errorf("parseXRayHeader() sampled = %v, remote = %v, want %v, true", sc.IsSampled(), sc.IsRemote(), tt.wantSampled)
+			}
+		})
+	}
+}
+
+func TestTracer_XRay_Inject(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("5759e988bd862e3fe1be46a994272793")
+	spanID, _ := trace.SpanIDFromHex("53995c3f42cd8ad8")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	carrier := propagation.HeaderCarrier{}
+	NewPropagator(true).Inject(ctx, carrier)
+	if got, want := carrier.Get(xrayHeader), "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1"; got != want {
+		t.Errorf("%s = %q, want %q", xrayHeader, got, want)
+	}
+	if carrier.Get("traceparent") == "" {
+		t.Error("traceparent not injected next to the X-Ray header")
+	}
+
+	carrier = propagation.HeaderCarrier{}
+	NewPropagator(false).Inject(ctx, carrier)
+	if got := carrier.Get(xrayHeader); got != "" {
+		t.Errorf("%s = %q without X-Ray propagation, want none", xrayHeader, got)
+	}
+}
+
+func TestTracer_XRay_Extract(t *testing.T) {
+	const xray = "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1"
+	const traceparent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	tests := []struct {
+		name        string
+		xray        bool
+		md          metadata.MD
+		wantTraceID string
+	}{
+		{"x-ray header", true, metadata.Pairs("x-amzn-trace-id", xray), "5759e988bd862e3fe1be46a994272793"},
+		{"w3c wins over x-ray", true, metadata.Pairs("x-amzn-trace-id", xray, "traceparent", traceparent), "0af7651916cd43dd8448eb211c80319c"},
+		{"x-ray disabled", false, metadata.Pairs("x-amzn-trace-id", xray), "00000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := NewTracer(WithServiceName("test-service"), WithXRayPropagation(tt.xray))
+			if err != nil {
+				t.Fatalf("NewTracer() error = %v", err)
+			}
+			defer func() {
+				_ = tr.Shutdown(context.Background())
+			}()
+
+			ctx := tr.ExtractContext(context.Background(), tt.md)
+			if got := trace.SpanContextFromContext(ctx).TraceID().String(); got != tt.wantTraceID {
+				t.Errorf("extracted trace ID = %s, want %s", got, tt.wantTraceID)
+			}
+		})
+	}
+}
+
+func TestTracer_XRay_IDGenerator(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	gen := &xrayIDGenerator{now: func() time.Time { return now }}
+
+	traceID, spanID := gen.NewIDs(context.Background())
+	if got := binary.BigEndian.Uint32(traceID[:4]); got != uint32(now.Unix()) {
+		t.Errorf("trace ID time = %d, want %d", got, now.Unix())
+	}
+	if !traceID.IsValid() || !spanID.IsValid() {
+		t.Errorf("NewIDs() = %s/%s, want valid IDs", traceID, spanID)
+	}
+	if other, _ := gen.NewIDs(context.Background()); other == traceID {
+		t.Errorf("NewIDs() returned %s twice", traceID)
+	}
+}
+
+func TestTracer_XRay_WithXRayIDs(t *testing.T) {
+	tr, err := NewTracer(WithServiceName("test-service"), WithXRayIDs(true))
+	if err != nil {
+		t.Fatalf("NewTracer() error = %v", err)
+	}
+	defer func() {
+		_ = tr.Shutdown(context.Background())
+	}()
+
+	before := time.Now().Unix()
+	_, span := tr.StartSpan(context.Background(), "operation")
+	tr.EndSpan(span)
+	traceID := span.SpanContext().TraceID()
+	if got := int64(binary.BigEndian.Uint32(traceID[:4])); got < before || got > time.Now().Unix() {
+		t.Errorf("trace ID %s does not start with the current time %d", traceID, before)
+	}
+}
@@ -0,0 +1,142 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// JobRunner instruments a scheduled or periodic job.
+// Every call to Run gets its own root span, and the outcome of each run is recorded in
+// shared job metrics labelled with the job name:
+//   - job_success_total: counter of successful runs
+//   - job_failure_total: counter of failed runs
+//   - job_duration_ms: histogram of run durations in milliseconds
+//   - job_last_success_timestamp_seconds: gauge holding the Unix time of the last successful run
+//
+// A JobRunner is safe for concurrent use.
+type JobRunner struct {
+	name        string
+	monitoring  *Monitoring
+	successes   otelmetric.Int64Counter
+	failures    otelmetric.Int64Counter
+	duration    otelmetric.Int64Histogram
+	lastSuccess otelmetric.Int64Gauge
+}
+
+// NewJobRunner creates a JobRunner for the job with the given name.
+// The job metrics are created once here so that Run only records values.
+//
+// Parameters:
+//   - name: The name of the job (e.g., "cleanup-expired-sessions")
+//
+// Returns an error if any of the job metrics cannot be created.
+//
+// Example:
+//
+//	job, err := mon.NewJobRunner("cleanup-expired-sessions")
+//	if err != nil {
+//	    return err
+//	}
+//	for range time.Tick(time.Hour) {
+//	    _ = job.Run(ctx, cleanupExpiredSessions)
+//	}
+func (m *Monitoring) NewJobRunner(name string) (*JobRunner, error) {
+	j := &JobRunner{
+		name:       name,
+		monitoring: m,
+	}
+	if m.Metric == nil {
+		return j, nil
+	}
+
+	var err error
+	if j.successes, err = m.Metric.CreateCounter("job_success_total", "1", "Total number of successful job runs"); err != nil {
+		return nil, err
+	}
+	if j.failures, err = m.Metric.CreateCounter("job_failure_total", "1", "Total number of failed job runs"); err != nil {
+		return nil, err
+	}
+	if j.duration, err = m.Metric.CreateHistogram("job_duration_ms", "ms", "Job run duration in milliseconds"); err != nil {
+		return nil, err
+	}
+	if j.lastSuccess, err = m.Metric.CreateGauge("job_last_success_timestamp_seconds", "s", "Unix time of the last successful job run"); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Run executes one run of the job and records its outcome.
+// fn runs under a new root span named after the job; a panic inside fn is recovered and
+// reported as a failed run. The error returned by fn (or the recovered panic) is returned
+// to the caller.
+//
+// Parameters:
+//   - ctx: The context for this run
+//   - fn: The job body
+//
+// Example:
+//
+//	if err := job.Run(ctx, func(ctx context.Context) error {
+//	    return store.DeleteExpiredSessions(ctx)
+//	}); err != nil {
+//	    log.Printf("cleanup failed: %v", err)
+//	}
+func (j *JobRunner) Run(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	m := j.monitoring
+	labels := []attribute.KeyValue{attribute.String("job", j.name)}
+
+	var span trace.Span
+	if m.Tracer != nil {
+		ctx, span = m.Tracer.StartSpan(ctx, j.name,
+			trace.WithNewRoot(),
+			trace.WithAttributes(labels...),
+		)
+		defer m.Tracer.EndSpan(span)
+	}
+
+	start := m.now()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job %s panicked: %v", j.name, r)
+		}
+		j.record(ctx, span, m.now().Sub(start), err, labels)
+	}()
+
+	return fn(ctx)
+}
+
+// record reports the outcome of a single run on the span, logger, and job metrics.
+func (j *JobRunner) record(ctx context.Context, span trace.Span, elapsed time.Duration, err error, labels []attribute.KeyValue) {
+	m := j.monitoring
+
+	if err != nil {
+		if span != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		if m.Logger != nil {
+			m.Logger.Error("Job run failed", map[string]interface{}{
+				"job":         j.name,
+				"duration_ms": elapsed.Milliseconds(),
+				"error":       err.Error(),
+			})
+		}
+	}
+
+	if m.Metric == nil || j.duration == nil {
+		return
+	}
+	m.Metric.RecordHistogram(ctx, j.duration, elapsed.Milliseconds(), labels...)
+	if err != nil {
+		m.Metric.RecordCounter(ctx, j.failures, 1, labels...)
+		return
+	}
+	m.Metric.RecordCounter(ctx, j.successes, 1, labels...)
+	m.Metric.RecordGauge(ctx, j.lastSuccess, m.now().Unix(), labels...)
+}
@@ -0,0 +1,132 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMonitoring_Job_NewJobRunner(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	job, err := mon.NewJobRunner("test-job")
+	if err != nil {
+		t.Fatalf("NewJobRunner() error = %v", err)
+	}
+	if job == nil {
+		t.Fatal("expected job runner, got nil")
+	}
+
+	// Creating a second runner must reuse the shared job instruments.
+	if _, err := mon.NewJobRunner("other-job"); err != nil {
+		t.Errorf("NewJobRunner() second runner error = %v", err)
+	}
+}
+
+func TestMonitoring_Job_Run(t *testing.T) {
+	tests := []struct {
+		name       string
+		fn         func(ctx context.Context) error
+		wantErr    bool
+		wantErrMsg string
+		wantLogs   int
+	}{
+		{
+			name:     "success",
+			fn:       func(ctx context.Context) error { return nil },
+			wantErr:  false,
+			wantLogs: 0,
+		},
+		{
+			name:       "failure",
+			fn:         func(ctx context.Context) error { return errors.New("boom") },
+			wantErr:    true,
+			wantErrMsg: "boom",
+			wantLogs:   1,
+		},
+		{
+			name:       "panic",
+			fn:         func(ctx context.Context) error { panic("boom") },
+			wantErr:    true,
+			wantErrMsg: "job test-job panicked: boom",
+			wantLogs:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(WithServiceName("test-service"))
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+			recorder := &recordingLogger{}
+			mon.Logger = recorder
+
+			job, err := mon.NewJobRunner("test-job")
+			if err != nil {
+				t.Fatalf("NewJobRunner() error = %v", err)
+			}
+
+			err = job.Run(context.Background(), tt.fn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Run() error = %q, want %q", err.Error(), tt.wantErrMsg)
+			}
+			if len(recorder.errors) != tt.wantLogs {
+				t.Errorf("expected %d error logs, got %d", tt.wantLogs, len(recorder.errors))
+			}
+		})
+	}
+}
+
+func TestMonitoring_Job_Run_NilComponents(t *testing.T) {
+	job, err := (&Monitoring{}).NewJobRunner("bare")
+	if err != nil {
+		t.Fatalf("NewJobRunner() error = %v", err)
+	}
+	if err := job.Run(context.Background(), func(ctx context.Context) error { return nil }); err != nil {
+		t.Errorf("Run() error = %v", err)
+	}
+}
+
+func TestMonitoring_Job_Run_Clock(t *testing.T) {
+	clk := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	mon, err := NewMonitoring(WithServiceName("test-service"), WithClock(clk))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	recorder := &recordingLogger{}
+	mon.Logger = recorder
+
+	job, err := mon.NewJobRunner("test-job")
+	if err != nil {
+		t.Fatalf("NewJobRunner() error = %v", err)
+	}
+	_ = job.Run(context.Background(), func(ctx context.Context) error {
+		clk.Advance(1500 * time.Millisecond)
+		return errors.New("boom")
+	})
+
+	if len(recorder.errors) != 1 {
+		t.Fatalf("expected 1 error log, got %d", len(recorder.errors))
+	}
+	if got := recorder.errors[0]["duration_ms"]; got != int64(1500) {
+		t.Errorf("duration_ms = %v, want 1500", got)
+	}
+}
@@ -0,0 +1,202 @@
+package monitoring
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/adityakw90/go-monitoring/internal/tracer"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultTraceIDHeader is the response header WithTraceIDResponseHeader uses when no name is given.
+const defaultTraceIDHeader = "X-Trace-Id"
+
+// Body size histograms recorded by HTTPMiddleware when WithHTTPBodyRecording is enabled.
+const (
+	requestSizeMetricName  = "http_server_request_size_bytes"
+	responseSizeMetricName = "http_server_response_size_bytes"
+)
+
+// HTTPMiddleware returns an http.Handler that traces every request served by next with
+// Tracer.SpanFromRequest: the span continues the trace of the incoming W3C headers, is named
+// after the http.ServeMux route that served the request (e.g. "GET /orders/{id}"), and ends
+// with the response status. Ignored routes (see WithIgnoredRoutes) are served without a span;
+// when next is an *http.ServeMux, the request is routed before the span starts, so ignored
+// routes written as mux patterns match too.
+// With WithTraceIDResponseHeader, the trace ID is also returned to the client in a response
+// header, so a user-reported request can be found in the tracing backend. With
+// WithHTTPBodyRecording, the request and response body sizes and content types are recorded on
+// the span and in the "http_server_request_size_bytes" and "http_server_response_size_bytes"
+// histograms, and body snippets on the span; ignored routes record neither. Handlers can still
+// flush the response and hijack the connection through the http.Flusher and http.Hijacker
+// interfaces, for server-sent events and websocket upgrades.
+//
+// Parameters:
+//   - next: The handler to trace, typically an *http.ServeMux
+//
+// Example:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("GET /orders/{id}", getOrder)
+//	http.ListenAndServe(":8080", mon.HTTPMiddleware(mux))
+func (m *Monitoring) HTTPMiddleware(next http.Handler) http.Handler {
+	mux, _ := next.(*http.ServeMux)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if mux != nil && r.Pattern == "" {
+			// Route the request up front, so the ignored routes and the span name see its
+			// pattern; the mux routes it again when serving it.
+			if _, pattern := mux.Handler(r); pattern != "" {
+				r = r.WithContext(r.Context())
+				r.Pattern = pattern
+			}
+		}
+		ctx, span, finish := m.tracerOrNoop().SpanFromRequest(r)
+
+		m.mu.Lock()
+		header := ""
+		recordBodies, snippetLimit := false, 0
+		var ignoredRoutes []string
+		if m.options != nil {
+			header = m.options.TraceIDResponseHeader
+			recordBodies, snippetLimit = m.options.Tracer.BodyRecording, m.options.Tracer.BodySnippetLimit
+			ignoredRoutes = m.options.Tracer.IgnoredRoutes
+		}
+		m.mu.Unlock()
+		// Decided before next routes the request, as for the span, so both always agree.
+		ignored := tracer.IgnoredRoute(ignoredRoutes, r)
+		if spanContext := span.SpanContext(); header != "" && spanContext.HasTraceID() {
+			w.Header().Set(header, spanContext.TraceID().String())
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		var requestBody *tracer.BodyCapture
+		if recordBodies && r.Body != nil {
+			requestBody = tracer.NewBodyCapture(snippetLimit)
+			recorder.body = tracer.NewBodyCapture(snippetLimit)
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.TeeReader(r.Body, requestBody), r.Body}
+		}
+		r = r.WithContext(ctx)
+		next.ServeHTTP(recorder, r)
+
+		// ServeMux sets the pattern on the request it was given while routing, so the route is
+		// only known once next returns.
+		reportRoutePattern(r)
+		route := tracer.HTTPRoute(r)
+		if route != "" && span.IsRecording() {
+			span.SetName(r.Method + " " + route)
+			span.SetAttributes(semconv.HTTPRoute(route))
+		}
+		if requestBody != nil && !ignored {
+			m.recordHTTPBodies(ctx, span, r, route, requestBody, recorder)
+		}
+		finish(recorder.status)
+	})
+}
+
+// routePatternKey is the context key of the *string a middleware wrapping HTTPMiddleware, such
+// as SLOTracker.Middleware, receives the http.ServeMux pattern of a request through. The mux
+// routes the copy of the request HTTPMiddleware creates, so the pattern is not set on the
+// request the outer middleware holds.
+type routePatternKey struct{}
+
+// withRoutePattern returns a copy of r whose context carries the string the pattern of r is
+// reported to by reportRoutePattern.
+func withRoutePattern(r *http.Request) (*http.Request, *string) {
+	pattern := new(string)
+	return r.WithContext(context.WithValue(r.Context(), routePatternKey{}, pattern)), pattern
+}
+
+// reportRoutePattern stores the pattern of r in the string of withRoutePattern, when its context
+// carries one.
+func reportRoutePattern(r *http.Request) {
+	if pattern, ok := r.Context().Value(routePatternKey{}).(*string); ok && r.Pattern != "" {
+		*pattern = r.Pattern
+	}
+}
+
+// recordHTTPBodies records the body sizes, content types, and snippets of a request served by
+// HTTPMiddleware on span and the body sizes in the size histograms, labelled with the method
+// and route. The request size is its Content-Length when known, or the bytes the handler read.
+func (m *Monitoring) recordHTTPBodies(ctx context.Context, span trace.Span, r *http.Request, route string, requestBody *tracer.BodyCapture, recorder *statusRecorder) {
+	requestSize := r.ContentLength
+	if requestSize < 0 {
+		requestSize = requestBody.Size()
+	}
+	responseSize := recorder.body.Size()
+	span.SetAttributes(requestBody.Attributes("request", r.Header.Get("Content-Type"), requestSize)...)
+	span.SetAttributes(recorder.body.Attributes("response", recorder.Header().Get("Content-Type"), responseSize)...)
+
+	if m.Metric == nil {
+		return
+	}
+	labels := []attribute.KeyValue{semconv.HTTPRequestMethodKey.String(r.Method), semconv.HTTPRoute(route)}
+	if histogram, err := m.Metric.CreateHistogram(requestSizeMetricName, "By", "Size of HTTP server request bodies"); err == nil {
+		m.Metric.RecordHistogram(ctx, histogram, requestSize, labels...)
+	}
+	if histogram, err := m.Metric.CreateHistogram(responseSizeMetricName, "By", "Size of HTTP server response bodies"); err == nil {
+		m.Metric.RecordHistogram(ctx, histogram, responseSize, labels...)
+	}
+}
+
+// statusRecorder is an http.ResponseWriter that remembers the status code written through it
+// and, when body is set, captures the response body.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        *tracer.BodyCapture
+}
+
+// WriteHeader records status and writes it to the underlying ResponseWriter.
+func (s *statusRecorder) WriteHeader(status int) {
+	if !s.wroteHeader {
+		s.status = status
+		s.wroteHeader = true
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// Write writes b to the underlying ResponseWriter, which implies a 200 status if none was written.
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	s.wroteHeader = true
+	n, err := s.ResponseWriter.Write(b)
+	if s.body != nil {
+		_, _ = s.body.Write(b[:n])
+	}
+	return n, err
+}
+
+// Flush sends any buffered data to the client, so streamed responses such as server-sent
+// events are delivered through the middleware. It does nothing when the underlying
+// ResponseWriter cannot flush.
+func (s *statusRecorder) Flush() {
+	s.wroteHeader = true
+	_ = http.NewResponseController(s.ResponseWriter).Flush()
+}
+
+// Hijack hands the connection over to the handler, as websocket upgrades require. A request
+// whose connection was hijacked before a status was written is recorded as 101 Switching
+// Protocols. It returns an error wrapping http.ErrNotSupported when the underlying
+// ResponseWriter cannot be hijacked.
+func (s *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := http.NewResponseController(s.ResponseWriter).Hijack()
+	if err == nil && !s.wroteHeader {
+		s.status = http.StatusSwitchingProtocols
+		s.wroteHeader = true
+	}
+	return conn, rw, err
+}
+
+// Unwrap returns the underlying ResponseWriter, so http.ResponseController can reach its
+// other optional interfaces.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
@@ -0,0 +1,453 @@
+package monitoring
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMonitoring_Middleware_HTTPMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       []Option
+		target     string
+		wantName   string
+		wantStatus int
+		wantHeader string
+	}{
+		{
+			name:       "routed request without header",
+			target:     "/orders/42",
+			wantName:   "GET /orders/{id}",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "default header name",
+			opts:       []Option{WithTraceIDResponseHeader(true, "")},
+			target:     "/orders/42",
+			wantName:   "GET /orders/{id}",
+			wantStatus: http.StatusOK,
+			wantHeader: "X-Trace-Id",
+		},
+		{
+			name:       "custom header name on a server error",
+			opts:       []Option{WithTraceIDResponseHeader(true, "X-Request-Trace")},
+			target:     "/fail",
+			wantName:   "GET /fail",
+			wantStatus: http.StatusInternalServerError,
+			wantHeader: "X-Request-Trace",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(append([]Option{WithServiceName("test-service")}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+
+			var span trace.Span
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /orders/{id}", func(w http.ResponseWriter, r *http.Request) {
+				span = trace.SpanFromContext(r.Context())
+				_, _ = w.Write([]byte("ok"))
+			})
+			mux.HandleFunc("GET /fail", func(w http.ResponseWriter, r *http.Request) {
+				span = trace.SpanFromContext(r.Context())
+				http.Error(w, "failed", http.StatusInternalServerError)
+			})
+
+			rec := httptest.NewRecorder()
+			mon.HTTPMiddleware(mux).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			ro := span.(sdktrace.ReadOnlySpan)
+			if ro.Name() != tt.wantName {
+				t.Errorf("span name = %q, want %q", ro.Name(), tt.wantName)
+			}
+			if !ro.EndTime().After(ro.StartTime()) {
+				t.Error("span was not ended")
+			}
+			var gotStatus int64
+			for _, attr := range ro.Attributes() {
+				if attr.Key == semconv.HTTPResponseStatusCodeKey {
+					gotStatus = attr.Value.AsInt64()
+				}
+			}
+			if gotStatus != int64(tt.wantStatus) {
+				t.Errorf("span status code attribute = %d, want %d", gotStatus, tt.wantStatus)
+			}
+			if tt.wantStatus >= http.StatusInternalServerError && ro.Status().Code != codes.Error {
+				t.Errorf("span status = %v, want error", ro.Status())
+			}
+
+			if tt.wantHeader == "" {
+				if got := rec.Header().Get("X-Trace-Id"); got != "" {
+					t.Errorf("X-Trace-Id = %q, want no header", got)
+				}
+				return
+			}
+			if got := rec.Header().Get(tt.wantHeader); got != span.SpanContext().TraceID().String() {
+				t.Errorf("%s = %q, want trace ID %s", tt.wantHeader, got, span.SpanContext().TraceID())
+			}
+		})
+	}
+}
+
+func TestMonitoring_Middleware_IgnoredRoute(t *testing.T) {
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithIgnoredRoutes("/healthz"),
+		WithTraceIDResponseHeader(true, ""),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	var recording bool
+	handler := mon.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recording = trace.SpanFromContext(r.Context()).IsRecording()
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if recording {
+		t.Error("ignored route got a recording span")
+	}
+	if got := rec.Header().Get("X-Trace-Id"); got != "" {
+		t.Errorf("X-Trace-Id = %q for an untraced request, want none", got)
+	}
+}
+
+func TestMonitoring_Middleware_IgnoredRouteBodies(t *testing.T) {
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithIgnoredRoutes("/webhooks/{id}"),
+		WithHTTPBodyRecording(true, 4),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	metric := &sizeMetric{Metric: mon.Metric, names: map[otelmetric.Int64Histogram]string{}, values: map[string][]int64{}}
+	mon.Metric = metric
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("ok"))
+	})
+	mon.HTTPMiddleware(mux).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/webhooks/1", strings.NewReader("hello")))
+
+	if len(metric.values) != 0 {
+		t.Errorf("histogram values = %v for an ignored route, want none", metric.values)
+	}
+}
+
+func TestMonitoring_Middleware_IgnoredMuxPattern(t *testing.T) {
+	for _, ignored := range []string{"GET /orders/{id}", "/orders/{id}"} {
+		t.Run(ignored, func(t *testing.T) {
+			mon, err := NewMonitoring(
+				WithServiceName("test-service"),
+				WithIgnoredRoutes(ignored),
+				WithHTTPBodyRecording(true, 4),
+			)
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+			metric := &sizeMetric{Metric: mon.Metric, names: map[otelmetric.Int64Histogram]string{}, values: map[string][]int64{}}
+			mon.Metric = metric
+
+			recording := map[string]bool{}
+			mux := http.NewServeMux()
+			for _, pattern := range []string{"GET /orders/{id}", "GET /items/{id}"} {
+				mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+					recording[pattern] = trace.SpanFromContext(r.Context()).IsRecording()
+					_, _ = w.Write([]byte("ok"))
+				})
+			}
+			handler := mon.HTTPMiddleware(mux)
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders/1", nil))
+			if recording["GET /orders/{id}"] {
+				t.Error("ignored mux pattern got a recording span")
+			}
+			if len(metric.values) != 0 {
+				t.Errorf("histogram values = %v for an ignored mux pattern, want none", metric.values)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/1", nil))
+			if !recording["GET /items/{id}"] {
+				t.Error("route that is not ignored got no recording span")
+			}
+		})
+	}
+}
+
+func TestMonitoring_Middleware_FlushHijack(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /events", func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			t.Error("ResponseWriter does not implement http.Flusher")
+			return
+		}
+		_, _ = w.Write([]byte("data: ping\n\n"))
+		flusher.Flush()
+	})
+	mux.HandleFunc("GET /ws", func(w http.ResponseWriter, r *http.Request) {
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			t.Error("ResponseWriter does not implement http.Hijacker")
+			return
+		}
+		conn, rw, err := hijacker.Hijack()
+		if err != nil {
+			t.Errorf("Hijack() error = %v", err)
+			return
+		}
+		defer conn.Close()
+		_, _ = rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+		_ = rw.Flush()
+	})
+	server := httptest.NewServer(mon.HTTPMiddleware(mux))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/events")
+	if err != nil {
+		t.Fatalf("GET /events error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if string(body) != "data: ping\n\n" {
+		t.Errorf("GET /events body = %q, want the flushed event", body)
+	}
+
+	resp, err = http.Get(server.URL + "/ws")
+	if err != nil {
+		t.Fatalf("GET /ws error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Errorf("GET /ws status = %d, want %d", resp.StatusCode, http.StatusSwitchingProtocols)
+	}
+}
+
+func TestMonitoring_Middleware_SamplingRules(t *testing.T) {
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithTracerSamplingRules(SamplingRule{Name: "GET /healthz", Ratio: 0}),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	mux := http.NewServeMux()
+	sampled := make(map[string]bool)
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		sampled[r.URL.Path] = trace.SpanFromContext(r.Context()).SpanContext().IsSampled()
+	}
+	mux.HandleFunc("GET /healthz", handle)
+	mux.HandleFunc("GET /orders", handle)
+	handler := mon.HTTPMiddleware(mux)
+
+	for _, path := range []string{"/healthz", "/orders"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	// The rule matches the method and path, as the span starts before the request is routed.
+	if sampled["/healthz"] {
+		t.Error("GET /healthz was sampled, want it dropped by the rule")
+	}
+	if !sampled["/orders"] {
+		t.Error("GET /orders was dropped, want it sampled by the ratio")
+	}
+
+	// A remote parent's sampled flag decides, whatever the rules.
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if !sampled["/healthz"] {
+		t.Error("GET /healthz with a sampled remote parent was dropped, want it sampled")
+	}
+}
+
+func TestMonitoring_Middleware_Scrubbing(t *testing.T) {
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithHTTPCapturedHeaders("Authorization", "X-Request-Id"),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	var span trace.Span
+	handler := mon.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		span = trace.SpanFromContext(r.Context())
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/orders?access_token=s3cr3t&page=2", nil)
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	req.Header.Set("X-Request-Id", "req-1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	attrs := map[string]interface{}{}
+	for _, attr := range span.(sdktrace.ReadOnlySpan).Attributes() {
+		attrs[string(attr.Key)] = attr.Value.AsInterface()
+	}
+	if got := attrs["url.query"]; got != "access_token=REDACTED&page=2" {
+		t.Errorf("url.query = %v, want access_token redacted", got)
+	}
+	if got, _ := attrs["http.request.header.authorization"].([]string); len(got) != 1 || got[0] != "REDACTED" {
+		t.Errorf("http.request.header.authorization = %v, want [REDACTED]", attrs["http.request.header.authorization"])
+	}
+	if got, _ := attrs["http.request.header.x-request-id"].([]string); len(got) != 1 || got[0] != "req-1" {
+		t.Errorf("http.request.header.x-request-id = %v, want [req-1]", attrs["http.request.header.x-request-id"])
+	}
+}
+
+// sizeMetric records the values recorded in histograms, by histogram name.
+type sizeMetric struct {
+	Metric
+	names  map[otelmetric.Int64Histogram]string
+	values map[string][]int64
+	labels [][]attribute.KeyValue
+}
+
+func (s *sizeMetric) CreateHistogram(name, unit, description string) (otelmetric.Int64Histogram, error) {
+	histogram, err := s.Metric.CreateHistogram(name, unit, description)
+	if err == nil {
+		s.names[histogram] = name
+	}
+	return histogram, err
+}
+
+func (s *sizeMetric) RecordHistogram(ctx context.Context, histogram otelmetric.Int64Histogram, value int64, labels ...attribute.KeyValue) {
+	s.values[s.names[histogram]] = append(s.values[s.names[histogram]], value)
+	s.labels = append(s.labels, labels)
+}
+
+func TestMonitoring_Middleware_BodyRecording(t *testing.T) {
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithHTTPBodyRecording(true, 4),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	metric := &sizeMetric{Metric: mon.Metric, names: map[otelmetric.Int64Histogram]string{}, values: map[string][]int64{}}
+	mon.Metric = metric
+
+	var span trace.Span
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /echo", func(w http.ResponseWriter, r *http.Request) {
+		span = trace.SpanFromContext(r.Context())
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write(append(body, body...))
+	})
+	req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader("hello"))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	rec := httptest.NewRecorder()
+	mon.HTTPMiddleware(mux).ServeHTTP(rec, req)
+
+	if rec.Body.String() != "hellohello" {
+		t.Fatalf("response body = %q, want %q", rec.Body.String(), "hellohello")
+	}
+	attrs := map[string]interface{}{}
+	for _, attr := range span.(sdktrace.ReadOnlySpan).Attributes() {
+		attrs[string(attr.Key)] = attr.Value.AsInterface()
+	}
+	want := map[string]interface{}{
+		"http.request.body.size":     int64(5),
+		"http.request.body.snippet":  "hell",
+		"http.response.body.size":    int64(10),
+		"http.response.body.snippet": "hell",
+	}
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("%s = %v, want %v", key, attrs[key], value)
+		}
+	}
+	if got, _ := attrs["http.response.header.content-type"].([]string); len(got) != 1 || got[0] != "text/plain" {
+		t.Errorf("http.response.header.content-type = %v, want [text/plain]", attrs["http.response.header.content-type"])
+	}
+
+	if got := metric.values[requestSizeMetricName]; len(got) != 1 || got[0] != 5 {
+		t.Errorf("%s values = %v, want [5]", requestSizeMetricName, got)
+	}
+	if got := metric.values[responseSizeMetricName]; len(got) != 1 || got[0] != 10 {
+		t.Errorf("%s values = %v, want [10]", responseSizeMetricName, got)
+	}
+	wantLabels := []attribute.KeyValue{semconv.HTTPRequestMethodKey.String(http.MethodPost), semconv.HTTPRoute("/echo")}
+	for _, labels := range metric.labels {
+		if len(labels) != len(wantLabels) || labels[0] != wantLabels[0] || labels[1] != wantLabels[1] {
+			t.Errorf("histogram labels = %v, want %v", labels, wantLabels)
+		}
+	}
+}
+
+func TestMonitoring_Middleware_BodyRecordingDisabled(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	metric := &sizeMetric{Metric: mon.Metric, names: map[otelmetric.Int64Histogram]string{}, values: map[string][]int64{}}
+	mon.Metric = metric
+
+	var span trace.Span
+	handler := mon.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		span = trace.SpanFromContext(r.Context())
+		_, _ = w.Write([]byte("ok"))
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader("hello")))
+
+	for _, attr := range span.(sdktrace.ReadOnlySpan).Attributes() {
+		if attr.Key == semconv.HTTPResponseBodySizeKey {
+			t.Error("body size recorded although body recording is disabled")
+		}
+	}
+	if len(metric.values) != 0 {
+		t.Errorf("histogram values = %v, want none", metric.values)
+	}
+}
@@ -2,29 +2,56 @@ package monitoring
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+	"github.com/adityakw90/go-monitoring/internal/logger"
+	"github.com/adityakw90/go-monitoring/internal/metric"
+	"github.com/adityakw90/go-monitoring/internal/tracer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Monitoring contains all observability components in a single unified structure.
 // It provides access to logging, tracing, and metrics functionality.
 type Monitoring struct {
-	Logger Logger // Logger provides structured logging capabilities.
-	Tracer Tracer // Tracer provides distributed tracing capabilities.
-	Metric Metric // Metric provides metrics collection capabilities.
+	Logger Logger        // Logger provides structured logging capabilities.
+	Tracer Tracer        // Tracer provides distributed tracing capabilities.
+	Metric Metric        // Metric provides metrics collection capabilities.
+	Errors ErrorReporter // Errors reports errors with stack traces to Sentry or GlitchTip (see WithErrorReporting) and logs them.
+
+	tracerShutdownTimeout time.Duration // tracerShutdownTimeout bounds Tracer shutdown; zero means only ctx applies.
+	metricShutdownTimeout time.Duration // metricShutdownTimeout bounds Metric shutdown; zero means only ctx applies.
+	clock                 Clock         // clock measures job durations; nil means the real clock.
+
+	breakerStates sync.Map // breakerStates maps exporter names ("tracer", "metric") to their last breaker.State.
+
+	// componentsMu guards the assignment of Logger and Metric in NewMonitoring against the
+	// handlers the components call back from their own goroutines (see handlerLogger).
+	componentsMu sync.RWMutex
+
+	mu      sync.Mutex // mu guards the fields below and serializes Reload calls.
+	options *Options   // options is the configuration the components are currently running with.
 }
 
 // Shutdown gracefully shuts down all monitoring components.
-// It shuts down the Tracer and Metric providers in order, ensuring all
-// pending traces and metrics are exported before termination.
+// The Tracer and Metric providers and the error reporter are shut down concurrently, so a slow
+// collector for one signal does not delay flushing the others. Each component is bounded by ctx and, when
+// configured, by its own timeout (see WithTracerShutdownTimeout and WithMetricShutdownTimeout).
 //
 // This should be called before application shutdown to ensure proper cleanup.
-// The Logger does not require explicit shutdown.
+// Once the other components are shut down, the Logger is closed, so entries buffered by its
+// async buffer and sinks (for example "loki" and "kafka") are written, including those logged
+// during shutdown, and their goroutines are stopped. A Logger assigned to the struct that
+// does not implement Close is synced instead.
 //
 // Parameters:
-//   - ctx: Context for controlling shutdown timeout
+//   - ctx: Context for controlling the combined shutdown deadline
 //
-// Returns an error if shutdown of any component fails.
-// Errors from individual components are wrapped with context.
+// Returns a *ShutdownError if shutdown of any component fails. The error reports which
+// component failed and wraps the underlying errors, so errors.Is and errors.As see through it.
 //
 // Example:
 //
@@ -34,15 +61,396 @@ type Monitoring struct {
 //	    log.Printf("Failed to shutdown monitoring: %v", err)
 //	}
 func (m *Monitoring) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	tracerTimeout, metricTimeout := m.tracerShutdownTimeout, m.metricShutdownTimeout
+	m.mu.Unlock()
+
+	var (
+		wg                   sync.WaitGroup
+		tracerErr, metricErr error
+		errorsErr            error
+	)
+
+	if m.Errors != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errorsErr = m.Errors.Shutdown(ctx)
+		}()
+	}
 	if m.Tracer != nil {
-		if err := m.Tracer.Shutdown(ctx); err != nil {
-			return fmt.Errorf("failed to shutdown tracer: %w", err)
-		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tracerErr = shutdownWithTimeout(ctx, tracerTimeout, m.Tracer.Shutdown)
+		}()
 	}
 	if m.Metric != nil {
-		if err := m.Metric.Shutdown(ctx); err != nil {
-			return fmt.Errorf("failed to shutdown metric: %w", err)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			metricErr = shutdownWithTimeout(ctx, metricTimeout, m.Metric.Shutdown)
+		}()
+	}
+	wg.Wait()
+	// Syncing stdout fails on terminals and pipes on some platforms, so the error is not reported.
+	closeLogger(m.Logger)
+
+	if tracerErr == nil && metricErr == nil && errorsErr == nil {
+		return nil
+	}
+	return &ShutdownError{Tracer: tracerErr, Metric: metricErr, Errors: errorsErr}
+}
+
+// shutdownWithTimeout calls shutdown with ctx, further bounded by timeout when it is positive.
+func shutdownWithTimeout(ctx context.Context, timeout time.Duration, shutdown func(context.Context) error) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return shutdown(ctx)
+}
+
+// Flush exports the telemetry buffered so far without shutting anything down: the Tracer's
+// pending spans and the Metric's current values are exported concurrently, and buffered log
+// entries are written. Serverless functions call it at the end of each invocation, since the
+// runtime may freeze or kill the process before the next batch or periodic export; see
+// WithServerlessMode. Components that cannot flush (for example custom implementations
+// assigned to the struct) are skipped.
+//
+// Parameters:
+//   - ctx: Context for controlling the flush deadline
+//
+// Returns the *Error of each component that failed to export, with Operation OperationFlush,
+// joined with errors.Join.
+//
+// Example:
+//
+//	func handler(ctx context.Context, event Event) error {
+//	    defer func() {
+//	        if err := mon.Flush(ctx); err != nil {
+//	            log.Printf("Failed to flush monitoring: %v", err)
+//	        }
+//	    }()
+//	    return process(ctx, event)
+//	}
+func (m *Monitoring) Flush(ctx context.Context) error {
+	m.mu.Lock()
+	options := defaultOptions()
+	if m.options != nil {
+		*options = *m.options
+	}
+	m.mu.Unlock()
+
+	var (
+		wg                   sync.WaitGroup
+		tracerErr, metricErr error
+	)
+
+	if f, ok := m.Tracer.(tracer.Flusher); ok {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := f.ForceFlush(ctx); err != nil {
+				tracerErr = &Error{Component: ComponentTracer, Operation: OperationFlush, Provider: tracerProviderName(options), Err: err}
+			}
+		}()
+	}
+	if m.Metric != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := m.Metric.Collect(ctx); err != nil {
+				metricErr = &Error{Component: ComponentMetric, Operation: OperationFlush, Provider: metricProviderName(options), Err: err}
+			}
+		}()
+	}
+	if m.Logger != nil {
+		// Syncing stdout fails on terminals and pipes on some platforms, so the error is not reported.
+		_ = m.Logger.Sync()
+	}
+	wg.Wait()
+
+	return errors.Join(tracerErr, metricErr)
+}
+
+// Reload changes the monitoring configuration at runtime without recreating the providers.
+// The given options are applied on top of the current configuration, so only the settings
+// being changed need to be passed. The following settings take effect immediately:
+//   - Logger level, only when WithLoggerLevel is given, so a level set with
+//     Logger.SetLogLevel survives reloads of other settings
+//   - Tracer sample ratio, sampling rules, and remote sampling
+//   - Ignored routes
+//   - Metric export interval
+//
+// Exporters are rebuilt only when their provider, endpoint, insecure flag, circuit breaker
+// settings, or (for the tracer) batch timeout or fallback provider change. Identity options (service name, environment, instance), the logger
+// output path and standard and gRPC log capture, the OpenTelemetry global registrations, and
+// the clock cannot be changed at runtime and are ignored. Components that do not support
+// reloading (for example custom implementations assigned to the struct) are left untouched.
+//
+// Parameters:
+//   - opts: The options to change
+//
+// Returns an error if any option is invalid; the options of every component are validated
+// before any is reloaded, so an invalid option changes nothing. When a metric exporter cannot
+// be created, the tracer is reloaded back to its previous configuration.
+//
+// Example:
+//
+//	// Raise sampling and verbosity during an incident
+//	if err := mon.Reload(
+//	    WithLoggerLevel("debug"),
+//	    WithTracerSampleRatio(1.0),
+//	); err != nil {
+//	    log.Printf("Failed to reload monitoring: %v", err)
+//	}
+func (m *Monitoring) Reload(opts ...Option) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous := defaultOptions()
+	if m.options != nil {
+		*previous = *m.options
+	}
+	options := &Options{}
+	*options = *previous
+	for _, opt := range opts {
+		opt(options)
+	}
+	options.Clock = m.clock
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.reloadComponents(options, previous, setsLoggerLevel(opts)); err != nil {
+		return err
+	}
+	m.tracerShutdownTimeout = options.TracerShutdownTimeout
+	m.metricShutdownTimeout = options.MetricShutdownTimeout
+	m.options = options
+	return nil
+}
+
+// reloadComponents reloads the tracer, the metric, and, when level is set, the logger level
+// with options, which must have passed Validate. When the metric fails to reload, the tracer
+// is reloaded with previous again.
+func (m *Monitoring) reloadComponents(options, previous *Options, level bool) error {
+	tr, reloadTracer := m.Tracer.(tracer.Reloader)
+	if reloadTracer {
+		if err := tr.Reload(tracerOptions(options)...); err != nil {
+			return parseError(err, ComponentTracer, OperationReload, tracerProviderName(options))
+		}
+	}
+	if r, ok := m.Metric.(metric.Reloader); ok {
+		if err := r.Reload(metricOptions(options)...); err != nil {
+			if reloadTracer {
+				_ = tr.Reload(tracerOptions(previous)...)
+			}
+			return parseError(err, ComponentMetric, OperationReload, metricProviderName(options))
+		}
+	}
+	if r, ok := m.Logger.(logger.Reloader); ok && level {
+		if err := r.Reload(logger.WithLevel(options.Logger.Level)); err != nil {
+			return parseError(err, ComponentLogger, OperationReload, "")
 		}
 	}
 	return nil
 }
+
+// setsLoggerLevel reports whether opts set the logger level.
+func setsLoggerLevel(opts []Option) bool {
+	probe := &Options{}
+	for _, opt := range opts {
+		opt(probe)
+	}
+	return probe.Logger.Level != ""
+}
+
+// now returns the current time according to the configured clock.
+func (m *Monitoring) now() time.Time {
+	if m.clock == nil {
+		return time.Now()
+	}
+	return m.clock.Now()
+}
+
+// loggerOrNoop returns m's Logger, or a noop Logger when m or its Logger is nil.
+func (m *Monitoring) loggerOrNoop() Logger {
+	if m == nil || m.Logger == nil {
+		return logger.NewNoopLogger()
+	}
+	return m.Logger
+}
+
+// tracerOrNoop returns m's Tracer, or a noop Tracer when m or its Tracer is nil.
+func (m *Monitoring) tracerOrNoop() Tracer {
+	if m == nil || m.Tracer == nil {
+		return tracer.NewNoopTracer()
+	}
+	return m.Tracer
+}
+
+// metricOrNoop returns m's Metric, or a noop Metric when m or its Metric is nil.
+func (m *Monitoring) metricOrNoop() Metric {
+	if m == nil || m.Metric == nil {
+		return metric.NewNoopMetric()
+	}
+	return m.Metric
+}
+
+// handlerLogger returns m's Logger, or a noop Logger while NewMonitoring has not assigned it.
+// Handlers installed on the components use it instead of reading m.Logger, as they may run
+// before the components are assigned.
+func (m *Monitoring) handlerLogger() Logger {
+	m.componentsMu.RLock()
+	defer m.componentsMu.RUnlock()
+	return m.loggerOrNoop()
+}
+
+// handlerMetric returns m's Metric, or nil while NewMonitoring has not assigned it. Handlers
+// installed on the components use it instead of reading m.Metric, as they may run before the
+// components are assigned.
+func (m *Monitoring) handlerMetric() Metric {
+	m.componentsMu.RLock()
+	defer m.componentsMu.RUnlock()
+	return m.Metric
+}
+
+// droppedLogsMetricName is the counter incremented when the async logger or the Kafka or Loki
+// sink discards entries.
+const droppedLogsMetricName = "logger_dropped_logs_total"
+
+// recordDroppedLogs counts log entries discarded by the async logger's drop policy or by the
+// Kafka or Loki sink. It is installed as the logger's dropped handler by NewMonitoring.
+func (m *Monitoring) recordDroppedLogs(entries int) {
+	metricInstance := m.handlerMetric()
+	if metricInstance == nil {
+		return
+	}
+	counter, err := metricInstance.CreateCounter(droppedLogsMetricName, "1", "Total number of log entries dropped by the async logger or the Kafka or Loki sink")
+	if err != nil {
+		return
+	}
+	metricInstance.RecordCounter(context.Background(), counter, int64(entries))
+}
+
+// logMetricNameWarning logs a naming convention broken by an instrument name. It is installed
+// as the metric's name warning handler by NewMonitoring and only called with strict metric
+// names enabled.
+func (m *Monitoring) logMetricNameWarning(name, warning string) {
+	m.handlerLogger().Warn("Metric instrument name breaks a naming convention", map[string]interface{}{
+		"instrument": name,
+		"warning":    warning,
+	})
+}
+
+// spilledSpansMetricName is the counter incremented when the tracer spills spans to its
+// fallback exporter.
+const spilledSpansMetricName = "tracer_spilled_spans_total"
+
+// recordSpilledSpans counts spans the tracer wrote to its fallback exporter because the
+// primary export failed. It is installed as the tracer's spill handler by NewMonitoring.
+func (m *Monitoring) recordSpilledSpans(ctx context.Context, spans int) {
+	metricInstance := m.handlerMetric()
+	if metricInstance == nil {
+		return
+	}
+	counter, err := metricInstance.CreateCounter(spilledSpansMetricName, "1", "Total number of spans spilled to the fallback exporter")
+	if err != nil {
+		return
+	}
+	metricInstance.RecordCounter(ctx, counter, int64(spans))
+}
+
+// longSpansMetricName is the counter incremented when the tracer reports a span open longer
+// than the long span threshold.
+const longSpansMetricName = "tracer_long_spans_total"
+
+// longSpanReporter returns a long span handler that logs a warning for the span and, when
+// emitMetric is set, counts it in the "tracer_long_spans_total" counter. It is installed as
+// the tracer's long span handler by NewMonitoring.
+func (m *Monitoring) longSpanReporter(emitMetric bool) func(span LongSpan) {
+	return func(span LongSpan) {
+		m.handlerLogger().Warn("Span open longer than the long span threshold", map[string]interface{}{
+			"span_name": span.Name,
+			"traceID":   span.TraceID.String(),
+			"spanID":    span.SpanID.String(),
+			"age":       span.Age.String(),
+		})
+		if !emitMetric {
+			return
+		}
+		metricInstance := m.handlerMetric()
+		if metricInstance == nil {
+			return
+		}
+		counter, err := metricInstance.CreateCounter(longSpansMetricName, "1", "Total number of spans open longer than the long span threshold")
+		if err != nil {
+			return
+		}
+		metricInstance.RecordCounter(context.Background(), counter, 1, attribute.String("span_name", span.Name))
+	}
+}
+
+// otelErrorsMetricName is the counter incremented for every error reported by the
+// OpenTelemetry SDK when OTel error logging is enabled.
+const otelErrorsMetricName = "otel_errors_total"
+
+// handleOTelError logs an error reported by the OpenTelemetry SDK (e.g. a failed export) and
+// counts it in the "otel_errors_total" counter. It is installed as the global OpenTelemetry
+// error handler by NewMonitoring when WithOTelErrorLogging is enabled.
+func (m *Monitoring) handleOTelError(err error) {
+	if m.Logger != nil {
+		m.Logger.Error("OpenTelemetry error", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	if m.Metric == nil {
+		return
+	}
+	counter, cerr := m.Metric.CreateCounter(otelErrorsMetricName, "1", "Total number of errors reported by the OpenTelemetry SDK")
+	if cerr != nil {
+		return
+	}
+	m.Metric.RecordCounter(context.Background(), counter, 1)
+}
+
+// logCapturedError logs an error captured with Errors.Capture at error level, with its fields,
+// the ID of the event reported for it, and the trace context of ctx. It is installed as the
+// error reporter's capture hook by NewMonitoring.
+func (m *Monitoring) logCapturedError(ctx context.Context, err error, fields map[string]interface{}, eventID string) {
+	if m.Logger == nil {
+		return
+	}
+	logFields := make(map[string]interface{}, len(fields)+2)
+	for k, v := range fields {
+		logFields[k] = v
+	}
+	logFields["error"] = err.Error()
+	logFields["event_id"] = eventID
+	m.Logger.WithSpanContext(trace.SpanContextFromContext(ctx)).Error("Captured error", logFields)
+}
+
+// breakerStateMetricName is the gauge holding the state of the exporter circuit breakers.
+const breakerStateMetricName = "exporter_circuit_breaker_state"
+
+// breakerStateRecorder returns a circuit breaker state handler that records the state of the
+// named exporter's breaker in the "exporter_circuit_breaker_state" gauge (0 closed, 1 open,
+// 2 half-open), and keeps it for DebugInfo. It is installed on the tracer and metric
+// exporters by NewMonitoring.
+func (m *Monitoring) breakerStateRecorder(exporter string) func(state breaker.State) {
+	return func(state breaker.State) {
+		m.breakerStates.Store(exporter, state)
+		metricInstance := m.handlerMetric()
+		if metricInstance == nil {
+			return
+		}
+		gauge, err := metricInstance.CreateGauge(breakerStateMetricName, "1", "State of the exporter circuit breaker (0 closed, 1 open, 2 half-open)")
+		if err != nil {
+			return
+		}
+		metricInstance.RecordGauge(context.Background(), gauge, int64(state), attribute.String("exporter", exporter))
+	}
+}
@@ -2,8 +2,16 @@ package monitoring
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/tracer"
 )
 
 func TestMonitoring_Monitoring_Shutdown(t *testing.T) {
@@ -21,3 +29,429 @@ func TestMonitoring_Monitoring_Shutdown(t *testing.T) {
 		t.Errorf("Shutdown() error = %v", err)
 	}
 }
+
+// stubTracer is a Tracer whose Shutdown behaviour is controlled by the test.
+// Methods other than Shutdown are not implemented.
+type stubTracer struct {
+	Tracer
+	shutdown func(ctx context.Context) error
+}
+
+func (s *stubTracer) Shutdown(ctx context.Context) error { return s.shutdown(ctx) }
+
+// stubMetric is a Metric whose Shutdown behaviour is controlled by the test.
+// Methods other than Shutdown are not implemented.
+type stubMetric struct {
+	Metric
+	shutdown func(ctx context.Context) error
+}
+
+func (s *stubMetric) Shutdown(ctx context.Context) error { return s.shutdown(ctx) }
+
+// blockUntilDone simulates a component stuck on an unreachable collector.
+func blockUntilDone(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestMonitoring_Monitoring_Shutdown_Parallel(t *testing.T) {
+	// Both components block until their deadline; run in parallel the total time
+	// must stay close to a single timeout rather than the sum of both.
+	mon := &Monitoring{
+		Tracer:                &stubTracer{shutdown: blockUntilDone},
+		Metric:                &stubMetric{shutdown: blockUntilDone},
+		tracerShutdownTimeout: 200 * time.Millisecond,
+		metricShutdownTimeout: 200 * time.Millisecond,
+	}
+
+	start := time.Now()
+	err := mon.Shutdown(context.Background())
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("Shutdown() expected error")
+	}
+	if elapsed >= 390*time.Millisecond {
+		t.Errorf("Shutdown() took %v, expected components to shut down concurrently", elapsed)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+// syncLogger is a Logger that records whether it was synced after the other components shut down.
+type syncLogger struct {
+	recordingLogger
+	tracerDone      *bool
+	syncedAfterStop bool
+}
+
+func (l *syncLogger) Sync() error {
+	l.syncedAfterStop = *l.tracerDone
+	return errors.New("sync /dev/stdout: invalid argument")
+}
+
+func TestMonitoring_Monitoring_Shutdown_SyncsLogger(t *testing.T) {
+	tracerDone := false
+	logger := &syncLogger{tracerDone: &tracerDone}
+	mon := &Monitoring{
+		Logger: logger,
+		Tracer: &stubTracer{shutdown: func(context.Context) error {
+			tracerDone = true
+			return nil
+		}},
+	}
+
+	// The sync error of stdout is not reported, as in Flush.
+	if err := mon.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+	if !logger.syncedAfterStop {
+		t.Error("Shutdown() did not sync the logger after shutting down the tracer")
+	}
+}
+
+func TestMonitoring_Monitoring_Shutdown_PartialFailure(t *testing.T) {
+	tracerErr := errors.New("tracer exporter unavailable")
+	tests := []struct {
+		name       string
+		mon        *Monitoring
+		wantErr    bool
+		wantTracer error
+		wantMetric bool
+	}{
+		{
+			name: "tracer fails",
+			mon: &Monitoring{
+				Tracer: &stubTracer{shutdown: func(context.Context) error { return tracerErr }},
+				Metric: &stubMetric{shutdown: func(context.Context) error { return nil }},
+			},
+			wantErr:    true,
+			wantTracer: tracerErr,
+		},
+		{
+			name: "metric times out",
+			mon: &Monitoring{
+				Tracer:                &stubTracer{shutdown: func(context.Context) error { return nil }},
+				Metric:                &stubMetric{shutdown: blockUntilDone},
+				metricShutdownTimeout: 50 * time.Millisecond,
+			},
+			wantErr:    true,
+			wantMetric: true,
+		},
+		{
+			name: "all components succeed",
+			mon: &Monitoring{
+				Tracer: &stubTracer{shutdown: func(context.Context) error { return nil }},
+				Metric: &stubMetric{shutdown: func(context.Context) error { return nil }},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "no components",
+			mon:     &Monitoring{},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mon.Shutdown(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Shutdown() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			var shutdownErr *ShutdownError
+			if !errors.As(err, &shutdownErr) {
+				t.Fatalf("expected *ShutdownError, got %T", err)
+			}
+			if shutdownErr.Tracer != tt.wantTracer {
+				t.Errorf("expected Tracer error = %v, got %v", tt.wantTracer, shutdownErr.Tracer)
+			}
+			if (shutdownErr.Metric != nil) != tt.wantMetric {
+				t.Errorf("expected Metric error = %v, got %v", tt.wantMetric, shutdownErr.Metric)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Monitoring_Reload(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+		check   func(*testing.T, *Monitoring)
+	}{
+		{
+			name: "runtime settings",
+			opts: []Option{
+				WithLoggerLevel("debug"),
+				WithTracerSampleRatio(0.5),
+				WithMetricInterval(10 * time.Second),
+				WithTracerShutdownTimeout(time.Second),
+			},
+			check: func(t *testing.T, m *Monitoring) {
+				if m.options.Logger.Level != "debug" {
+					t.Errorf("expected LoggerLevel = 'debug', got %q", m.options.Logger.Level)
+				}
+				if m.options.Tracer.SampleRatio != 0.5 {
+					t.Errorf("expected TracerSampleRatio = 0.5, got %v", m.options.Tracer.SampleRatio)
+				}
+				if m.options.Metric.Interval != 10*time.Second {
+					t.Errorf("expected MetricInterval = 10s, got %v", m.options.Metric.Interval)
+				}
+				if m.tracerShutdownTimeout != time.Second {
+					t.Errorf("expected tracerShutdownTimeout = 1s, got %v", m.tracerShutdownTimeout)
+				}
+			},
+		},
+		{
+			name:    "invalid log level",
+			opts:    []Option{WithLoggerLevel("invalid")},
+			wantErr: ErrLoggerInvalidLogLevel,
+		},
+		{
+			name:    "invalid tracer provider",
+			opts:    []Option{WithTracerProvider("invalid", "", 0)},
+			wantErr: ErrTracerInvalidProvider,
+		},
+		{
+			name:    "invalid metric interval",
+			opts:    []Option{WithMetricInterval(-time.Second)},
+			wantErr: ErrMetricIntervalInvalid,
+			check: func(t *testing.T, m *Monitoring) {
+				if m.options.Metric.Interval != 60*time.Second {
+					t.Errorf("expected MetricInterval to stay 60s, got %v", m.options.Metric.Interval)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(WithServiceName("test-service"))
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+
+			err = mon.Reload(tt.opts...)
+			if err != tt.wantErr {
+				t.Fatalf("Reload() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.check != nil {
+				tt.check(t, mon)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Monitoring_Reload_KeepsLogLevel(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantDebug bool
+	}{
+		{"other settings keep the runtime level", []Option{WithTracerSampleRatio(0.5)}, true},
+		{"explicit level replaces the runtime level", []Option{WithLoggerLevel("info")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(WithServiceName("test-service"))
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+
+			mon.Logger.SetLogLevel("debug")
+			if err := mon.Reload(tt.opts...); err != nil {
+				t.Fatalf("Reload() error = %v", err)
+			}
+			if got := mon.Logger.Enabled("debug"); got != tt.wantDebug {
+				t.Errorf("Enabled(debug) after Reload = %v, want %v", got, tt.wantDebug)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Monitoring_Reload_ValidatesBeforeApplying(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	err = mon.Reload(
+		WithLoggerLevel("debug"),
+		WithTracerSampleRatio(0.5),
+		WithMetricInterval(-time.Second),
+	)
+	if err != ErrMetricIntervalInvalid {
+		t.Fatalf("Reload() error = %v, want %v", err, ErrMetricIntervalInvalid)
+	}
+	if mon.Logger.Enabled("debug") {
+		t.Error("Reload() failing validation changed the logger level")
+	}
+	if got := mon.Tracer.(tracer.SampleRatioReporter).SampleRatio(); got != 1.0 {
+		t.Errorf("Reload() failing validation changed the sample ratio to %v", got)
+	}
+	if mon.options.Tracer.SampleRatio != 1.0 || mon.options.Logger.Level != "info" {
+		t.Errorf("Reload() failing validation changed options: ratio %v, level %q", mon.options.Tracer.SampleRatio, mon.options.Logger.Level)
+	}
+}
+
+// flushingTracer is a Tracer whose ForceFlush returns err. Other methods are not implemented.
+type flushingTracer struct {
+	Tracer
+	err error
+}
+
+func (f *flushingTracer) ForceFlush(ctx context.Context) error { return f.err }
+
+// collectingMetric is a Metric whose Collect returns err. Other methods are not implemented.
+type collectingMetric struct {
+	Metric
+	err error
+}
+
+func (c *collectingMetric) Collect(ctx context.Context) error { return c.err }
+
+func TestMonitoring_Monitoring_Flush(t *testing.T) {
+	exportErr := errors.New("collector unavailable")
+	tests := []struct {
+		name          string
+		mon           *Monitoring
+		wantComponent []string
+	}{
+		{
+			name: "all components flush",
+			mon:  &Monitoring{Tracer: &flushingTracer{}, Metric: &collectingMetric{}},
+		},
+		{
+			name:          "tracer fails",
+			mon:           &Monitoring{Tracer: &flushingTracer{err: exportErr}, Metric: &collectingMetric{}},
+			wantComponent: []string{ComponentTracer},
+		},
+		{
+			name:          "both fail",
+			mon:           &Monitoring{Tracer: &flushingTracer{err: exportErr}, Metric: &collectingMetric{err: exportErr}},
+			wantComponent: []string{ComponentTracer, ComponentMetric},
+		},
+		{
+			name: "tracer cannot flush",
+			mon:  &Monitoring{Tracer: &stubTracer{}, Metric: &collectingMetric{}},
+		},
+		{
+			name: "no components",
+			mon:  &Monitoring{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mon.Flush(context.Background())
+			if (err != nil) != (len(tt.wantComponent) > 0) {
+				t.Fatalf("Flush() error = %v, want errors from %v", err, tt.wantComponent)
+			}
+			for _, component := range tt.wantComponent {
+				if !errors.Is(err, exportErr) {
+					t.Errorf("Flush() error = %v, want it to wrap %v", err, exportErr)
+				}
+				found := false
+				for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+					var monErr *Error
+					if errors.As(e, &monErr) && monErr.Component == component && monErr.Operation == OperationFlush {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("Flush() error = %v, want a %s flush *Error", err, component)
+				}
+			}
+		})
+	}
+}
+
+func TestMonitoring_Monitoring_Flush_ServerlessMode(t *testing.T) {
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithServerlessMode(true),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	ctx, span := mon.Tracer.StartSpan(context.Background(), "invocation")
+	mon.Tracer.EndSpan(span)
+	counter, err := mon.Metric.CreateCounter("invocations_total", "1", "Invocations handled")
+	if err != nil {
+		t.Fatalf("CreateCounter() error = %v", err)
+	}
+	counter.Add(ctx, 1)
+
+	if err := mon.Flush(ctx); err != nil {
+		t.Errorf("Flush() error = %v", err)
+	}
+	if got := mon.DebugInfo().Metric.ReaderMode; got != "manual" {
+		t.Errorf("DebugInfo().Metric.ReaderMode = %q, want %q", got, "manual")
+	}
+}
+
+func TestMonitoring_Monitoring_Errors(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		events []map[string]interface{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&e)
+		mu.Lock()
+		events = append(events, e)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithErrorReporting(strings.Replace(server.URL, "http://", "http://key@", 1)+"/42"),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	logs := &recordingLogger{}
+	mon.Logger = logs
+
+	ctx, span := mon.Tracer.StartSpan(context.Background(), "checkout")
+	eventID := mon.Errors.Capture(ctx, errors.New("payment declined"), map[string]interface{}{"order_id": "o-1"})
+	mon.Tracer.EndSpan(span)
+
+	if err := mon.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	logged := logs.waitForErrors(t, 1)[0]
+	if logged["error"] != "payment declined" || logged["event_id"] != eventID || logged["order_id"] != "o-1" {
+		t.Errorf("logged fields = %v", logged)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 1 {
+		t.Fatalf("server received %d events, want 1", len(events))
+	}
+	tags := events[0]["tags"].(map[string]interface{})
+	if tags["service"] != "test-service" || tags["trace_id"] != span.SpanContext().TraceID().String() {
+		t.Errorf("event tags = %v", tags)
+	}
+}
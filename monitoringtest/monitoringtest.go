@@ -0,0 +1,176 @@
+// Package monitoringtest provides helpers for testing code instrumented with go-monitoring.
+//
+// DetectSpanLeaks fails a test that starts spans it never ends, reporting the name of each
+// open span and where it was started, the way goroutine leak detectors report goroutines:
+//
+//	func TestCheckout(t *testing.T) {
+//	    mon, err := monitoring.NewMonitoring(
+//	        monitoring.WithServiceName("test-service"),
+//	        monitoringtest.DetectSpanLeaks(t),
+//	    )
+//	    ...
+//	}
+package monitoringtest
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	monitoring "github.com/adityakw90/go-monitoring"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenSpan describes a span that was started and not ended.
+type OpenSpan struct {
+	Name     string        // Name is the name of the span.
+	TraceID  trace.TraceID // TraceID is the trace the span belongs to.
+	SpanID   trace.SpanID  // SpanID identifies the span.
+	Location string        // Location is the function, file, and line that started the span, outside the library and the OpenTelemetry SDK.
+}
+
+// String returns the span name and the location that started it.
+func (s OpenSpan) String() string {
+	return fmt.Sprintf("%q started at %s", s.Name, s.Location)
+}
+
+// startedSpan is a span tracked by a SpanLeakDetector.
+type startedSpan struct {
+	seq      uint64
+	span     sdktrace.ReadOnlySpan
+	location string
+}
+
+// SpanLeakDetector is a span processor that tracks the spans started and not yet ended.
+// Register it with monitoring.WithTracerSpanProcessor, or use DetectSpanLeaks.
+// A SpanLeakDetector is safe for concurrent use.
+type SpanLeakDetector struct {
+	mu   sync.Mutex
+	seq  uint64
+	open map[trace.SpanID]startedSpan
+}
+
+// NewSpanLeakDetector creates a SpanLeakDetector tracking no span.
+func NewSpanLeakDetector() *SpanLeakDetector {
+	return &SpanLeakDetector{open: make(map[trace.SpanID]startedSpan)}
+}
+
+// DetectSpanLeaks returns an Option that registers a SpanLeakDetector with the tracer and
+// fails t when it finishes with spans started and never ended, listing their names and
+// locations. The check runs in a t.Cleanup function, after the test and its subtests.
+//
+// Example:
+//
+//	mon, err := monitoring.NewMonitoring(
+//	    monitoring.WithServiceName("test-service"),
+//	    monitoringtest.DetectSpanLeaks(t),
+//	)
+func DetectSpanLeaks(t testing.TB) monitoring.Option {
+	t.Helper()
+	detector := NewSpanLeakDetector()
+	t.Cleanup(func() {
+		detector.Verify(t)
+	})
+	return monitoring.WithTracerSpanProcessor(detector)
+}
+
+// OpenSpans returns the spans started and not yet ended, in the order they started.
+func (d *SpanLeakDetector) OpenSpans() []OpenSpan {
+	d.mu.Lock()
+	started := make([]startedSpan, 0, len(d.open))
+	for _, s := range d.open {
+		started = append(started, s)
+	}
+	d.mu.Unlock()
+
+	sort.Slice(started, func(i, j int) bool { return started[i].seq < started[j].seq })
+	spans := make([]OpenSpan, len(started))
+	for i, s := range started {
+		sc := s.span.SpanContext()
+		spans[i] = OpenSpan{
+			Name:     s.span.Name(),
+			TraceID:  sc.TraceID(),
+			SpanID:   sc.SpanID(),
+			Location: s.location,
+		}
+	}
+	return spans
+}
+
+// Verify reports an error on t listing the spans started and not yet ended, if any.
+func (d *SpanLeakDetector) Verify(t testing.TB) {
+	t.Helper()
+	open := d.OpenSpans()
+	if len(open) == 0 {
+		return
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "found %d span(s) started and never ended:", len(open))
+	for _, s := range open {
+		fmt.Fprintf(&b, "\n\t%s", s)
+	}
+	t.Error(b.String())
+}
+
+// OnStart tracks s along with the location that started it.
+func (d *SpanLeakDetector) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	location := callerLocation()
+	d.mu.Lock()
+	d.seq++
+	d.open[s.SpanContext().SpanID()] = startedSpan{seq: d.seq, span: s, location: location}
+	d.mu.Unlock()
+}
+
+// OnEnd stops tracking s.
+func (d *SpanLeakDetector) OnEnd(s sdktrace.ReadOnlySpan) {
+	d.mu.Lock()
+	delete(d.open, s.SpanContext().SpanID())
+	d.mu.Unlock()
+}
+
+// Shutdown does nothing; spans still open are reported by Verify.
+func (d *SpanLeakDetector) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (d *SpanLeakDetector) ForceFlush(context.Context) error { return nil }
+
+// libraryPrefixes are the function name prefixes skipped to find the code that started a span.
+var libraryPrefixes = []string{
+	"runtime.",
+	"go.opentelemetry.io/",
+	"github.com/adityakw90/go-monitoring.",
+	"github.com/adityakw90/go-monitoring/internal/",
+	"github.com/adityakw90/go-monitoring/monitoringtest.(*SpanLeakDetector).",
+}
+
+// callerLocation returns the first stack frame outside the library and the OpenTelemetry SDK,
+// as "function (file:line)", or "unknown location" when there is none.
+func callerLocation() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !isLibraryFrame(frame.Function) {
+			return fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			return "unknown location"
+		}
+	}
+}
+
+// isLibraryFrame reports whether function belongs to the library or the OpenTelemetry SDK.
+func isLibraryFrame(function string) bool {
+	for _, prefix := range libraryPrefixes {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package monitoringtest
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	monitoring "github.com/adityakw90/go-monitoring"
+)
+
+// recordingTB is a testing.TB recording the errors reported instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	errors   []string
+	cleanups []func()
+}
+
+func (tb *recordingTB) Helper() {}
+
+func (tb *recordingTB) Error(args ...any) {
+	for _, arg := range args {
+		tb.errors = append(tb.errors, arg.(string))
+	}
+}
+
+func (tb *recordingTB) Cleanup(f func()) {
+	tb.cleanups = append(tb.cleanups, f)
+}
+
+// runCleanups runs the cleanup functions registered on tb, last registered first.
+func (tb *recordingTB) runCleanups() {
+	for i := len(tb.cleanups) - 1; i >= 0; i-- {
+		tb.cleanups[i]()
+	}
+}
+
+func newTestMonitoring(t *testing.T, opts ...monitoring.Option) *monitoring.Monitoring {
+	t.Helper()
+	mon, err := monitoring.NewMonitoring(append([]monitoring.Option{
+		monitoring.WithServiceName("test-service"),
+		monitoring.WithLoggerDisabled(true),
+		monitoring.WithTracerProvider("stdout", "", 0),
+		monitoring.WithTracerWriter(io.Discard),
+		monitoring.WithMetricDisabled(true),
+	}, opts...)...)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mon.Shutdown(context.Background())
+	})
+	return mon
+}
+
+func TestMonitoringtest_Monitoringtest_DetectSpanLeaks(t *testing.T) {
+	tests := []struct {
+		name       string
+		leak       bool
+		wantErrors int
+	}{
+		{"all spans ended", false, 0},
+		{"span never ended", true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := &recordingTB{TB: t}
+			mon := newTestMonitoring(t, DetectSpanLeaks(tb))
+
+			ctx, parent := mon.Tracer.StartSpan(context.Background(), "parent")
+			_, child := mon.Tracer.StartSpan(ctx, "child")
+			if !tt.leak {
+				child.End()
+			}
+			parent.End()
+
+			tb.runCleanups()
+			if len(tb.errors) != tt.wantErrors {
+				t.Fatalf("DetectSpanLeaks() reported %v, want %d error(s)", tb.errors, tt.wantErrors)
+			}
+			if tt.wantErrors == 0 {
+				return
+			}
+			got := tb.errors[0]
+			if !strings.Contains(got, `"child" started at`) || strings.Contains(got, `"parent"`) {
+				t.Errorf("DetectSpanLeaks() reported %q, want the child span only", got)
+			}
+			if !strings.Contains(got, "monitoringtest_test.go:") {
+				t.Errorf("DetectSpanLeaks() reported %q, want the test file as location", got)
+			}
+		})
+	}
+}
+
+func TestMonitoringtest_Monitoringtest_OpenSpans(t *testing.T) {
+	detector := NewSpanLeakDetector()
+	mon := newTestMonitoring(t, monitoring.WithTracerSpanProcessor(detector))
+
+	_, first := mon.Tracer.StartSpan(context.Background(), "first")
+	_, second := mon.Tracer.StartSpan(context.Background(), "second")
+	_, third := mon.Tracer.StartSpan(context.Background(), "third")
+	second.End()
+
+	open := detector.OpenSpans()
+	if len(open) != 2 {
+		t.Fatalf("OpenSpans() = %v, want 2 spans", open)
+	}
+	if open[0].Name != "first" || open[1].Name != "third" {
+		t.Errorf("OpenSpans() names = %q, %q, want first, third", open[0].Name, open[1].Name)
+	}
+	if open[0].SpanID != first.SpanContext().SpanID() || open[0].TraceID != first.SpanContext().TraceID() {
+		t.Errorf("OpenSpans()[0] IDs = %v/%v, want %v/%v", open[0].TraceID, open[0].SpanID, first.SpanContext().TraceID(), first.SpanContext().SpanID())
+	}
+	if !strings.Contains(open[0].Location, "TestMonitoringtest_Monitoringtest_OpenSpans") {
+		t.Errorf("OpenSpans()[0].Location = %q, want the test function", open[0].Location)
+	}
+
+	first.End()
+	third.End()
+	if open := detector.OpenSpans(); len(open) != 0 {
+		t.Errorf("OpenSpans() after End = %v, want none", open)
+	}
+	detector.Verify(t)
+}
@@ -0,0 +1,26 @@
+package monitoring
+
+import (
+	"github.com/adityakw90/go-monitoring/internal/tracer"
+	"github.com/opentracing/opentracing-go"
+)
+
+// OpenTracingTracer returns the OpenTracing bridge of the Tracer, an opentracing.Tracer whose
+// spans are started by the Tracer's own provider, so libraries still using the OpenTracing API
+// are sampled, exported, and propagated like the rest of the service. Spans started through
+// either API share the active span of a context. It returns opentracing.NoopTracer unless
+// WithTracerOpenTracingBridge is enabled and the Tracer is not disabled.
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerOpenTracingBridge(true),
+//	)
+//	opentracing.SetGlobalTracer(mon.OpenTracingTracer())
+func (m *Monitoring) OpenTracingTracer() opentracing.Tracer {
+	if o, ok := m.Tracer.(tracer.OpenTracer); ok {
+		return o.OpenTracing()
+	}
+	return opentracing.NoopTracer{}
+}
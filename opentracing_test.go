@@ -0,0 +1,55 @@
+package monitoring
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestMonitoring_OpenTracing_OpenTracingTracer(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantSpans int
+	}{
+		{"bridge disabled", nil, 1},
+		{"bridge enabled", []Option{WithTracerOpenTracingBridge(true)}, 2},
+		{"tracer disabled", []Option{WithTracerOpenTracingBridge(true), WithTracerDisabled(true)}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			opts := append([]Option{
+				WithServiceName("test-service"),
+				WithTracerWriter(io.Discard),
+				WithMetricWriter(io.Discard),
+				WithTracerSpanProcessor(sdktrace.NewSimpleSpanProcessor(exporter)),
+			}, tt.opts...)
+			mon, err := NewMonitoring(opts...)
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+
+			ctx, span := mon.Tracer.StartSpan(context.Background(), "consume")
+			legacy, _ := opentracing.StartSpanFromContextWithTracer(ctx, mon.OpenTracingTracer(), "kafka.fetch")
+			legacy.Finish()
+			mon.Tracer.EndSpan(span)
+
+			spans := exporter.GetSpans()
+			if len(spans) != tt.wantSpans {
+				t.Fatalf("exported %d spans, want %d", len(spans), tt.wantSpans)
+			}
+			if tt.wantSpans == 2 && spans[0].Parent.SpanID() != spans[1].SpanContext.SpanID() {
+				t.Errorf("OpenTracing span parent = %s, want %s", spans[0].Parent.SpanID(), spans[1].SpanContext.SpanID())
+			}
+		})
+	}
+}
@@ -1,27 +1,127 @@
 package monitoring
 
-import "time"
+import (
+	"io"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/cloud"
+	"github.com/adityakw90/go-monitoring/internal/errorreport"
+	"github.com/adityakw90/go-monitoring/internal/logger"
+	"github.com/adityakw90/go-monitoring/internal/metric"
+	"github.com/adityakw90/go-monitoring/internal/redact"
+	"github.com/adityakw90/go-monitoring/internal/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
 
 // Options contains all configuration for monitoring components.
 // It is used internally by NewMonitoring and should be configured using Option functions.
+// The settings of one component are held in the Options of its implementation (Logger, Tracer,
+// and Metric); the fields of Options itself are shared by several components or used by
+// Monitoring, and are added to the component settings when a component is created or reloaded.
+// TracerLongSpanMetric, TracerShutdownTimeout, and MetricShutdownTimeout stay on Options
+// because Monitoring applies them (it counts the long spans the tracer reports and bounds the
+// shutdown of each component), not the tracer or metric itself.
 type Options struct {
-	ServiceName        string        // ServiceName is the name of the service (required).
-	Environment        string        // Environment is the deployment environment (e.g., "development", "production").
-	InstanceName       string        // InstanceName is the unique identifier for this service instance.
-	InstanceHost       string        // InstanceHost is the hostname where this service instance is running.
-	LoggerLevel        string        // LoggerLevel is the minimum log level to output. Valid values: "debug", "info", "warn", "error", "fatal".
-	LoggerOutputPath   string        // LoggerOutputPath is the file path where logs will be written. If empty, logs will be written to stdout.
-	TracerProvider     string        // TracerProvider specifies the trace exporter to use ("stdout" or "otlp").
-	TracerProviderHost string        // TracerProviderHost is the hostname of the OTLP trace collector.
-	TracerProviderPort int           // TracerProviderPort is the port of the OTLP trace collector.
-	TracerSampleRatio  float64       // TracerSampleRatio controls the sampling rate for traces (0.0 to 1.0). 0.0 means never sample, 1.0 means always sample.
-	TracerBatchTimeout time.Duration // TracerBatchTimeout is the maximum time to wait before exporting a batch of spans.
-	TracerInsecure     bool          // TracerInsecure controls whether to use an insecure (non-TLS) connection for OTLP exporter.
-	MetricProvider     string        // MetricProvider specifies the metric exporter to use ("stdout" or "otlp").
-	MetricProviderHost string        // MetricProviderHost is the hostname of the OTLP metric collector.
-	MetricProviderPort int           // MetricProviderPort is the port of the OTLP metric collector.
-	MetricInterval     time.Duration // MetricInterval is the time interval between metric exports.
-	MetricInsecure     bool          // MetricInsecure controls whether to use an insecure (non-TLS) connection for OTLP exporter.
+	ServiceName                 string        // ServiceName is the name of the service (required).
+	Environment                 string        // Environment is the deployment environment (e.g., "development", "production").
+	InstanceName                string        // InstanceName is the unique identifier for this service instance.
+	InstanceHost                string        // InstanceHost is the hostname where this service instance is running.
+	KubernetesMetadata          bool          // KubernetesMetadata adds the pod, namespace, and node from the POD_NAME, POD_NAMESPACE, and NODE_NAME environment variables to resources and log entries.
+	CloudDetection              string        // CloudDetection selects the cloud whose metadata is added to resources: "aws", "gcp", "azure", or "auto". If empty, no detection runs.
+	RedactedKeys                []string      // RedactedKeys are the span attribute and log field keys redacted before export, e.g. "db.statement" or "user.email".
+	RedactionAction             string        // RedactionAction selects how RedactedKeys are redacted: "delete" (default) or "hash".
+	InstrumentationScopeName    string        // InstrumentationScopeName is the instrumentation scope name of the tracer and meter. If empty, ServiceName is used.
+	InstrumentationScopeVersion string        // InstrumentationScopeVersion is the instrumentation scope version of the tracer and meter.
+	LoggerDisabled              bool          // LoggerDisabled replaces the logger with a noop logger when true.
+	Logger                      LoggerOptions // Logger holds the logger settings set by the WithLogger options; the syslog tag defaults to ServiceName.
+	TracerDisabled              bool          // TracerDisabled replaces the tracer with a noop tracer when true.
+	Tracer                      TracerOptions // Tracer holds the tracer settings set by the WithTracer and WithHTTP options, WithIgnoredRoutes, and WithDevTraceViewer.
+	TracerLongSpanMetric        bool          // TracerLongSpanMetric counts the spans open longer than Tracer.LongSpanThreshold in the "tracer_long_spans_total" metric.
+	TracerShutdownTimeout       time.Duration // TracerShutdownTimeout bounds how long Monitoring.Shutdown waits for the tracer. Zero means no per-component limit.
+	MetricDisabled              bool          // MetricDisabled replaces the metric with a noop metric when true.
+	Metric                      MetricOptions // Metric holds the metric settings set by the WithMetric options.
+	MetricShutdownTimeout       time.Duration // MetricShutdownTimeout bounds how long Monitoring.Shutdown waits for the metric provider. Zero means no per-component limit.
+	ExporterBreakerThreshold    int           // ExporterBreakerThreshold is the number of consecutive export failures that opens the tracer and metric exporter circuit breakers. Zero disables the breakers.
+	ExporterBreakerMaxBackoff   time.Duration // ExporterBreakerMaxBackoff caps the time an open circuit breaker waits before a trial export.
+	StartupProbeTimeout         time.Duration // StartupProbeTimeout bounds the check that the OTLP collectors are reachable when the tracer and metric are created. Zero skips the check.
+	TraceIDResponseHeader       string        // TraceIDResponseHeader is the response header Monitoring.HTTPMiddleware returns the trace ID in. If empty, no header is set.
+	ServerlessMode              bool          // ServerlessMode exports each span as it ends and annotates local root spans with faas.coldstart. Set through WithServerlessMode, which also selects the manual metric reader.
+	SetGlobalProviders          bool          // SetGlobalProviders registers the tracer provider, meter provider, and propagator as the OpenTelemetry globals.
+	EventMetrics                bool          // EventMetrics counts the events emitted with Monitoring.Event in "events_total", labelled with the event name.
+	ErrorReportingDSN           string        // ErrorReportingDSN is the Sentry or GlitchTip DSN errors captured with Monitoring.Errors are sent to. If empty, captured errors are only logged.
+	OTelErrorLogging            bool          // OTelErrorLogging installs the Logger as the global OpenTelemetry error handler and counts SDK errors in "otel_errors_total".
+	Clock                       Clock         // Clock measures span timestamps, job durations, and the metric export interval. If nil, the real clock is used.
+
+	cloudAttributes []attribute.KeyValue // cloudAttributes are the attributes detected for CloudDetection when a component is created.
+}
+
+// Validate reports whether the options describe a valid Monitoring without creating any
+// component. It applies the same checks NewMonitoring does, so a configuration that passes
+// Validate only fails in NewMonitoring for environmental reasons (e.g., an unwritable output
+// path). Disabled components are not validated.
+//
+// Returns ErrServiceNameRequired when ServiceName is empty, ErrInvalidCloudDetection for an
+// unsupported CloudDetection, ErrInvalidRedactionAction for an unsupported RedactionAction, ErrErrorReportingInvalidDSN for a malformed ErrorReportingDSN, or the exported error matching the
+// first invalid component setting (e.g., ErrLoggerInvalidLogLevel, ErrTracerProviderHostRequired,
+// ErrMetricIntervalInvalid).
+//
+// Example:
+//
+//	options := NewBuilder().Service("my-service").Options()
+//	if err := options.Validate(); err != nil {
+//	    log.Fatalf("Invalid monitoring configuration: %v", err)
+//	}
+func (o *Options) Validate() error {
+	if o.ServiceName == "" {
+		return ErrServiceNameRequired
+	}
+	if o.CloudDetection != "" {
+		if err := cloud.Validate(o.CloudDetection); err != nil {
+			return ErrInvalidCloudDetection
+		}
+	}
+	if len(o.RedactedKeys) > 0 {
+		if err := redact.Validate(o.RedactionAction); err != nil {
+			return ErrInvalidRedactionAction
+		}
+	}
+	if o.ErrorReportingDSN != "" {
+		reporterOpts := &errorreport.Options{}
+		for _, opt := range errorReporterOptions(o) {
+			opt(reporterOpts)
+		}
+		if err := reporterOpts.Validate(); err != nil {
+			return parseError(err, ComponentErrors, OperationValidate, "")
+		}
+	}
+	if !o.LoggerDisabled {
+		loggerOpts := &logger.Options{}
+		for _, opt := range loggerOptions(o) {
+			opt(loggerOpts)
+		}
+		if err := loggerOpts.Validate(); err != nil {
+			return parseError(err, ComponentLogger, OperationValidate, "")
+		}
+	}
+	if !o.TracerDisabled {
+		tracerOpts := &tracer.Options{}
+		for _, opt := range tracerOptions(o) {
+			opt(tracerOpts)
+		}
+		if err := tracerOpts.Validate(); err != nil {
+			return parseError(err, ComponentTracer, OperationValidate, tracerProviderName(o))
+		}
+	}
+	if !o.MetricDisabled {
+		metricOpts := &metric.Options{}
+		for _, opt := range metricOptions(o) {
+			opt(metricOpts)
+		}
+		if err := metricOpts.Validate(); err != nil {
+			return parseError(err, ComponentMetric, OperationValidate, metricProviderName(o))
+		}
+	}
+	return nil
 }
 
 // Option is a function that configures Options.
@@ -46,7 +146,9 @@ func WithServiceName(name string) Option {
 }
 
 // WithEnvironment sets the deployment environment.
-// This is used to tag traces and metrics with environment information.
+// This is used to tag traces and metrics with environment information. It overrides a
+// deployment.environment set in OTEL_RESOURCE_ATTRIBUTES; without it, the default "development"
+// is only used when the environment variable sets none.
 //
 // Parameters:
 //   - env: The environment name (e.g., "development", "staging", "production")
@@ -64,7 +166,8 @@ func WithEnvironment(env string) Option {
 }
 
 // WithInstance sets the instance name and host.
-// This is used to identify the specific service instance in distributed systems.
+// This is used to identify the specific service instance in distributed systems. A non-empty
+// name or host overrides the service.instance.id or host.name set in OTEL_RESOURCE_ATTRIBUTES.
 //
 // Parameters:
 //   - name: The unique identifier for this instance (e.g., "instance-1", "pod-abc123")
@@ -83,11 +186,126 @@ func WithInstance(name, host string) Option {
 	}
 }
 
+// WithKubernetesMetadata sets whether the Kubernetes pod, namespace, and node the service runs
+// in are added to the tracer and metric resources (k8s.pod.name, k8s.namespace.name,
+// k8s.node.name) and as fields of every log entry. They are read from the POD_NAME,
+// POD_NAMESPACE, and NODE_NAME environment variables, which the pod spec populates from the
+// downward API; unset variables are skipped. The metadata overrides the same attributes given in
+// the OTEL_RESOURCE_ATTRIBUTES environment variable.
+//
+// Parameters:
+//   - enabled: Whether to add the Kubernetes metadata (default: false)
+//
+// Example:
+//
+//	// env:
+//	//   - name: POD_NAME
+//	//     valueFrom: {fieldRef: {fieldPath: metadata.name}}
+//	//   - name: POD_NAMESPACE
+//	//     valueFrom: {fieldRef: {fieldPath: metadata.namespace}}
+//	//   - name: NODE_NAME
+//	//     valueFrom: {fieldRef: {fieldPath: spec.nodeName}}
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithKubernetesMetadata(true),
+//	)
+func WithKubernetesMetadata(enabled bool) Option {
+	return func(o *Options) {
+		o.KubernetesMetadata = enabled
+	}
+}
+
+// WithCloudDetection sets the cloud whose environment is detected when the tracer and metric
+// are created, adding the cloud.* resource attributes (provider, platform, region,
+// availability zone, account) and instance identifiers (host.id, faas.*, aws.ecs.*). AWS
+// Lambda, Amazon ECS, and Cloud Run are recognized from their environment variables; EC2,
+// Compute Engine, and Azure virtual machines through the instance metadata endpoint, which
+// delays initialization by up to 2 seconds outside that cloud. When the environment is not
+// recognized, no attributes are added.
+//
+// Parameters:
+//   - provider: "aws", "gcp", "azure", or "auto" to try each; empty disables detection (default)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithCloudDetection("auto"),
+//	)
+func WithCloudDetection(provider string) Option {
+	return func(o *Options) {
+		o.CloudDetection = provider
+	}
+}
+
+// WithRedaction sets a single redaction policy for traces and logs: the span and span event
+// attributes and the log entry fields named by keys are redacted before they leave the
+// process. With "hash", values are replaced by their SHA-256 hash, the same in spans and
+// entries, so records of the same user or query still correlate. Span processors registered
+// with WithTracerSpanProcessor still see the original values.
+//
+// Parameters:
+//   - action: "delete" to remove the values or "hash" to replace them with their hash
+//   - keys: The attribute and field keys to redact
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithRedaction("hash", "db.statement", "user.email"),
+//	)
+func WithRedaction(action string, keys ...string) Option {
+	return func(o *Options) {
+		o.RedactionAction = action
+		o.RedactedKeys = keys
+	}
+}
+
+// WithInstrumentationScope sets the instrumentation scope name and version of the spans and
+// metrics recorded through the Tracer and Metric, which backends use to tell which library
+// produced telemetry. Scopes created with Scoped keep their own name and version.
+//
+// Parameters:
+//   - name: The scope name, e.g. the module path, or empty for the service name (default)
+//   - version: The scope version, e.g. the module version (default: none)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithInstrumentationScope("github.com/acme/my-service", "v1.8.0"),
+//	)
+func WithInstrumentationScope(name, version string) Option {
+	return func(o *Options) {
+		o.InstrumentationScopeName = name
+		o.InstrumentationScopeVersion = version
+	}
+}
+
+// WithLoggerDisabled sets whether logging is disabled.
+// When disabled, NewMonitoring and NewLogger return a noop Logger that discards every entry
+// (Fatal still exits the process), and the logger options are not validated.
+//
+// Parameters:
+//   - disabled: Whether to disable the logger
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithLoggerDisabled(true),
+//	)
+func WithLoggerDisabled(disabled bool) Option {
+	return func(o *Options) {
+		o.LoggerDisabled = disabled
+	}
+}
+
 // WithLoggerLevel returns an Option that sets the logger minimum level for monitoring
 // (e.g., "debug", "info", "warn", "error", "fatal").
 func WithLoggerLevel(level string) Option {
 	return func(o *Options) {
-		o.LoggerLevel = level
+		o.Logger.Level = level
 	}
 }
 
@@ -95,166 +313,1721 @@ func WithLoggerLevel(level string) Option {
 // If the provided path is empty, logs will be written to stdout.
 func WithLoggerOutputPath(path string) Option {
 	return func(o *Options) {
-		o.LoggerOutputPath = path
+		o.Logger.OutputPath = path
 	}
 }
 
-// WithTracerProvider sets the tracer provider configuration.
-// This determines where traces are exported (stdout for development, OTLP for production).
+// WithLoggerErrorOutputPath sets where warn, error, and fatal entries are written, separately
+// from debug and info entries, following the 12-factor convention of errors on stderr so that
+// container runtimes can route log severity by stream.
 //
 // Parameters:
-//   - provider: The provider type ("stdout" or "otlp")
-//   - host: The hostname of the OTLP collector (ignored for "stdout")
-//   - port: The port of the OTLP collector (ignored for "stdout")
+//   - path: "stderr", "stdout", or a file path; empty writes every entry to the output path (default)
 //
 // Example:
 //
 //	mon, err := NewMonitoring(
 //	    WithServiceName("my-service"),
-//	    WithTracerProvider("otlp", "localhost", 4317),
+//	    WithLoggerErrorOutputPath("stderr"), // debug and info stay on stdout
 //	)
-func WithTracerProvider(provider, host string, port int) Option {
+func WithLoggerErrorOutputPath(path string) Option {
 	return func(o *Options) {
-		o.TracerProvider = provider
-		o.TracerProviderHost = host
-		o.TracerProviderPort = port
+		o.Logger.ErrorOutputPath = path
 	}
 }
 
-// WithTracerSampleRatio sets the tracer sampling ratio.
-// This controls what percentage of traces are sampled and exported.
+// WithLoggerSink sends log entries to a logging service instead of the output path, for on-prem
+// hosts, systemd-managed deployments, and Grafana Loki without an OpenTelemetry collector. For
+// syslog and journald, levels are mapped to syslog severities: debug to debug, info to info,
+// warn to warning, error to err, and fatal to crit.
 //
 // Parameters:
-//   - ratio: Sampling ratio between 0.0 and 1.0
-//   - 0.0: Never sample (no traces exported)
-//   - 1.0: Always sample (all traces exported)
-//   - 0.1: Sample 10% of traces
+//   - sink: "syslog" (JSON entries, see WithLoggerSyslog), "journald" (the message, caller, and
+//     fields as separate journal fields), "loki" (JSON entries, see WithLoggerLoki), "kafka"
+//     (JSON entries, see WithLoggerKafka), or empty to write to the output path (default)
 //
 // Example:
 //
 //	mon, err := NewMonitoring(
 //	    WithServiceName("my-service"),
-//	    WithTracerSampleRatio(0.1), // Sample 10% of traces
+//	    WithLoggerSink("journald"),
 //	)
-func WithTracerSampleRatio(ratio float64) Option {
+func WithLoggerSink(sink string) Option {
 	return func(o *Options) {
-		o.TracerSampleRatio = ratio
+		o.Logger.Sink = sink
 	}
 }
 
-// WithTracerBatchTimeout sets the tracer batch timeout.
-// This is the maximum time to wait before exporting a batch of spans.
-// Longer timeouts allow more spans to be batched together, improving efficiency.
+// WithLoggerSyslog sets the syslog daemon the "syslog" sink connects to and how entries are
+// tagged. The tag is also the SYSLOG_IDENTIFIER of the "journald" sink.
 //
 // Parameters:
-//   - timeout: The maximum time to wait before exporting a batch
+//   - network: "udp", "tcp", or "unix"; ignored when address is empty
+//   - address: The address of the syslog daemon, or empty for the local daemon (default)
+//   - facility: The syslog facility, e.g. "daemon" or "local0"; empty means "user"
+//   - tag: The program name of the entries; empty means the service name
 //
 // Example:
 //
 //	mon, err := NewMonitoring(
 //	    WithServiceName("my-service"),
-//	    WithTracerBatchTimeout(10*time.Second),
+//	    WithLoggerSink("syslog"),
+//	    WithLoggerSyslog("udp", "syslog.internal:514", "local0", ""),
 //	)
-func WithTracerBatchTimeout(timeout time.Duration) Option {
+func WithLoggerSyslog(network, address, facility, tag string) Option {
 	return func(o *Options) {
-		o.TracerBatchTimeout = timeout
+		o.Logger.SyslogNetwork = network
+		o.Logger.SyslogAddress = address
+		o.Logger.SyslogFacility = facility
+		o.Logger.SyslogTag = tag
 	}
 }
 
-// WithTracerInsecure sets whether to use an insecure (non-TLS) connection for OTLP exporter.
-// When false (default), a secure TLS connection is used. When true, connections are made without TLS.
-// This should only be used in development or when TLS is handled by a proxy.
+// WithLoggerLoki sets the Grafana Loki server the "loki" sink pushes to. Entries are pushed to
+// one stream labelled with service, env, and instance, taken from the service name, environment,
+// and instance name; empty ones are left out. They are buffered and pushed every second, in
+// requests of up to 1000 entries, and Logger.Sync pushes them immediately. Up to 10000 entries
+// are buffered; entries written while the buffer is full and the entries of a failed push are
+// discarded and counted in the "logger_dropped_logs_total" counter, and the push error is
+// returned by the next Sync. Monitoring.Shutdown pushes the buffer and stops the goroutine.
 //
 // Parameters:
-//   - insecure: Whether to use an insecure connection
+//   - url: The Loki server; without a path, "/loki/api/v1/push" is used
 //
 // Example:
 //
 //	mon, err := NewMonitoring(
 //	    WithServiceName("my-service"),
-//	    WithTracerProvider("otlp", "localhost", 4317),
-//	    WithTracerInsecure(true), // Use insecure connection
+//	    WithEnvironment("production"),
+//	    WithLoggerSink("loki"),
+//	    WithLoggerLoki("http://loki:3100"),
 //	)
-func WithTracerInsecure(insecure bool) Option {
+func WithLoggerLoki(url string) Option {
 	return func(o *Options) {
-		o.TracerInsecure = insecure
+		o.Logger.LokiURL = url
 	}
 }
 
-// WithMetricProvider sets the metric provider configuration.
-// This determines where metrics are exported (stdout for development, OTLP for production).
+// WithLoggerKafka sets the brokers and topic the "kafka" sink produces to, for log pipelines
+// ingesting from Kafka rather than from files. Entries are produced as uncompressed JSON
+// records without keys, to the partitions in turn, by a background goroutine (see
+// WithLoggerKafkaBatch). The sink never blocks the caller: entries written while its buffer is
+// full, and the entries of a failed produce, are dropped and counted in the
+// "logger_dropped_logs_total" counter. Logger.Sync waits for the buffer to drain and returns
+// the error of a failed produce; Monitoring.Shutdown also stops the goroutine and closes the
+// broker connections. The brokers need Kafka 0.11 or newer and a plaintext listener;
+// TLS and SASL are not supported.
 //
 // Parameters:
-//   - provider: The provider type ("stdout" or "otlp")
-//   - host: The hostname of the OTLP collector (ignored for "stdout")
-//   - port: The port of the OTLP collector (ignored for "stdout")
+//   - brokers: The bootstrap brokers, as "host:port"
+//   - topic: The topic to produce to
 //
 // Example:
 //
 //	mon, err := NewMonitoring(
 //	    WithServiceName("my-service"),
-//	    WithMetricProvider("otlp", "localhost", 4318),
+//	    WithLoggerSink("kafka"),
+//	    WithLoggerKafka([]string{"kafka-0:9092", "kafka-1:9092"}, "logs"),
 //	)
-func WithMetricProvider(provider, host string, port int) Option {
+func WithLoggerKafka(brokers []string, topic string) Option {
 	return func(o *Options) {
-		o.MetricProvider = provider
-		o.MetricProviderHost = host
-		o.MetricProviderPort = port
+		o.Logger.KafkaBrokers = brokers
+		o.Logger.KafkaTopic = topic
 	}
 }
 
-// WithMetricInterval sets the metric export interval.
-// This determines how frequently metrics are exported to the configured provider.
-// Shorter intervals provide more real-time metrics but increase overhead.
+// WithLoggerKafkaBatch sets the batch settings of the "kafka" sink.
 //
 // Parameters:
-//   - interval: The time interval between metric exports
+//   - size: The number of entries produced in one request; 0 means 100
+//   - timeout: How long a batch waits to fill before it is produced; 0 means one second
+//   - bufferSize: The number of entries buffered; 0 means 10000
 //
 // Example:
 //
 //	mon, err := NewMonitoring(
 //	    WithServiceName("my-service"),
-//	    WithMetricInterval(30*time.Second), // Export every 30 seconds
+//	    WithLoggerSink("kafka"),
+//	    WithLoggerKafka([]string{"kafka-0:9092"}, "logs"),
+//	    WithLoggerKafkaBatch(500, 200*time.Millisecond, 50000),
 //	)
-func WithMetricInterval(interval time.Duration) Option {
+func WithLoggerKafkaBatch(size int, timeout time.Duration, bufferSize int) Option {
 	return func(o *Options) {
-		o.MetricInterval = interval
+		o.Logger.KafkaBatchSize = size
+		o.Logger.KafkaBatchTimeout = timeout
+		o.Logger.KafkaBufferSize = bufferSize
 	}
 }
 
-// WithMetricInsecure sets whether to use an insecure (non-TLS) connection for OTLP exporter.
-// When false (default), a secure TLS connection is used. When true, connections are made without TLS.
-// This should only be used in development or when TLS is handled by a proxy.
+// WithLoggerSchema names the standard log fields after a log schema, so entries land in their
+// backend without an ingest pipeline. With "ecs" (Elastic Common Schema), ts becomes
+// @timestamp in RFC 3339, msg message, level log.level, stacktrace error.stack_trace, and the
+// trace and span IDs trace.id and span.id; every entry also carries ecs.version. With "gcp"
+// (Google Cloud Logging), ts becomes time, msg message, level severity (DEBUG, INFO, WARNING,
+// ERROR, or EMERGENCY for fatal), and the trace ID logging.googleapis.com/trace as
+// "projects/<project>/traces/<trace ID>", so Cloud Logging correlates entries with Cloud Trace.
+// The project is the one detected with WithCloudDetection("gcp"), or else the
+// GOOGLE_CLOUD_PROJECT environment variable; without one, the bare trace ID is written. With
+// "datadog", the default names are kept and dd.trace_id and dd.span_id are added in the 64-bit
+// decimal form Datadog expects, so logs and traces exported to Datadog over OTLP correlate.
 //
 // Parameters:
-//   - insecure: Whether to use an insecure connection
+//   - schema: "ecs", "gcp", "datadog", or empty for the default field names (default)
 //
 // Example:
 //
 //	mon, err := NewMonitoring(
 //	    WithServiceName("my-service"),
-//	    WithMetricProvider("otlp", "localhost", 4318),
-//	    WithMetricInsecure(true), // Use insecure connection
+//	    WithLoggerSchema("ecs"),
 //	)
-func WithMetricInsecure(insecure bool) Option {
+func WithLoggerSchema(schema string) Option {
+	return func(o *Options) {
+		o.Logger.Schema = schema
+	}
+}
+
+// WithLoggerTimeFormat sets how log timestamps are written, for ingestion pipelines requiring
+// UTC with "Z" or epoch timestamps instead of the default "2006-01-02T15:04:05.000-0700".
+//
+// Parameters:
+//   - layout: A time.Format layout; "epoch", "epoch_millis", or "epoch_nanos" for the seconds
+//     (with a fraction), milliseconds, or nanoseconds since the Unix epoch; or empty to keep
+//     the layout of the log schema or the default
+//   - location: The time zone of the timestamps, e.g. time.UTC; nil keeps the local time zone
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithLoggerTimeFormat(time.RFC3339Nano, time.UTC), // 2024-03-01T05:30:45.123456789Z
+//	)
+func WithLoggerTimeFormat(layout string, location *time.Location) Option {
+	return func(o *Options) {
+		o.Logger.TimeLayout = layout
+		o.Logger.TimeLocation = location
+	}
+}
+
+// WithLoggerCaller sets whether log entries carry the caller file and line, and how many
+// additional stack frames are skipped to find it. A helper wrapping the Logger reports its own
+// file and line for every entry; skipping its frames makes entries point at the code calling
+// the helper instead. The skip applies to the Logger methods, not to StdLogger.
+//
+// Parameters:
+//   - enabled: Whether entries carry the caller (default true)
+//   - skip: The number of helper frames between the caller and the Logger method (default 0)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithLoggerCaller(true, 1), // entries logged through logError point at its callers
+//	)
+//
+//	func logError(mon *monitoring.Monitoring, err error) {
+//	    mon.Logger.Error(err.Error(), nil)
+//	}
+func WithLoggerCaller(enabled bool, skip int) Option {
+	return func(o *Options) {
+		o.Logger.DisableCaller = !enabled
+		o.Logger.CallerSkip = skip
+	}
+}
+
+// WithLoggerStacktraceLevel sets the lowest level log entries carry a stack trace at.
+//
+// Parameters:
+//   - level: A log level ("debug", "info", "warn", "error", "fatal"), "off" to never attach
+//     stack traces, or empty for "error" (default)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithLoggerStacktraceLevel("fatal"), // errors without stack traces
+//	)
+func WithLoggerStacktraceLevel(level string) Option {
+	return func(o *Options) {
+		o.Logger.StacktraceLevel = level
+	}
+}
+
+// WithLoggerDedup collapses identical log entries written within a time window.
+// The first entry is written immediately; repeats with the same level, message, and
+// fields are suppressed, and a summary of the entry with a "count" field holding the
+// number of suppressed repeats is written when the window closes.
+// Entries above error level are never suppressed.
+//
+// Parameters:
+//   - window: The deduplication window, or zero to disable deduplication (default)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithLoggerDedup(10*time.Second),
+//	)
+func WithLoggerDedup(window time.Duration) Option {
+	return func(o *Options) {
+		o.Logger.DedupWindow = window
+	}
+}
+
+// WithLoggerRateLimit throttles noisy log entries identified by key to perSecond entries per
+// second, independently of sampling. An entry is identified by key when its message starts
+// with key or it carries a field named key. Entries over the limit are dropped; entries above
+// error level are never throttled. The option can be given once per key.
+//
+// Parameters:
+//   - key: A message prefix or field name identifying the entries to throttle
+//   - perSecond: The number of matching entries written per second; must be positive
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithLoggerRateLimit("cache miss", 10), // messages starting with "cache miss"
+//	    WithLoggerRateLimit("healthcheck", 1), // entries with a "healthcheck" field
+//	)
+func WithLoggerRateLimit(key string, perSecond int) Option {
+	return func(o *Options) {
+		if o.Logger.RateLimits == nil {
+			o.Logger.RateLimits = make(map[string]int)
+		}
+		o.Logger.RateLimits[key] = perSecond
+	}
+}
+
+// WithLoggerAudit sets the output of the audit records written by Logger.Audit, a stream
+// isolated from the ordinary log entries: it ignores the log level, sink, schema, sampling,
+// deduplication, and rate limits. Every record carries a sequence number one above the
+// previous record, continued across restarts when path is a file, and, when hmacKey is set,
+// an HMAC-SHA256 chained to the previous record, so removed, reordered, or edited records
+// are detected by VerifyAuditLog.
+// A partial last record left in the file by an interrupted write is cut when the logger is
+// created and reported as a warning; the chain continues from the record before it.
+// The record fields "time", "event", "seq", and "hmac" are reserved: Logger.Audit rejects
+// fields named after them with ErrLoggerAuditReservedField.
+//
+// Parameters:
+//   - path: "stderr", "stdout", or the file audit records are appended to
+//   - hmacKey: The key signing the records, or nil to write them unsigned
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithLoggerAudit("/var/log/app/audit.log", []byte(os.Getenv("AUDIT_HMAC_KEY"))),
+//	)
+//	err = mon.Logger.Audit("user.deleted", map[string]interface{}{"actor": "admin@example.com"})
+func WithLoggerAudit(path string, hmacKey []byte) Option {
+	return func(o *Options) {
+		o.Logger.AuditOutputPath = path
+		o.Logger.AuditHMACKey = hmacKey
+	}
+}
+
+// WithLoggerCaptureStdLog returns an Option that sets whether output written through the
+// standard library's global logger (log.Printf and friends) is captured into the Logger at
+// info level, so third-party dependencies produce structured entries instead of raw stderr
+// lines. The redirection is process-wide and cannot be changed with Monitoring.Reload.
+func WithLoggerCaptureStdLog(capture bool) Option {
+	return func(o *Options) {
+		o.Logger.CaptureStdLog = capture
+	}
+}
+
+// WithLoggerCaptureGRPCLog returns an Option that sets whether the Logger is installed as
+// gRPC's internal logger (grpclog.LoggerV2), so transport warnings such as connection resets
+// and TLS failures appear as structured entries instead of being dropped or printed to stderr.
+// gRPC info logs are written at debug level; warning, error, and fatal keep their severity.
+// The logger is process-wide and cannot be changed with Monitoring.Reload.
+func WithLoggerCaptureGRPCLog(capture bool) Option {
+	return func(o *Options) {
+		o.Logger.CaptureGRPCLog = capture
+	}
+}
+
+// WithLoggerAsync moves log encoding and writing onto a background goroutine through a bounded
+// buffer, protecting request latency when the disk or stdout stalls. Entries discarded by the
+// drop policy are counted in the "logger_dropped_logs_total" counter. Logger.Sync waits for the
+// buffer to drain, so call it before the process exits; Fatal entries are written synchronously.
+// Monitoring.Shutdown drains the buffer and stops the goroutine.
+// The async mode is fixed when the Logger is created and cannot be changed with Monitoring.Reload.
+//
+// Parameters:
+//   - bufferSize: The number of entries buffered; 0 writes synchronously (default)
+//   - dropPolicy: What to do when the buffer is full: "block" waits for room, "drop_newest"
+//     discards the new entry, "drop_oldest" discards the oldest buffered entry
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithLoggerAsync(4096, "drop_newest"),
+//	)
+func WithLoggerAsync(bufferSize int, dropPolicy string) Option {
+	return func(o *Options) {
+		o.Logger.AsyncBufferSize = bufferSize
+		o.Logger.AsyncDropPolicy = dropPolicy
+	}
+}
+
+// WithTracerDisabled sets whether tracing is disabled.
+// When disabled, NewMonitoring and NewTracer return a noop Tracer that records and exports
+// nothing, and the tracer options are not validated. Trace context is still extracted and
+// injected, so propagation between the surrounding services keeps working.
+// This is useful for deployments that cannot reach a trace collector.
+//
+// Parameters:
+//   - disabled: Whether to disable the tracer
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerDisabled(true),
+//	)
+func WithTracerDisabled(disabled bool) Option {
+	return func(o *Options) {
+		o.TracerDisabled = disabled
+	}
+}
+
+// WithTracerProvider sets the tracer provider configuration.
+// This determines where traces are exported (stdout for development, OTLP for production).
+//
+// Parameters:
+//   - provider: The provider type ("stdout" or "otlp")
+//   - host: The hostname of the OTLP collector (ignored for "stdout")
+//   - port: The port of the OTLP collector (ignored for "stdout")
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerProvider("otlp", "localhost", 4317),
+//	)
+func WithTracerProvider(provider, host string, port int) Option {
 	return func(o *Options) {
-		o.MetricInsecure = insecure
+		o.Tracer.Provider = provider
+		o.Tracer.ProviderHost = host
+		o.Tracer.ProviderPort = port
 	}
 }
 
+// WithTracerStdoutFormat sets how the "stdout" tracer provider writes spans.
+// "pretty" (default) writes indented JSON, which is easy to read but cannot be parsed line by
+// line. "ndjson" writes every span as one compact JSON object per line, with the field names of
+// the OpenTelemetry SDK span stubs, so local tooling and CI log scrapers can parse it.
+//
+// Parameters:
+//   - format: "pretty" or "ndjson"
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerStdoutFormat("ndjson"),
+//	)
+func WithTracerStdoutFormat(format string) Option {
+	return func(o *Options) {
+		o.Tracer.StdoutFormat = format
+	}
+}
+
+// WithTracerWriter sets where the "stdout" tracer provider, and the "stdout" fallback provider,
+// write spans, e.g. a file, a buffer, or a test sink, so they do not mix with the JSON logs on
+// the process stdout. The writer is fixed when the tracer is created; Reload keeps it.
+//
+// Parameters:
+//   - w: The writer spans are written to; nil writes to os.Stdout (default)
+//
+// Example:
+//
+//	spans, _ := os.Create("spans.ndjson")
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerWriter(spans),
+//	    WithTracerStdoutFormat("ndjson"),
+//	)
+func WithTracerWriter(w io.Writer) Option {
+	return func(o *Options) {
+		o.Tracer.Writer = w
+	}
+}
+
+// WithTracerSampleRatio sets the tracer sampling ratio.
+// This controls what percentage of traces are sampled and exported.
+//
+// Parameters:
+//   - ratio: Sampling ratio between 0.0 and 1.0
+//   - 0.0: Never sample (no traces exported)
+//   - 1.0: Always sample (all traces exported)
+//   - 0.1: Sample 10% of traces
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerSampleRatio(0.1), // Sample 10% of traces
+//	)
+func WithTracerSampleRatio(ratio float64) Option {
+	return func(o *Options) {
+		o.Tracer.SampleRatio = ratio
+	}
+}
+
+// WithTracerSamplingRules sets rules that sample root spans by name and attributes, so that
+// high-volume, low-value traffic such as health checks can be sampled less than the rest. The
+// first rule matching a span decides its ratio; spans no rule matches use the
+// WithTracerSampleRatio ratio. Rules are checked when a span starts, so only the name and the
+// attributes passed at start are seen. HTTPMiddleware starts its span before the request is
+// routed, so the span carries "url.path" but not yet "http.route", and a rule Name such as
+// "GET /healthz" matches it by method and path. Once rules are set, spans with a parent, local
+// or remote, follow the parent's sampled flag, keeping or dropping a trace as a whole across
+// services. Rules can be replaced with Reload.
+//
+// Parameters:
+//   - rules: The rules in order of precedence; none disables rule sampling (default)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerSamplingRules(
+//	        SamplingRule{Name: "GET /healthz", Ratio: 0},
+//	        SamplingRule{Name: "GET /orders/*", Ratio: 1.0},
+//	    ),
+//	    WithTracerSampleRatio(0.1),
+//	)
+func WithTracerSamplingRules(rules ...SamplingRule) Option {
+	return func(o *Options) {
+		o.Tracer.SamplingRules = rules
+	}
+}
+
+// WithTracerBatchTimeout sets the tracer batch timeout.
+// This is the maximum time to wait before exporting a batch of spans.
+// Longer timeouts allow more spans to be batched together, improving efficiency.
+//
+// Parameters:
+//   - timeout: The maximum time to wait before exporting a batch
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerBatchTimeout(10*time.Second),
+//	)
+func WithTracerBatchTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.Tracer.BatchTimeout = timeout
+	}
+}
+
+// WithTracerContextAnnotations sets whether spans record the cancellation of their context.
+// When enabled, a span whose parent context is canceled or exceeds its deadline before the
+// span ends gets a "context.done" event at that moment and a context.error attribute
+// ("context canceled" or "context deadline exceeded", with context.cause when one was given),
+// and spans started with a deadline carry the time left in context.deadline_remaining_ms.
+// Each span with a cancelable context registers a callback until it ends.
+//
+// Parameters:
+//   - enabled: Whether to annotate spans (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerContextAnnotations(true),
+//	)
+func WithTracerContextAnnotations(enabled bool) Option {
+	return func(o *Options) {
+		o.Tracer.ContextAnnotations = enabled
+	}
+}
+
+// WithTracerLongSpanWatchdog logs a warning for every span still open after threshold,
+// catching spans that are leaked and never ended. Each span is reported once, with its name,
+// trace and span IDs, and age; open spans are checked every half threshold. When emitMetric
+// is set, the spans are also counted in the "tracer_long_spans_total" metric, labeled with
+// span_name.
+//
+// Parameters:
+//   - threshold: The age past which an open span is reported (default: 0, disabled)
+//   - emitMetric: Whether to count reported spans in a metric (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerLongSpanWatchdog(5*time.Minute, true),
+//	)
+func WithTracerLongSpanWatchdog(threshold time.Duration, emitMetric bool) Option {
+	return func(o *Options) {
+		o.Tracer.LongSpanThreshold = threshold
+		o.TracerLongSpanMetric = emitMetric
+	}
+}
+
+// WithTracerEndpoint sets the OTLP tracer collector as a URL, replacing WithTracerProvider and
+// WithTracerInsecure. The scheme selects the transport and TLS:
+//   - grpc://host:port and grpcs://host:port use gRPC, without and with TLS
+//   - http://host:port/path and https://host:port/path use HTTP, without and with TLS
+//
+// A missing port defaults to 4317 for gRPC and 4318 for HTTP. For HTTP the path defaults to
+// "/v1/traces". An empty url (default) keeps the provider, host, and port options.
+//
+// Parameters:
+//   - url: The collector URL (e.g., "https://collector.example.com:4318/v1/traces")
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerEndpoint("https://collector.example.com:4318/v1/traces"),
+//	)
+func WithTracerEndpoint(url string) Option {
+	return func(o *Options) {
+		o.Tracer.Endpoint = url
+	}
+}
+
+// WithTracerMirror mirrors a ratio of the exported traces to a second OTLP collector, e.g. a
+// vendor under evaluation, while the primary exporter keeps receiving every span. Traces are
+// selected by trace ID, so a mirrored trace is mirrored whole, and services mirroring the same
+// ratio mirror the same traces. The URL scheme selects the transport and TLS as in
+// WithTracerEndpoint. The mirror has no circuit breaker or fallback: when it fails, only its
+// copy of the spans is lost.
+//
+// Parameters:
+//   - url: The collector URL of the mirror; empty disables mirroring (default)
+//   - ratio: The ratio of the traces mirrored, greater than 0.0 and at most 1.0
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerEndpoint("grpcs://collector.example.com:4317"),
+//	    WithTracerMirror("https://otlp.vendor.example.com/v1/traces", 0.05),
+//	)
+func WithTracerMirror(url string, ratio float64) Option {
+	return func(o *Options) {
+		o.Tracer.MirrorEndpoint = url
+		o.Tracer.MirrorRatio = ratio
+	}
+}
+
+// WithDevTraceViewer serves the spans of the last 200 traces on http://localhost:<port> as an
+// HTML trace index and waterfall, so developers can inspect traces without running Jaeger
+// locally. Spans are kept in memory next to the configured exporter, before filtering and
+// redaction; the viewer is meant for development and must not be enabled in production.
+// NewMonitoring fails when the port is in use.
+//
+// Parameters:
+//   - port: The localhost port of the viewer; 0 disables it (default)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithDevTraceViewer(16686),
+//	)
+func WithDevTraceViewer(port int) Option {
+	return func(o *Options) {
+		o.Tracer.DevViewerPort = port
+	}
+}
+
+// WithTracerInsecure sets whether to use an insecure (non-TLS) connection for OTLP exporter.
+// When false (default), a secure TLS connection is used. When true, connections are made without TLS.
+// This should only be used in development or when TLS is handled by a proxy.
+//
+// Parameters:
+//   - insecure: Whether to use an insecure connection
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerProvider("otlp", "localhost", 4317),
+//	    WithTracerInsecure(true), // Use insecure connection
+//	)
+func WithTracerInsecure(insecure bool) Option {
+	return func(o *Options) {
+		o.Tracer.Insecure = insecure
+	}
+}
+
+// WithTracerRemoteSampling enables pulling the sampling ratio from a remote endpoint.
+// The endpoint is polled immediately and then every interval, so operators can raise or lower
+// sampling fleet-wide (for example during an incident) without redeploying. The response must
+// be a Jaeger sampling strategy with a probabilistic strategy; if the URL has no "service" query
+// parameter, the service name is added. Once fetched, the remote ratio overrides the ratio set
+// with WithTracerSampleRatio; if a poll fails, the last known ratio is kept. Monitoring.Reload
+// restarts polling with a new URL or interval; an empty URL stops it and restores the ratio set
+// with WithTracerSampleRatio. Shutdown aborts an in-flight poll.
+//
+// Parameters:
+//   - url: The sampling strategy endpoint (e.g., "http://jaeger-agent:5778/sampling")
+//   - interval: The time between polls (must be greater than 0)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerRemoteSampling("http://jaeger-agent:5778/sampling", time.Minute),
+//	)
+func WithTracerRemoteSampling(url string, interval time.Duration) Option {
+	return func(o *Options) {
+		o.Tracer.RemoteSamplingURL = url
+		o.Tracer.RemoteSamplingInterval = interval
+	}
+}
+
+// WithTracerFallbackProvider sets where spans go when the primary exporter fails.
+// When an export to the configured tracer provider fails (for OTLP, after the exporter's own
+// retries are exhausted), the batch is spilled to the fallback instead of being dropped, so the
+// telemetry can be recovered after an incident. When created with NewMonitoring, spilled spans
+// are counted in the "tracer_spilled_spans_total" metric.
+//
+// Parameters:
+//   - provider: The fallback type ("file" or "stdout")
+//   - path: The file spans are appended to as JSON (required for "file", ignored for "stdout")
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerProvider("otlp", "localhost", 4317),
+//	    WithTracerFallbackProvider("file", "/var/log/my-service/spans.json"),
+//	)
+func WithTracerFallbackProvider(provider, path string) Option {
+	return func(o *Options) {
+		o.Tracer.FallbackProvider = provider
+		o.Tracer.FallbackPath = path
+	}
+}
+
+// WithTracerIDGenerator sets the generator of trace and span IDs.
+// By default IDs are random; use NewSequentialIDGenerator in tests so exported spans are
+// reproducible and can be compared against golden files.
+//
+// Parameters:
+//   - generator: The ID generator (nil restores random IDs)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerIDGenerator(NewSequentialIDGenerator()),
+//	)
+func WithTracerIDGenerator(generator IDGenerator) Option {
+	return func(o *Options) {
+		o.Tracer.IDGenerator = generator
+	}
+}
+
+// WithTracerXRayIDs sets whether trace IDs are X-Ray compatible, for services exporting to
+// AWS X-Ray through the ADOT collector. X-Ray requires the first 4 bytes of a trace ID to be
+// its creation time in Unix seconds and rejects the random W3C trace IDs generated by default;
+// the other 12 bytes stay random. Ignored when WithTracerIDGenerator sets a generator.
+//
+// Parameters:
+//   - enabled: true to generate X-Ray compatible trace IDs (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerXRayIDs(true),
+//	    WithTracerXRayPropagation(true),
+//	)
+func WithTracerXRayIDs(enabled bool) Option {
+	return func(o *Options) {
+		o.Tracer.XRayIDs = enabled
+	}
+}
+
+// WithTracerTraceID64 sets whether trace IDs are generated with their high 64 bits zero, for
+// interop with legacy tracing backends and proxies that only keep 64-bit trace IDs (older
+// Zipkin and Jaeger deployments): the truncated ID they keep still identifies the trace. Use
+// TraceID64 and TraceID128 to print both forms. Trace IDs continued from upstream services keep
+// their 128 bits. It cannot be combined with WithTracerXRayIDs and is ignored when
+// WithTracerIDGenerator sets a generator.
+//
+// Parameters:
+//   - enabled: true to generate 64-bit trace IDs (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerTraceID64(true),
+//	)
+func WithTracerTraceID64(enabled bool) Option {
+	return func(o *Options) {
+		o.Tracer.TraceID64 = enabled
+	}
+}
+
+// WithTracerXRayPropagation sets whether trace context is also extracted from and injected in
+// the X-Ray trace header (X-Amzn-Trace-Id), so traces continue from ALB, API Gateway, and
+// X-Ray SDK upstream segments and into X-Ray instrumented downstream services. The W3C trace
+// context is still propagated and is used when a request carries both headers. The setting
+// also applies to the global propagator registered by WithSetGlobalProviders.
+//
+// Parameters:
+//   - enabled: true to propagate the X-Ray trace header (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerXRayPropagation(true),
+//	)
+func WithTracerXRayPropagation(enabled bool) Option {
+	return func(o *Options) {
+		o.Tracer.XRayPropagation = enabled
+	}
+}
+
+// WithTracerOpenTracingBridge sets whether the tracer is bridged to the OpenTracing API, so
+// libraries still instrumented with OpenTracing, such as older Kafka clients, feed their spans
+// into the tracer's provider: they are sampled, exported, and carry the resource like any other
+// span. Monitoring.OpenTracingTracer returns the bridge. Spans started through either API share
+// the active span of a context, so an OpenTracing span started with
+// opentracing.StartSpanFromContext continues the trace of mon.Tracer and the other way around.
+// The setting cannot be changed by Reload.
+//
+// Parameters:
+//   - enabled: true to bridge the tracer to OpenTracing (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerOpenTracingBridge(true),
+//	)
+//	opentracing.SetGlobalTracer(mon.OpenTracingTracer())
+func WithTracerOpenTracingBridge(enabled bool) Option {
+	return func(o *Options) {
+		o.Tracer.OpenTracingBridge = enabled
+	}
+}
+
+// WithTracerOpenCensusBridge sets whether the global OpenCensus tracer is replaced with a
+// bridge starting spans on the Tracer's provider, so the spans of dependencies still
+// instrumented with OpenCensus, such as older Google client libraries, are sampled, exported,
+// and parented like the spans of the Tracer instead of being dropped. OpenCensus has a single
+// global tracer: the last Monitoring created with the option receives the spans, and the
+// bridge stays installed after Shutdown. The setting cannot be changed by Reload.
+//
+// Parameters:
+//   - enabled: true to bridge OpenCensus spans to the Tracer (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerOpenCensusBridge(true),
+//	)
+func WithTracerOpenCensusBridge(enabled bool) Option {
+	return func(o *Options) {
+		o.Tracer.OpenCensusBridge = enabled
+	}
+}
+
+// WithTracerSpanProcessor registers processor with the tracer provider, before the processor
+// exporting spans, so it sees every sampled span start and end; monitoringtest.DetectSpanLeaks
+// uses it to find spans a test never ended. The option can be given more than once; the
+// processors are called in the order given. Processors are shut down with the tracer.
+//
+// Parameters:
+//   - processor: The span processor to register
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerSpanProcessor(sdktrace.NewSimpleSpanProcessor(exporter)),
+//	)
+func WithTracerSpanProcessor(processor SpanProcessor) Option {
+	return func(o *Options) {
+		o.Tracer.SpanProcessors = append(o.Tracer.SpanProcessors, processor)
+	}
+}
+
+// WithTracerSpanFilter sets the function selecting the ended spans that are exported: spans it
+// returns false for, such as health checks, static assets, or internal polling, never leave
+// the process. Sampling is not affected, so the children of a dropped span are still sampled
+// and exported, and the processors set with WithTracerSpanProcessor still see every span.
+//
+// Parameters:
+//   - filter: Returns true for the spans to export
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerSpanFilter(func(span ReadOnlySpan) bool {
+//	        return span.Name() != "GET /healthz"
+//	    }),
+//	)
+func WithTracerSpanFilter(filter func(span ReadOnlySpan) bool) Option {
+	return func(o *Options) {
+		o.Tracer.SpanFilter = filter
+	}
+}
+
+// WithSetGlobalProviders sets whether NewMonitoring registers its tracer provider, meter
+// provider, and W3C trace context propagator as the OpenTelemetry globals (otel.SetTracerProvider,
+// otel.SetMeterProvider, otel.SetTextMapPropagator), so auto-instrumentation that relies on the
+// globals uses the same exporters. It is off by default, keeping separate Monitoring instances
+// isolated from each other; prefer passing Tracer.Provider and Metric.Provider explicitly where
+// the instrumentation allows it. Disabled components are not registered.
+//
+// Parameters:
+//   - enabled: Whether to register the global providers (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithSetGlobalProviders(true),
+//	)
+func WithSetGlobalProviders(enabled bool) Option {
+	return func(o *Options) {
+		o.SetGlobalProviders = enabled
+	}
+}
+
+// WithEventMetrics sets whether Monitoring.Event also counts events in the "events_total"
+// counter, labelled with the event name, so event rates can be graphed and alerted on without
+// querying logs. Event names should be a fixed set, since each becomes a metric series.
+//
+// Parameters:
+//   - enabled: Whether to count events (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithEventMetrics(true),
+//	)
+func WithEventMetrics(enabled bool) Option {
+	return func(o *Options) {
+		o.EventMetrics = enabled
+	}
+}
+
+// WithTraceIDResponseHeader sets whether Monitoring.HTTPMiddleware returns the trace ID of each
+// request in a response header, so support engineers can look up the trace of a request a user
+// reports. The header is set before the handler runs, so it is present on every response.
+//
+// Parameters:
+//   - enabled: Whether to set the header (default: false)
+//   - name: The header name (default: "X-Trace-Id" when empty)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTraceIDResponseHeader(true, ""),
+//	)
+func WithTraceIDResponseHeader(enabled bool, name string) Option {
+	return func(o *Options) {
+		switch {
+		case !enabled:
+			o.TraceIDResponseHeader = ""
+		case name == "":
+			o.TraceIDResponseHeader = defaultTraceIDHeader
+		default:
+			o.TraceIDResponseHeader = name
+		}
+	}
+}
+
+// WithFatalHooks sets the functions called after Logger.Fatal (or FatalFields) writes its
+// entry and before the process exits, e.g. to capture the failure with Monitoring.Errors or
+// deliver a webhook (see NewWebhookFatalHook). Hooks run in order on the goroutine that logged
+// the entry; a panicking hook does not stop the others. A Monitoring created by NewMonitoring
+// then shuts itself down within the exit flush timeout (see WithExitFlushTimeout), so the
+// spans, metrics, and error events buffered so far are exported before the exit.
+//
+// Parameters:
+//   - hooks: The functions to call with the fatal entry
+//
+// Example:
+//
+//	var mon *Monitoring
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithFatalHooks(func(entry FatalEntry) {
+//	        mon.Errors.Capture(context.Background(), errors.New(entry.Message), entry.Fields)
+//	    }),
+//	)
+func WithFatalHooks(hooks ...FatalHook) Option {
+	return func(o *Options) {
+		o.Logger.FatalHooks = hooks
+	}
+}
+
+// WithExitFlushTimeout sets how long the Monitoring may spend exporting buffered telemetry
+// when the process is about to die: after Logger.Fatal writes its entry (the Monitoring is shut
+// down) and in FlushOnPanic (the Monitoring is flushed). A slow or unreachable collector then
+// delays the exit by at most timeout.
+//
+// Parameters:
+//   - timeout: The maximum time to spend flushing (default: 5s, 0 means no limit)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithExitFlushTimeout(2*time.Second),
+//	)
+func WithExitFlushTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.Logger.ExitFlushTimeout = timeout
+	}
+}
+
+// WithErrorReporting sets the Sentry-compatible DSN (Sentry, GlitchTip) that errors captured
+// with Monitoring.Errors.Capture are sent to, with their stack trace, the trace ID of the span
+// in the context, and the service, environment, and instance. Events are sent in the background
+// and flushed by Monitoring.Shutdown. Captured errors are also logged at error level with the
+// event ID, with or without a DSN.
+//
+// Parameters:
+//   - dsn: The project DSN, e.g. "https://<public key>@o1.ingest.sentry.io/<project id>" (default: "", nothing is sent)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithErrorReporting(os.Getenv("SENTRY_DSN")),
+//	)
+func WithErrorReporting(dsn string) Option {
+	return func(o *Options) {
+		o.ErrorReportingDSN = dsn
+	}
+}
+
+// WithOTelErrorLogging sets whether errors reported by the OpenTelemetry SDK (failed exports,
+// dropped data, invalid instruments) are logged through the Logger at error level and counted
+// in the "otel_errors_total" counter, instead of going to stderr where alerting cannot see
+// them. The handler is registered globally with otel.SetErrorHandler, so the most recently
+// created Monitoring receives the errors of every OpenTelemetry provider in the process.
+//
+// Parameters:
+//   - enabled: Whether to install the error handler (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithOTelErrorLogging(true),
+//	)
+func WithOTelErrorLogging(enabled bool) Option {
+	return func(o *Options) {
+		o.OTelErrorLogging = enabled
+	}
+}
+
+// WithClock sets the clock used for span timestamps, job durations, and the metric export
+// interval, so duration-dependent behavior can be tested without sleeping.
+// The clock is fixed at creation and is not changed by Monitoring.Reload.
+//
+// Parameters:
+//   - c: The clock (nil uses the real clock)
+//
+// Example:
+//
+//	clk := NewFakeClock(time.Now())
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithClock(clk),
+//	)
+//	// ...
+//	clk.Advance(time.Minute) // triggers a metric export
+func WithClock(c Clock) Option {
+	return func(o *Options) {
+		o.Clock = c
+	}
+}
+
+// WithTracerShutdownTimeout sets the maximum time Monitoring.Shutdown waits for the tracer
+// to flush pending spans. The tracer is also bounded by the context passed to Shutdown,
+// whichever expires first. A zero timeout (default) applies only the context deadline.
+//
+// Parameters:
+//   - timeout: The maximum time to wait for the tracer to shut down
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerShutdownTimeout(5*time.Second),
+//	)
+func WithTracerShutdownTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.TracerShutdownTimeout = timeout
+	}
+}
+
+// WithMetricDisabled sets whether metrics are disabled.
+// When disabled, NewMonitoring and NewMetric return a noop Metric whose instruments record
+// nothing, and the metric options are not validated.
+//
+// Parameters:
+//   - disabled: Whether to disable the metric
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricDisabled(true),
+//	)
+func WithMetricDisabled(disabled bool) Option {
+	return func(o *Options) {
+		o.MetricDisabled = disabled
+	}
+}
+
+// WithMetricProvider sets the metric provider configuration.
+// This determines where metrics are exported (stdout for development, OTLP for production,
+// a Prometheus Pushgateway for batch jobs that can be neither scraped nor reach a
+// collector, see WithMetricPushgateway, or an InfluxDB v2 server, see WithMetricInfluxDB).
+//
+// Parameters:
+//   - provider: The provider type ("stdout", "otlp", "pushgateway", or "influxdb")
+//   - host: The hostname of the OTLP collector or Pushgateway (ignored for "stdout" and "influxdb")
+//   - port: The port of the OTLP collector or Pushgateway (ignored for "stdout" and "influxdb")
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricProvider("otlp", "localhost", 4318),
+//	)
+func WithMetricProvider(provider, host string, port int) Option {
+	return func(o *Options) {
+		o.Metric.Provider = provider
+		o.Metric.ProviderHost = host
+		o.Metric.ProviderPort = port
+	}
+}
+
+// WithMetricStdoutFormat sets how the "stdout" metric provider writes metrics.
+// "pretty" (default) writes indented JSON, which is easy to read but cannot be parsed line by
+// line. "ndjson" writes every export as one compact JSON object per line, with the field names
+// of the OpenTelemetry SDK metric data, so local tooling and CI log scrapers can parse it.
+// "emf" writes every data point as one CloudWatch Embedded Metric Format object per line, which
+// CloudWatch Logs turns into metrics on AWS Lambda and ECS without an agent or collector; the
+// data point attributes and the service name become dimensions, histograms are written as
+// statistic sets, and counters and histograms use delta temporality, since CloudWatch adds up
+// the values it receives. See WithMetricEMFNamespace.
+//
+// Parameters:
+//   - format: "pretty", "ndjson", or "emf"
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricStdoutFormat("ndjson"),
+//	)
+func WithMetricStdoutFormat(format string) Option {
+	return func(o *Options) {
+		o.Metric.StdoutFormat = format
+	}
+}
+
+// WithMetricEMFNamespace sets the CloudWatch namespace of the metrics written by the "stdout"
+// metric provider in the "emf" format. An empty namespace (default) uses the service name.
+//
+// Parameters:
+//   - namespace: The CloudWatch namespace (e.g., "Shop/Checkout")
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("checkout"),
+//	    WithMetricStdoutFormat("emf"),
+//	    WithMetricEMFNamespace("Shop/Checkout"),
+//	    WithMetricReaderMode("manual"),
+//	)
+//	defer mon.Metric.Collect(ctx) // write the metrics before the Lambda invocation returns
+func WithMetricEMFNamespace(namespace string) Option {
+	return func(o *Options) {
+		o.Metric.EMFNamespace = namespace
+	}
+}
+
+// WithMetricWriter sets where the "stdout" metric provider writes metrics, e.g. a file, a
+// buffer, or a test sink, so they do not mix with the JSON logs on the process stdout. The
+// writer is fixed when the metric is created; Reload keeps it.
+//
+// Parameters:
+//   - w: The writer metrics are written to; nil writes to os.Stdout (default)
+//
+// Example:
+//
+//	var metrics bytes.Buffer
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricWriter(&metrics),
+//	)
+func WithMetricWriter(w io.Writer) Option {
+	return func(o *Options) {
+		o.Metric.Writer = w
+	}
+}
+
+// WithMetricInterval sets the metric export interval.
+// This determines how frequently metrics are exported to the configured provider.
+// Shorter intervals provide more real-time metrics but increase overhead.
+//
+// Parameters:
+//   - interval: The time interval between metric exports
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricInterval(30*time.Second), // Export every 30 seconds
+//	)
+func WithMetricInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.Metric.Interval = interval
+	}
+}
+
+// WithMetricReaderMode sets how metrics are exported.
+// "periodic" (default) exports at the WithMetricInterval interval. "manual" never exports on its own: metrics
+// are exported when Metric.Collect is called and once more on Shutdown. Use it for short-lived
+// CLIs and cron jobs that exit before a periodic export would fire.
+//
+// Parameters:
+//   - mode: "periodic" or "manual"
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("nightly-report"),
+//	    WithMetricReaderMode("manual"),
+//	)
+//	// ... do the work ...
+//	_ = mon.Metric.Collect(ctx)
+func WithMetricReaderMode(mode string) Option {
+	return func(o *Options) {
+		o.Metric.ReaderMode = mode
+	}
+}
+
+// WithMetricTemporality sets the aggregation temporality of exported metrics.
+// "cumulative" (default) reports totals since the process started. "delta" reports the change
+// since the previous export for counters and histograms, which backends such as Datadog (via
+// OTLP) require; up-down counters and gauges remain cumulative.
+//
+// Parameters:
+//   - temporality: "cumulative" or "delta"
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricTemporality("delta"),
+//	)
+func WithMetricTemporality(temporality string) Option {
+	return func(o *Options) {
+		o.Metric.Temporality = temporality
+	}
+}
+
+// WithStrictMetricNames sets whether instrument names are validated when Metric.CreateCounter,
+// CreateHistogram, and CreateGauge are called. A name that is not a valid OpenTelemetry
+// instrument name (a letter followed by letters, digits, "_", ".", "-", or "/", at most 255
+// characters) is rejected with an error wrapping ErrMetricInstrumentNameInvalid. A name
+// breaking a Prometheus convention is still created, and each convention it breaks is logged
+// once as a warning: snake_case, "_total" on counters and only on counters, and a unit suffix
+// matching the unit ("_milliseconds" or "_ms" for "ms", "_seconds" for "s", "_bytes" for "By",
+// "_percent" for "%"). Namespace prefixes are part of the validated name.
+//
+// Parameters:
+//   - enabled: Whether instrument names are validated (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithStrictMetricNames(true),
+//	)
+func WithStrictMetricNames(enabled bool) Option {
+	return func(o *Options) {
+		o.Metric.StrictNames = enabled
+	}
+}
+
+// WithMetricExemplars enables exemplars linking metrics to traces.
+// When enabled, measurements recorded with a context carrying a sampled span (for example a
+// histogram recorded inside StartSpan) keep that span's trace and span IDs, letting dashboards
+// jump from a latency spike directly to representative traces. Exemplars are disabled by default.
+//
+// Parameters:
+//   - enabled: true to collect exemplars
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricExemplars(true),
+//	)
+func WithMetricExemplars(enabled bool) Option {
+	return func(o *Options) {
+		o.Metric.Exemplars = enabled
+	}
+}
+
+// WithMetricPushgateway sets the grouping key the "pushgateway" metric provider pushes to.
+// Every export replaces the metrics of the job and instance on the Pushgateway with the
+// current cumulative values, in the Prometheus text format; the temporality option is ignored.
+// The Pushgateway is reached over HTTPS, or HTTP with WithMetricInsecure. A job that is not
+// blank is required with the "pushgateway" provider. Labels that are not safe in a URL path are
+// sent base64 encoded.
+//
+// Parameters:
+//   - job: The job label of the grouping key
+//   - instance: The instance label of the grouping key (empty for none)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("nightly-export"),
+//	    WithMetricProvider("pushgateway", "pushgateway", 9091),
+//	    WithMetricPushgateway("nightly-export", "worker-1"),
+//	    WithMetricReaderMode("manual"),
+//	)
+func WithMetricPushgateway(job, instance string) Option {
+	return func(o *Options) {
+		o.Metric.PushgatewayJob = job
+		o.Metric.PushgatewayInstance = instance
+	}
+}
+
+// WithMetricInfluxDB sets the InfluxDB v2 server the "influxdb" metric provider writes to,
+// for deployments running InfluxDB locally without an OpenTelemetry collector. Every export
+// is written to the bucket in the line protocol: one point per data point, measured by the
+// metric name and tagged with its attributes and the service name. Counters and gauges have
+// a "value" field; histograms have "count", "sum", "min", and "max" fields.
+//
+// Parameters:
+//   - url: The base URL of the InfluxDB server, "http" or "https" (e.g., "http://localhost:8086")
+//   - org: The organization owning the bucket (required)
+//   - bucket: The bucket metrics are written to (required)
+//   - token: The API token with write access to the bucket (empty for none)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("edge-agent"),
+//	    WithMetricProvider("influxdb", "", 0),
+//	    WithMetricInfluxDB("http://localhost:8086", "edge", "metrics", os.Getenv("INFLUX_TOKEN")),
+//	)
+func WithMetricInfluxDB(url, org, bucket, token string) Option {
+	return func(o *Options) {
+		o.Metric.InfluxDBURL = url
+		o.Metric.InfluxDBOrg = org
+		o.Metric.InfluxDBBucket = bucket
+		o.Metric.InfluxDBToken = token
+	}
+}
+
+// WithMetricProducer adds the metrics of producer to every collection of the Metric, so metrics
+// recorded outside its meter provider are exported, snapshotted, and watched with the others.
+// The option can be given more than once. Use WithMetricOpenCensusBridge for the metrics of
+// dependencies instrumented with OpenCensus.
+//
+// Parameters:
+//   - producer: The metric producer
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricProducer(legacyProducer),
+//	)
+func WithMetricProducer(producer MetricProducer) Option {
+	return func(o *Options) {
+		o.Metric.Producers = append(o.Metric.Producers, producer)
+	}
+}
+
+// WithMetricOpenCensusBridge sets whether the metrics of the views registered with OpenCensus,
+// by dependencies still instrumented with it such as older Google client libraries, are
+// collected with every collection of the Metric through the OpenCensus bridge, so they are
+// exported, snapshotted, and watched with the others instead of being dropped. The setting
+// cannot be changed by Reload.
+//
+// Parameters:
+//   - enabled: true to collect the OpenCensus views (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricOpenCensusBridge(true),
+//	)
+func WithMetricOpenCensusBridge(enabled bool) Option {
+	return func(o *Options) {
+		o.Metric.OpenCensusBridge = enabled
+	}
+}
+
+// WithMetricStatsDListener starts a StatsD listener that accepts packets from legacy
+// applications, such as sidecars not yet migrated to this library, and republishes them
+// through the Metric's meter provider under the "statsd" instrumentation scope, so they are
+// exported with the service's own metrics. Counters ("c", corrected for their sample rate),
+// gauges ("g", including signed relative changes), timers ("ms"), and histograms and
+// distributions ("h", "d") are supported; DogStatsD tags ("#key:value") become attributes.
+// Sets ("s"), negative counter values, NaN or infinite values and sample rates, invalid names
+// or tags, and lines that would create more than 1000 instruments or 10000 series (name and
+// tags) are dropped and reported through the OpenTelemetry error handler. The listener stops
+// on Shutdown.
+//
+// Parameters:
+//   - address: The UDP address to listen on, as "host:port" (default: "", no listener)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricStatsDListener("127.0.0.1:8125"),
+//	)
+func WithMetricStatsDListener(address string) Option {
+	return func(o *Options) {
+		o.Metric.StatsDAddress = address
+	}
+}
+
+// WithMetricEndpoint sets the OTLP metric collector as a URL, replacing WithMetricProvider and
+// WithMetricInsecure. The scheme selects the transport and TLS:
+//   - grpc://host:port and grpcs://host:port use gRPC, without and with TLS
+//   - http://host:port/path and https://host:port/path use HTTP, without and with TLS
+//
+// A missing port defaults to 4317 for gRPC and 4318 for HTTP. For HTTP the path defaults to
+// "/v1/metrics". An empty url (default) keeps the provider, host, and port options.
+//
+// Parameters:
+//   - url: The collector URL (e.g., "https://collector.example.com:4318/v1/metrics")
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricEndpoint("https://collector.example.com:4318/v1/metrics"),
+//	)
+func WithMetricEndpoint(url string) Option {
+	return func(o *Options) {
+		o.Metric.Endpoint = url
+	}
+}
+
+// WithMetricInsecure sets whether to use an insecure (non-TLS) connection for OTLP exporter.
+// When false (default), a secure TLS connection is used. When true, connections are made without TLS.
+// This should only be used in development or when TLS is handled by a proxy.
+//
+// Parameters:
+//   - insecure: Whether to use an insecure connection
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricProvider("otlp", "localhost", 4318),
+//	    WithMetricInsecure(true), // Use insecure connection
+//	)
+func WithMetricInsecure(insecure bool) Option {
+	return func(o *Options) {
+		o.Metric.Insecure = insecure
+	}
+}
+
+// WithMetricShutdownTimeout sets the maximum time Monitoring.Shutdown waits for the metric
+// provider to flush pending metrics. The metric provider is also bounded by the context passed
+// to Shutdown, whichever expires first. A zero timeout (default) applies only the context deadline.
+//
+// Parameters:
+//   - timeout: The maximum time to wait for the metric provider to shut down
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithMetricShutdownTimeout(5*time.Second),
+//	)
+func WithMetricShutdownTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.MetricShutdownTimeout = timeout
+	}
+}
+
+// WithOTLPEndpoint sends traces and metrics to the same OTLP collector.
+// It is equivalent to calling WithTracerProvider and WithMetricProvider with the "otlp" provider
+// and the same host and port, and will also cover logs once they can be exported. Options given
+// after it (e.g., WithMetricProvider) override the setting for that signal only.
+//
+// Parameters:
+//   - host: The hostname of the OTLP collector
+//   - port: The OTLP gRPC port of the collector (typically 4317)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithOTLPEndpoint("otel-collector", 4317),
+//	    WithOTLPInsecure(true),
+//	)
+func WithOTLPEndpoint(host string, port int) Option {
+	return func(o *Options) {
+		WithTracerProvider("otlp", host, port)(o)
+		WithMetricProvider("otlp", host, port)(o)
+	}
+}
+
+// WithOTLPInsecure sets whether the OTLP exporters of every signal use an insecure (non-TLS)
+// connection. It is equivalent to calling WithTracerInsecure and WithMetricInsecure with the
+// same value.
+//
+// Parameters:
+//   - insecure: Whether to use insecure connections
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithOTLPEndpoint("localhost", 4317),
+//	    WithOTLPInsecure(true),
+//	)
+func WithOTLPInsecure(insecure bool) Option {
+	return func(o *Options) {
+		WithTracerInsecure(insecure)(o)
+		WithMetricInsecure(insecure)(o)
+	}
+}
+
+// WithExporterCircuitBreaker configures the circuit breakers guarding the tracer and metric exporters.
+// After threshold consecutive failed exports a breaker opens and stops contacting the collector;
+// it then allows a single trial export after a backoff that starts at one second and doubles
+// after every failed trial, up to maxBackoff. Spans rejected by an open breaker go to the tracer
+// fallback exporter when one is configured. When created with NewMonitoring, the breaker states
+// are exposed in the "exporter_circuit_breaker_state" gauge (0 closed, 1 open, 2 half-open).
+// Defaults to a threshold of 5 and a maximum backoff of 5 minutes; a threshold of 0 disables
+// the breakers.
+//
+// Parameters:
+//   - threshold: The number of consecutive failures that opens a breaker
+//   - maxBackoff: The maximum time a breaker stays open before a trial export
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerProvider("otlp", "localhost", 4317),
+//	    WithExporterCircuitBreaker(3, time.Minute),
+//	)
+func WithExporterCircuitBreaker(threshold int, maxBackoff time.Duration) Option {
+	return func(o *Options) {
+		o.ExporterBreakerThreshold = threshold
+		o.ExporterBreakerMaxBackoff = maxBackoff
+	}
+}
+
+// WithStartupProbe sets whether the OTLP trace and metric collectors are checked for
+// reachability when the tracer and metric are created. The OTLP exporters connect lazily, so
+// without the probe a wrong host, port, or TLS setting only shows up as failed exports later.
+// The probe resolves the collector hostname, opens a TCP connection, and performs a TLS
+// handshake unless the connection is insecure; it sends no telemetry. A failure is returned as
+// an *Error with Operation OperationProbe wrapping ErrStartupProbeDNS, ErrStartupProbeUnreachable,
+// or ErrStartupProbeTLS. The "stdout" providers are not probed.
+//
+// Parameters:
+//   - timeout: The time allowed for each collector's probe; zero skips the probe (default: 0)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithTracerEndpoint("https://collector.example.com:4318/v1/traces"),
+//	    WithStartupProbe(5*time.Second),
+//	)
+func WithStartupProbe(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.StartupProbeTimeout = timeout
+	}
+}
+
+// WithIgnoredRoutes sets the request paths or routes that Tracer.SpanFromRequest creates no
+// span for, such as health checks and scrape endpoints that would otherwise dominate the
+// trace volume. Unlike a sampling rule, an ignored request is not traced at all. An entry
+// matches the request path, or the http.ServeMux pattern (e.g. "GET /orders/{id}") or route
+// (e.g. "/orders/{id}"), exactly; a trailing "*" matches every path with the preceding prefix.
+// The caller's trace context is still propagated, and Monitoring.HTTPMiddleware records no
+// body size histograms for them. Ignored routes can be replaced with Reload.
+//
+// Patterns and routes are known once a request is routed: Monitoring.HTTPMiddleware routes the
+// requests of an *http.ServeMux it wraps before starting the span, but a request passed to
+// Tracer.SpanFromRequest before routing, or served by another router, is only matched by path
+// and prefix. Only HTTP requests are filtered; the library has no gRPC middleware, so gRPC
+// calls are out of scope.
+//
+// Parameters:
+//   - routes: The paths or routes to ignore (default: none)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithIgnoredRoutes("/healthz", "/readyz", "/debug/*"),
+//	)
+func WithIgnoredRoutes(routes ...string) Option {
+	return func(o *Options) {
+		o.Tracer.IgnoredRoutes = routes
+	}
+}
+
+// WithHTTPScrubbing sets the headers and query parameters whose values are recorded as
+// "REDACTED" by Tracer.SpanFromRequest, Monitoring.HTTPMiddleware, and Tracer.RoundTripper, so
+// credentials sent in them never reach the tracing backend. Names are case-insensitive. The
+// lists replace the defaults; pass nil to scrub nothing. Scrubbing can be changed with Reload.
+//
+// Parameters:
+//   - headers: The headers to scrub (default: Authorization, Proxy-Authorization, Cookie, Set-Cookie, X-Api-Key)
+//   - queryParams: The query parameters to scrub (default: access_token, api_key, apikey, code, key, password, secret, sig, signature, token)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithHTTPScrubbing([]string{"Authorization", "Cookie", "X-Session"}, []string{"token", "otp"}),
+//	)
+func WithHTTPScrubbing(headers, queryParams []string) Option {
+	return func(o *Options) {
+		o.Tracer.ScrubbedHeaders = headers
+		o.Tracer.ScrubbedQueryParams = queryParams
+	}
+}
+
+// WithHTTPCapturedHeaders sets the request and response headers recorded on HTTP spans as
+// http.request.header.<name> and http.response.header.<name> attributes. Values of scrubbed
+// headers (see WithHTTPScrubbing) are recorded as "REDACTED".
+//
+// Parameters:
+//   - headers: The headers to record (default: none)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithHTTPCapturedHeaders("X-Request-Id", "Content-Type", "Authorization"),
+//	)
+func WithHTTPCapturedHeaders(headers ...string) Option {
+	return func(o *Options) {
+		o.Tracer.CapturedHeaders = headers
+	}
+}
+
+// WithHTTPBodyRecording sets whether Monitoring.HTTPMiddleware and Tracer.RoundTripper record
+// the body size and content type of each request and response on its span, for debugging
+// content negotiation issues. HTTPMiddleware also records the sizes in the
+// "http_server_request_size_bytes" and "http_server_response_size_bytes" histograms, labelled
+// with the method and route. With a positive snippetLimit, the first snippetLimit bytes of each
+// body are recorded too; snippets are not scrubbed, so keep them off where bodies carry
+// credentials or personal data. Disabled by default.
+//
+// Parameters:
+//   - enabled: Whether body sizes and content types are recorded (default: false)
+//   - snippetLimit: The maximum number of bytes of each body recorded (default: 0, no snippet)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("my-service"),
+//	    WithHTTPBodyRecording(true, 256),
+//	)
+func WithHTTPBodyRecording(enabled bool, snippetLimit int) Option {
+	return func(o *Options) {
+		o.Tracer.BodyRecording = enabled
+		o.Tracer.BodySnippetLimit = snippetLimit
+	}
+}
+
+// WithServerlessMode sets whether the components are configured for serverless functions
+// (AWS Lambda, Cloud Run, Azure Functions), whose process may be frozen or killed between
+// invocations, losing whatever the batch span processor and periodic metric reader still
+// buffer. When enabled:
+//   - each span is exported synchronously when it ends instead of batching
+//   - metrics use the "manual" reader mode and are only exported on Metric.Collect, Flush, and Shutdown
+//   - local root spans are annotated with faas.coldstart, true for the first invocation of the process
+//
+// Call Flush at the end of every invocation. The reader mode is set when the option is
+// applied, so a later WithMetricReaderMode overrides it. Combine with WithCloudDetection to
+// describe the function in the resources.
+//
+// Parameters:
+//   - enabled: Whether to configure for serverless functions (default: false)
+//
+// Example:
+//
+//	mon, err := NewMonitoring(
+//	    WithServiceName("checkout"),
+//	    WithServerlessMode(true),
+//	    WithCloudDetection("aws"),
+//	)
+//	// in the handler
+//	defer mon.Flush(ctx)
+func WithServerlessMode(enabled bool) Option {
+	return func(o *Options) {
+		o.ServerlessMode = enabled
+		if enabled {
+			o.Metric.ReaderMode = "manual"
+		}
+	}
+}
+
+// defaultEnvironment is the Environment used when none is set. Unlike a configured one, it does
+// not override the deployment.environment of OTEL_RESOURCE_ATTRIBUTES or the detectors.
+const defaultEnvironment = "development"
+
 // defaultOptions returns a pointer to Options populated with sensible defaults for monitoring components.
-// The defaults set the environment to "development", logger level to "info" with an empty LoggerOutputPath (use stdout),
+// The defaults set the environment to "development", logger level to "info" with an empty Logger.OutputPath (use stdout),
 // tracer and metric providers to "stdout", tracer sample ratio to 1.0, tracer batch timeout to 5s, and metric export
 // interval to 60s.
 func defaultOptions() *Options {
 	return &Options{
-		Environment:        "development",
-		LoggerLevel:        "info",
-		LoggerOutputPath:   "",
-		TracerProvider:     "stdout",
-		TracerSampleRatio:  1.0,
-		TracerBatchTimeout: 5 * time.Second,
-		MetricProvider:     "stdout",
-		MetricInterval:     60 * time.Second,
+		Environment: defaultEnvironment,
+		Logger: LoggerOptions{
+			Level:            "info",
+			ExitFlushTimeout: 5 * time.Second,
+		},
+		Tracer: TracerOptions{
+			Provider:            "stdout",
+			StdoutFormat:        "pretty",
+			SampleRatio:         1.0,
+			BatchTimeout:        5 * time.Second,
+			ScrubbedHeaders:     tracer.DefaultScrubbedHeaders(),
+			ScrubbedQueryParams: tracer.DefaultScrubbedQueryParams(),
+		},
+		Metric: MetricOptions{
+			Provider:     "stdout",
+			StdoutFormat: "pretty",
+			Interval:     60 * time.Second,
+			ReaderMode:   "periodic",
+			Temporality:  "cumulative",
+		},
+		ExporterBreakerThreshold:  5,
+		ExporterBreakerMaxBackoff: 5 * time.Minute,
 	}
 }
@@ -1,6 +1,13 @@
 package monitoring
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
 	"testing"
 	"time"
 )
@@ -14,22 +21,28 @@ func TestMonitoring_Options_DefaultOptions(t *testing.T) {
 		want interface{}
 	}{
 		{"Environment", opts.Environment, "development"},
-		{"LoggerLevel", opts.LoggerLevel, "info"},
-		{"LoggerOutputPath", opts.LoggerOutputPath, ""},
-		{"TracerProvider", opts.TracerProvider, "stdout"},
-		{"TracerSampleRatio", opts.TracerSampleRatio, 1.0},
-		{"TracerBatchTimeout", opts.TracerBatchTimeout, 5 * time.Second},
-		{"TracerInsecure", opts.TracerInsecure, false},
-		{"MetricProvider", opts.MetricProvider, "stdout"},
-		{"MetricInterval", opts.MetricInterval, 60 * time.Second},
-		{"MetricInsecure", opts.MetricInsecure, false},
+		{"Logger.Level", opts.Logger.Level, "info"},
+		{"Logger.OutputPath", opts.Logger.OutputPath, ""},
+		{"Tracer.Provider", opts.Tracer.Provider, "stdout"},
+		{"Tracer.SampleRatio", opts.Tracer.SampleRatio, 1.0},
+		{"Tracer.BatchTimeout", opts.Tracer.BatchTimeout, 5 * time.Second},
+		{"Tracer.Insecure", opts.Tracer.Insecure, false},
+		{"Metric.Provider", opts.Metric.Provider, "stdout"},
+		{"Metric.Interval", opts.Metric.Interval, 60 * time.Second},
+		{"Metric.ReaderMode", opts.Metric.ReaderMode, "periodic"},
+		{"Metric.Temporality", opts.Metric.Temporality, "cumulative"},
+		{"Metric.Exemplars", opts.Metric.Exemplars, false},
+		{"Metric.StatsDAddress", opts.Metric.StatsDAddress, ""},
+		{"Metric.Insecure", opts.Metric.Insecure, false},
 		{"ServiceName", opts.ServiceName, ""},
 		{"InstanceName", opts.InstanceName, ""},
 		{"InstanceHost", opts.InstanceHost, ""},
-		{"TracerProviderHost", opts.TracerProviderHost, ""},
-		{"TracerProviderPort", opts.TracerProviderPort, 0},
-		{"MetricProviderHost", opts.MetricProviderHost, ""},
-		{"MetricProviderPort", opts.MetricProviderPort, 0},
+		{"Tracer.ProviderHost", opts.Tracer.ProviderHost, ""},
+		{"Tracer.ProviderPort", opts.Tracer.ProviderPort, 0},
+		{"Metric.ProviderHost", opts.Metric.ProviderHost, ""},
+		{"Metric.ProviderPort", opts.Metric.ProviderPort, 0},
+		{"ExporterBreakerThreshold", opts.ExporterBreakerThreshold, 5},
+		{"ExporterBreakerMaxBackoff", opts.ExporterBreakerMaxBackoff, 5 * time.Minute},
 	}
 
 	for _, tt := range tests {
@@ -135,8 +148,8 @@ func TestMonitoring_Options_WithLoggerLevel(t *testing.T) {
 		t.Run(tt.level, func(t *testing.T) {
 			opts := defaultOptions()
 			WithLoggerLevel(tt.level)(opts)
-			if opts.LoggerLevel != tt.want {
-				t.Errorf("WithLoggerLevel(%q) LoggerLevel = %v, want %v", tt.level, opts.LoggerLevel, tt.want)
+			if opts.Logger.Level != tt.want {
+				t.Errorf("WithLoggerLevel(%q) Logger.Level = %v, want %v", tt.level, opts.Logger.Level, tt.want)
 			}
 		})
 	}
@@ -158,13 +171,187 @@ func TestMonitoring_Options_WithLoggerOutputPath(t *testing.T) {
 		t.Run(tt.path, func(t *testing.T) {
 			opts := defaultOptions()
 			WithLoggerOutputPath(tt.path)(opts)
-			if opts.LoggerOutputPath != tt.want {
-				t.Errorf("WithLoggerOutputPath(%q) LoggerOutputPath = %v, want %v", tt.path, opts.LoggerOutputPath, tt.want)
+			if opts.Logger.OutputPath != tt.want {
+				t.Errorf("WithLoggerOutputPath(%q) Logger.OutputPath = %v, want %v", tt.path, opts.Logger.OutputPath, tt.want)
 			}
 		})
 	}
 }
 
+func TestMonitoring_Options_WithLoggerErrorOutputPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"stderr", "stderr"},
+		{"/var/log/error.log", "/var/log/error.log"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			opts := defaultOptions()
+			WithLoggerErrorOutputPath(tt.path)(opts)
+			if opts.Logger.ErrorOutputPath != tt.want {
+				t.Errorf("WithLoggerErrorOutputPath(%q) Logger.ErrorOutputPath = %v, want %v", tt.path, opts.Logger.ErrorOutputPath, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithLoggerSink(t *testing.T) {
+	opts := defaultOptions()
+	WithLoggerSink("syslog")(opts)
+	WithLoggerSyslog("udp", "syslog:514", "daemon", "api")(opts)
+	if opts.Logger.Sink != "syslog" {
+		t.Errorf("WithLoggerSink() Logger.Sink = %v, want syslog", opts.Logger.Sink)
+	}
+	got := []string{opts.Logger.SyslogNetwork, opts.Logger.SyslogAddress, opts.Logger.SyslogFacility, opts.Logger.SyslogTag}
+	want := []string{"udp", "syslog:514", "daemon", "api"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WithLoggerSyslog() = %v, want %v", got, want)
+	}
+}
+
+func TestMonitoring_Options_WithLoggerLoki(t *testing.T) {
+	opts := defaultOptions()
+	WithLoggerLoki("http://loki:3100")(opts)
+	if opts.Logger.LokiURL != "http://loki:3100" {
+		t.Errorf("WithLoggerLoki() Logger.LokiURL = %v, want http://loki:3100", opts.Logger.LokiURL)
+	}
+}
+
+func TestMonitoring_Options_WithLoggerKafka(t *testing.T) {
+	opts := defaultOptions()
+	WithLoggerKafka([]string{"kafka-0:9092", "kafka-1:9092"}, "logs")(opts)
+	WithLoggerKafkaBatch(500, 200*time.Millisecond, 50000)(opts)
+	if want := []string{"kafka-0:9092", "kafka-1:9092"}; !reflect.DeepEqual(opts.Logger.KafkaBrokers, want) {
+		t.Errorf("WithLoggerKafka() Logger.KafkaBrokers = %v, want %v", opts.Logger.KafkaBrokers, want)
+	}
+	if opts.Logger.KafkaTopic != "logs" {
+		t.Errorf("WithLoggerKafka() Logger.KafkaTopic = %v, want logs", opts.Logger.KafkaTopic)
+	}
+	if opts.Logger.KafkaBatchSize != 500 || opts.Logger.KafkaBatchTimeout != 200*time.Millisecond || opts.Logger.KafkaBufferSize != 50000 {
+		t.Errorf("WithLoggerKafkaBatch() = %v, %v, %v, want 500, 200ms, 50000", opts.Logger.KafkaBatchSize, opts.Logger.KafkaBatchTimeout, opts.Logger.KafkaBufferSize)
+	}
+}
+
+func TestMonitoring_Options_WithLoggerSchema(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Logger.Schema != "" {
+		t.Errorf("default Logger.Schema = %v, want empty", opts.Logger.Schema)
+	}
+	WithLoggerSchema("ecs")(opts)
+	if opts.Logger.Schema != "ecs" {
+		t.Errorf("WithLoggerSchema() Logger.Schema = %v, want ecs", opts.Logger.Schema)
+	}
+}
+
+func TestMonitoring_Options_WithLoggerTimeFormat(t *testing.T) {
+	opts := defaultOptions()
+	WithLoggerTimeFormat(time.RFC3339, time.UTC)(opts)
+	if opts.Logger.TimeLayout != time.RFC3339 || opts.Logger.TimeLocation != time.UTC {
+		t.Errorf("WithLoggerTimeFormat() = %q, %v, want %q, UTC", opts.Logger.TimeLayout, opts.Logger.TimeLocation, time.RFC3339)
+	}
+}
+
+func TestMonitoring_Options_WithLoggerCaller(t *testing.T) {
+	tests := []struct {
+		enabled      bool
+		skip         int
+		wantDisabled bool
+	}{
+		{true, 1, false},
+		{false, 0, true},
+	}
+
+	for _, tt := range tests {
+		opts := defaultOptions()
+		WithLoggerCaller(tt.enabled, tt.skip)(opts)
+		if opts.Logger.DisableCaller != tt.wantDisabled || opts.Logger.CallerSkip != tt.skip {
+			t.Errorf("WithLoggerCaller(%v, %d) = %v, %d, want %v, %d", tt.enabled, tt.skip, opts.Logger.DisableCaller, opts.Logger.CallerSkip, tt.wantDisabled, tt.skip)
+		}
+	}
+}
+
+func TestMonitoring_Options_WithLoggerStacktraceLevel(t *testing.T) {
+	for _, level := range []string{"error", "fatal", "off", ""} {
+		opts := defaultOptions()
+		WithLoggerStacktraceLevel(level)(opts)
+		if opts.Logger.StacktraceLevel != level {
+			t.Errorf("WithLoggerStacktraceLevel(%q) Logger.StacktraceLevel = %q, want %q", level, opts.Logger.StacktraceLevel, level)
+		}
+	}
+}
+
+func TestMonitoring_Options_WithLoggerDedup(t *testing.T) {
+	for _, window := range []time.Duration{0, time.Second, time.Minute} {
+		opts := defaultOptions()
+		WithLoggerDedup(window)(opts)
+		if opts.Logger.DedupWindow != window {
+			t.Errorf("WithLoggerDedup(%v) Logger.DedupWindow = %v, want %v", window, opts.Logger.DedupWindow, window)
+		}
+	}
+}
+
+func TestMonitoring_Options_WithLoggerRateLimit(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Logger.RateLimits != nil {
+		t.Errorf("defaultOptions() Logger.RateLimits = %v, want nil", opts.Logger.RateLimits)
+	}
+	WithLoggerRateLimit("cache miss", 10)(opts)
+	WithLoggerRateLimit("healthcheck", 1)(opts)
+	WithLoggerRateLimit("cache miss", 5)(opts)
+	want := map[string]int{"cache miss": 5, "healthcheck": 1}
+	if !reflect.DeepEqual(opts.Logger.RateLimits, want) {
+		t.Errorf("WithLoggerRateLimit() Logger.RateLimits = %v, want %v", opts.Logger.RateLimits, want)
+	}
+}
+
+func TestMonitoring_Options_WithLoggerAudit(t *testing.T) {
+	opts := defaultOptions()
+	WithLoggerAudit("/var/log/audit.log", []byte("secret"))(opts)
+	if opts.Logger.AuditOutputPath != "/var/log/audit.log" || string(opts.Logger.AuditHMACKey) != "secret" {
+		t.Errorf("WithLoggerAudit() = %q, %q, want %q, %q", opts.Logger.AuditOutputPath, opts.Logger.AuditHMACKey, "/var/log/audit.log", "secret")
+	}
+}
+
+func TestMonitoring_Options_WithLoggerCaptureStdLog(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Logger.CaptureStdLog {
+		t.Error("defaultOptions() Logger.CaptureStdLog = true, want false")
+	}
+
+	WithLoggerCaptureStdLog(true)(opts)
+	if !opts.Logger.CaptureStdLog {
+		t.Error("WithLoggerCaptureStdLog(true) Logger.CaptureStdLog = false, want true")
+	}
+}
+
+func TestMonitoring_Options_WithLoggerCaptureGRPCLog(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Logger.CaptureGRPCLog {
+		t.Error("defaultOptions() Logger.CaptureGRPCLog = true, want false")
+	}
+
+	WithLoggerCaptureGRPCLog(true)(opts)
+	if !opts.Logger.CaptureGRPCLog {
+		t.Error("WithLoggerCaptureGRPCLog(true) Logger.CaptureGRPCLog = false, want true")
+	}
+}
+
+func TestMonitoring_Options_WithLoggerAsync(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Logger.AsyncBufferSize != 0 {
+		t.Errorf("defaultOptions() Logger.AsyncBufferSize = %d, want 0", opts.Logger.AsyncBufferSize)
+	}
+
+	WithLoggerAsync(4096, "drop_newest")(opts)
+	if opts.Logger.AsyncBufferSize != 4096 || opts.Logger.AsyncDropPolicy != "drop_newest" {
+		t.Errorf("WithLoggerAsync(4096, drop_newest) = (%d, %q), want (4096, \"drop_newest\")", opts.Logger.AsyncBufferSize, opts.Logger.AsyncDropPolicy)
+	}
+}
+
 func TestMonitoring_Options_WithTracerProvider(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -188,14 +375,14 @@ func TestMonitoring_Options_WithTracerProvider(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := defaultOptions()
 			WithTracerProvider(tt.provider, tt.host, tt.port)(opts)
-			if opts.TracerProvider != tt.wantProv {
-				t.Errorf("WithTracerProvider() TracerProvider = %v, want %v", opts.TracerProvider, tt.wantProv)
+			if opts.Tracer.Provider != tt.wantProv {
+				t.Errorf("WithTracerProvider() Tracer.Provider = %v, want %v", opts.Tracer.Provider, tt.wantProv)
 			}
-			if opts.TracerProviderHost != tt.wantHost {
-				t.Errorf("WithTracerProvider() TracerProviderHost = %v, want %v", opts.TracerProviderHost, tt.wantHost)
+			if opts.Tracer.ProviderHost != tt.wantHost {
+				t.Errorf("WithTracerProvider() Tracer.ProviderHost = %v, want %v", opts.Tracer.ProviderHost, tt.wantHost)
 			}
-			if opts.TracerProviderPort != tt.wantPort {
-				t.Errorf("WithTracerProvider() TracerProviderPort = %v, want %v", opts.TracerProviderPort, tt.wantPort)
+			if opts.Tracer.ProviderPort != tt.wantPort {
+				t.Errorf("WithTracerProvider() Tracer.ProviderPort = %v, want %v", opts.Tracer.ProviderPort, tt.wantPort)
 			}
 		})
 	}
@@ -221,8 +408,8 @@ func TestMonitoring_Options_WithTracerSampleRatio(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := defaultOptions()
 			WithTracerSampleRatio(tt.ratio)(opts)
-			if opts.TracerSampleRatio != tt.want {
-				t.Errorf("WithTracerSampleRatio(%v) TracerSampleRatio = %v, want %v", tt.ratio, opts.TracerSampleRatio, tt.want)
+			if opts.Tracer.SampleRatio != tt.want {
+				t.Errorf("WithTracerSampleRatio(%v) Tracer.SampleRatio = %v, want %v", tt.ratio, opts.Tracer.SampleRatio, tt.want)
 			}
 		})
 	}
@@ -247,13 +434,38 @@ func TestMonitoring_Options_WithTracerBatchTimeout(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := defaultOptions()
 			WithTracerBatchTimeout(tt.timeout)(opts)
-			if opts.TracerBatchTimeout != tt.want {
-				t.Errorf("WithTracerBatchTimeout(%v) TracerBatchTimeout = %v, want %v", tt.timeout, opts.TracerBatchTimeout, tt.want)
+			if opts.Tracer.BatchTimeout != tt.want {
+				t.Errorf("WithTracerBatchTimeout(%v) Tracer.BatchTimeout = %v, want %v", tt.timeout, opts.Tracer.BatchTimeout, tt.want)
 			}
 		})
 	}
 }
 
+func TestMonitoring_Options_WithTracerContextAnnotations(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.ContextAnnotations {
+		t.Error("defaultOptions() Tracer.ContextAnnotations = true, want false")
+	}
+	WithTracerContextAnnotations(true)(opts)
+	if !opts.Tracer.ContextAnnotations {
+		t.Error("WithTracerContextAnnotations(true) Tracer.ContextAnnotations = false, want true")
+	}
+}
+
+func TestMonitoring_Options_WithTracerLongSpanWatchdog(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.LongSpanThreshold != 0 || opts.TracerLongSpanMetric {
+		t.Errorf("defaultOptions() long span watchdog = %v, %v, want 0, false", opts.Tracer.LongSpanThreshold, opts.TracerLongSpanMetric)
+	}
+	WithTracerLongSpanWatchdog(5*time.Minute, true)(opts)
+	if opts.Tracer.LongSpanThreshold != 5*time.Minute {
+		t.Errorf("WithTracerLongSpanWatchdog() Tracer.LongSpanThreshold = %v, want %v", opts.Tracer.LongSpanThreshold, 5*time.Minute)
+	}
+	if !opts.TracerLongSpanMetric {
+		t.Error("WithTracerLongSpanWatchdog() TracerLongSpanMetric = false, want true")
+	}
+}
+
 func TestMonitoring_Options_WithTracerInsecure(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -268,8 +480,8 @@ func TestMonitoring_Options_WithTracerInsecure(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := defaultOptions()
 			WithTracerInsecure(tt.insecure)(opts)
-			if opts.TracerInsecure != tt.want {
-				t.Errorf("WithTracerInsecure(%v) TracerInsecure = %v, want %v", tt.insecure, opts.TracerInsecure, tt.want)
+			if opts.Tracer.Insecure != tt.want {
+				t.Errorf("WithTracerInsecure(%v) Tracer.Insecure = %v, want %v", tt.insecure, opts.Tracer.Insecure, tt.want)
 			}
 		})
 	}
@@ -298,14 +510,14 @@ func TestMonitoring_Options_WithMetricProvider(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := defaultOptions()
 			WithMetricProvider(tt.provider, tt.host, tt.port)(opts)
-			if opts.MetricProvider != tt.wantProv {
-				t.Errorf("WithMetricProvider() MetricProvider = %v, want %v", opts.MetricProvider, tt.wantProv)
+			if opts.Metric.Provider != tt.wantProv {
+				t.Errorf("WithMetricProvider() Metric.Provider = %v, want %v", opts.Metric.Provider, tt.wantProv)
 			}
-			if opts.MetricProviderHost != tt.wantHost {
-				t.Errorf("WithMetricProvider() MetricProviderHost = %v, want %v", opts.MetricProviderHost, tt.wantHost)
+			if opts.Metric.ProviderHost != tt.wantHost {
+				t.Errorf("WithMetricProvider() Metric.ProviderHost = %v, want %v", opts.Metric.ProviderHost, tt.wantHost)
 			}
-			if opts.MetricProviderPort != tt.wantPort {
-				t.Errorf("WithMetricProvider() MetricProviderPort = %v, want %v", opts.MetricProviderPort, tt.wantPort)
+			if opts.Metric.ProviderPort != tt.wantPort {
+				t.Errorf("WithMetricProvider() Metric.ProviderPort = %v, want %v", opts.Metric.ProviderPort, tt.wantPort)
 			}
 		})
 	}
@@ -331,13 +543,157 @@ func TestMonitoring_Options_WithMetricInterval(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := defaultOptions()
 			WithMetricInterval(tt.interval)(opts)
-			if opts.MetricInterval != tt.want {
-				t.Errorf("WithMetricInterval(%v) MetricInterval = %v, want %v", tt.interval, opts.MetricInterval, tt.want)
+			if opts.Metric.Interval != tt.want {
+				t.Errorf("WithMetricInterval(%v) Metric.Interval = %v, want %v", tt.interval, opts.Metric.Interval, tt.want)
 			}
 		})
 	}
 }
 
+func TestMonitoring_Options_WithMetricReaderMode(t *testing.T) {
+	tests := []struct {
+		name string
+		mode string
+		want string
+	}{
+		{"periodic", "periodic", "periodic"},
+		{"manual", "manual", "manual"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultOptions()
+			WithMetricReaderMode(tt.mode)(opts)
+			if opts.Metric.ReaderMode != tt.want {
+				t.Errorf("WithMetricReaderMode(%q) Metric.ReaderMode = %q, want %q", tt.mode, opts.Metric.ReaderMode, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithMetricTemporality(t *testing.T) {
+	tests := []struct {
+		name        string
+		temporality string
+		want        string
+	}{
+		{"cumulative", "cumulative", "cumulative"},
+		{"delta", "delta", "delta"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultOptions()
+			WithMetricTemporality(tt.temporality)(opts)
+			if opts.Metric.Temporality != tt.want {
+				t.Errorf("WithMetricTemporality(%q) Metric.Temporality = %q, want %q", tt.temporality, opts.Metric.Temporality, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithStdoutFormat(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.StdoutFormat != "pretty" || opts.Metric.StdoutFormat != "pretty" {
+		t.Errorf("defaultOptions() stdout formats = %q, %q, want %q", opts.Tracer.StdoutFormat, opts.Metric.StdoutFormat, "pretty")
+	}
+	WithTracerStdoutFormat("ndjson")(opts)
+	WithMetricStdoutFormat("ndjson")(opts)
+	if opts.Tracer.StdoutFormat != "ndjson" {
+		t.Errorf("WithTracerStdoutFormat() Tracer.StdoutFormat = %q, want %q", opts.Tracer.StdoutFormat, "ndjson")
+	}
+	if opts.Metric.StdoutFormat != "ndjson" {
+		t.Errorf("WithMetricStdoutFormat() Metric.StdoutFormat = %q, want %q", opts.Metric.StdoutFormat, "ndjson")
+	}
+}
+
+func TestMonitoring_Options_WithWriters(t *testing.T) {
+	var spans, metrics bytes.Buffer
+	opts := defaultOptions()
+	WithTracerWriter(&spans)(opts)
+	WithMetricWriter(&metrics)(opts)
+	if opts.Tracer.Writer != &spans {
+		t.Errorf("WithTracerWriter() Tracer.Writer = %v, want %v", opts.Tracer.Writer, &spans)
+	}
+	if opts.Metric.Writer != &metrics {
+		t.Errorf("WithMetricWriter() Metric.Writer = %v, want %v", opts.Metric.Writer, &metrics)
+	}
+}
+
+func TestMonitoring_Options_WithMetricExemplars(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+	}{
+		{"enabled", true},
+		{"disabled", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultOptions()
+			WithMetricExemplars(tt.enabled)(opts)
+			if opts.Metric.Exemplars != tt.enabled {
+				t.Errorf("WithMetricExemplars(%v) Metric.Exemplars = %v, want %v", tt.enabled, opts.Metric.Exemplars, tt.enabled)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithMetricEMFNamespace(t *testing.T) {
+	opts := defaultOptions()
+	WithMetricEMFNamespace("Shop/Checkout")(opts)
+	if opts.Metric.EMFNamespace != "Shop/Checkout" {
+		t.Errorf("WithMetricEMFNamespace() = %q, want %q", opts.Metric.EMFNamespace, "Shop/Checkout")
+	}
+}
+
+func TestMonitoring_Options_WithMetricPushgateway(t *testing.T) {
+	opts := defaultOptions()
+	WithMetricPushgateway("nightly-export", "worker-1")(opts)
+	if opts.Metric.PushgatewayJob != "nightly-export" || opts.Metric.PushgatewayInstance != "worker-1" {
+		t.Errorf("WithMetricPushgateway() = %q, %q, want %q, %q", opts.Metric.PushgatewayJob, opts.Metric.PushgatewayInstance, "nightly-export", "worker-1")
+	}
+}
+
+func TestMonitoring_Options_WithMetricInfluxDB(t *testing.T) {
+	opts := defaultOptions()
+	WithMetricInfluxDB("http://localhost:8086", "edge", "metrics", "secret")(opts)
+	if opts.Metric.InfluxDBURL != "http://localhost:8086" || opts.Metric.InfluxDBOrg != "edge" || opts.Metric.InfluxDBBucket != "metrics" || opts.Metric.InfluxDBToken != "secret" {
+		t.Errorf("WithMetricInfluxDB() = %q, %q, %q, %q", opts.Metric.InfluxDBURL, opts.Metric.InfluxDBOrg, opts.Metric.InfluxDBBucket, opts.Metric.InfluxDBToken)
+	}
+}
+
+func TestMonitoring_Options_WithMetricProducer(t *testing.T) {
+	opts := defaultOptions()
+	WithMetricProducer(emptyProducer{})(opts)
+	WithMetricProducer(emptyProducer{})(opts)
+	if len(opts.Metric.Producers) != 2 {
+		t.Errorf("WithMetricProducer() twice = %d producers, want 2", len(opts.Metric.Producers))
+	}
+}
+
+func TestMonitoring_Options_WithMetricOpenCensusBridge(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Metric.OpenCensusBridge {
+		t.Error("defaultOptions() Metric.OpenCensusBridge = true, want false")
+	}
+	WithMetricOpenCensusBridge(true)(opts)
+	if !opts.Metric.OpenCensusBridge {
+		t.Error("WithMetricOpenCensusBridge(true) did not set MetricOpenCensusBridge")
+	}
+}
+
+func TestMonitoring_Options_WithMetricStatsDListener(t *testing.T) {
+	opts := defaultOptions()
+	WithMetricStatsDListener(":8125")(opts)
+	if opts.Metric.StatsDAddress != ":8125" {
+		t.Errorf("WithMetricStatsDListener() Metric.StatsDAddress = %q, want %q", opts.Metric.StatsDAddress, ":8125")
+	}
+}
+
 func TestMonitoring_Options_WithMetricInsecure(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -352,8 +708,8 @@ func TestMonitoring_Options_WithMetricInsecure(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := defaultOptions()
 			WithMetricInsecure(tt.insecure)(opts)
-			if opts.MetricInsecure != tt.want {
-				t.Errorf("WithMetricInsecure(%v) MetricInsecure = %v, want %v", tt.insecure, opts.MetricInsecure, tt.want)
+			if opts.Metric.Insecure != tt.want {
+				t.Errorf("WithMetricInsecure(%v) Metric.Insecure = %v, want %v", tt.insecure, opts.Metric.Insecure, tt.want)
 			}
 		})
 	}
@@ -387,44 +743,44 @@ func TestMonitoring_Options_Combined(t *testing.T) {
 	if opts.InstanceHost != "localhost" {
 		t.Errorf("InstanceHost = %v, want localhost", opts.InstanceHost)
 	}
-	if opts.LoggerLevel != "debug" {
-		t.Errorf("LoggerLevel = %v, want debug", opts.LoggerLevel)
+	if opts.Logger.Level != "debug" {
+		t.Errorf("Logger.Level = %v, want debug", opts.Logger.Level)
 	}
-	if opts.LoggerOutputPath != "/var/log/app.log" {
-		t.Errorf("LoggerOutputPath = %v, want /var/log/app.log", opts.LoggerOutputPath)
+	if opts.Logger.OutputPath != "/var/log/app.log" {
+		t.Errorf("Logger.OutputPath = %v, want /var/log/app.log", opts.Logger.OutputPath)
 	}
-	if opts.TracerProvider != "otlp" {
-		t.Errorf("TracerProvider = %v, want otlp", opts.TracerProvider)
+	if opts.Tracer.Provider != "otlp" {
+		t.Errorf("Tracer.Provider = %v, want otlp", opts.Tracer.Provider)
 	}
-	if opts.TracerProviderHost != "localhost" {
-		t.Errorf("TracerProviderHost = %v, want localhost", opts.TracerProviderHost)
+	if opts.Tracer.ProviderHost != "localhost" {
+		t.Errorf("Tracer.ProviderHost = %v, want localhost", opts.Tracer.ProviderHost)
 	}
-	if opts.TracerProviderPort != 4317 {
-		t.Errorf("TracerProviderPort = %v, want 4317", opts.TracerProviderPort)
+	if opts.Tracer.ProviderPort != 4317 {
+		t.Errorf("Tracer.ProviderPort = %v, want 4317", opts.Tracer.ProviderPort)
 	}
-	if opts.TracerSampleRatio != 0.5 {
-		t.Errorf("TracerSampleRatio = %v, want 0.5", opts.TracerSampleRatio)
+	if opts.Tracer.SampleRatio != 0.5 {
+		t.Errorf("Tracer.SampleRatio = %v, want 0.5", opts.Tracer.SampleRatio)
 	}
-	if opts.TracerBatchTimeout != 10*time.Second {
-		t.Errorf("TracerBatchTimeout = %v, want 10s", opts.TracerBatchTimeout)
+	if opts.Tracer.BatchTimeout != 10*time.Second {
+		t.Errorf("Tracer.BatchTimeout = %v, want 10s", opts.Tracer.BatchTimeout)
 	}
-	if opts.TracerInsecure != true {
-		t.Errorf("TracerInsecure = %v, want true", opts.TracerInsecure)
+	if opts.Tracer.Insecure != true {
+		t.Errorf("Tracer.Insecure = %v, want true", opts.Tracer.Insecure)
 	}
-	if opts.MetricProvider != "otlp" {
-		t.Errorf("MetricProvider = %v, want otlp", opts.MetricProvider)
+	if opts.Metric.Provider != "otlp" {
+		t.Errorf("Metric.Provider = %v, want otlp", opts.Metric.Provider)
 	}
-	if opts.MetricProviderHost != "localhost" {
-		t.Errorf("MetricProviderHost = %v, want localhost", opts.MetricProviderHost)
+	if opts.Metric.ProviderHost != "localhost" {
+		t.Errorf("Metric.ProviderHost = %v, want localhost", opts.Metric.ProviderHost)
 	}
-	if opts.MetricProviderPort != 4318 {
-		t.Errorf("MetricProviderPort = %v, want 4318", opts.MetricProviderPort)
+	if opts.Metric.ProviderPort != 4318 {
+		t.Errorf("Metric.ProviderPort = %v, want 4318", opts.Metric.ProviderPort)
 	}
-	if opts.MetricInterval != 30*time.Second {
-		t.Errorf("MetricInterval = %v, want 30s", opts.MetricInterval)
+	if opts.Metric.Interval != 30*time.Second {
+		t.Errorf("Metric.Interval = %v, want 30s", opts.Metric.Interval)
 	}
-	if opts.MetricInsecure != true {
-		t.Errorf("MetricInsecure = %v, want true", opts.MetricInsecure)
+	if opts.Metric.Insecure != true {
+		t.Errorf("Metric.Insecure = %v, want true", opts.Metric.Insecure)
 	}
 }
 
@@ -447,8 +803,8 @@ func TestMonitoring_Options_Override(t *testing.T) {
 	WithTracerSampleRatio(0.1)(opts)
 	WithTracerSampleRatio(0.5)(opts)
 	WithTracerSampleRatio(1.0)(opts)
-	if opts.TracerSampleRatio != 1.0 {
-		t.Errorf("TracerSampleRatio = %v, want 1.0", opts.TracerSampleRatio)
+	if opts.Tracer.SampleRatio != 1.0 {
+		t.Errorf("Tracer.SampleRatio = %v, want 1.0", opts.Tracer.SampleRatio)
 	}
 }
 
@@ -469,3 +825,765 @@ func TestMonitoring_Options_Isolation(t *testing.T) {
 		t.Error("Options instances should be isolated from each other")
 	}
 }
+
+func TestMonitoring_Options_WithShutdownTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+	}{
+		{"zero", 0},
+		{"five_seconds", 5 * time.Second},
+		{"sub_second", 250 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultOptions()
+			WithTracerShutdownTimeout(tt.timeout)(opts)
+			WithMetricShutdownTimeout(tt.timeout)(opts)
+			if opts.TracerShutdownTimeout != tt.timeout {
+				t.Errorf("WithTracerShutdownTimeout(%v) TracerShutdownTimeout = %v, want %v", tt.timeout, opts.TracerShutdownTimeout, tt.timeout)
+			}
+			if opts.MetricShutdownTimeout != tt.timeout {
+				t.Errorf("WithMetricShutdownTimeout(%v) MetricShutdownTimeout = %v, want %v", tt.timeout, opts.MetricShutdownTimeout, tt.timeout)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithTracerRemoteSampling(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		interval time.Duration
+	}{
+		{"jaeger_agent", "http://jaeger-agent:5778/sampling", time.Minute},
+		{"disabled", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultOptions()
+			WithTracerRemoteSampling(tt.url, tt.interval)(opts)
+			if opts.Tracer.RemoteSamplingURL != tt.url {
+				t.Errorf("WithTracerRemoteSampling() Tracer.RemoteSamplingURL = %q, want %q", opts.Tracer.RemoteSamplingURL, tt.url)
+			}
+			if opts.Tracer.RemoteSamplingInterval != tt.interval {
+				t.Errorf("WithTracerRemoteSampling() Tracer.RemoteSamplingInterval = %v, want %v", opts.Tracer.RemoteSamplingInterval, tt.interval)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithTracerFallbackProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		path     string
+	}{
+		{"file", "file", "/var/log/spans.json"},
+		{"stdout", "stdout", ""},
+		{"disabled", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultOptions()
+			WithTracerFallbackProvider(tt.provider, tt.path)(opts)
+			if opts.Tracer.FallbackProvider != tt.provider {
+				t.Errorf("WithTracerFallbackProvider() Tracer.FallbackProvider = %q, want %q", opts.Tracer.FallbackProvider, tt.provider)
+			}
+			if opts.Tracer.FallbackPath != tt.path {
+				t.Errorf("WithTracerFallbackProvider() Tracer.FallbackPath = %q, want %q", opts.Tracer.FallbackPath, tt.path)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithExporterCircuitBreaker(t *testing.T) {
+	opts := defaultOptions()
+	WithExporterCircuitBreaker(0, time.Minute)(opts)
+	if opts.ExporterBreakerThreshold != 0 {
+		t.Errorf("WithExporterCircuitBreaker() ExporterBreakerThreshold = %d, want 0", opts.ExporterBreakerThreshold)
+	}
+	if opts.ExporterBreakerMaxBackoff != time.Minute {
+		t.Errorf("WithExporterCircuitBreaker() ExporterBreakerMaxBackoff = %v, want %v", opts.ExporterBreakerMaxBackoff, time.Minute)
+	}
+}
+
+func TestMonitoring_Options_WithKubernetesMetadata(t *testing.T) {
+	opts := defaultOptions()
+	if opts.KubernetesMetadata {
+		t.Error("defaultOptions() KubernetesMetadata = true, want false")
+	}
+	WithKubernetesMetadata(true)(opts)
+	if !opts.KubernetesMetadata {
+		t.Error("WithKubernetesMetadata(true) did not set KubernetesMetadata")
+	}
+}
+
+func TestMonitoring_Options_WithCloudDetection(t *testing.T) {
+	opts := defaultOptions()
+	WithCloudDetection("auto")(opts)
+	if opts.CloudDetection != "auto" {
+		t.Errorf("WithCloudDetection() CloudDetection = %q, want %q", opts.CloudDetection, "auto")
+	}
+
+	err := parseOptions(WithServiceName("test-service"), WithCloudDetection("digitalocean")).Validate()
+	if !errors.Is(err, ErrInvalidCloudDetection) {
+		t.Errorf("Validate() error = %v, want %v", err, ErrInvalidCloudDetection)
+	}
+}
+
+func TestMonitoring_Options_WithRedaction(t *testing.T) {
+	opts := defaultOptions()
+	WithRedaction("hash", "db.statement", "user.email")(opts)
+	if opts.RedactionAction != "hash" || !reflect.DeepEqual(opts.RedactedKeys, []string{"db.statement", "user.email"}) {
+		t.Errorf("WithRedaction() set %q %v, want hash [db.statement user.email]", opts.RedactionAction, opts.RedactedKeys)
+	}
+
+	err := parseOptions(WithServiceName("test-service"), WithRedaction("mask", "user.email")).Validate()
+	if !errors.Is(err, ErrInvalidRedactionAction) {
+		t.Errorf("Validate() error = %v, want %v", err, ErrInvalidRedactionAction)
+	}
+}
+
+func TestMonitoring_Options_WithInstrumentationScope(t *testing.T) {
+	opts := defaultOptions()
+	WithInstrumentationScope("github.com/acme/api", "v1.8.0")(opts)
+	if opts.InstrumentationScopeName != "github.com/acme/api" || opts.InstrumentationScopeVersion != "v1.8.0" {
+		t.Errorf("WithInstrumentationScope() = %q, %q, want %q, %q", opts.InstrumentationScopeName, opts.InstrumentationScopeVersion, "github.com/acme/api", "v1.8.0")
+	}
+}
+
+func TestMonitoring_Options_WithStartupProbe(t *testing.T) {
+	opts := defaultOptions()
+	if opts.StartupProbeTimeout != 0 {
+		t.Errorf("defaultOptions() StartupProbeTimeout = %v, want 0", opts.StartupProbeTimeout)
+	}
+	WithStartupProbe(5 * time.Second)(opts)
+	if opts.StartupProbeTimeout != 5*time.Second {
+		t.Errorf("WithStartupProbe() StartupProbeTimeout = %v, want %v", opts.StartupProbeTimeout, 5*time.Second)
+	}
+}
+
+func TestMonitoring_Options_WithServerlessMode(t *testing.T) {
+	opts := defaultOptions()
+	WithServerlessMode(true)(opts)
+	if !opts.ServerlessMode || opts.Metric.ReaderMode != "manual" {
+		t.Errorf("WithServerlessMode(true) ServerlessMode = %v, Metric.ReaderMode = %q, want true, %q", opts.ServerlessMode, opts.Metric.ReaderMode, "manual")
+	}
+
+	// A later reader mode overrides the preset.
+	opts = defaultOptions()
+	WithServerlessMode(true)(opts)
+	WithMetricReaderMode("periodic")(opts)
+	if opts.Metric.ReaderMode != "periodic" {
+		t.Errorf("Metric.ReaderMode = %q, want %q", opts.Metric.ReaderMode, "periodic")
+	}
+
+	opts = defaultOptions()
+	WithServerlessMode(false)(opts)
+	if opts.ServerlessMode || opts.Metric.ReaderMode != "periodic" {
+		t.Errorf("WithServerlessMode(false) ServerlessMode = %v, Metric.ReaderMode = %q, want false, %q", opts.ServerlessMode, opts.Metric.ReaderMode, "periodic")
+	}
+}
+
+func TestMonitoring_Options_WithTracerSamplingRules(t *testing.T) {
+	rules := []SamplingRule{{Name: "GET /healthz", Ratio: 0}}
+	opts := defaultOptions()
+	WithTracerSamplingRules(rules...)(opts)
+	if !reflect.DeepEqual(opts.Tracer.SamplingRules, rules) {
+		t.Fatalf("WithTracerSamplingRules() Tracer.SamplingRules = %v, want %v", opts.Tracer.SamplingRules, rules)
+	}
+
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithTracerSamplingRules(rules...),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	for name, want := range map[string]bool{"GET /healthz": false, "GET /orders": true} {
+		_, span := mon.Tracer.StartSpan(context.Background(), name)
+		if got := span.SpanContext().IsSampled(); got != want {
+			t.Errorf("span %q sampled = %v, want %v", name, got, want)
+		}
+		span.End()
+	}
+}
+
+func TestMonitoring_Options_WithIgnoredRoutes(t *testing.T) {
+	opts := defaultOptions()
+	WithIgnoredRoutes("/healthz", "/metrics")(opts)
+	if want := []string{"/healthz", "/metrics"}; !reflect.DeepEqual(opts.Tracer.IgnoredRoutes, want) {
+		t.Fatalf("WithIgnoredRoutes() Tracer.IgnoredRoutes = %v, want %v", opts.Tracer.IgnoredRoutes, want)
+	}
+
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithIgnoredRoutes("/healthz"),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	for target, want := range map[string]bool{"/healthz": false, "/orders": true} {
+		_, span, finish := mon.Tracer.SpanFromRequest(httptest.NewRequest(http.MethodGet, target, nil))
+		if got := span.IsRecording(); got != want {
+			t.Errorf("span for %s recording = %v, want %v", target, got, want)
+		}
+		finish(http.StatusOK)
+	}
+
+	// Reload replaces the ignored routes.
+	if err := mon.Reload(WithIgnoredRoutes()); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	_, span, finish := mon.Tracer.SpanFromRequest(httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if !span.IsRecording() {
+		t.Error("expected /healthz to be traced after Reload cleared the ignored routes")
+	}
+	finish(http.StatusOK)
+}
+
+func TestMonitoring_Options_WithEndpoint(t *testing.T) {
+	opts := defaultOptions()
+	WithTracerEndpoint("https://collector:4318/v1/traces")(opts)
+	WithMetricEndpoint("grpc://collector:4317")(opts)
+	if opts.Tracer.Endpoint != "https://collector:4318/v1/traces" {
+		t.Errorf("WithTracerEndpoint() Tracer.Endpoint = %q, want %q", opts.Tracer.Endpoint, "https://collector:4318/v1/traces")
+	}
+	if opts.Metric.Endpoint != "grpc://collector:4317" {
+		t.Errorf("WithMetricEndpoint() Metric.Endpoint = %q, want %q", opts.Metric.Endpoint, "grpc://collector:4317")
+	}
+}
+
+func TestMonitoring_Options_WithTracerMirror(t *testing.T) {
+	opts := defaultOptions()
+	WithTracerMirror("grpc://vendor:4317", 0.05)(opts)
+	if opts.Tracer.MirrorEndpoint != "grpc://vendor:4317" || opts.Tracer.MirrorRatio != 0.05 {
+		t.Errorf("WithTracerMirror() set %q %v, want grpc://vendor:4317 0.05", opts.Tracer.MirrorEndpoint, opts.Tracer.MirrorRatio)
+	}
+}
+
+func TestMonitoring_Options_WithDevTraceViewer(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.DevViewerPort != 0 {
+		t.Errorf("defaultOptions() Tracer.DevViewerPort = %d, want 0", opts.Tracer.DevViewerPort)
+	}
+	WithDevTraceViewer(16686)(opts)
+	if opts.Tracer.DevViewerPort != 16686 {
+		t.Errorf("WithDevTraceViewer() Tracer.DevViewerPort = %d, want 16686", opts.Tracer.DevViewerPort)
+	}
+}
+
+func TestMonitoring_Options_WithOTLPEndpoint(t *testing.T) {
+	opts := defaultOptions()
+	WithOTLPEndpoint("otel-collector", 4317)(opts)
+	WithOTLPInsecure(true)(opts)
+
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"Tracer.Provider", opts.Tracer.Provider, "otlp"},
+		{"Tracer.ProviderHost", opts.Tracer.ProviderHost, "otel-collector"},
+		{"Tracer.ProviderPort", opts.Tracer.ProviderPort, 4317},
+		{"Tracer.Insecure", opts.Tracer.Insecure, true},
+		{"Metric.Provider", opts.Metric.Provider, "otlp"},
+		{"Metric.ProviderHost", opts.Metric.ProviderHost, "otel-collector"},
+		{"Metric.ProviderPort", opts.Metric.ProviderPort, 4317},
+		{"Metric.Insecure", opts.Metric.Insecure, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("WithOTLPEndpoint() %s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithOTLPEndpoint_Override(t *testing.T) {
+	opts := parseOptions(
+		WithOTLPEndpoint("otel-collector", 4317),
+		WithMetricProvider("stdout", "", 0),
+	)
+	if opts.Tracer.Provider != "otlp" {
+		t.Errorf("expected tracer provider otlp, got %q", opts.Tracer.Provider)
+	}
+	if opts.Metric.Provider != "stdout" {
+		t.Errorf("expected later WithMetricProvider to override metric provider, got %q", opts.Metric.Provider)
+	}
+}
+
+func TestMonitoring_Options_WithTracerXRay(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.XRayIDs || opts.Tracer.XRayPropagation {
+		t.Errorf("defaultOptions() X-Ray = %v, %v, want false, false", opts.Tracer.XRayIDs, opts.Tracer.XRayPropagation)
+	}
+	WithTracerXRayIDs(true)(opts)
+	WithTracerXRayPropagation(true)(opts)
+	if !opts.Tracer.XRayIDs || !opts.Tracer.XRayPropagation {
+		t.Errorf("WithTracerXRayIDs/WithTracerXRayPropagation() = %v, %v, want true, true", opts.Tracer.XRayIDs, opts.Tracer.XRayPropagation)
+	}
+}
+
+func TestMonitoring_Options_WithTracerOpenTracingBridge(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.OpenTracingBridge {
+		t.Error("defaultOptions() Tracer.OpenTracingBridge = true, want false")
+	}
+	WithTracerOpenTracingBridge(true)(opts)
+	if !opts.Tracer.OpenTracingBridge {
+		t.Error("WithTracerOpenTracingBridge(true) did not set TracerOpenTracingBridge")
+	}
+}
+
+func TestMonitoring_Options_WithTracerOpenCensusBridge(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.OpenCensusBridge {
+		t.Error("defaultOptions() Tracer.OpenCensusBridge = true, want false")
+	}
+	WithTracerOpenCensusBridge(true)(opts)
+	if !opts.Tracer.OpenCensusBridge {
+		t.Error("WithTracerOpenCensusBridge(true) did not set TracerOpenCensusBridge")
+	}
+}
+
+func TestMonitoring_Options_WithTracerTraceID64(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.TraceID64 {
+		t.Error("defaultOptions() Tracer.TraceID64 = true, want false")
+	}
+	WithTracerTraceID64(true)(opts)
+	if !opts.Tracer.TraceID64 {
+		t.Error("WithTracerTraceID64(true) did not set TracerTraceID64")
+	}
+}
+
+func TestMonitoring_Options_WithTracerSpanFilter(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.SpanFilter != nil {
+		t.Error("defaultOptions() TracerSpanFilter is set, want nil")
+	}
+	WithTracerSpanFilter(func(ReadOnlySpan) bool { return false })(opts)
+	if opts.Tracer.SpanFilter == nil {
+		t.Error("WithTracerSpanFilter() did not set TracerSpanFilter")
+	}
+}
+
+func TestMonitoring_Options_WithTracerIDGenerator(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.IDGenerator != nil {
+		t.Errorf("defaultOptions() Tracer.IDGenerator = %v, want nil", opts.Tracer.IDGenerator)
+	}
+
+	generator := NewSequentialIDGenerator()
+	WithTracerIDGenerator(generator)(opts)
+	if opts.Tracer.IDGenerator != generator {
+		t.Errorf("WithTracerIDGenerator() Tracer.IDGenerator = %v, want %v", opts.Tracer.IDGenerator, generator)
+	}
+}
+
+func TestMonitoring_Options_WithSetGlobalProviders(t *testing.T) {
+	opts := defaultOptions()
+	if opts.SetGlobalProviders {
+		t.Error("defaultOptions() SetGlobalProviders = true, want false")
+	}
+
+	WithSetGlobalProviders(true)(opts)
+	if !opts.SetGlobalProviders {
+		t.Error("WithSetGlobalProviders(true) SetGlobalProviders = false, want true")
+	}
+}
+
+func TestMonitoring_Options_WithOTelErrorLogging(t *testing.T) {
+	opts := defaultOptions()
+	if opts.OTelErrorLogging {
+		t.Error("defaultOptions() OTelErrorLogging = true, want false")
+	}
+
+	WithOTelErrorLogging(true)(opts)
+	if !opts.OTelErrorLogging {
+		t.Error("WithOTelErrorLogging(true) OTelErrorLogging = false, want true")
+	}
+}
+
+func TestMonitoring_Options_WithTraceIDResponseHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		header  string
+		want    string
+	}{
+		{name: "disabled", enabled: false, header: "X-Trace-Id", want: ""},
+		{name: "default name", enabled: true, header: "", want: "X-Trace-Id"},
+		{name: "custom name", enabled: true, header: "X-Request-Trace", want: "X-Request-Trace"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultOptions()
+			WithTraceIDResponseHeader(tt.enabled, tt.header)(opts)
+			if opts.TraceIDResponseHeader != tt.want {
+				t.Errorf("WithTraceIDResponseHeader(%v, %q) TraceIDResponseHeader = %q, want %q", tt.enabled, tt.header, opts.TraceIDResponseHeader, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_WithHTTPScrubbing(t *testing.T) {
+	opts := defaultOptions()
+	if !slices.Contains(opts.Tracer.ScrubbedHeaders, "Authorization") || !slices.Contains(opts.Tracer.ScrubbedHeaders, "Cookie") {
+		t.Errorf("defaultOptions() Tracer.ScrubbedHeaders = %v, want Authorization and Cookie", opts.Tracer.ScrubbedHeaders)
+	}
+	if !slices.Contains(opts.Tracer.ScrubbedQueryParams, "token") {
+		t.Errorf("defaultOptions() Tracer.ScrubbedQueryParams = %v, want token", opts.Tracer.ScrubbedQueryParams)
+	}
+	if len(opts.Tracer.CapturedHeaders) != 0 {
+		t.Errorf("defaultOptions() Tracer.CapturedHeaders = %v, want none", opts.Tracer.CapturedHeaders)
+	}
+
+	WithHTTPScrubbing([]string{"X-Session"}, nil)(opts)
+	WithHTTPCapturedHeaders("X-Request-Id", "X-Session")(opts)
+	if want := []string{"X-Session"}; !reflect.DeepEqual(opts.Tracer.ScrubbedHeaders, want) {
+		t.Errorf("WithHTTPScrubbing() Tracer.ScrubbedHeaders = %v, want %v", opts.Tracer.ScrubbedHeaders, want)
+	}
+	if opts.Tracer.ScrubbedQueryParams != nil {
+		t.Errorf("WithHTTPScrubbing() Tracer.ScrubbedQueryParams = %v, want nil", opts.Tracer.ScrubbedQueryParams)
+	}
+	if want := []string{"X-Request-Id", "X-Session"}; !reflect.DeepEqual(opts.Tracer.CapturedHeaders, want) {
+		t.Errorf("WithHTTPCapturedHeaders() Tracer.CapturedHeaders = %v, want %v", opts.Tracer.CapturedHeaders, want)
+	}
+}
+
+func TestMonitoring_Options_WithHTTPBodyRecording(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Tracer.BodyRecording || opts.Tracer.BodySnippetLimit != 0 {
+		t.Errorf("defaultOptions() Tracer.BodyRecording = %v, Tracer.BodySnippetLimit = %d, want disabled", opts.Tracer.BodyRecording, opts.Tracer.BodySnippetLimit)
+	}
+
+	WithHTTPBodyRecording(true, 512)(opts)
+	if !opts.Tracer.BodyRecording || opts.Tracer.BodySnippetLimit != 512 {
+		t.Errorf("WithHTTPBodyRecording(true, 512) Tracer.BodyRecording = %v, Tracer.BodySnippetLimit = %d", opts.Tracer.BodyRecording, opts.Tracer.BodySnippetLimit)
+	}
+
+	_, err := NewMonitoring(WithServiceName("test-service"), WithHTTPBodyRecording(true, -1))
+	if !errors.Is(err, ErrTracerBodySnippetLimitInvalid) {
+		t.Errorf("NewMonitoring() error = %v, want ErrTracerBodySnippetLimitInvalid", err)
+	}
+}
+
+// warnLogger records the fields of warnings.
+type warnLogger struct {
+	recordingLogger
+	warnings []map[string]interface{}
+}
+
+func (l *warnLogger) Warn(message string, fields map[string]interface{}) {
+	l.warnings = append(l.warnings, fields)
+}
+
+func TestMonitoring_Options_WithStrictMetricNames(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Metric.StrictNames {
+		t.Error("defaultOptions() Metric.StrictNames = true, want false")
+	}
+
+	mon, err := NewMonitoring(WithServiceName("test-service"), WithStrictMetricNames(true))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	logs := &warnLogger{}
+	mon.Logger = logs
+
+	if _, err := mon.Metric.CreateCounter("orders placed", "1", ""); !errors.Is(err, ErrMetricInstrumentNameInvalid) {
+		t.Errorf("CreateCounter() error = %v, want ErrMetricInstrumentNameInvalid", err)
+	}
+	if _, err := mon.Metric.CreateHistogram("checkout_latency", "ms", ""); err != nil {
+		t.Fatalf("CreateHistogram() error = %v", err)
+	}
+	if len(logs.warnings) != 1 || logs.warnings[0]["instrument"] != "checkout_latency" {
+		t.Errorf("logged warnings = %v, want one for checkout_latency", logs.warnings)
+	}
+}
+
+func TestMonitoring_Options_WithFatalHooks(t *testing.T) {
+	opts := defaultOptions()
+	if len(opts.Logger.FatalHooks) != 0 {
+		t.Errorf("defaultOptions() Logger.FatalHooks = %d hooks, want none", len(opts.Logger.FatalHooks))
+	}
+
+	WithFatalHooks(func(FatalEntry) {}, func(FatalEntry) {})(opts)
+	if len(opts.Logger.FatalHooks) != 2 {
+		t.Errorf("WithFatalHooks() Logger.FatalHooks = %d hooks, want 2", len(opts.Logger.FatalHooks))
+	}
+}
+
+func TestMonitoring_Options_WithExitFlushTimeout(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Logger.ExitFlushTimeout != 5*time.Second {
+		t.Errorf("defaultOptions() Logger.ExitFlushTimeout = %v, want 5s", opts.Logger.ExitFlushTimeout)
+	}
+
+	WithExitFlushTimeout(time.Second)(opts)
+	if opts.Logger.ExitFlushTimeout != time.Second {
+		t.Errorf("WithExitFlushTimeout() Logger.ExitFlushTimeout = %v, want 1s", opts.Logger.ExitFlushTimeout)
+	}
+}
+
+func TestMonitoring_Options_WithErrorReporting(t *testing.T) {
+	opts := defaultOptions()
+	if opts.ErrorReportingDSN != "" {
+		t.Errorf("defaultOptions() ErrorReportingDSN = %q, want empty", opts.ErrorReportingDSN)
+	}
+
+	dsn := "https://key@o1.ingest.sentry.io/42"
+	WithErrorReporting(dsn)(opts)
+	if opts.ErrorReportingDSN != dsn {
+		t.Errorf("WithErrorReporting() ErrorReportingDSN = %q, want %q", opts.ErrorReportingDSN, dsn)
+	}
+}
+
+func TestMonitoring_Options_WithClock(t *testing.T) {
+	opts := defaultOptions()
+	if opts.Clock != nil {
+		t.Errorf("defaultOptions() Clock = %v, want nil", opts.Clock)
+	}
+
+	clk := NewFakeClock(time.Unix(0, 0))
+	WithClock(clk)(opts)
+	if opts.Clock != clk {
+		t.Errorf("WithClock() Clock = %v, want %v", opts.Clock, clk)
+	}
+}
+
+func TestMonitoring_Options_WithDisabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		disabled bool
+	}{
+		{"true", true},
+		{"false", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultOptions()
+			WithLoggerDisabled(tt.disabled)(opts)
+			WithTracerDisabled(tt.disabled)(opts)
+			WithMetricDisabled(tt.disabled)(opts)
+			if opts.LoggerDisabled != tt.disabled {
+				t.Errorf("WithLoggerDisabled(%v) LoggerDisabled = %v", tt.disabled, opts.LoggerDisabled)
+			}
+			if opts.TracerDisabled != tt.disabled {
+				t.Errorf("WithTracerDisabled(%v) TracerDisabled = %v", tt.disabled, opts.TracerDisabled)
+			}
+			if opts.MetricDisabled != tt.disabled {
+				t.Errorf("WithMetricDisabled(%v) MetricDisabled = %v", tt.disabled, opts.MetricDisabled)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Options_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "valid",
+			opts: []Option{WithServiceName("test-service")},
+		},
+		{
+			name:    "missing service name",
+			opts:    nil,
+			wantErr: ErrServiceNameRequired,
+		},
+		{
+			name:    "invalid log level",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerLevel("verbose")},
+			wantErr: ErrLoggerInvalidLogLevel,
+		},
+		{
+			name:    "negative logger async buffer",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerAsync(-1, "block")},
+			wantErr: ErrLoggerInvalidAsyncBufferSize,
+		},
+		{
+			name:    "invalid logger drop policy",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerAsync(16, "drop_random")},
+			wantErr: ErrLoggerInvalidDropPolicy,
+		},
+		{
+			name:    "invalid logger sink",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerSink("eventlog")},
+			wantErr: ErrLoggerInvalidSink,
+		},
+		{
+			name:    "invalid logger syslog facility",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerSink("syslog"), WithLoggerSyslog("", "", "local9", "")},
+			wantErr: ErrLoggerInvalidSyslogFacility,
+		},
+		{
+			name:    "logger loki sink without url",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerSink("loki")},
+			wantErr: ErrLoggerLokiURLRequired,
+		},
+		{
+			name:    "logger kafka sink without topic",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerSink("kafka"), WithLoggerKafka([]string{"kafka:9092"}, "")},
+			wantErr: ErrLoggerKafkaTopicRequired,
+		},
+		{
+			name:    "invalid logger schema",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerSchema("gelf")},
+			wantErr: ErrLoggerInvalidSchema,
+		},
+		{
+			name:    "datadog logger schema",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerSchema("datadog")},
+			wantErr: nil,
+		},
+		{
+			name:    "negative logger caller skip",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerCaller(true, -1)},
+			wantErr: ErrLoggerInvalidCallerSkip,
+		},
+		{
+			name:    "invalid logger stacktrace level",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerStacktraceLevel("never")},
+			wantErr: ErrLoggerInvalidStacktraceLevel,
+		},
+		{
+			name:    "negative logger dedup window",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerDedup(-time.Second)},
+			wantErr: ErrLoggerInvalidDedupWindow,
+		},
+		{
+			name:    "invalid logger rate limit",
+			opts:    []Option{WithServiceName("test-service"), WithLoggerRateLimit("poll", 0)},
+			wantErr: ErrLoggerInvalidRateLimit,
+		},
+		{
+			name:    "tracer otlp without host",
+			opts:    []Option{WithServiceName("test-service"), WithTracerProvider("otlp", "", 4317)},
+			wantErr: ErrTracerProviderHostRequired,
+		},
+		{
+			name:    "tracer fallback file without path",
+			opts:    []Option{WithServiceName("test-service"), WithTracerFallbackProvider("file", "")},
+			wantErr: ErrTracerFallbackPathRequired,
+		},
+		{
+			name:    "invalid tracer endpoint",
+			opts:    []Option{WithServiceName("test-service"), WithTracerEndpoint("collector:4317")},
+			wantErr: ErrTracerEndpointInvalid,
+		},
+		{
+			name:    "invalid tracer mirror endpoint",
+			opts:    []Option{WithServiceName("test-service"), WithTracerMirror("vendor:4317", 0.1)},
+			wantErr: ErrTracerMirrorEndpointInvalid,
+		},
+		{
+			name:    "tracer mirror without ratio",
+			opts:    []Option{WithServiceName("test-service"), WithTracerMirror("grpc://vendor:4317", 0)},
+			wantErr: ErrTracerMirrorRatioInvalid,
+		},
+		{
+			name:    "dev trace viewer port out of range",
+			opts:    []Option{WithServiceName("test-service"), WithDevTraceViewer(70000)},
+			wantErr: ErrTracerDevViewerPortInvalid,
+		},
+		{
+			name:    "metric endpoint replaces provider",
+			opts:    []Option{WithServiceName("test-service"), WithMetricProvider("otlp", "", 0), WithMetricEndpoint("http://collector")},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid metric interval",
+			opts:    []Option{WithServiceName("test-service"), WithMetricInterval(0)},
+			wantErr: ErrMetricIntervalInvalid,
+		},
+		{
+			name:    "invalid metric reader mode",
+			opts:    []Option{WithServiceName("test-service"), WithMetricReaderMode("push")},
+			wantErr: ErrMetricInvalidReaderMode,
+		},
+		{
+			name:    "invalid metric temporality",
+			opts:    []Option{WithServiceName("test-service"), WithMetricTemporality("lowmemory")},
+			wantErr: ErrMetricInvalidTemporality,
+		},
+		{
+			name:    "invalid tracer stdout format",
+			opts:    []Option{WithServiceName("test-service"), WithTracerStdoutFormat("yaml")},
+			wantErr: ErrTracerInvalidStdoutFormat,
+		},
+		{
+			name:    "negative tracer long span threshold",
+			opts:    []Option{WithServiceName("test-service"), WithTracerLongSpanWatchdog(-time.Second, false)},
+			wantErr: ErrTracerLongSpanThresholdInvalid,
+		},
+		{
+			name:    "tracer 64-bit and x-ray trace ids",
+			opts:    []Option{WithServiceName("test-service"), WithTracerTraceID64(true), WithTracerXRayIDs(true)},
+			wantErr: ErrTracerTraceIDFormatConflict,
+		},
+		{
+			name:    "metric pushgateway without job",
+			opts:    []Option{WithServiceName("test-service"), WithMetricProvider("pushgateway", "pushgateway", 9091)},
+			wantErr: ErrMetricPushgatewayJobRequired,
+		},
+		{
+			name:    "metric influxdb without bucket",
+			opts:    []Option{WithServiceName("test-service"), WithMetricProvider("influxdb", "", 0), WithMetricInfluxDB("http://localhost:8086", "edge", "", "")},
+			wantErr: ErrMetricInfluxDBBucketRequired,
+		},
+		{
+			name:    "invalid metric statsd address",
+			opts:    []Option{WithServiceName("test-service"), WithMetricStatsDListener("8125")},
+			wantErr: ErrMetricStatsDAddressInvalid,
+		},
+		{
+			name:    "invalid metric stdout format",
+			opts:    []Option{WithServiceName("test-service"), WithMetricStdoutFormat("yaml")},
+			wantErr: ErrMetricInvalidStdoutFormat,
+		},
+		{
+			name:    "invalid error reporting DSN",
+			opts:    []Option{WithServiceName("test-service"), WithErrorReporting("o1.ingest.sentry.io/42")},
+			wantErr: ErrErrorReportingInvalidDSN,
+		},
+		{
+			name: "disabled component is not validated",
+			opts: []Option{
+				WithServiceName("test-service"),
+				WithTracerProvider("invalid", "", 0),
+				WithTracerDisabled(true),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseOptions(tt.opts...).Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
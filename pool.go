@@ -0,0 +1,257 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+)
+
+// PoolRecorder records the capacity telemetry of a worker pool or channel-backed queue in
+// shared metrics labelled with the pool name:
+//   - pool_queue_length: gauge of the tasks waiting in the queue
+//   - pool_task_wait_ms: histogram of the time tasks waited in the queue before a worker took them
+//   - pool_task_duration_ms: histogram of task processing times in milliseconds
+//   - pool_saturated_total: counter of tasks submitted while the queue was full
+//
+// Use a PoolRecorder to instrument an existing pool; WorkerPool records the same metrics
+// itself. A PoolRecorder is safe for concurrent use.
+type PoolRecorder struct {
+	monitoring  *Monitoring
+	labels      []attribute.KeyValue
+	queueLength otelmetric.Int64Gauge
+	wait        otelmetric.Int64Histogram
+	duration    otelmetric.Int64Histogram
+	saturated   otelmetric.Int64Counter
+}
+
+// NewPoolRecorder creates a PoolRecorder for the pool with the given name.
+// The pool metrics are created once here so that the Record methods only record values.
+//
+// Parameters:
+//   - name: The name of the pool (e.g., "image-resizer")
+//
+// Returns an error if any of the pool metrics cannot be created.
+//
+// Example:
+//
+//	pool, err := mon.NewPoolRecorder("image-resizer")
+//	if err != nil {
+//	    return err
+//	}
+//	select {
+//	case jobs <- job:
+//	default:
+//	    pool.RecordSaturation(ctx)
+//	    jobs <- job
+//	}
+//	pool.RecordQueueLength(ctx, len(jobs))
+func (m *Monitoring) NewPoolRecorder(name string) (*PoolRecorder, error) {
+	r := &PoolRecorder{
+		monitoring: m,
+		labels:     []attribute.KeyValue{attribute.String("pool", name)},
+	}
+	if m.Metric == nil {
+		return r, nil
+	}
+
+	var err error
+	if r.queueLength, err = m.Metric.CreateGauge("pool_queue_length", "1", "Number of tasks waiting in the pool queue"); err != nil {
+		return nil, err
+	}
+	if r.wait, err = m.Metric.CreateHistogram("pool_task_wait_ms", "ms", "Time tasks waited in the pool queue in milliseconds"); err != nil {
+		return nil, err
+	}
+	if r.duration, err = m.Metric.CreateHistogram("pool_task_duration_ms", "ms", "Pool task processing duration in milliseconds"); err != nil {
+		return nil, err
+	}
+	if r.saturated, err = m.Metric.CreateCounter("pool_saturated_total", "1", "Total number of tasks submitted while the pool queue was full"); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// RecordQueueLength records the number of tasks waiting in the queue, e.g. len(ch).
+func (r *PoolRecorder) RecordQueueLength(ctx context.Context, length int) {
+	if r.queueLength == nil {
+		return
+	}
+	r.monitoring.Metric.RecordGauge(ctx, r.queueLength, int64(length), r.labels...)
+}
+
+// RecordWait records the time a task waited in the queue before a worker took it.
+func (r *PoolRecorder) RecordWait(ctx context.Context, wait time.Duration) {
+	if r.wait == nil {
+		return
+	}
+	r.monitoring.Metric.RecordHistogram(ctx, r.wait, wait.Milliseconds(), r.labels...)
+}
+
+// RecordProcessing records the time a worker spent processing a task.
+func (r *PoolRecorder) RecordProcessing(ctx context.Context, duration time.Duration) {
+	if r.duration == nil {
+		return
+	}
+	r.monitoring.Metric.RecordHistogram(ctx, r.duration, duration.Milliseconds(), r.labels...)
+}
+
+// RecordSaturation counts a task submitted while the queue was full.
+func (r *PoolRecorder) RecordSaturation(ctx context.Context) {
+	if r.saturated == nil {
+		return
+	}
+	r.monitoring.Metric.RecordCounter(ctx, r.saturated, 1, r.labels...)
+}
+
+// poolTask is a task waiting in a WorkerPool queue.
+type poolTask struct {
+	ctx    context.Context
+	fn     func(ctx context.Context)
+	queued time.Time
+}
+
+// WorkerPool runs tasks on a fixed number of goroutines fed by a bounded queue, and records
+// the PoolRecorder metrics for them. A task that panics is recovered, logged, and counted in
+// "goroutine_failures_total" like a failed Go goroutine; the worker keeps running.
+//
+// A WorkerPool is safe for concurrent use.
+type WorkerPool struct {
+	name     string
+	recorder *PoolRecorder
+	tasks    chan poolTask
+	wg       sync.WaitGroup
+
+	mu     sync.RWMutex // mu guards closed and orders Close after the Submit calls in flight.
+	closed bool
+}
+
+// NewWorkerPool creates a WorkerPool with the given number of workers and queue capacity,
+// and starts its workers.
+//
+// Parameters:
+//   - name: The name of the pool, used as metric label and in logs
+//   - workers: The number of goroutines running tasks; must be at least 1
+//   - queueSize: The number of tasks that can wait for a worker; 0 hands tasks to idle workers only
+//
+// Returns ErrWorkerPoolSizeInvalid for an invalid size, or an error if any of the pool
+// metrics cannot be created.
+//
+// Example:
+//
+//	pool, err := mon.NewWorkerPool("image-resizer", 8, 100)
+//	if err != nil {
+//	    return err
+//	}
+//	defer pool.Close()
+//	err = pool.Submit(ctx, func(ctx context.Context) {
+//	    resize(ctx, image)
+//	})
+func (m *Monitoring) NewWorkerPool(name string, workers, queueSize int) (*WorkerPool, error) {
+	if workers < 1 || queueSize < 0 {
+		return nil, ErrWorkerPoolSizeInvalid
+	}
+	recorder, err := m.NewPoolRecorder(name)
+	if err != nil {
+		return nil, err
+	}
+	p := &WorkerPool{
+		name:     name,
+		recorder: recorder,
+		tasks:    make(chan poolTask, queueSize),
+	}
+	p.wg.Add(workers)
+	for range workers {
+		go p.work()
+	}
+	return p, nil
+}
+
+// Submit queues fn to run on a worker, waiting for room in the queue while it is full; such
+// a submission is counted as saturation. fn receives ctx without its cancellation, since the
+// task usually outlives the caller.
+//
+// Returns ErrWorkerPoolClosed after Close, or the error of ctx when it is done before fn
+// could be queued.
+func (p *WorkerPool) Submit(ctx context.Context, fn func(ctx context.Context)) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return ErrWorkerPoolClosed
+	}
+
+	task := poolTask{ctx: context.WithoutCancel(ctx), fn: fn, queued: p.recorder.monitoring.now()}
+	select {
+	case p.tasks <- task:
+		p.recorder.RecordQueueLength(ctx, len(p.tasks))
+		return nil
+	default:
+	}
+
+	p.recorder.RecordSaturation(ctx)
+	select {
+	case p.tasks <- task:
+		p.recorder.RecordQueueLength(ctx, len(p.tasks))
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TrySubmit queues fn to run on a worker if the queue has room, and reports whether it did.
+// A rejected task is counted as saturation. fn receives ctx without its cancellation.
+func (p *WorkerPool) TrySubmit(ctx context.Context, fn func(ctx context.Context)) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return false
+	}
+
+	task := poolTask{ctx: context.WithoutCancel(ctx), fn: fn, queued: p.recorder.monitoring.now()}
+	select {
+	case p.tasks <- task:
+		p.recorder.RecordQueueLength(ctx, len(p.tasks))
+		return true
+	default:
+		p.recorder.RecordSaturation(ctx)
+		return false
+	}
+}
+
+// Close stops accepting tasks and waits for the queued tasks to finish.
+// It is safe to call Close more than once.
+func (p *WorkerPool) Close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.tasks)
+	}
+	p.mu.Unlock()
+	p.wg.Wait()
+}
+
+// work runs queued tasks until the queue is closed and drained.
+func (p *WorkerPool) work() {
+	defer p.wg.Done()
+	m := p.recorder.monitoring
+	for task := range p.tasks {
+		p.recorder.RecordQueueLength(task.ctx, len(p.tasks))
+		start := m.now()
+		p.recorder.RecordWait(task.ctx, start.Sub(task.queued))
+		p.run(task)
+		p.recorder.RecordProcessing(task.ctx, m.now().Sub(start))
+	}
+}
+
+// run calls the task function, recovering a panic.
+func (p *WorkerPool) run(task poolTask) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.recorder.monitoring.recordGoroutineFailure(task.ctx, nil, p.name, "panic", fmt.Errorf("panic: %v", r), debug.Stack())
+		}
+	}()
+	task.fn(task.ctx)
+}
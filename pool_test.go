@@ -0,0 +1,218 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// poolSnapshot returns the pool counters, gauges, and histogram counts of mon by instrument name.
+func poolSnapshot(t *testing.T, mon *Monitoring) map[string]float64 {
+	t.Helper()
+	snapshot, err := mon.Metric.Snapshot(context.Background())
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	values := make(map[string]float64)
+	for _, c := range snapshot.Counters {
+		values[c.Name] = c.Value
+	}
+	for _, g := range snapshot.Gauges {
+		values[g.Name] = g.Value
+	}
+	for _, h := range snapshot.Histograms {
+		values[h.Name] = float64(h.Count)
+	}
+	return values
+}
+
+func TestMonitoring_Pool_NewWorkerPool(t *testing.T) {
+	tests := []struct {
+		name      string
+		workers   int
+		queueSize int
+		wantErr   error
+	}{
+		{"valid", 2, 10, nil},
+		{"unbuffered queue", 1, 0, nil},
+		{"no workers", 0, 10, ErrWorkerPoolSizeInvalid},
+		{"negative queue size", 1, -1, ErrWorkerPoolSizeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, err := (&Monitoring{}).NewWorkerPool("test-pool", tt.workers, tt.queueSize)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewWorkerPool() error = %v, want %v", err, tt.wantErr)
+			}
+			if pool != nil {
+				pool.Close()
+			}
+		})
+	}
+}
+
+func TestMonitoring_Pool_Submit(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	pool, err := mon.NewWorkerPool("test-pool", 2, 10)
+	if err != nil {
+		t.Fatalf("NewWorkerPool() error = %v", err)
+	}
+	var ran atomic.Int64
+	for range 5 {
+		if err := pool.Submit(context.Background(), func(ctx context.Context) { ran.Add(1) }); err != nil {
+			t.Fatalf("Submit() error = %v", err)
+		}
+	}
+	pool.Close()
+
+	if got := ran.Load(); got != 5 {
+		t.Errorf("ran %d tasks, want 5", got)
+	}
+	values := poolSnapshot(t, mon)
+	if values["pool_task_wait_ms"] != 5 || values["pool_task_duration_ms"] != 5 {
+		t.Errorf("pool histograms counted %v waits and %v durations, want 5 each", values["pool_task_wait_ms"], values["pool_task_duration_ms"])
+	}
+	if values["pool_queue_length"] != 0 {
+		t.Errorf("pool_queue_length = %v, want 0", values["pool_queue_length"])
+	}
+
+	if err := pool.Submit(context.Background(), func(ctx context.Context) {}); !errors.Is(err, ErrWorkerPoolClosed) {
+		t.Errorf("Submit() after Close error = %v, want %v", err, ErrWorkerPoolClosed)
+	}
+	if pool.TrySubmit(context.Background(), func(ctx context.Context) {}) {
+		t.Error("TrySubmit() after Close = true, want false")
+	}
+	pool.Close()
+}
+
+func TestMonitoring_Pool_Saturation(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	pool, err := mon.NewWorkerPool("test-pool", 1, 1)
+	if err != nil {
+		t.Fatalf("NewWorkerPool() error = %v", err)
+	}
+	release := make(chan struct{})
+	started := make(chan struct{})
+	if err := pool.Submit(context.Background(), func(ctx context.Context) {
+		close(started)
+		<-release
+	}); err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	<-started
+	if !pool.TrySubmit(context.Background(), func(ctx context.Context) {}) {
+		t.Fatal("TrySubmit() with room in the queue = false, want true")
+	}
+	if pool.TrySubmit(context.Background(), func(ctx context.Context) {}) {
+		t.Error("TrySubmit() with a full queue = true, want false")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := pool.Submit(ctx, func(ctx context.Context) {}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Submit() with a full queue error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	values := poolSnapshot(t, mon)
+	if values["pool_saturated_total"] != 2 {
+		t.Errorf("pool_saturated_total = %v, want 2", values["pool_saturated_total"])
+	}
+	if values["pool_queue_length"] != 1 {
+		t.Errorf("pool_queue_length = %v, want 1", values["pool_queue_length"])
+	}
+	close(release)
+	pool.Close()
+}
+
+func TestMonitoring_Pool_Panic(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	recorder := &recordingLogger{}
+	mon.Logger = recorder
+
+	pool, err := mon.NewWorkerPool("test-pool", 1, 2)
+	if err != nil {
+		t.Fatalf("NewWorkerPool() error = %v", err)
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	_ = pool.Submit(context.Background(), func(ctx context.Context) { panic("boom") })
+	_ = pool.Submit(context.Background(), func(ctx context.Context) { wg.Done() })
+	wg.Wait()
+	pool.Close()
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.errors) != 1 {
+		t.Fatalf("expected 1 error log, got %d", len(recorder.errors))
+	}
+	if got := recorder.errors[0]["goroutine"]; got != "test-pool" {
+		t.Errorf("goroutine = %v, want test-pool", got)
+	}
+}
+
+func TestMonitoring_Pool_PoolRecorder(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	recorder, err := mon.NewPoolRecorder("test-queue")
+	if err != nil {
+		t.Fatalf("NewPoolRecorder() error = %v", err)
+	}
+	ctx := context.Background()
+	recorder.RecordQueueLength(ctx, 7)
+	recorder.RecordWait(ctx, 20*time.Millisecond)
+	recorder.RecordProcessing(ctx, 30*time.Millisecond)
+	recorder.RecordSaturation(ctx)
+
+	want := map[string]float64{
+		"pool_queue_length":     7,
+		"pool_task_wait_ms":     1,
+		"pool_task_duration_ms": 1,
+		"pool_saturated_total":  1,
+	}
+	values := poolSnapshot(t, mon)
+	for name, v := range want {
+		if values[name] != v {
+			t.Errorf("%s = %v, want %v", name, values[name], v)
+		}
+	}
+
+	// a recorder without a Metric records nothing and does not panic
+	bare, err := (&Monitoring{}).NewPoolRecorder("bare")
+	if err != nil {
+		t.Fatalf("NewPoolRecorder() error = %v", err)
+	}
+	bare.RecordQueueLength(ctx, 1)
+	bare.RecordWait(ctx, time.Millisecond)
+	bare.RecordProcessing(ctx, time.Millisecond)
+	bare.RecordSaturation(ctx)
+}
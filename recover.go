@@ -0,0 +1,107 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// panicsMetricName is the counter incremented by RecoverAndLog when WithPanicMetric is set.
+const panicsMetricName = "panics_total"
+
+// RecoverOptions configures RecoverAndLog.
+type RecoverOptions struct {
+	Metric  Metric // Metric counts recovered panics in "panics_total". If nil, panics are not counted.
+	Repanic bool   // Repanic re-raises the panic after it is reported, e.g. to crash a worker that must not continue.
+}
+
+// RecoverOption is a function that configures RecoverOptions.
+type RecoverOption func(*RecoverOptions)
+
+// WithPanicMetric returns a RecoverOption that counts recovered panics in the "panics_total"
+// counter of metric.
+func WithPanicMetric(metric Metric) RecoverOption {
+	return func(o *RecoverOptions) {
+		o.Metric = metric
+	}
+}
+
+// WithRepanic returns a RecoverOption that sets whether the panic is re-raised after it is
+// reported.
+func WithRepanic(enabled bool) RecoverOption {
+	return func(o *RecoverOptions) {
+		o.Repanic = enabled
+	}
+}
+
+// RecoverAndLog recovers a panic of the calling goroutine and reports it the same way
+// everywhere: the panic is logged at error level with its stack trace and correlated with the
+// span in ctx, recorded as an error on that span (or on a new "panic" span started with tracer
+// when ctx holds no recording span), and, with WithPanicMetric, counted in "panics_total". The
+// goroutine then returns normally unless WithRepanic is set. It must be deferred directly, as
+// recover only stops a panic when called by the deferred function itself. Without a panic it
+// does nothing. logger and tracer may be nil.
+//
+// Parameters:
+//   - ctx: The context of the goroutine (may contain a span)
+//   - logger: The Logger the panic is logged with
+//   - tracer: The Tracer used to start a span when ctx holds none
+//   - opts: Optional settings (WithPanicMetric, WithRepanic)
+//
+// Example:
+//
+//	go func() {
+//	    defer monitoring.RecoverAndLog(ctx, mon.Logger, mon.Tracer, monitoring.WithPanicMetric(mon.Metric))
+//	    consume(ctx, queue)
+//	}()
+func RecoverAndLog(ctx context.Context, logger Logger, tracer Tracer, opts ...RecoverOption) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	options := &RecoverOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	reportPanic(ctx, logger, tracer, options.Metric, r, debug.Stack())
+	if options.Repanic {
+		panic(r)
+	}
+}
+
+// reportPanic logs the recovered value r with stack, records it on the span in ctx or on a new
+// span started with tracer, and counts it with metric. Nil components are skipped.
+func reportPanic(ctx context.Context, logger Logger, tracer Tracer, metric Metric, r interface{}, stack []byte) {
+	err := fmt.Errorf("panic: %v", r)
+
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() && tracer != nil {
+		ctx, span = tracer.StartSpan(ctx, "panic")
+		defer tracer.EndSpan(span)
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	if logger != nil {
+		if spanContext := span.SpanContext(); spanContext.IsValid() {
+			logger = logger.WithSpanContext(spanContext)
+		}
+		logger.Error("Recovered panic", map[string]interface{}{
+			"panic": fmt.Sprint(r),
+			"stack": string(stack),
+		})
+	}
+
+	if metric != nil {
+		counter, cerr := metric.CreateCounter(panicsMetricName, "1", "Total number of recovered panics")
+		if cerr != nil {
+			return
+		}
+		metric.RecordCounter(ctx, counter, 1)
+	}
+}
@@ -0,0 +1,71 @@
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestMonitoring_Recover_RecoverAndLog(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	logs := &recordingLogger{}
+	metric := &eventMetric{Metric: mon.Metric}
+
+	ctx, span := mon.Tracer.StartSpan(context.Background(), "consume")
+	func() {
+		defer RecoverAndLog(ctx, logs, mon.Tracer, WithPanicMetric(metric))
+		panic("nil message")
+	}()
+	span.End()
+
+	logged := logs.waitForErrors(t, 1)[0]
+	if logged["panic"] != "nil message" || logged["stack"] == "" {
+		t.Errorf("logged fields = %v, want panic and stack", logged)
+	}
+	ro := span.(sdktrace.ReadOnlySpan)
+	if ro.Status().Code != codes.Error || len(ro.Events()) != 1 || ro.Events()[0].Name != "exception" {
+		t.Errorf("span status = %v, events = %v, want an error with an exception event", ro.Status(), ro.Events())
+	}
+	if len(metric.labels) != 1 {
+		t.Errorf("panics_total incremented %d times, want 1", len(metric.labels))
+	}
+}
+
+func TestMonitoring_Recover_RecoverAndLog_Repanic(t *testing.T) {
+	logs := &recordingLogger{}
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recovered %v, want the panic re-raised", r)
+		}
+		if len(logs.errors) != 1 {
+			t.Errorf("logged %d errors before re-panicking, want 1", len(logs.errors))
+		}
+	}()
+	defer RecoverAndLog(context.Background(), logs, nil, WithRepanic(true))
+	panic("boom")
+}
+
+func TestMonitoring_Recover_RecoverAndLog_NoPanic(t *testing.T) {
+	logs := &recordingLogger{}
+	func() {
+		defer RecoverAndLog(context.Background(), logs, nil)
+	}()
+	if len(logs.errors) != 0 {
+		t.Errorf("logged %d errors without a panic, want 0", len(logs.errors))
+	}
+}
+
+func TestMonitoring_Recover_RecoverAndLog_NilComponents(t *testing.T) {
+	func() {
+		defer RecoverAndLog(context.Background(), nil, nil)
+		panic("boom")
+	}()
+}
@@ -2,10 +2,17 @@ package monitoring
 
 import (
 	"context"
+	"net"
+	"strconv"
+	"time"
 
+	"github.com/adityakw90/go-monitoring/internal/breaker"
+	"github.com/adityakw90/go-monitoring/internal/endpoint"
+	"github.com/adityakw90/go-monitoring/internal/errorreport"
 	"github.com/adityakw90/go-monitoring/internal/logger"
 	"github.com/adityakw90/go-monitoring/internal/metric"
 	"github.com/adityakw90/go-monitoring/internal/tracer"
+	"go.opentelemetry.io/otel"
 )
 
 // parseOptions applies the provided functional options to a copy of the package default Options
@@ -20,121 +27,387 @@ func parseOptions(opts ...Option) *Options {
 
 // NewLogger creates a Logger configured by the provided functional options.
 // It returns the initialized Logger or an error if initialization fails.
+// When the logger is disabled it returns a noop Logger.
 func NewLogger(opts ...Option) (Logger, error) {
-	options := parseOptions(opts...)
-	loggerInstance, err := logger.NewLogger(
-		logger.WithLevel(options.LoggerLevel),
-		logger.WithOutputPath(options.LoggerOutputPath),
-	)
-	if err != nil {
-		return nil, parseError(err, "failed to initialize logger")
-	}
-	return loggerInstance, nil
+	return newLogger(parseOptions(opts...))
 }
 
 // NewTracer creates and returns a Tracer configured by the provided options.
 // It applies functional options to the default configuration and initializes an
 // underlying tracer instance with service name, environment, instance info,
 // provider settings, sampling ratio, batch timeout, and insecure flag.
+// When the tracer is disabled it returns a noop Tracer.
 // Returns a non-nil error if tracer initialization fails.
 func NewTracer(opts ...Option) (Tracer, error) {
 	options := parseOptions(opts...)
-	tracerInstance, err := tracer.NewTracer(
-		tracer.WithServiceName(options.ServiceName),
-		tracer.WithEnvironment(options.Environment),
-		tracer.WithInstance(options.InstanceName, options.InstanceHost),
-		tracer.WithProvider(options.TracerProvider, options.TracerProviderHost, options.TracerProviderPort),
-		tracer.WithSampleRatio(options.TracerSampleRatio),
-		tracer.WithBatchTimeout(options.TracerBatchTimeout),
-		tracer.WithInsecure(options.TracerInsecure),
-	)
-	if err != nil {
-		return nil, parseError(err, "failed to initialize tracer")
-	}
-	return tracerInstance, nil
+	detectCloud(options)
+	return newTracer(options)
 }
 
 // NewMetric creates a Metric configured by the provided functional options.
 // It applies the options to defaults and initializes the metric backend accordingly.
+// When the metric is disabled it returns a noop Metric.
 // On success it returns the initialized Metric. If initialization fails it returns
-// nil and an error describing the failure (prefixed with "failed to initialize metric").
+// nil and either an exported sentinel error or an *Error (reported as "failed to initialize metric: ...").
 func NewMetric(opts ...Option) (Metric, error) {
 	options := parseOptions(opts...)
-	metricInstance, err := metric.NewMetric(
+	detectCloud(options)
+	return newMetric(options)
+}
+
+// loggerOptions, tracerOptions, and metricOptions are the only place the root settings are
+// translated to the options of the internal components, so construction and Reload cannot
+// drift apart.
+
+// loggerOptions returns the internal logger options of options: its Logger settings with the
+// settings it shares with the other components added.
+func loggerOptions(options *Options) []logger.Option {
+	return []logger.Option{
+		logger.WithOptions(options.Logger),
+		logger.WithSyslog(options.Logger.SyslogNetwork, options.Logger.SyslogAddress, options.Logger.SyslogFacility, syslogTag(options)),
+		logger.WithLoki(options.Logger.LokiURL, lokiLabels(options)),
+		logger.WithGCPProject(gcpProjectID(options)),
+		logger.WithFields(loggerFields(options)),
+		logger.WithRedaction(options.RedactionAction, options.RedactedKeys...),
+	}
+}
+
+// syslogTag returns the program name syslog and journald entries are tagged with, defaulting to
+// the service name.
+func syslogTag(options *Options) string {
+	if options.Logger.SyslogTag != "" {
+		return options.Logger.SyslogTag
+	}
+	return options.ServiceName
+}
+
+// lokiLabels returns the labels of the Loki stream: service, env, and instance, each left out
+// when empty.
+func lokiLabels(options *Options) map[string]string {
+	labels := make(map[string]string, 3)
+	for name, value := range map[string]string{
+		"service":  options.ServiceName,
+		"env":      options.Environment,
+		"instance": options.InstanceName,
+	} {
+		if value != "" {
+			labels[name] = value
+		}
+	}
+	return labels
+}
+
+// tracerOptions returns the internal tracer options of options: its Tracer settings with the
+// identity, resource, serverless, circuit breaker, redaction, and clock settings it shares
+// with the other components added.
+func tracerOptions(options *Options) []tracer.Option {
+	return []tracer.Option{
+		tracer.WithOptions(options.Tracer),
+		tracer.WithServiceName(options.ServiceName),
+		tracer.WithEnvironment(configuredEnvironment(options)),
+		tracer.WithInstance(options.InstanceName, options.InstanceHost),
+		tracer.WithResourceAttributes(componentResourceAttributes(options)...),
+		tracer.WithInstrumentationScope(options.InstrumentationScopeName, options.InstrumentationScopeVersion),
+		tracer.WithSimpleProcessor(options.ServerlessMode),
+		tracer.WithColdStart(options.ServerlessMode),
+		tracer.WithCircuitBreaker(options.ExporterBreakerThreshold, options.ExporterBreakerMaxBackoff),
+		tracer.WithRedaction(options.RedactionAction, options.RedactedKeys...),
+		tracer.WithClock(options.Clock),
+	}
+}
+
+// metricOptions returns the internal metric options of options: its Metric settings with the
+// identity, resource, circuit breaker, and clock settings it shares with the other components
+// added.
+func metricOptions(options *Options) []metric.Option {
+	return []metric.Option{
+		metric.WithOptions(options.Metric),
 		metric.WithServiceName(options.ServiceName),
-		metric.WithEnvironment(options.Environment),
+		metric.WithEnvironment(configuredEnvironment(options)),
 		metric.WithInstance(options.InstanceName, options.InstanceHost),
-		metric.WithProvider(options.MetricProvider, options.MetricProviderHost, options.MetricProviderPort),
-		metric.WithInterval(options.MetricInterval),
-		metric.WithInsecure(options.MetricInsecure),
-	)
+		metric.WithResourceAttributes(componentResourceAttributes(options)...),
+		metric.WithInstrumentationScope(options.InstrumentationScopeName, options.InstrumentationScopeVersion),
+		metric.WithCircuitBreaker(options.ExporterBreakerThreshold, options.ExporterBreakerMaxBackoff),
+		metric.WithClock(options.Clock),
+	}
+}
+
+// errorReporterOptions translates options into the internal error reporter options.
+func errorReporterOptions(options *Options) []errorreport.Option {
+	return []errorreport.Option{
+		errorreport.WithDSN(options.ErrorReportingDSN),
+		errorreport.WithServiceName(options.ServiceName),
+		errorreport.WithEnvironment(options.Environment),
+		errorreport.WithInstance(options.InstanceName, options.InstanceHost),
+		errorreport.WithQueueSize(100),
+	}
+}
+
+// tracerProviderName returns the exporter the tracer options select, as reported in Error.Provider:
+// the endpoint URL when one is set, the provider name otherwise.
+func tracerProviderName(options *Options) string {
+	if options.Tracer.Endpoint != "" {
+		return options.Tracer.Endpoint
+	}
+	return options.Tracer.Provider
+}
+
+// metricProviderName returns the exporter the metric options select, as reported in Error.Provider:
+// the endpoint URL when one is set, the provider name otherwise.
+func metricProviderName(options *Options) string {
+	if options.Metric.Endpoint != "" {
+		return options.Metric.Endpoint
+	}
+	return options.Metric.Provider
+}
+
+// tracerCollector returns the OTLP collector the tracer options export to, or false when they
+// select no collector ("stdout") or an endpoint URL that does not parse.
+func tracerCollector(options *Options) (endpoint.Endpoint, bool) {
+	return collector(options.Tracer.Endpoint, options.Tracer.Provider, options.Tracer.ProviderHost, options.Tracer.ProviderPort, options.Tracer.Insecure)
+}
+
+// metricCollector returns the OTLP collector the metric options export to, or false when they
+// select no collector ("stdout") or an endpoint URL that does not parse.
+func metricCollector(options *Options) (endpoint.Endpoint, bool) {
+	return collector(options.Metric.Endpoint, options.Metric.Provider, options.Metric.ProviderHost, options.Metric.ProviderPort, options.Metric.Insecure)
+}
+
+// collector resolves a component's collector the way its exporter does: the endpoint URL when
+// set, otherwise the "otlp" provider's host and port over gRPC.
+func collector(rawURL, provider, host string, port int, insecure bool) (endpoint.Endpoint, bool) {
+	if rawURL != "" {
+		ep, err := endpoint.Parse(rawURL)
+		return ep, err == nil
+	}
+	if provider != "otlp" {
+		return endpoint.Endpoint{}, false
+	}
+	return endpoint.Endpoint{
+		Protocol: endpoint.ProtocolGRPC,
+		Host:     host,
+		Address:  net.JoinHostPort(host, strconv.Itoa(port)),
+		Insecure: insecure,
+	}, true
+}
+
+// probeCollector runs endpoint.Probe against ep, giving up after timeout.
+func probeCollector(ep endpoint.Endpoint, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return endpoint.Probe(ctx, ep)
+}
+
+// newLogger builds the Logger described by options, or a noop Logger when it is disabled.
+// A noop Logger still runs the fatal hooks and exit flush before exiting.
+// extra is applied after the options derived from Options.
+func newLogger(options *Options, extra ...logger.Option) (Logger, error) {
+	if options.LoggerDisabled {
+		return logger.NewNoopLogger(append(loggerOptions(options), extra...)...), nil
+	}
+	loggerInstance, err := logger.NewLogger(append(loggerOptions(options), extra...)...)
 	if err != nil {
-		return nil, parseError(err, "failed to initialize metric")
+		return nil, parseError(err, ComponentLogger, OperationInitialize, "")
+	}
+	return loggerInstance, nil
+}
+
+// newTracer builds the Tracer described by options, or a noop Tracer when it is disabled.
+// When StartupProbeTimeout is set, its OTLP collector is probed first.
+// extra is applied after the options derived from Options.
+func newTracer(options *Options, extra ...tracer.Option) (Tracer, error) {
+	if options.TracerDisabled {
+		return tracer.NewNoopTracer(), nil
+	}
+	if ep, ok := tracerCollector(options); ok && options.StartupProbeTimeout > 0 {
+		if err := probeCollector(ep, options.StartupProbeTimeout); err != nil {
+			return nil, &Error{Component: ComponentTracer, Operation: OperationProbe, Provider: tracerProviderName(options), Err: err}
+		}
+	}
+	tracerInstance, err := tracer.NewTracer(append(tracerOptions(options), extra...)...)
+	if err != nil {
+		return nil, parseError(err, ComponentTracer, OperationInitialize, tracerProviderName(options))
+	}
+	return tracerInstance, nil
+}
+
+// newMetric builds the Metric described by options, or a noop Metric when it is disabled.
+// When StartupProbeTimeout is set, its OTLP collector is probed first.
+// extra is applied after the options derived from Options.
+func newMetric(options *Options, extra ...metric.Option) (Metric, error) {
+	if options.MetricDisabled {
+		return metric.NewNoopMetric(), nil
+	}
+	if ep, ok := metricCollector(options); ok && options.StartupProbeTimeout > 0 {
+		if err := probeCollector(ep, options.StartupProbeTimeout); err != nil {
+			return nil, &Error{Component: ComponentMetric, Operation: OperationProbe, Provider: metricProviderName(options), Err: err}
+		}
+	}
+	metricInstance, err := metric.NewMetric(append(metricOptions(options), extra...)...)
+	if err != nil {
+		return nil, parseError(err, ComponentMetric, OperationInitialize, metricProviderName(options))
 	}
 	return metricInstance, nil
 }
 
 // NewMonitoring initializes and returns a Monitoring containing Logger, Tracer, and Metric configured by the provided options.
-// It requires the ServiceName option; when ServiceName is empty it returns ErrServiceNameRequired.
-// If initialization of any component fails, previously initialized components are cleaned up (logger Sync, tracer Shutdown) and the error is returned wrapped via parseError.
+// The options are checked with Options.Validate before any component is created; in particular
+// it requires the ServiceName option and returns ErrServiceNameRequired when it is empty.
+// Disabled components are replaced with noop implementations, so every field of the returned Monitoring is non-nil.
+// If initialization of any component fails, previously initialized components are cleaned up (logger Close, tracer Shutdown) and the error is returned wrapped via parseError.
+// Use NewMonitoringLenient to keep the components that did initialize instead.
 func NewMonitoring(opts ...Option) (*Monitoring, error) {
-	options := parseOptions(opts...)
+	return newMonitoring(parseOptions(opts...), false)
+}
+
+// NewMonitoringLenient is like NewMonitoring, but a component that fails to initialize (for
+// example because the metrics collector cannot be resolved) is replaced with its noop
+// implementation instead of failing the whole Monitoring. The failures are logged through the
+// Logger when it initialized, and returned as an *InitError together with the usable Monitoring.
+// Invalid options are still rejected with a nil Monitoring, as they would fail on every attempt.
+//
+// Example:
+//
+//	mon, err := NewMonitoringLenient(WithServiceName("my-service"), WithMetricEndpoint(url))
+//	var initErr *InitError
+//	if errors.As(err, &initErr) {
+//	    // mon is usable; initErr.Metric describes why metrics are disabled
+//	} else if err != nil {
+//	    log.Fatalf("Invalid monitoring configuration: %v", err)
+//	}
+func NewMonitoringLenient(opts ...Option) (*Monitoring, error) {
+	return newMonitoring(parseOptions(opts...), true)
+}
 
-	// Validate required options
-	if options.ServiceName == "" {
-		return nil, ErrServiceNameRequired
+// newMonitoring builds a Monitoring from options. When lenient is false, the first component
+// failure is returned after cleaning up the components created before it. When lenient is
+// true, failed components are disabled in options and replaced with noops, and the failures are
+// returned as an *InitError alongside the Monitoring.
+func newMonitoring(options *Options, lenient bool) (*Monitoring, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
 	}
+	detectCloud(options)
 
-	// Initialize logger
-	loggerInstance, err := logger.NewLogger(
-		logger.WithLevel(options.LoggerLevel),
-		logger.WithOutputPath(options.LoggerOutputPath),
-	)
+	mon := &Monitoring{
+		tracerShutdownTimeout: options.TracerShutdownTimeout,
+		metricShutdownTimeout: options.MetricShutdownTimeout,
+		clock:                 options.Clock,
+		options:               options,
+	}
+	initErr := &InitError{}
+
+	// Initialize logger; Fatal shuts the Monitoring down after the fatal hooks, so buffered
+	// telemetry is exported before the process exits.
+	exitFlush := logger.WithExitFlush(mon.Shutdown, options.Logger.ExitFlushTimeout)
+	loggerInstance, err := newLogger(options, logger.WithDroppedHandler(mon.recordDroppedLogs), exitFlush)
 	if err != nil {
-		return nil, parseError(err, "failed to initialize logger")
+		if !lenient {
+			return nil, err
+		}
+		initErr.Logger = err
+		options.LoggerDisabled = true
+		loggerInstance = logger.NewNoopLogger(append(loggerOptions(options), exitFlush)...)
 	}
 
 	// Initialize tracer
-	tracerInstance, err := tracer.NewTracer(
-		tracer.WithServiceName(options.ServiceName),
-		tracer.WithEnvironment(options.Environment),
-		tracer.WithInstance(options.InstanceName, options.InstanceHost),
-		tracer.WithProvider(options.TracerProvider, options.TracerProviderHost, options.TracerProviderPort),
-		tracer.WithSampleRatio(options.TracerSampleRatio),
-		tracer.WithBatchTimeout(options.TracerBatchTimeout),
-		tracer.WithInsecure(options.TracerInsecure),
+	tracerInstance, err := newTracer(options,
+		tracer.WithSpillHandler(mon.recordSpilledSpans),
+		tracer.WithBreakerStateHandler(mon.breakerStateRecorder("tracer")),
+		tracer.WithLongSpanHandler(mon.longSpanReporter(options.TracerLongSpanMetric)),
 	)
 	if err != nil {
-		// Cleanup logger before returning
-		if loggerInstance != nil {
-			_ = loggerInstance.Sync() // Ignore cleanup errors when returning initialization error
+		if !lenient {
+			// Cleanup logger before returning
+			closeLogger(loggerInstance) // Ignore cleanup errors when returning initialization error
+			return nil, err
 		}
-		return nil, parseError(err, "failed to initialize tracer")
+		initErr.Tracer = err
+		options.TracerDisabled = true
+		tracerInstance = tracer.NewNoopTracer()
 	}
 
 	// Initialize metric
-	metricInstance, err := metric.NewMetric(
-		metric.WithServiceName(options.ServiceName),
-		metric.WithEnvironment(options.Environment),
-		metric.WithInstance(options.InstanceName, options.InstanceHost),
-		metric.WithProvider(options.MetricProvider, options.MetricProviderHost, options.MetricProviderPort),
-		metric.WithInterval(options.MetricInterval),
-		metric.WithInsecure(options.MetricInsecure),
+	metricInstance, err := newMetric(options,
+		metric.WithBreakerStateHandler(mon.breakerStateRecorder("metric")),
+		metric.WithNameWarningHandler(mon.logMetricNameWarning),
 	)
 	if err != nil {
-		// Cleanup tracer and logger before returning (in reverse order of initialization)
-		if tracerInstance != nil {
-			_ = tracerInstance.Shutdown(context.Background()) // Ignore cleanup errors when returning initialization error
+		if !lenient {
+			// Cleanup tracer and logger before returning (in reverse order of initialization)
+			if tracerInstance != nil {
+				_ = tracerInstance.Shutdown(context.Background()) // Ignore cleanup errors when returning initialization error
+			}
+			closeLogger(loggerInstance) // Ignore cleanup errors when returning initialization error
+			return nil, err
+		}
+		initErr.Metric = err
+		options.MetricDisabled = true
+		metricInstance = metric.NewNoopMetric()
+	}
+
+	// Initialize error reporter; its options were validated above and it opens no connection.
+	errorsInstance, err := errorreport.NewReporter(append(errorReporterOptions(options), errorreport.WithCaptureHook(mon.logCapturedError))...)
+	if err != nil {
+		errorsInstance = errorreport.NewNoopReporter()
+	}
+
+	// The components' goroutines may already be calling the handlers above.
+	mon.componentsMu.Lock()
+	mon.Logger = loggerInstance
+	mon.Tracer = tracerInstance
+	mon.Metric = metricInstance
+	mon.Errors = errorsInstance
+	mon.componentsMu.Unlock()
+
+	// The breakers reported their initial state before the metric existed, record it now.
+	if options.ExporterBreakerThreshold > 0 {
+		if !options.TracerDisabled {
+			mon.breakerStateRecorder("tracer")(breaker.StateClosed)
 		}
-		if loggerInstance != nil {
-			_ = loggerInstance.Sync() // Ignore cleanup errors when returning initialization error
+		if !options.MetricDisabled {
+			mon.breakerStateRecorder("metric")(breaker.StateClosed)
 		}
-		return nil, parseError(err, "failed to initialize metric")
 	}
 
-	return &Monitoring{
-		Logger: loggerInstance,
-		Tracer: tracerInstance,
-		Metric: metricInstance,
-	}, nil
+	if options.OTelErrorLogging {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(mon.handleOTelError))
+	}
+	if options.SetGlobalProviders {
+		setGlobalProviders(mon, options)
+	}
+
+	if errs := initErr.Unwrap(); len(errs) > 0 {
+		for _, err := range errs {
+			mon.Logger.Error("Monitoring component disabled after failing to initialize", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		return mon, initErr
+	}
+	return mon, nil
+}
+
+// closeLogger stops the background goroutines and connections of l after writing its buffered
+// entries, or only syncs l when it has none to stop.
+func closeLogger(l logger.Logger) {
+	if c, ok := l.(logger.Closer); ok {
+		_ = c.Close()
+	} else if l != nil {
+		_ = l.Sync()
+	}
+}
+
+// setGlobalProviders registers the providers of mon as the OpenTelemetry globals, together
+// with the propagator the Tracer uses: the W3C trace context, plus the X-Ray trace header with
+// TracerXRayPropagation. Disabled components are skipped so
+// their noop providers do not replace globals registered elsewhere.
+func setGlobalProviders(mon *Monitoring, options *Options) {
+	if !options.TracerDisabled {
+		otel.SetTracerProvider(mon.Tracer.Provider())
+		otel.SetTextMapPropagator(tracer.NewPropagator(options.Tracer.XRayPropagation))
+	}
+	if !options.MetricDisabled {
+		otel.SetMeterProvider(mon.Metric.Provider())
+	}
 }
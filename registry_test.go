@@ -3,12 +3,23 @@ package monitoring
 import (
 	"context"
 	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/adityakw90/go-monitoring/internal/logger"
 	"github.com/adityakw90/go-monitoring/internal/metric"
 	"github.com/adityakw90/go-monitoring/internal/tracer"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestMonitoring_Registry_ParseOptions(t *testing.T) {
@@ -24,23 +35,23 @@ func TestMonitoring_Registry_ParseOptions(t *testing.T) {
 				if o.Environment != "development" {
 					t.Errorf("expected Environment = 'development', got %q", o.Environment)
 				}
-				if o.LoggerLevel != "info" {
-					t.Errorf("expected LoggerLevel = 'info', got %q", o.LoggerLevel)
+				if o.Logger.Level != "info" {
+					t.Errorf("expected LoggerLevel = 'info', got %q", o.Logger.Level)
 				}
-				if o.TracerProvider != "stdout" {
-					t.Errorf("expected TracerProvider = 'stdout', got %q", o.TracerProvider)
+				if o.Tracer.Provider != "stdout" {
+					t.Errorf("expected TracerProvider = 'stdout', got %q", o.Tracer.Provider)
 				}
-				if o.TracerSampleRatio != 1.0 {
-					t.Errorf("expected TracerSampleRatio = 1.0, got %f", o.TracerSampleRatio)
+				if o.Tracer.SampleRatio != 1.0 {
+					t.Errorf("expected TracerSampleRatio = 1.0, got %f", o.Tracer.SampleRatio)
 				}
-				if o.TracerBatchTimeout != 5*time.Second {
-					t.Errorf("expected TracerBatchTimeout = 5s, got %v", o.TracerBatchTimeout)
+				if o.Tracer.BatchTimeout != 5*time.Second {
+					t.Errorf("expected TracerBatchTimeout = 5s, got %v", o.Tracer.BatchTimeout)
 				}
-				if o.MetricProvider != "stdout" {
-					t.Errorf("expected MetricProvider = 'stdout', got %q", o.MetricProvider)
+				if o.Metric.Provider != "stdout" {
+					t.Errorf("expected MetricProvider = 'stdout', got %q", o.Metric.Provider)
 				}
-				if o.MetricInterval != 60*time.Second {
-					t.Errorf("expected MetricInterval = 60s, got %v", o.MetricInterval)
+				if o.Metric.Interval != 60*time.Second {
+					t.Errorf("expected MetricInterval = 60s, got %v", o.Metric.Interval)
 				}
 			},
 		},
@@ -83,41 +94,41 @@ func TestMonitoring_Registry_ParseOptions(t *testing.T) {
 				if o.InstanceHost != "localhost" {
 					t.Errorf("expected InstanceHost = 'localhost', got %q", o.InstanceHost)
 				}
-				if o.LoggerLevel != "debug" {
-					t.Errorf("expected LoggerLevel = 'debug', got %q", o.LoggerLevel)
+				if o.Logger.Level != "debug" {
+					t.Errorf("expected LoggerLevel = 'debug', got %q", o.Logger.Level)
 				}
-				if o.TracerProvider != "otlp" {
-					t.Errorf("expected TracerProvider = 'otlp', got %q", o.TracerProvider)
+				if o.Tracer.Provider != "otlp" {
+					t.Errorf("expected TracerProvider = 'otlp', got %q", o.Tracer.Provider)
 				}
-				if o.TracerProviderHost != "localhost" {
-					t.Errorf("expected TracerProviderHost = 'localhost', got %q", o.TracerProviderHost)
+				if o.Tracer.ProviderHost != "localhost" {
+					t.Errorf("expected TracerProviderHost = 'localhost', got %q", o.Tracer.ProviderHost)
 				}
-				if o.TracerProviderPort != 4317 {
-					t.Errorf("expected TracerProviderPort = 4317, got %d", o.TracerProviderPort)
+				if o.Tracer.ProviderPort != 4317 {
+					t.Errorf("expected TracerProviderPort = 4317, got %d", o.Tracer.ProviderPort)
 				}
-				if o.TracerSampleRatio != 0.5 {
-					t.Errorf("expected TracerSampleRatio = 0.5, got %f", o.TracerSampleRatio)
+				if o.Tracer.SampleRatio != 0.5 {
+					t.Errorf("expected TracerSampleRatio = 0.5, got %f", o.Tracer.SampleRatio)
 				}
-				if o.TracerBatchTimeout != 10*time.Second {
-					t.Errorf("expected TracerBatchTimeout = 10s, got %v", o.TracerBatchTimeout)
+				if o.Tracer.BatchTimeout != 10*time.Second {
+					t.Errorf("expected TracerBatchTimeout = 10s, got %v", o.Tracer.BatchTimeout)
 				}
-				if !o.TracerInsecure {
-					t.Errorf("expected TracerInsecure = true, got %v", o.TracerInsecure)
+				if !o.Tracer.Insecure {
+					t.Errorf("expected TracerInsecure = true, got %v", o.Tracer.Insecure)
 				}
-				if o.MetricProvider != "otlp" {
-					t.Errorf("expected MetricProvider = 'otlp', got %q", o.MetricProvider)
+				if o.Metric.Provider != "otlp" {
+					t.Errorf("expected MetricProvider = 'otlp', got %q", o.Metric.Provider)
 				}
-				if o.MetricProviderHost != "localhost" {
-					t.Errorf("expected MetricProviderHost = 'localhost', got %q", o.MetricProviderHost)
+				if o.Metric.ProviderHost != "localhost" {
+					t.Errorf("expected MetricProviderHost = 'localhost', got %q", o.Metric.ProviderHost)
 				}
-				if o.MetricProviderPort != 4318 {
-					t.Errorf("expected MetricProviderPort = 4318, got %d", o.MetricProviderPort)
+				if o.Metric.ProviderPort != 4318 {
+					t.Errorf("expected MetricProviderPort = 4318, got %d", o.Metric.ProviderPort)
 				}
-				if o.MetricInterval != 30*time.Second {
-					t.Errorf("expected MetricInterval = 30s, got %v", o.MetricInterval)
+				if o.Metric.Interval != 30*time.Second {
+					t.Errorf("expected MetricInterval = 30s, got %v", o.Metric.Interval)
 				}
-				if !o.MetricInsecure {
-					t.Errorf("expected MetricInsecure = true, got %v", o.MetricInsecure)
+				if !o.Metric.Insecure {
+					t.Errorf("expected MetricInsecure = true, got %v", o.Metric.Insecure)
 				}
 			},
 		},
@@ -540,6 +551,59 @@ func TestMonitoring_Registry_NewMonitoring(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "disabled components skip validation",
+			opts: []Option{
+				WithServiceName("test-service"),
+				WithLoggerDisabled(true),
+				WithLoggerLevel("invalid"),
+				WithTracerDisabled(true),
+				WithTracerProvider("invalid", "", 0),
+				WithMetricDisabled(true),
+				WithMetricProvider("invalid", "", 0),
+			},
+			wantErr: false,
+			check: func(t *testing.T, m *Monitoring) {
+				if m == nil {
+					t.Error("expected monitoring, got nil")
+					return
+				}
+				if m.Logger == nil {
+					t.Error("expected noop Logger, got nil")
+				}
+				if m.Tracer == nil {
+					t.Error("expected noop Tracer, got nil")
+				}
+				if m.Metric == nil {
+					t.Error("expected noop Metric, got nil")
+				}
+				_, span := m.Tracer.StartSpan(context.Background(), "disabled")
+				if span.SpanContext().IsValid() {
+					t.Error("expected noop span from disabled tracer")
+				}
+			},
+		},
+		{
+			name: "only tracer disabled",
+			opts: []Option{
+				WithServiceName("test-service"),
+				WithTracerDisabled(true),
+			},
+			wantErr: false,
+			check: func(t *testing.T, m *Monitoring) {
+				if m == nil {
+					t.Error("expected monitoring, got nil")
+					return
+				}
+				_, span := m.Tracer.StartSpan(context.Background(), "disabled")
+				if span.SpanContext().IsValid() {
+					t.Error("expected noop span from disabled tracer")
+				}
+				if _, err := m.Metric.CreateCounter("enabled_counter", "1", "Metric still enabled"); err != nil {
+					t.Errorf("CreateCounter() error = %v", err)
+				}
+			},
+		},
 		{
 			name: "invalid metric provider",
 			opts: []Option{
@@ -673,3 +737,498 @@ func TestMonitoring_Registry_NewMonitoring_CleanupOnFailure(t *testing.T) {
 		}
 	})
 }
+
+// emptyProducer is a metric producer with no metrics.
+type emptyProducer struct{}
+
+func (emptyProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	return nil, nil
+}
+
+func TestMonitoring_Registry_ComponentOptions(t *testing.T) {
+	t.Setenv("GOOGLE_CLOUD_PROJECT", "my-project")
+	clk := NewFakeClock(time.Unix(0, 0))
+	processor := sdktrace.NewSimpleSpanProcessor(tracetest.NewInMemoryExporter())
+	producer := emptyProducer{}
+	generator := NewSequentialIDGenerator()
+	options := parseOptions(
+		WithServiceName("test-service"),
+		WithEnvironment("production"),
+		WithInstance("instance-1", "localhost"),
+		WithInstrumentationScope("github.com/acme/test-service", "v1.2.3"),
+		WithRedaction("hash", "user.email"),
+		WithLoggerLevel("debug"),
+		WithLoggerOutputPath("/tmp/app.log"),
+		WithLoggerErrorOutputPath("stderr"),
+		WithLoggerSink("syslog"),
+		WithLoggerSyslog("udp", "syslog:514", "local0", ""),
+		WithLoggerLoki("http://loki:3100"),
+		WithLoggerKafka([]string{"kafka:9092"}, "logs"),
+		WithLoggerKafkaBatch(500, 200*time.Millisecond, 50000),
+		WithLoggerSchema("ecs"),
+		WithLoggerTimeFormat("epoch_millis", time.UTC),
+		WithLoggerCaller(true, 2),
+		WithLoggerStacktraceLevel("fatal"),
+		WithLoggerDedup(5*time.Second),
+		WithLoggerRateLimit("cache miss", 10),
+		WithLoggerAudit("/var/log/audit.log", []byte("secret")),
+		WithLoggerCaptureStdLog(true),
+		WithLoggerCaptureGRPCLog(true),
+		WithLoggerAsync(1024, "drop_oldest"),
+		WithTracerProvider("otlp", "collector", 4317),
+		WithTracerStdoutFormat("ndjson"),
+		WithTracerSampleRatio(0.25),
+		WithTracerBatchTimeout(2*time.Second),
+		WithTracerContextAnnotations(true),
+		WithTracerXRayIDs(true),
+		WithTracerIDGenerator(generator),
+		WithTracerTraceID64(true),
+		WithTracerXRayPropagation(true),
+		WithTracerOpenTracingBridge(true),
+		WithTracerOpenCensusBridge(true),
+		WithTracerLongSpanWatchdog(5*time.Minute, true),
+		WithTracerSpanProcessor(processor),
+		WithTracerInsecure(true),
+		WithTracerEndpoint("grpcs://collector:4317"),
+		WithTracerMirror("https://vendor:4318/v1/traces", 0.05),
+		WithDevTraceViewer(16686),
+		WithTracerRemoteSampling("http://jaeger-agent:5778/sampling", time.Minute),
+		WithTracerFallbackProvider("file", "/tmp/spans.json"),
+		WithMetricProvider("otlp", "collector", 4318),
+		WithMetricStdoutFormat("ndjson"),
+		WithMetricEMFNamespace("Shop/Checkout"),
+		WithMetricInterval(30*time.Second),
+		WithMetricInsecure(true),
+		WithMetricEndpoint("https://collector:4318/v1/metrics"),
+		WithMetricReaderMode("manual"),
+		WithMetricTemporality("delta"),
+		WithMetricExemplars(true),
+		WithMetricPushgateway("nightly-export", "worker-1"),
+		WithMetricInfluxDB("http://localhost:8086", "edge", "metrics", "secret"),
+		WithMetricProducer(producer),
+		WithMetricOpenCensusBridge(true),
+		WithMetricStatsDListener("127.0.0.1:8125"),
+		WithExporterCircuitBreaker(3, time.Minute),
+		WithClock(clk),
+	)
+
+	loggerOpts := &logger.Options{}
+	for _, opt := range loggerOptions(options) {
+		opt(loggerOpts)
+	}
+	loggerWant := logger.Options{
+		Level:             "debug",
+		OutputPath:        "/tmp/app.log",
+		ErrorOutputPath:   "stderr",
+		Sink:              "syslog",
+		SyslogNetwork:     "udp",
+		SyslogAddress:     "syslog:514",
+		SyslogFacility:    "local0",
+		SyslogTag:         "test-service",
+		LokiURL:           "http://loki:3100",
+		LokiLabels:        map[string]string{"service": "test-service", "env": "production", "instance": "instance-1"},
+		KafkaBrokers:      []string{"kafka:9092"},
+		KafkaTopic:        "logs",
+		KafkaBatchSize:    500,
+		KafkaBatchTimeout: 200 * time.Millisecond,
+		KafkaBufferSize:   50000,
+		Schema:            "ecs",
+		GCPProjectID:      "my-project",
+		TimeLayout:        "epoch_millis",
+		TimeLocation:      time.UTC,
+		CallerSkip:        2,
+		StacktraceLevel:   "fatal",
+		DedupWindow:       5 * time.Second,
+		RateLimits:        map[string]int{"cache miss": 10},
+		AuditOutputPath:   "/var/log/audit.log",
+		AuditHMACKey:      []byte("secret"),
+		CaptureStdLog:     true,
+		CaptureGRPCLog:    true,
+		AsyncBufferSize:   1024,
+		AsyncDropPolicy:   "drop_oldest",
+		ExitFlushTimeout:  5 * time.Second,
+		RedactedFields:    []string{"user.email"},
+		RedactionAction:   "hash",
+	}
+	if !reflect.DeepEqual(*loggerOpts, loggerWant) {
+		t.Errorf("loggerOptions() = %+v, want %+v", *loggerOpts, loggerWant)
+	}
+
+	tracerOpts := &tracer.Options{}
+	for _, opt := range tracerOptions(options) {
+		opt(tracerOpts)
+	}
+	tracerWant := tracer.Options{
+		ServiceName:            "test-service",
+		Environment:            "production",
+		InstanceName:           "instance-1",
+		InstanceHost:           "localhost",
+		ScopeName:              "github.com/acme/test-service",
+		ScopeVersion:           "v1.2.3",
+		Provider:               "otlp",
+		ProviderHost:           "collector",
+		ProviderPort:           4317,
+		StdoutFormat:           "ndjson",
+		SampleRatio:            0.25,
+		ScrubbedHeaders:        tracer.DefaultScrubbedHeaders(),
+		ScrubbedQueryParams:    tracer.DefaultScrubbedQueryParams(),
+		BatchTimeout:           2 * time.Second,
+		ContextAnnotations:     true,
+		XRayIDs:                true,
+		IDGenerator:            generator,
+		TraceID64:              true,
+		XRayPropagation:        true,
+		OpenTracingBridge:      true,
+		OpenCensusBridge:       true,
+		LongSpanThreshold:      5 * time.Minute,
+		SpanProcessors:         []sdktrace.SpanProcessor{processor},
+		RedactedAttributes:     []string{"user.email"},
+		RedactionAction:        "hash",
+		Insecure:               true,
+		Endpoint:               "grpcs://collector:4317",
+		MirrorEndpoint:         "https://vendor:4318/v1/traces",
+		MirrorRatio:            0.05,
+		DevViewerPort:          16686,
+		RemoteSamplingURL:      "http://jaeger-agent:5778/sampling",
+		RemoteSamplingInterval: time.Minute,
+		FallbackProvider:       "file",
+		FallbackPath:           "/tmp/spans.json",
+		BreakerThreshold:       3,
+		BreakerMaxBackoff:      time.Minute,
+		Clock:                  clk,
+	}
+	if !reflect.DeepEqual(*tracerOpts, tracerWant) {
+		t.Errorf("tracerOptions() = %+v, want %+v", *tracerOpts, tracerWant)
+	}
+
+	metricOpts := &metric.Options{}
+	for _, opt := range metricOptions(options) {
+		opt(metricOpts)
+	}
+	metricWant := metric.Options{
+		ServiceName:         "test-service",
+		Environment:         "production",
+		InstanceName:        "instance-1",
+		InstanceHost:        "localhost",
+		ScopeName:           "github.com/acme/test-service",
+		ScopeVersion:        "v1.2.3",
+		Provider:            "otlp",
+		ProviderHost:        "collector",
+		ProviderPort:        4318,
+		StdoutFormat:        "ndjson",
+		EMFNamespace:        "Shop/Checkout",
+		Interval:            30 * time.Second,
+		ReaderMode:          "manual",
+		Temporality:         "delta",
+		Exemplars:           true,
+		PushgatewayJob:      "nightly-export",
+		PushgatewayInstance: "worker-1",
+		InfluxDBURL:         "http://localhost:8086",
+		InfluxDBOrg:         "edge",
+		InfluxDBBucket:      "metrics",
+		InfluxDBToken:       "secret",
+		Producers:           []sdkmetric.Producer{producer},
+		OpenCensusBridge:    true,
+		StatsDAddress:       "127.0.0.1:8125",
+		Insecure:            true,
+		Endpoint:            "https://collector:4318/v1/metrics",
+		BreakerThreshold:    3,
+		BreakerMaxBackoff:   time.Minute,
+		Clock:               clk,
+	}
+	if !reflect.DeepEqual(*metricOpts, metricWant) {
+		t.Errorf("metricOptions() = %+v, want %+v", *metricOpts, metricWant)
+	}
+}
+
+func TestMonitoring_Registry_NewMonitoring_OTelErrorLogging(t *testing.T) {
+	previous := otel.GetErrorHandler()
+	t.Cleanup(func() {
+		otel.SetErrorHandler(previous)
+	})
+
+	mon, err := NewMonitoring(WithServiceName("test-service"), WithOTelErrorLogging(true))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	recorder := &recordingLogger{}
+	mon.Logger = recorder
+
+	otel.Handle(errors.New("export failed"))
+
+	if len(recorder.errors) != 1 {
+		t.Fatalf("expected 1 error log, got %d", len(recorder.errors))
+	}
+	if got := recorder.errors[0]["error"]; got != "export failed" {
+		t.Errorf("logged error = %v, want %q", got, "export failed")
+	}
+}
+
+func TestMonitoring_Registry_HandleOTelError_NilComponents(t *testing.T) {
+	// Must not panic when the components are missing.
+	(&Monitoring{}).handleOTelError(errors.New("export failed"))
+}
+
+func TestMonitoring_Registry_NewMonitoring_SetGlobalProviders(t *testing.T) {
+	tests := []struct {
+		name             string
+		opts             []Option
+		wantGlobalTracer bool
+		wantGlobalMeter  bool
+	}{
+		{
+			name: "disabled by default",
+			opts: nil,
+		},
+		{
+			name:             "enabled",
+			opts:             []Option{WithSetGlobalProviders(true)},
+			wantGlobalTracer: true,
+			wantGlobalMeter:  true,
+		},
+		{
+			name:            "enabled with tracer disabled",
+			opts:            []Option{WithSetGlobalProviders(true), WithTracerDisabled(true)},
+			wantGlobalMeter: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracerProvider, meterProvider, propagator := otel.GetTracerProvider(), otel.GetMeterProvider(), otel.GetTextMapPropagator()
+			t.Cleanup(func() {
+				// Only restore what changed; re-setting a default global logs a warning.
+				if otel.GetTracerProvider() != tracerProvider {
+					otel.SetTracerProvider(tracerProvider)
+				}
+				if otel.GetMeterProvider() != meterProvider {
+					otel.SetMeterProvider(meterProvider)
+				}
+				if otel.GetTextMapPropagator() != propagator {
+					otel.SetTextMapPropagator(propagator)
+				}
+			})
+
+			mon, err := NewMonitoring(append([]Option{WithServiceName("test-service")}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+
+			if got := otel.GetTracerProvider() == mon.Tracer.Provider(); got != tt.wantGlobalTracer {
+				t.Errorf("global tracer provider registered = %v, want %v", got, tt.wantGlobalTracer)
+			}
+			if got := otel.GetMeterProvider() == mon.Metric.Provider(); got != tt.wantGlobalMeter {
+				t.Errorf("global meter provider registered = %v, want %v", got, tt.wantGlobalMeter)
+			}
+			if got := otel.GetTextMapPropagator() == propagator; got == tt.wantGlobalTracer {
+				t.Errorf("global propagator unchanged = %v, want %v", got, !tt.wantGlobalTracer)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Registry_NewMonitoring_LoggerAsync(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	mon, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithLoggerOutputPath(path),
+		WithLoggerAsync(8, "drop_newest"),
+	)
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+
+	mon.Logger.InfoFields("async entry", String("key", "value"))
+	if err := mon.Logger.Sync(); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if err := mon.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"msg":"async entry"`) {
+		t.Errorf("log output %q does not contain the async entry", data)
+	}
+}
+
+func TestMonitoring_Registry_NewMonitoringLenient(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name          string
+		opts          []Option
+		wantNilMon    bool
+		wantComponent string
+	}{
+		{
+			name: "all components initialize",
+			opts: []Option{WithServiceName("test-service")},
+		},
+		{
+			name:          "logger fails",
+			opts:          []Option{WithServiceName("test-service"), WithLoggerOutputPath(filepath.Join(missing, "app.log"))},
+			wantComponent: ComponentLogger,
+		},
+		{
+			name:          "tracer fails",
+			opts:          []Option{WithServiceName("test-service"), WithTracerFallbackProvider("file", filepath.Join(missing, "spans.json"))},
+			wantComponent: ComponentTracer,
+		},
+		{
+			name:       "invalid options are not degraded",
+			opts:       []Option{WithServiceName("")},
+			wantNilMon: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoringLenient(tt.opts...)
+			if tt.wantNilMon {
+				if mon != nil || !errors.Is(err, ErrServiceNameRequired) {
+					t.Fatalf("NewMonitoringLenient() = (%v, %v), want (nil, ErrServiceNameRequired)", mon, err)
+				}
+				return
+			}
+			if mon == nil {
+				t.Fatalf("NewMonitoringLenient() returned nil Monitoring, error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+			if mon.Logger == nil || mon.Tracer == nil || mon.Metric == nil {
+				t.Fatal("expected every component to be non-nil")
+			}
+
+			if tt.wantComponent == "" {
+				if err != nil {
+					t.Errorf("NewMonitoringLenient() error = %v, want nil", err)
+				}
+				return
+			}
+			var initErr *InitError
+			if !errors.As(err, &initErr) {
+				t.Fatalf("NewMonitoringLenient() error = %v, want *InitError", err)
+			}
+			if errs := initErr.Unwrap(); len(errs) != 1 {
+				t.Fatalf("InitError has %d errors, want 1: %v", len(errs), initErr)
+			}
+			var monErr *Error
+			if !errors.As(err, &monErr) || monErr.Component != tt.wantComponent {
+				t.Errorf("component error = %v, want an *Error for %s", monErr, tt.wantComponent)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Registry_NewMonitoring_NotLenient(t *testing.T) {
+	_, err := NewMonitoring(
+		WithServiceName("test-service"),
+		WithTracerFallbackProvider("file", filepath.Join(t.TempDir(), "missing", "spans.json")),
+	)
+	var initErr *InitError
+	if err == nil || errors.As(err, &initErr) {
+		t.Errorf("NewMonitoring() error = %v, want a component error without degradation", err)
+	}
+}
+
+func TestMonitoring_Registry_NewMonitoring_StartupProbe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	defer listener.Close()
+	open := listener.Addr().(*net.TCPAddr).Port
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	refused := closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+
+	tests := []struct {
+		name          string
+		opts          []Option
+		wantComponent string
+	}{
+		{
+			name: "stdout providers are not probed",
+			opts: []Option{WithStartupProbe(time.Second)},
+		},
+		{
+			name: "reachable collectors",
+			opts: []Option{
+				WithOTLPEndpoint("127.0.0.1", open),
+				WithOTLPInsecure(true),
+				WithStartupProbe(time.Second),
+			},
+		},
+		{
+			name: "probe disabled",
+			opts: []Option{WithOTLPEndpoint("127.0.0.1", refused), WithOTLPInsecure(true)},
+		},
+		{
+			name: "tracer collector refused",
+			opts: []Option{
+				WithTracerProvider("otlp", "127.0.0.1", refused),
+				WithTracerInsecure(true),
+				WithStartupProbe(time.Second),
+			},
+			wantComponent: ComponentTracer,
+		},
+		{
+			name: "metric endpoint refused",
+			opts: []Option{
+				WithMetricEndpoint("grpc://127.0.0.1:" + strconv.Itoa(refused)),
+				WithStartupProbe(time.Second),
+			},
+			wantComponent: ComponentMetric,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(append([]Option{WithServiceName("test-service")}, tt.opts...)...)
+			if tt.wantComponent == "" {
+				if err != nil {
+					t.Fatalf("NewMonitoring() error = %v", err)
+				}
+				// Nothing serves OTLP on the listeners, so do not wait for the final export.
+				ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+				defer cancel()
+				_ = mon.Shutdown(ctx)
+				return
+			}
+
+			var monErr *Error
+			if !errors.As(err, &monErr) {
+				t.Fatalf("NewMonitoring() error = %v, want *Error", err)
+			}
+			if monErr.Component != tt.wantComponent || monErr.Operation != OperationProbe {
+				t.Errorf("Error = %s/%s, want %s/%s", monErr.Component, monErr.Operation, tt.wantComponent, OperationProbe)
+			}
+			if !errors.Is(err, ErrStartupProbeUnreachable) {
+				t.Errorf("NewMonitoring() error = %v, want %v", err, ErrStartupProbeUnreachable)
+			}
+		})
+	}
+}
+
+func TestMonitoring_Registry_LokiLabels(t *testing.T) {
+	got := lokiLabels(parseOptions(WithServiceName("api"), WithEnvironment("production")))
+	want := map[string]string{"service": "api", "env": "production"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("lokiLabels() = %v, want %v", got, want)
+	}
+}
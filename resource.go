@@ -0,0 +1,133 @@
+package monitoring
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/cloud"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// kubernetesEnv maps the environment variables conventionally populated from the Kubernetes
+// downward API to the resource attributes WithKubernetesMetadata sets from them.
+var kubernetesEnv = []struct {
+	name string
+	key  attribute.Key
+}{
+	{name: "POD_NAME", key: semconv.K8SPodNameKey},
+	{name: "POD_NAMESPACE", key: semconv.K8SNamespaceNameKey},
+	{name: "NODE_NAME", key: semconv.K8SNodeNameKey},
+}
+
+// cloudDetectionTimeout bounds the cloud metadata detection. Outside the cloud the metadata
+// requests go to link-local addresses that may never answer.
+const cloudDetectionTimeout = 2 * time.Second
+
+// resourceAttributes returns the attributes added to the tracer and metric resources next to
+// the service identity: the OTEL_RESOURCE_ATTRIBUTES attributes, the Kubernetes metadata, and
+// the detected cloud attributes. Later attributes override earlier ones with the same key, and
+// the configured service identity overrides them all.
+func resourceAttributes(options *Options) []attribute.KeyValue {
+	attrs := append(envResourceAttributes(), kubernetesAttributes(options)...)
+	return append(attrs, options.cloudAttributes...)
+}
+
+// componentResourceAttributes returns the resource attributes of the tracer and metric options:
+// deployment.environment when Environment is left at its default, followed by
+// resourceAttributes, so the default yields to the environment and the detectors.
+func componentResourceAttributes(options *Options) []attribute.KeyValue {
+	if options.Environment != defaultEnvironment {
+		return resourceAttributes(options)
+	}
+	attrs := []attribute.KeyValue{semconv.DeploymentEnvironmentKey.String(defaultEnvironment)}
+	return append(attrs, resourceAttributes(options)...)
+}
+
+// configuredEnvironment returns the Environment the tracer and metric resources are identified
+// by, overriding the resource attributes: empty when it is left at its default, which
+// componentResourceAttributes adds instead.
+func configuredEnvironment(options *Options) string {
+	if options.Environment == defaultEnvironment {
+		return ""
+	}
+	return options.Environment
+}
+
+// envResourceAttributes returns the attributes of the standard OTEL_RESOURCE_ATTRIBUTES
+// environment variable, so platforms can inject cluster-level attributes without code
+// changes. Malformed entries are skipped, and service.name is left to WithServiceName.
+func envResourceAttributes() []attribute.KeyValue {
+	// resource.New reports malformed entries with the resource of the entries it parsed.
+	res, _ := resource.New(context.Background(), resource.WithFromEnv())
+	var attrs []attribute.KeyValue
+	for _, attr := range res.Attributes() {
+		if attr.Key != semconv.ServiceNameKey {
+			attrs = append(attrs, attr)
+		}
+	}
+	return attrs
+}
+
+// kubernetesAttributes returns the Kubernetes metadata when options enable it. Unset
+// environment variables are skipped.
+func kubernetesAttributes(options *Options) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if options.KubernetesMetadata {
+		for _, env := range kubernetesEnv {
+			if value := os.Getenv(env.name); value != "" {
+				attrs = append(attrs, env.key.String(value))
+			}
+		}
+	}
+	return attrs
+}
+
+// loggerFields returns the Kubernetes metadata as fields added to every log entry, keyed by
+// attribute name, or nil when there is none. Cloud attributes describe the host rather than
+// the workload and are left to the resources.
+func loggerFields(options *Options) map[string]interface{} {
+	attrs := kubernetesAttributes(options)
+	if len(attrs) == 0 {
+		return nil
+	}
+	fields := make(map[string]interface{}, len(attrs))
+	for _, attr := range attrs {
+		fields[string(attr.Key)] = attr.Value.AsInterface()
+	}
+	return fields
+}
+
+// gcpProjectID returns the Google Cloud project of the "gcp" log schema trace IDs: the project
+// detected with WithCloudDetection, or else the GOOGLE_CLOUD_PROJECT environment variable.
+func gcpProjectID(options *Options) string {
+	var gcp bool
+	var project string
+	for _, attr := range options.cloudAttributes {
+		switch {
+		case attr == semconv.CloudProviderGCP:
+			gcp = true
+		case attr.Key == semconv.CloudAccountIDKey:
+			project = attr.Value.AsString()
+		}
+	}
+	if gcp && project != "" {
+		return project
+	}
+	return os.Getenv("GOOGLE_CLOUD_PROJECT")
+}
+
+// detectCloud stores the attributes of the cloud selected by options.CloudDetection in
+// options, so the resources built from options include them. It runs once per constructor
+// call, since the detection may wait on metadata requests; an unrecognized environment adds
+// no attributes.
+func detectCloud(options *Options) {
+	if options.CloudDetection == "" || cloud.Validate(options.CloudDetection) != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cloudDetectionTimeout)
+	defer cancel()
+	options.cloudAttributes = cloud.Detect(ctx, options.CloudDetection)
+}
@@ -0,0 +1,174 @@
+package monitoring
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestMonitoring_Resource_ResourceAttributes(t *testing.T) {
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "")
+	t.Setenv("POD_NAME", "api-0")
+	t.Setenv("POD_NAMESPACE", "payments")
+	t.Setenv("NODE_NAME", "")
+
+	if attrs := resourceAttributes(parseOptions()); len(attrs) != 0 {
+		t.Errorf("resourceAttributes() without Kubernetes metadata = %v, want none", attrs)
+	}
+	if fields := loggerFields(parseOptions()); fields != nil {
+		t.Errorf("loggerFields() without Kubernetes metadata = %v, want nil", fields)
+	}
+
+	options := parseOptions(WithKubernetesMetadata(true))
+	want := map[string]interface{}{
+		"k8s.pod.name":       "api-0",
+		"k8s.namespace.name": "payments",
+	}
+	if got := loggerFields(options); !reflect.DeepEqual(got, want) {
+		t.Errorf("loggerFields() = %v, want %v", got, want)
+	}
+	if got := resourceAttributes(options); len(got) != 2 {
+		t.Errorf("resourceAttributes() = %v, want the pod name and namespace", got)
+	}
+
+	mon, err := NewMonitoring(WithServiceName("test-service"), WithKubernetesMetadata(true))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	if got := mon.DebugInfo().Resource["k8s.namespace.name"]; got != "payments" {
+		t.Errorf("DebugInfo().Resource[k8s.namespace.name] = %q, want %q", got, "payments")
+	}
+}
+
+func TestMonitoring_Resource_EnvResourceAttributes(t *testing.T) {
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "k8s.cluster.name=prod-eu,service.name=env-service,k8s.pod.name=env-pod,team=pay%20ments,malformed")
+	t.Setenv("OTEL_SERVICE_NAME", "env-service")
+	t.Setenv("POD_NAME", "api-0")
+
+	got := make(map[string]string)
+	for _, attr := range resourceAttributes(parseOptions(WithKubernetesMetadata(true))) {
+		// later attributes take precedence, as they do in the resources
+		got[string(attr.Key)] = attr.Value.AsString()
+	}
+	want := map[string]string{
+		"k8s.cluster.name": "prod-eu",
+		"k8s.pod.name":     "api-0",
+		"team":             "pay ments",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resourceAttributes() = %v, want %v", got, want)
+	}
+
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+	info := mon.DebugInfo()
+	if got := info.Resource["k8s.cluster.name"]; got != "prod-eu" {
+		t.Errorf("DebugInfo().Resource[k8s.cluster.name] = %q, want %q", got, "prod-eu")
+	}
+	if got := info.Resource["service.name"]; got != "test-service" {
+		t.Errorf("DebugInfo().Resource[service.name] = %q, want %q", got, "test-service")
+	}
+}
+
+func TestMonitoring_Resource_IdentityAttributes(t *testing.T) {
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "deployment.environment=prod,host.name=node-7,service.instance.id=pod-7")
+
+	tests := []struct {
+		name string
+		opts []Option
+		want map[string]string
+	}{
+		{
+			name: "unset identity",
+			want: map[string]string{"deployment.environment": "prod", "host.name": "node-7", "service.instance.id": "pod-7"},
+		},
+		{
+			name: "configured identity",
+			opts: []Option{WithEnvironment("staging"), WithInstance("api-1", "")},
+			want: map[string]string{"deployment.environment": "staging", "host.name": "node-7", "service.instance.id": "api-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(append([]Option{WithServiceName("test-service")}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+			info := mon.DebugInfo()
+			for key, want := range tt.want {
+				if got := info.Resource[key]; got != want {
+					t.Errorf("DebugInfo().Resource[%s] = %q, want %q", key, got, want)
+				}
+			}
+		})
+	}
+
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "")
+	got := componentResourceAttributes(parseOptions())
+	if len(got) != 1 || got[0] != semconv.DeploymentEnvironmentKey.String("development") {
+		t.Errorf("componentResourceAttributes() = %v, want the default environment", got)
+	}
+}
+
+func TestMonitoring_Resource_DetectCloud(t *testing.T) {
+	t.Setenv("AWS_LAMBDA_FUNCTION_NAME", "checkout")
+	t.Setenv("AWS_REGION", "eu-west-1")
+	t.Setenv("POD_NAME", "api-0")
+
+	options := parseOptions(WithKubernetesMetadata(true))
+	detectCloud(options)
+	if len(options.cloudAttributes) != 0 {
+		t.Errorf("detectCloud() without CloudDetection set %v", options.cloudAttributes)
+	}
+
+	options = parseOptions(WithKubernetesMetadata(true), WithCloudDetection("aws"))
+	detectCloud(options)
+	got := map[string]string{}
+	for _, attr := range resourceAttributes(options) {
+		got[string(attr.Key)] = attr.Value.AsString()
+	}
+	if got["cloud.platform"] != "aws_lambda" || got["cloud.region"] != "eu-west-1" || got["k8s.pod.name"] != "api-0" {
+		t.Errorf("resourceAttributes() = %v, want the Lambda and Kubernetes attributes", got)
+	}
+	if _, ok := loggerFields(options)["cloud.platform"]; ok {
+		t.Error("loggerFields() should not include cloud attributes")
+	}
+}
+
+func TestMonitoring_Resource_GCPProjectID(t *testing.T) {
+	t.Setenv("GOOGLE_CLOUD_PROJECT", "env-project")
+
+	tests := []struct {
+		name  string
+		attrs []attribute.KeyValue
+		want  string
+	}{
+		{"detected project", []attribute.KeyValue{semconv.CloudProviderGCP, semconv.CloudAccountID("detected-project")}, "detected-project"},
+		{"other cloud account", []attribute.KeyValue{semconv.CloudProviderAWS, semconv.CloudAccountID("123456789012")}, "env-project"},
+		{"no detection", nil, "env-project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := parseOptions()
+			options.cloudAttributes = tt.attrs
+			if got := gcpProjectID(options); got != tt.want {
+				t.Errorf("gcpProjectID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
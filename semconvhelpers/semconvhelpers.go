@@ -0,0 +1,184 @@
+// Package semconvhelpers exports the OpenTelemetry semantic-convention attribute keys and
+// builders of the semconv version go-monitoring uses, so applications annotate spans and
+// metrics with the same keys as the library without importing a semconv package that may
+// drift from it.
+//
+// Example:
+//
+//	span.SetAttributes(
+//	    semconvhelpers.DBSystem("postgresql"),
+//	    semconvhelpers.DBCollectionName("orders"),
+//	)
+package semconvhelpers
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// SchemaURL is the schema URL of the semantic conventions the keys belong to.
+const SchemaURL = semconv.SchemaURL
+
+// HTTP attribute keys.
+const (
+	HTTPMethodKey             = semconv.HTTPRequestMethodKey      // HTTPMethodKey is "http.request.method".
+	HTTPResponseStatusCodeKey = semconv.HTTPResponseStatusCodeKey // HTTPResponseStatusCodeKey is "http.response.status_code".
+	HTTPRouteKey              = semconv.HTTPRouteKey              // HTTPRouteKey is "http.route".
+	URLFullKey                = semconv.URLFullKey                // URLFullKey is "url.full".
+	URLPathKey                = semconv.URLPathKey                // URLPathKey is "url.path".
+	URLSchemeKey              = semconv.URLSchemeKey              // URLSchemeKey is "url.scheme".
+	ServerAddressKey          = semconv.ServerAddressKey          // ServerAddressKey is "server.address".
+	ServerPortKey             = semconv.ServerPortKey             // ServerPortKey is "server.port".
+	UserAgentOriginalKey      = semconv.UserAgentOriginalKey      // UserAgentOriginalKey is "user_agent.original".
+	ErrorTypeKey              = semconv.ErrorTypeKey              // ErrorTypeKey is "error.type".
+	NetworkProtocolNameKey    = semconv.NetworkProtocolNameKey    // NetworkProtocolNameKey is "network.protocol.name".
+)
+
+// Database attribute keys.
+const (
+	DBSystemKey         = semconv.DBSystemKey         // DBSystemKey is "db.system".
+	DBNamespaceKey      = semconv.DBNamespaceKey      // DBNamespaceKey is "db.namespace".
+	DBCollectionNameKey = semconv.DBCollectionNameKey // DBCollectionNameKey is "db.collection.name".
+	DBOperationNameKey  = semconv.DBOperationNameKey  // DBOperationNameKey is "db.operation.name".
+	DBQueryTextKey      = semconv.DBQueryTextKey      // DBQueryTextKey is "db.query.text".
+)
+
+// Messaging attribute keys.
+const (
+	MessagingSystemKey          = semconv.MessagingSystemKey          // MessagingSystemKey is "messaging.system".
+	MessagingDestinationNameKey = semconv.MessagingDestinationNameKey // MessagingDestinationNameKey is "messaging.destination.name".
+	MessagingOperationTypeKey   = semconv.MessagingOperationTypeKey   // MessagingOperationTypeKey is "messaging.operation.type".
+	MessagingOperationNameKey   = semconv.MessagingOperationNameKey   // MessagingOperationNameKey is "messaging.operation.name".
+	MessagingMessageIDKey       = semconv.MessagingMessageIDKey       // MessagingMessageIDKey is "messaging.message.id".
+)
+
+// RPC attribute keys.
+const (
+	RPCSystemKey  = semconv.RPCSystemKey  // RPCSystemKey is "rpc.system".
+	RPCServiceKey = semconv.RPCServiceKey // RPCServiceKey is "rpc.service".
+	RPCMethodKey  = semconv.RPCMethodKey  // RPCMethodKey is "rpc.method".
+)
+
+// HTTPMethod returns the "http.request.method" attribute, e.g. "GET".
+func HTTPMethod(method string) attribute.KeyValue {
+	return HTTPMethodKey.String(method)
+}
+
+// HTTPResponseStatusCode returns the "http.response.status_code" attribute.
+func HTTPResponseStatusCode(code int) attribute.KeyValue {
+	return semconv.HTTPResponseStatusCode(code)
+}
+
+// HTTPRoute returns the "http.route" attribute, the matched route template, e.g. "/orders/{id}".
+func HTTPRoute(route string) attribute.KeyValue {
+	return semconv.HTTPRoute(route)
+}
+
+// URLFull returns the "url.full" attribute.
+func URLFull(url string) attribute.KeyValue {
+	return semconv.URLFull(url)
+}
+
+// URLPath returns the "url.path" attribute.
+func URLPath(path string) attribute.KeyValue {
+	return semconv.URLPath(path)
+}
+
+// URLScheme returns the "url.scheme" attribute, e.g. "https".
+func URLScheme(scheme string) attribute.KeyValue {
+	return semconv.URLScheme(scheme)
+}
+
+// ServerAddress returns the "server.address" attribute.
+func ServerAddress(address string) attribute.KeyValue {
+	return semconv.ServerAddress(address)
+}
+
+// ServerPort returns the "server.port" attribute.
+func ServerPort(port int) attribute.KeyValue {
+	return semconv.ServerPort(port)
+}
+
+// UserAgentOriginal returns the "user_agent.original" attribute.
+func UserAgentOriginal(userAgent string) attribute.KeyValue {
+	return semconv.UserAgentOriginal(userAgent)
+}
+
+// ErrorType returns the "error.type" attribute, the class of error an operation ended with,
+// e.g. "timeout" or a status code.
+func ErrorType(errorType string) attribute.KeyValue {
+	return ErrorTypeKey.String(errorType)
+}
+
+// NetworkProtocolName returns the "network.protocol.name" attribute, e.g. "http".
+func NetworkProtocolName(name string) attribute.KeyValue {
+	return semconv.NetworkProtocolName(name)
+}
+
+// DBSystem returns the "db.system" attribute, e.g. "postgresql" or "redis".
+func DBSystem(system string) attribute.KeyValue {
+	return DBSystemKey.String(system)
+}
+
+// DBNamespace returns the "db.namespace" attribute, the database name.
+func DBNamespace(namespace string) attribute.KeyValue {
+	return semconv.DBNamespace(namespace)
+}
+
+// DBCollectionName returns the "db.collection.name" attribute, the table or collection.
+func DBCollectionName(name string) attribute.KeyValue {
+	return semconv.DBCollectionName(name)
+}
+
+// DBOperationName returns the "db.operation.name" attribute, e.g. "SELECT".
+func DBOperationName(name string) attribute.KeyValue {
+	return semconv.DBOperationName(name)
+}
+
+// DBQueryText returns the "db.query.text" attribute. The query should not contain sensitive
+// values.
+func DBQueryText(query string) attribute.KeyValue {
+	return semconv.DBQueryText(query)
+}
+
+// MessagingSystem returns the "messaging.system" attribute, e.g. "kafka" or "rabbitmq".
+func MessagingSystem(system string) attribute.KeyValue {
+	return MessagingSystemKey.String(system)
+}
+
+// MessagingDestinationName returns the "messaging.destination.name" attribute, the topic or
+// queue.
+func MessagingDestinationName(name string) attribute.KeyValue {
+	return semconv.MessagingDestinationName(name)
+}
+
+// MessagingOperationType returns the "messaging.operation.type" attribute, e.g. "publish" or
+// "process".
+func MessagingOperationType(operationType string) attribute.KeyValue {
+	return MessagingOperationTypeKey.String(operationType)
+}
+
+// MessagingOperationName returns the "messaging.operation.name" attribute.
+func MessagingOperationName(name string) attribute.KeyValue {
+	return semconv.MessagingOperationName(name)
+}
+
+// MessagingMessageID returns the "messaging.message.id" attribute.
+func MessagingMessageID(id string) attribute.KeyValue {
+	return semconv.MessagingMessageID(id)
+}
+
+// RPCSystem returns the "rpc.system" attribute, e.g. "grpc".
+func RPCSystem(system string) attribute.KeyValue {
+	return RPCSystemKey.String(system)
+}
+
+// RPCService returns the "rpc.service" attribute, the full service name.
+func RPCService(service string) attribute.KeyValue {
+	return semconv.RPCService(service)
+}
+
+// RPCMethod returns the "rpc.method" attribute.
+func RPCMethod(method string) attribute.KeyValue {
+	return semconv.RPCMethod(method)
+}
@@ -0,0 +1,53 @@
+package semconvhelpers
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestSemconvhelpers_Semconvhelpers_Builders(t *testing.T) {
+	tests := []struct {
+		name string
+		got  attribute.KeyValue
+		want attribute.KeyValue
+	}{
+		{"http method", HTTPMethod("GET"), attribute.String("http.request.method", "GET")},
+		{"http status code", HTTPResponseStatusCode(404), attribute.Int("http.response.status_code", 404)},
+		{"http route", HTTPRoute("/orders/{id}"), attribute.String("http.route", "/orders/{id}")},
+		{"url full", URLFull("https://example.com/a?b=c"), attribute.String("url.full", "https://example.com/a?b=c")},
+		{"url path", URLPath("/a"), attribute.String("url.path", "/a")},
+		{"url scheme", URLScheme("https"), attribute.String("url.scheme", "https")},
+		{"server address", ServerAddress("example.com"), attribute.String("server.address", "example.com")},
+		{"server port", ServerPort(443), attribute.Int("server.port", 443)},
+		{"user agent", UserAgentOriginal("curl/8.0"), attribute.String("user_agent.original", "curl/8.0")},
+		{"error type", ErrorType("timeout"), attribute.String("error.type", "timeout")},
+		{"network protocol", NetworkProtocolName("http"), attribute.String("network.protocol.name", "http")},
+		{"db system", DBSystem("postgresql"), attribute.String("db.system", "postgresql")},
+		{"db namespace", DBNamespace("shop"), attribute.String("db.namespace", "shop")},
+		{"db collection", DBCollectionName("orders"), attribute.String("db.collection.name", "orders")},
+		{"db operation", DBOperationName("SELECT"), attribute.String("db.operation.name", "SELECT")},
+		{"db query", DBQueryText("SELECT 1"), attribute.String("db.query.text", "SELECT 1")},
+		{"messaging system", MessagingSystem("kafka"), attribute.String("messaging.system", "kafka")},
+		{"messaging destination", MessagingDestinationName("orders"), attribute.String("messaging.destination.name", "orders")},
+		{"messaging operation type", MessagingOperationType("publish"), attribute.String("messaging.operation.type", "publish")},
+		{"messaging operation name", MessagingOperationName("send"), attribute.String("messaging.operation.name", "send")},
+		{"messaging message id", MessagingMessageID("m-1"), attribute.String("messaging.message.id", "m-1")},
+		{"rpc system", RPCSystem("grpc"), attribute.String("rpc.system", "grpc")},
+		{"rpc service", RPCService("shop.Orders"), attribute.String("rpc.service", "shop.Orders")},
+		{"rpc method", RPCMethod("Get"), attribute.String("rpc.method", "Get")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSemconvhelpers_Semconvhelpers_SchemaURL(t *testing.T) {
+	if want := "https://opentelemetry.io/schemas/1.26.0"; SchemaURL != want {
+		t.Errorf("SchemaURL = %q, want %q", SchemaURL, want)
+	}
+}
@@ -0,0 +1,192 @@
+package monitoring
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/adityakw90/go-monitoring/internal/tracer"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+)
+
+// Objective is a latency service level objective: at least Target of the requests to Route
+// complete within Threshold.
+type Objective struct {
+	Route     string        // Route is the route the objective applies to, as "<method> <route>" (e.g. "GET /orders/{id}"), a bare route, or any operation name passed to Observe.
+	Threshold time.Duration // Threshold is the latency a request must not exceed to count as a success.
+	Target    float64       // Target is the fraction of requests meant to succeed, e.g. 0.99.
+}
+
+// objectiveState is an Objective with its running request counts.
+type objectiveState struct {
+	Objective
+	labels     []attribute.KeyValue
+	successes  atomic.Int64
+	violations atomic.Int64
+}
+
+// SLOTracker records latency SLO metrics for a fixed set of objectives, labelled with the
+// objective's "route" and "threshold_ms":
+//   - slo_success_total: counter of requests completed within the threshold
+//   - slo_violation_total: counter of requests that exceeded the threshold
+//   - slo_error_budget_burn_percent: gauge of the error budget burn rate since the tracker was
+//     created, in percent; 100 means the violations consume the budget exactly as fast as the
+//     target allows, above 100 the objective will be missed if the rate persists
+//
+// An SLOTracker is safe for concurrent use.
+type SLOTracker struct {
+	monitoring *Monitoring
+	objectives map[string][]*objectiveState
+	successes  otelmetric.Int64Counter
+	violations otelmetric.Int64Counter
+	burnRate   otelmetric.Int64Gauge
+}
+
+// NewSLOTracker creates an SLOTracker for the given objectives.
+// Several objectives may share a route, e.g. to track both a p90 and a p99 latency target.
+// The SLO metrics are created once here so that Observe only records values.
+//
+// Parameters:
+//   - objectives: The latency objectives to track
+//
+// Returns ErrSLORouteRequired, ErrSLOThresholdInvalid, or ErrSLOTargetInvalid for an invalid
+// objective, or an error if any of the SLO metrics cannot be created.
+//
+// Example:
+//
+//	slo, err := mon.NewSLOTracker(
+//	    Objective{Route: "GET /orders/{id}", Threshold: 300 * time.Millisecond, Target: 0.99},
+//	    Objective{Route: "POST /orders", Threshold: time.Second, Target: 0.995},
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	http.ListenAndServe(":8080", slo.Middleware(mux))
+func (m *Monitoring) NewSLOTracker(objectives ...Objective) (*SLOTracker, error) {
+	s := &SLOTracker{
+		monitoring: m,
+		objectives: make(map[string][]*objectiveState, len(objectives)),
+	}
+	for _, objective := range objectives {
+		switch {
+		case objective.Route == "":
+			return nil, ErrSLORouteRequired
+		case objective.Threshold <= 0:
+			return nil, ErrSLOThresholdInvalid
+		case objective.Target <= 0 || objective.Target >= 1:
+			return nil, ErrSLOTargetInvalid
+		}
+		s.objectives[objective.Route] = append(s.objectives[objective.Route], &objectiveState{
+			Objective: objective,
+			labels: []attribute.KeyValue{
+				attribute.String("route", objective.Route),
+				attribute.Int64("threshold_ms", objective.Threshold.Milliseconds()),
+			},
+		})
+	}
+	if m.Metric == nil {
+		return s, nil
+	}
+
+	var err error
+	if s.successes, err = m.Metric.CreateCounter("slo_success_total", "1", "Total number of requests completed within the SLO latency threshold"); err != nil {
+		return nil, err
+	}
+	if s.violations, err = m.Metric.CreateCounter("slo_violation_total", "1", "Total number of requests exceeding the SLO latency threshold"); err != nil {
+		return nil, err
+	}
+	if s.burnRate, err = m.Metric.CreateGauge("slo_error_budget_burn_percent", "%", "SLO error budget burn rate in percent of the sustainable rate"); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Observe records a request to route that took latency against every objective for route.
+// Routes without an objective are ignored.
+//
+// Parameters:
+//   - ctx: The request context, used to attach exemplars
+//   - route: The route or operation, matched exactly against Objective.Route
+//   - latency: How long the request took
+//
+// Example:
+//
+//	start := time.Now()
+//	resp, err := client.GetOrder(ctx, req)
+//	slo.Observe(ctx, "/orders.OrderService/GetOrder", time.Since(start))
+func (s *SLOTracker) Observe(ctx context.Context, route string, latency time.Duration) {
+	for _, objective := range s.objectives[route] {
+		s.record(ctx, objective, latency)
+	}
+}
+
+// Middleware returns an http.Handler that times every request served by next and observes it
+// against the objectives matching its http.ServeMux route, first as "<method> <route>" and
+// then as the bare route. Requests that were not routed by a ServeMux are not observed. The
+// route is also found when next is a Monitoring.HTTPMiddleware wrapping the ServeMux.
+//
+// Example:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("GET /orders/{id}", getOrder)
+//	http.ListenAndServe(":8080", slo.Middleware(mux))
+func (s *SLOTracker) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, pattern := withRoutePattern(r)
+		start := s.monitoring.now()
+		next.ServeHTTP(w, r)
+		latency := s.monitoring.now().Sub(start)
+
+		// ServeMux sets the pattern on the request it was given while routing, and
+		// HTTPMiddleware reports the pattern of the copy it routes.
+		if r.Pattern == "" {
+			r.Pattern = *pattern
+		}
+		route := tracer.HTTPRoute(r)
+		if route == "" {
+			return
+		}
+		if _, ok := s.objectives[r.Method+" "+route]; ok {
+			s.Observe(r.Context(), r.Method+" "+route, latency)
+			return
+		}
+		s.Observe(r.Context(), route, latency)
+	})
+}
+
+// record counts one request against objective and updates its burn rate.
+func (s *SLOTracker) record(ctx context.Context, objective *objectiveState, latency time.Duration) {
+	var successes, violations int64
+	if latency <= objective.Threshold {
+		successes = objective.successes.Add(1)
+		violations = objective.violations.Load()
+	} else {
+		violations = objective.violations.Add(1)
+		successes = objective.successes.Load()
+	}
+
+	m := s.monitoring
+	if m.Metric == nil || s.burnRate == nil {
+		return
+	}
+	if latency <= objective.Threshold {
+		m.Metric.RecordCounter(ctx, s.successes, 1, objective.labels...)
+	} else {
+		m.Metric.RecordCounter(ctx, s.violations, 1, objective.labels...)
+	}
+	m.Metric.RecordGauge(ctx, s.burnRate, burnRatePercent(successes, violations, objective.Target), objective.labels...)
+}
+
+// burnRatePercent returns the share of violations relative to the share target allows, in
+// percent and rounded to the nearest integer.
+func burnRatePercent(successes, violations int64, target float64) int64 {
+	total := successes + violations
+	if total == 0 {
+		return 0
+	}
+	violationRatio := float64(violations) / float64(total)
+	return int64(math.Round(violationRatio / (1 - target) * 100))
+}
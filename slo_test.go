@@ -0,0 +1,205 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+)
+
+// sloMetric is a Metric that remembers the SLO counter totals and the last burn rate per route.
+type sloMetric struct {
+	Metric
+	mu         sync.Mutex
+	successes  otelmetric.Int64Counter
+	successful map[string]int64
+	violated   map[string]int64
+	burnRate   map[string]int64
+}
+
+func (s *sloMetric) RecordCounter(ctx context.Context, counter otelmetric.Int64Counter, value int64, labels ...attribute.KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	route := encodeLabels(labels...)
+	if counter == s.successes {
+		s.successful[route] += value
+	} else {
+		s.violated[route] += value
+	}
+}
+
+func (s *sloMetric) RecordGauge(ctx context.Context, gauge otelmetric.Int64Gauge, value int64, labels ...attribute.KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.burnRate[encodeLabels(labels...)] = value
+}
+
+// newSLOMonitoring returns a Monitoring on clk whose Metric records SLO values in the returned sloMetric.
+func newSLOMonitoring(t *testing.T, clk Clock) (*Monitoring, *sloMetric) {
+	t.Helper()
+	mon, err := NewMonitoring(WithServiceName("test-service"), WithClock(clk))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mon.Shutdown(context.Background())
+	})
+	recorder := &sloMetric{
+		Metric:     mon.Metric,
+		successful: map[string]int64{},
+		violated:   map[string]int64{},
+		burnRate:   map[string]int64{},
+	}
+	mon.Metric = recorder
+	return mon, recorder
+}
+
+// sloLabels returns the encoded SLO labels of route and threshold.
+func sloLabels(route string, threshold time.Duration) string {
+	return encodeLabels(
+		attribute.String("route", route),
+		attribute.Int64("threshold_ms", threshold.Milliseconds()),
+	)
+}
+
+// encodeLabels returns labels encoded as a comparable map key.
+func encodeLabels(labels ...attribute.KeyValue) string {
+	set := attribute.NewSet(labels...)
+	return set.Encoded(attribute.DefaultEncoder())
+}
+
+func TestMonitoring_SLO_NewSLOTracker(t *testing.T) {
+	tests := []struct {
+		name      string
+		objective Objective
+		wantErr   error
+	}{
+		{"valid", Objective{Route: "GET /orders", Threshold: time.Second, Target: 0.99}, nil},
+		{"missing route", Objective{Threshold: time.Second, Target: 0.99}, ErrSLORouteRequired},
+		{"zero threshold", Objective{Route: "GET /orders", Target: 0.99}, ErrSLOThresholdInvalid},
+		{"zero target", Objective{Route: "GET /orders", Threshold: time.Second}, ErrSLOTargetInvalid},
+		{"target of one", Objective{Route: "GET /orders", Threshold: time.Second, Target: 1}, ErrSLOTargetInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, _ := newSLOMonitoring(t, nil)
+			_, err := mon.NewSLOTracker(tt.objective)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewSLOTracker() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMonitoring_SLO_Observe(t *testing.T) {
+	mon, recorder := newSLOMonitoring(t, nil)
+	slo, err := mon.NewSLOTracker(
+		Objective{Route: "checkout", Threshold: 100 * time.Millisecond, Target: 0.9},
+		Objective{Route: "checkout", Threshold: 500 * time.Millisecond, Target: 0.99},
+	)
+	if err != nil {
+		t.Fatalf("NewSLOTracker() error = %v", err)
+	}
+	recorder.successes = slo.successes
+
+	for _, latency := range []time.Duration{50, 80, 100, 200, 900} {
+		slo.Observe(context.Background(), "checkout", latency*time.Millisecond)
+	}
+	slo.Observe(context.Background(), "untracked", time.Hour)
+
+	tests := []struct {
+		threshold      time.Duration
+		wantSuccesses  int64
+		wantViolations int64
+		wantBurnRate   int64
+	}{
+		// 2 of 5 violate a 10% budget: the budget burns 4 times as fast as sustainable.
+		{100 * time.Millisecond, 3, 2, 400},
+		// 1 of 5 violates a 1% budget.
+		{500 * time.Millisecond, 4, 1, 2000},
+	}
+	for _, tt := range tests {
+		labels := sloLabels("checkout", tt.threshold)
+		if got := recorder.successful[labels]; got != tt.wantSuccesses {
+			t.Errorf("slo_success_total{threshold %v} = %d, want %d", tt.threshold, got, tt.wantSuccesses)
+		}
+		if got := recorder.violated[labels]; got != tt.wantViolations {
+			t.Errorf("slo_violation_total{threshold %v} = %d, want %d", tt.threshold, got, tt.wantViolations)
+		}
+		if got := recorder.burnRate[labels]; got != tt.wantBurnRate {
+			t.Errorf("slo_error_budget_burn_percent{threshold %v} = %d, want %d", tt.threshold, got, tt.wantBurnRate)
+		}
+	}
+	if len(recorder.burnRate) != 2 {
+		t.Errorf("expected burn rates for 2 objectives, got %v", recorder.burnRate)
+	}
+}
+
+func TestMonitoring_SLO_Middleware(t *testing.T) {
+	clk := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	mon, recorder := newSLOMonitoring(t, clk)
+	slo, err := mon.NewSLOTracker(
+		Objective{Route: "GET /orders/{id}", Threshold: 300 * time.Millisecond, Target: 0.99},
+		Objective{Route: "/orders", Threshold: time.Second, Target: 0.99},
+	)
+	if err != nil {
+		t.Fatalf("NewSLOTracker() error = %v", err)
+	}
+	recorder.successes = slo.successes
+
+	latencies := map[string]time.Duration{"/orders/1": 100 * time.Millisecond, "/orders/2": 400 * time.Millisecond, "/orders": 2 * time.Second, "/unrouted": time.Hour}
+	mux := http.NewServeMux()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		clk.Advance(latencies[r.URL.Path])
+	}
+	mux.HandleFunc("GET /orders/{id}", handler)
+	mux.HandleFunc("POST /orders", handler)
+	server := slo.Middleware(mux)
+
+	server.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders/1", nil))
+	server.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders/2", nil))
+	server.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/orders", nil))
+	server.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/unrouted", nil))
+
+	byID := sloLabels("GET /orders/{id}", 300*time.Millisecond)
+	if recorder.successful[byID] != 1 || recorder.violated[byID] != 1 {
+		t.Errorf("GET /orders/{id} successes = %d, violations = %d, want 1, 1", recorder.successful[byID], recorder.violated[byID])
+	}
+	bare := sloLabels("/orders", time.Second)
+	if recorder.successful[bare] != 0 || recorder.violated[bare] != 1 {
+		t.Errorf("/orders successes = %d, violations = %d, want 0, 1", recorder.successful[bare], recorder.violated[bare])
+	}
+}
+
+func TestMonitoring_SLO_MiddlewareNesting(t *testing.T) {
+	clk := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	mon, recorder := newSLOMonitoring(t, clk)
+	slo, err := mon.NewSLOTracker(Objective{Route: "GET /orders/{id}", Threshold: 300 * time.Millisecond, Target: 0.99})
+	if err != nil {
+		t.Fatalf("NewSLOTracker() error = %v", err)
+	}
+	recorder.successes = slo.successes
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orders/{id}", func(w http.ResponseWriter, r *http.Request) {
+		clk.Advance(100 * time.Millisecond)
+	})
+	handlers := map[string]http.Handler{
+		"slo outside": slo.Middleware(mon.HTTPMiddleware(mux)),
+		"slo inside":  mon.HTTPMiddleware(slo.Middleware(mux)),
+	}
+	for name, handler := range handlers {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders/1", nil))
+		if got := recorder.successful[sloLabels("GET /orders/{id}", 300*time.Millisecond)]; got != 1 {
+			t.Errorf("%s: successes = %d, want 1", name, got)
+		}
+		recorder.successful = map[string]int64{}
+	}
+}
@@ -0,0 +1,28 @@
+package monitoring
+
+import (
+	"context"
+
+	"github.com/adityakw90/go-monitoring/internal/tracer"
+)
+
+// TraceID128 returns the trace ID of the span in ctx in its full 128-bit form, 32 hexadecimal
+// digits as in the W3C trace context, or "" if ctx holds no valid span context.
+//
+// Example:
+//
+//	w.Header().Set("X-Trace-Id", TraceID128(ctx))
+func TraceID128(ctx context.Context) string {
+	return tracer.TraceID128(ctx)
+}
+
+// TraceID64 returns the low 64 bits of the trace ID of the span in ctx, 16 hexadecimal digits
+// as legacy 64-bit tracing backends store it, or "" if ctx holds no valid span context. With
+// WithTracerTraceID64 the two forms identify the same trace.
+//
+// Example:
+//
+//	legacyClient.SetTraceID(TraceID64(ctx))
+func TraceID64(ctx context.Context) string {
+	return tracer.TraceID64(ctx)
+}
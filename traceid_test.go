@@ -0,0 +1,48 @@
+package monitoring
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestMonitoring_TraceID_TraceID64(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantZeros bool
+	}{
+		{"128-bit trace ids", nil, false},
+		{"64-bit trace ids", []Option{WithTracerTraceID64(true)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mon, err := NewMonitoring(append([]Option{WithServiceName("test-service")}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("NewMonitoring() error = %v", err)
+			}
+			defer func() {
+				_ = mon.Shutdown(context.Background())
+			}()
+
+			ctx, span := mon.Tracer.StartSpan(context.Background(), "checkout")
+			defer mon.Tracer.EndSpan(span)
+
+			full, low := TraceID128(ctx), TraceID64(ctx)
+			if len(full) != 32 || len(low) != 16 || !strings.HasSuffix(full, low) {
+				t.Fatalf("TraceID128() = %q, TraceID64() = %q, want the low 64 bits of the same ID", full, low)
+			}
+			if got := strings.HasPrefix(full, "0000000000000000"); got != tt.wantZeros {
+				t.Errorf("TraceID128() = %q, zero high bits = %v, want %v", full, got, tt.wantZeros)
+			}
+		})
+	}
+
+	if got := TraceID128(context.Background()); got != "" {
+		t.Errorf("TraceID128() without span = %q, want empty", got)
+	}
+	if got := TraceID64(context.Background()); got != "" {
+		t.Errorf("TraceID64() without span = %q, want empty", got)
+	}
+}
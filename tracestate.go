@@ -0,0 +1,60 @@
+package monitoring
+
+import (
+	"context"
+
+	"github.com/adityakw90/go-monitoring/internal/tracer"
+)
+
+// TraceStateValue returns the value of the W3C tracestate entry key carried by the span
+// context in ctx, e.g. a sampling hint set by an upstream service, or "" if it is absent.
+//
+// Parameters:
+//   - ctx: The context holding the span (local or extracted from a request)
+//   - key: The tracestate key, usually a vendor name
+//
+// Example:
+//
+//	if TraceStateValue(ctx, "acme") == "priority:high" {
+//	    // keep verbose diagnostics for this request
+//	}
+func TraceStateValue(ctx context.Context, key string) string {
+	return tracer.TraceStateValue(ctx, key)
+}
+
+// ContextWithTraceStateEntry returns a copy of ctx whose span context carries the W3C
+// tracestate entry key=value, so services can take part in the spec's vendor mechanism
+// (adaptive sampling hints, priority flags). The entry is moved to the front of the tracestate,
+// as the spec requires for updated entries. Spans started from the returned context inherit
+// it and Tracer.InjectContext sends it downstream; the span already in ctx keeps its original
+// tracestate but remains reachable and recording through the returned context.
+//
+// Parameters:
+//   - ctx: The context holding the span
+//   - key: The tracestate key, e.g. a vendor name ("acme") or a tenant-qualified key ("tenant@acme")
+//   - value: The value, printable ASCII without "," or "="
+//
+// Returns ErrTracerTraceStateEntryInvalid if key or value is not valid, or
+// ErrTracerTraceStateSpanRequired if ctx holds no valid span context; ctx is returned unchanged.
+//
+// Example:
+//
+//	ctx, err := ContextWithTraceStateEntry(ctx, "acme", "priority:high")
+//	if err != nil {
+//	    return err
+//	}
+//	md := mon.Tracer.InjectContext(ctx) // tracestate: acme=priority:high
+func ContextWithTraceStateEntry(ctx context.Context, key, value string) (context.Context, error) {
+	return tracer.ContextWithTraceStateEntry(ctx, key, value)
+}
+
+// ContextWithoutTraceStateEntry returns a copy of ctx whose span context no longer carries the
+// W3C tracestate entry key, so it is not propagated further. It returns ctx unchanged if the
+// entry is absent.
+//
+// Example:
+//
+//	ctx = ContextWithoutTraceStateEntry(ctx, "acme")
+func ContextWithoutTraceStateEntry(ctx context.Context, key string) context.Context {
+	return tracer.ContextWithoutTraceStateEntry(ctx, key)
+}
@@ -0,0 +1,43 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMonitoring_TraceState_ContextWithTraceStateEntry(t *testing.T) {
+	mon, err := NewMonitoring(WithServiceName("test-service"))
+	if err != nil {
+		t.Fatalf("NewMonitoring() error = %v", err)
+	}
+	defer func() {
+		_ = mon.Shutdown(context.Background())
+	}()
+
+	ctx, span := mon.Tracer.StartSpan(context.Background(), "checkout")
+	defer mon.Tracer.EndSpan(span)
+
+	ctx, err = ContextWithTraceStateEntry(ctx, "acme", "priority:high")
+	if err != nil {
+		t.Fatalf("ContextWithTraceStateEntry() error = %v", err)
+	}
+	if got := TraceStateValue(ctx, "acme"); got != "priority:high" {
+		t.Errorf("TraceStateValue() = %q, want %q", got, "priority:high")
+	}
+	if got := mon.Tracer.InjectContext(ctx).Get("tracestate"); len(got) != 1 || got[0] != "acme=priority:high" {
+		t.Errorf("injected tracestate = %v, want acme=priority:high", got)
+	}
+
+	ctx = ContextWithoutTraceStateEntry(ctx, "acme")
+	if got := TraceStateValue(ctx, "acme"); got != "" {
+		t.Errorf("TraceStateValue() after removal = %q, want empty", got)
+	}
+
+	if _, err := ContextWithTraceStateEntry(ctx, "acme", "a=b"); !errors.Is(err, ErrTracerTraceStateEntryInvalid) {
+		t.Errorf("invalid value: error = %v, want ErrTracerTraceStateEntryInvalid", err)
+	}
+	if _, err := ContextWithTraceStateEntry(context.Background(), "acme", "x"); !errors.Is(err, ErrTracerTraceStateSpanRequired) {
+		t.Errorf("without span: error = %v, want ErrTracerTraceStateSpanRequired", err)
+	}
+}